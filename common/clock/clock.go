@@ -0,0 +1,53 @@
+package clock
+
+import "time"
+
+// Clock tells the time and creates tickers. Consensus engines, block producers and the simulated network read the
+// time through a Clock so that the wall clock can be replaced by a virtual one in tests
+type Clock interface {
+	Now() time.Time
+	NewTicker(d time.Duration) Ticker
+	After(d time.Duration) <-chan time.Time
+}
+
+// Ticker delivers the time on its channel at every interval until it is stopped
+type Ticker interface {
+	Chan() <-chan time.Time
+	Stop()
+}
+
+type realClock struct{}
+
+type realTicker struct {
+	ticker *time.Ticker
+}
+
+// NewRealClock returns a clock that follows the wall clock
+func NewRealClock() Clock {
+	return realClock{}
+}
+
+// Now returns the current wall clock time
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// NewTicker returns a ticker that fires every d of wall clock time
+func (realClock) NewTicker(d time.Duration) Ticker {
+	return &realTicker{time.NewTicker(d)}
+}
+
+// After returns a channel that receives the time once d of wall clock time has passed
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// Chan returns the channel on which the ticks are delivered
+func (t *realTicker) Chan() <-chan time.Time {
+	return t.ticker.C
+}
+
+// Stop turns off the ticker
+func (t *realTicker) Stop() {
+	t.ticker.Stop()
+}
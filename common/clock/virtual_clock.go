@@ -0,0 +1,137 @@
+package clock
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// VirtualClock is a clock that only moves when it is advanced. Tickers and timers fire in the order of their due
+// time while the clock is advanced, so that a run driven by a VirtualClock is reproducible
+type VirtualClock struct {
+	now    time.Time
+	timers []*virtualTimer
+	seq    uint64
+	mutex  sync.Mutex
+}
+
+type virtualTimer struct {
+	clock    *VirtualClock
+	due      time.Time
+	interval time.Duration
+	seq      uint64
+	ch       chan time.Time
+}
+
+// NewVirtualClock returns a virtual clock that starts at start
+func NewVirtualClock(start time.Time) *VirtualClock {
+	return &VirtualClock{now: start}
+}
+
+// Now returns the current virtual time
+func (c *VirtualClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.now
+}
+
+// NewTicker returns a ticker that fires every d of virtual time
+func (c *VirtualClock) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("clock: non-positive interval for NewTicker")
+	}
+	return c.addTimer(d, d)
+}
+
+// After returns a channel that receives the virtual time once the clock was advanced by d
+func (c *VirtualClock) After(d time.Duration) <-chan time.Time {
+	return c.addTimer(d, 0).ch
+}
+
+// Advance moves the clock forward by d and fires the tickers and timers that become due, one at a time in the order
+// of their due time. A tick is dropped if the previous one was not received yet, as with time.Ticker
+func (c *VirtualClock) Advance(d time.Duration) {
+	c.mutex.Lock()
+	end := c.now.Add(d)
+	c.mutex.Unlock()
+
+	for {
+		c.mutex.Lock()
+		timer := c.nextTimer(end)
+		if timer == nil {
+			c.now = end
+			c.mutex.Unlock()
+			return
+		}
+		c.now = timer.due
+		if timer.interval > 0 {
+			timer.due = timer.due.Add(timer.interval)
+		} else {
+			c.removeTimer(timer)
+		}
+		now := c.now
+		c.mutex.Unlock()
+
+		select {
+		case timer.ch <- now:
+		default:
+		}
+	}
+}
+
+// NumOfTimers returns the number of tickers and timers that have not fired or stopped yet
+func (c *VirtualClock) NumOfTimers() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return len(c.timers)
+}
+
+func (c *VirtualClock) addTimer(d time.Duration, interval time.Duration) *virtualTimer {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.seq++
+	timer := &virtualTimer{
+		clock:    c,
+		due:      c.now.Add(d),
+		interval: interval,
+		seq:      c.seq,
+		ch:       make(chan time.Time, 1),
+	}
+	c.timers = append(c.timers, timer)
+	return timer
+}
+
+// nextTimer returns the timer that is due first, but not later than end; nil if there is none
+func (c *VirtualClock) nextTimer(end time.Time) *virtualTimer {
+	sort.SliceStable(c.timers, func(i, j int) bool {
+		if !c.timers[i].due.Equal(c.timers[j].due) {
+			return c.timers[i].due.Before(c.timers[j].due)
+		}
+		return c.timers[i].seq < c.timers[j].seq
+	})
+	if len(c.timers) == 0 || c.timers[0].due.After(end) {
+		return nil
+	}
+	return c.timers[0]
+}
+
+func (c *VirtualClock) removeTimer(timer *virtualTimer) {
+	for i, t := range c.timers {
+		if t == timer {
+			c.timers = append(c.timers[:i], c.timers[i+1:]...)
+			return
+		}
+	}
+}
+
+// Chan returns the channel on which the ticks are delivered
+func (t *virtualTimer) Chan() <-chan time.Time {
+	return t.ch
+}
+
+// Stop turns off the ticker
+func (t *virtualTimer) Stop() {
+	t.clock.mutex.Lock()
+	defer t.clock.mutex.Unlock()
+	t.clock.removeTimer(t)
+}
@@ -0,0 +1,48 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVirtualClock_Advance(t *testing.T) {
+	start := time.Unix(1000, 0)
+	c := NewVirtualClock(start)
+	ticker := c.NewTicker(time.Second)
+	timer := c.After(1500 * time.Millisecond)
+	assert.Equal(t, 2, c.NumOfTimers())
+
+	c.Advance(999 * time.Millisecond)
+	assert.Equal(t, start.Add(999*time.Millisecond), c.Now())
+	assert.Empty(t, ticker.Chan())
+	assert.Empty(t, timer)
+
+	c.Advance(time.Millisecond)
+	assert.Equal(t, start.Add(time.Second), <-ticker.Chan())
+
+	// the timer fires once, ticks that are not received are dropped
+	c.Advance(2 * time.Second)
+	assert.Equal(t, start.Add(1500*time.Millisecond), <-timer)
+	assert.Equal(t, start.Add(2*time.Second), <-ticker.Chan())
+	assert.Empty(t, ticker.Chan())
+	assert.Equal(t, 1, c.NumOfTimers())
+	assert.Equal(t, start.Add(3*time.Second), c.Now())
+
+	ticker.Stop()
+	c.Advance(time.Second)
+	assert.Empty(t, ticker.Chan())
+	assert.Equal(t, 0, c.NumOfTimers())
+}
+
+func TestRealClock(t *testing.T) {
+	c := NewRealClock()
+	before := time.Now()
+	assert.False(t, c.Now().Before(before))
+
+	ticker := c.NewTicker(time.Millisecond)
+	defer ticker.Stop()
+	assert.True(t, (<-ticker.Chan()).After(before))
+	assert.True(t, (<-c.After(time.Millisecond)).After(before))
+}
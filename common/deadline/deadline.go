@@ -1,17 +1,31 @@
 package deadline
 
-import "time"
+import (
+	"time"
+
+	"github.com/dappley/go-dappley/common/clock"
+)
 
 const NanoSecsInMilliSec = 1000000
 
 type Deadline struct {
 	deadlineInMs int64
+	clock        clock.Clock
 }
 
 //NewDeadline creates a new deadline instance
 func NewDeadline(deadlineInMs int64) Deadline {
 	return Deadline{
 		deadlineInMs,
+		nil,
+	}
+}
+
+//NewDeadlineWithClock creates a new deadline instance that is checked against the time of c
+func NewDeadlineWithClock(deadlineInMs int64, c clock.Clock) Deadline {
+	return Deadline{
+		deadlineInMs,
+		c,
 	}
 }
 
@@ -19,10 +33,15 @@ func NewDeadline(deadlineInMs int64) Deadline {
 func NewUnlimitedDeadline() Deadline {
 	return Deadline{
 		0,
+		nil,
 	}
 }
 
 //IsPassed returns if the deadline is passed
 func (d Deadline) IsPassed() bool {
-	return d.deadlineInMs > 0 && time.Now().UnixNano()/NanoSecsInMilliSec >= d.deadlineInMs
+	now := time.Now()
+	if d.clock != nil {
+		now = d.clock.Now()
+	}
+	return d.deadlineInMs > 0 && now.UnixNano()/NanoSecsInMilliSec >= d.deadlineInMs
 }
@@ -1,6 +1,7 @@
 package deadline
 
 import (
+	"github.com/dappley/go-dappley/common/clock"
 	"github.com/stretchr/testify/assert"
 	"testing"
 	"time"
@@ -46,3 +47,11 @@ func TestDeadline_IsPassed(t *testing.T) {
 		})
 	}
 }
+
+func TestDeadline_IsPassedWithClock(t *testing.T) {
+	c := clock.NewVirtualClock(time.Unix(100, 0))
+	deadline := NewDeadlineWithClock(c.Now().UnixNano()/NanoSecsInMilliSec+1000, c)
+	assert.False(t, deadline.IsPassed())
+	c.Advance(time.Second)
+	assert.True(t, deadline.IsPassed())
+}
@@ -80,8 +80,10 @@ type ConsensusConfig struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MinerAddress string `protobuf:"bytes,1,opt,name=miner_address,json=minerAddress,proto3" json:"miner_address,omitempty"`
-	PrivateKey   string `protobuf:"bytes,2,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	MinerAddress string   `protobuf:"bytes,1,opt,name=miner_address,json=minerAddress,proto3" json:"miner_address,omitempty"`
+	PrivateKey   string   `protobuf:"bytes,2,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	Engine       string   `protobuf:"bytes,3,opt,name=engine,proto3" json:"engine,omitempty"`   // dpos, dev or poa. dpos is used if it is not set
+	Signers      []string `protobuf:"bytes,4,rep,name=signers,proto3" json:"signers,omitempty"` // the signers of the poa engine. The genesis producers are used if it is empty
 }
 
 func (x *ConsensusConfig) Reset() {
@@ -130,21 +132,41 @@ func (x *ConsensusConfig) GetPrivateKey() string {
 	return ""
 }
 
+func (x *ConsensusConfig) GetEngine() string {
+	if x != nil {
+		return x.Engine
+	}
+	return ""
+}
+
+func (x *ConsensusConfig) GetSigners() []string {
+	if x != nil {
+		return x.Signers
+	}
+	return nil
+}
+
 type NodeConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Port                   uint32   `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
-	Seed                   []string `protobuf:"bytes,2,rep,name=seed,proto3" json:"seed,omitempty"`
-	DbPath                 string   `protobuf:"bytes,3,opt,name=db_path,json=dbPath,proto3" json:"db_path,omitempty"`
-	RpcPort                uint32   `protobuf:"varint,4,opt,name=rpc_port,json=rpcPort,proto3" json:"rpc_port,omitempty"`
-	Key                    string   `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`
-	TxPoolLimit            uint32   `protobuf:"varint,6,opt,name=tx_pool_limit,json=txPoolLimit,proto3" json:"tx_pool_limit,omitempty"`
-	BlkSizeLimit           uint32   `protobuf:"varint,7,opt,name=blk_size_limit,json=blkSizeLimit,proto3" json:"blk_size_limit,omitempty"`
-	GenesisPath            string   `protobuf:"bytes,9,opt,name=genesis_path,json=genesisPath,proto3" json:"genesis_path,omitempty"`
-	MetricsPollingInterval int64    `protobuf:"varint,12,opt,name=metrics_polling_interval,json=metricsPollingInterval,proto3" json:"metrics_polling_interval,omitempty"` // seconds
-	MetricsInterval        int64    `protobuf:"varint,13,opt,name=metrics_interval,json=metricsInterval,proto3" json:"metrics_interval,omitempty"`                        // seconds
+	Port                   uint32              `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	Seed                   []string            `protobuf:"bytes,2,rep,name=seed,proto3" json:"seed,omitempty"`
+	DbPath                 string              `protobuf:"bytes,3,opt,name=db_path,json=dbPath,proto3" json:"db_path,omitempty"`
+	RpcPort                uint32              `protobuf:"varint,4,opt,name=rpc_port,json=rpcPort,proto3" json:"rpc_port,omitempty"`
+	Key                    string              `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`
+	TxPoolLimit            uint32              `protobuf:"varint,6,opt,name=tx_pool_limit,json=txPoolLimit,proto3" json:"tx_pool_limit,omitempty"`
+	BlkSizeLimit           uint32              `protobuf:"varint,7,opt,name=blk_size_limit,json=blkSizeLimit,proto3" json:"blk_size_limit,omitempty"` // deprecated: the block size limit is a chain parameter set in the genesis configuration
+	GenesisPath            string              `protobuf:"bytes,9,opt,name=genesis_path,json=genesisPath,proto3" json:"genesis_path,omitempty"`
+	MetricsPollingInterval int64               `protobuf:"varint,12,opt,name=metrics_polling_interval,json=metricsPollingInterval,proto3" json:"metrics_polling_interval,omitempty"` // seconds
+	MetricsInterval        int64               `protobuf:"varint,13,opt,name=metrics_interval,json=metricsInterval,proto3" json:"metrics_interval,omitempty"`                        // seconds
+	Gossip                 bool                `protobuf:"varint,14,opt,name=gossip,proto3" json:"gossip,omitempty"`                                                                 // relay blocks and transactions by libp2p gossipsub instead of flooding them to all peers
+	SnapshotSync           *SnapshotSyncConfig `protobuf:"bytes,15,opt,name=snapshot_sync,json=snapshotSync,proto3" json:"snapshot_sync,omitempty"`
+	Discovery              *DiscoveryConfig    `protobuf:"bytes,16,opt,name=discovery,proto3" json:"discovery,omitempty"`
+	Psk                    string              `protobuf:"bytes,17,opt,name=psk,proto3" json:"psk,omitempty"`                                          // the hex of the 32 byte pre-shared key of a private network. The node joins the public network if it is not set
+	PeerAllowlist          []string            `protobuf:"bytes,18,rep,name=peer_allowlist,json=peerAllowlist,proto3" json:"peer_allowlist,omitempty"` // the ids of the only peers that the node connects to. All peers are allowed if it is empty
+	PeerDenylist           []string            `protobuf:"bytes,19,rep,name=peer_denylist,json=peerDenylist,proto3" json:"peer_denylist,omitempty"`    // the ids of the peers that the node never connects to
 }
 
 func (x *NodeConfig) Reset() {
@@ -249,19 +271,209 @@ func (x *NodeConfig) GetMetricsInterval() int64 {
 	return 0
 }
 
+func (x *NodeConfig) GetGossip() bool {
+	if x != nil {
+		return x.Gossip
+	}
+	return false
+}
+
+func (x *NodeConfig) GetSnapshotSync() *SnapshotSyncConfig {
+	if x != nil {
+		return x.SnapshotSync
+	}
+	return nil
+}
+
+func (x *NodeConfig) GetDiscovery() *DiscoveryConfig {
+	if x != nil {
+		return x.Discovery
+	}
+	return nil
+}
+
+func (x *NodeConfig) GetPsk() string {
+	if x != nil {
+		return x.Psk
+	}
+	return ""
+}
+
+func (x *NodeConfig) GetPeerAllowlist() []string {
+	if x != nil {
+		return x.PeerAllowlist
+	}
+	return nil
+}
+
+func (x *NodeConfig) GetPeerDenylist() []string {
+	if x != nil {
+		return x.PeerDenylist
+	}
+	return nil
+}
+
+type SnapshotSyncConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled          bool   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`                                           // download the checkpoint state snapshot instead of all blocks if the node has no blocks
+	Interval         uint64 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`                                         // the number of blocks between two served snapshots. The default interval is used if it is not set
+	CheckpointHeight uint64 `protobuf:"varint,3,opt,name=checkpoint_height,json=checkpointHeight,proto3" json:"checkpoint_height,omitempty"` // the height of the trusted snapshot. It is required and must be a multiple of the interval of the peers
+	CheckpointRoot   string `protobuf:"bytes,4,opt,name=checkpoint_root,json=checkpointRoot,proto3" json:"checkpoint_root,omitempty"`        // the hex root of the trusted snapshot. It is required
+}
+
+func (x *SnapshotSyncConfig) Reset() {
+	*x = SnapshotSyncConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotSyncConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotSyncConfig) ProtoMessage() {}
+
+func (x *SnapshotSyncConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotSyncConfig.ProtoReflect.Descriptor instead.
+func (*SnapshotSyncConfig) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SnapshotSyncConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SnapshotSyncConfig) GetInterval() uint64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *SnapshotSyncConfig) GetCheckpointHeight() uint64 {
+	if x != nil {
+		return x.CheckpointHeight
+	}
+	return 0
+}
+
+func (x *SnapshotSyncConfig) GetCheckpointRoot() string {
+	if x != nil {
+		return x.CheckpointRoot
+	}
+	return ""
+}
+
+type DiscoveryConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mdns         bool   `protobuf:"varint,1,opt,name=mdns,proto3" json:"mdns,omitempty"`                                     // find the peers of the local network by multicast DNS
+	Dht          bool   `protobuf:"varint,2,opt,name=dht,proto3" json:"dht,omitempty"`                                       // find the peers of the chain through a Kademlia DHT bootstrapped from the seeds
+	MdnsInterval uint32 `protobuf:"varint,3,opt,name=mdns_interval,json=mdnsInterval,proto3" json:"mdns_interval,omitempty"` // seconds between two multicast DNS queries. The default interval is used if it is not set
+	DhtInterval  uint32 `protobuf:"varint,4,opt,name=dht_interval,json=dhtInterval,proto3" json:"dht_interval,omitempty"`    // seconds between two DHT lookups. The default interval is used if it is not set
+}
+
+func (x *DiscoveryConfig) Reset() {
+	*x = DiscoveryConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscoveryConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscoveryConfig) ProtoMessage() {}
+
+func (x *DiscoveryConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscoveryConfig.ProtoReflect.Descriptor instead.
+func (*DiscoveryConfig) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DiscoveryConfig) GetMdns() bool {
+	if x != nil {
+		return x.Mdns
+	}
+	return false
+}
+
+func (x *DiscoveryConfig) GetDht() bool {
+	if x != nil {
+		return x.Dht
+	}
+	return false
+}
+
+func (x *DiscoveryConfig) GetMdnsInterval() uint32 {
+	if x != nil {
+		return x.MdnsInterval
+	}
+	return 0
+}
+
+func (x *DiscoveryConfig) GetDhtInterval() uint32 {
+	if x != nil {
+		return x.DhtInterval
+	}
+	return 0
+}
+
 type DynastyConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Producers    []string `protobuf:"bytes,1,rep,name=producers,proto3" json:"producers,omitempty"`
-	MaxProducers uint32   `protobuf:"varint,2,opt,name=max_producers,json=maxProducers,proto3" json:"max_producers,omitempty"`
+	Producers           []string              `protobuf:"bytes,1,rep,name=producers,proto3" json:"producers,omitempty"`
+	MaxProducers        uint32                `protobuf:"varint,2,opt,name=max_producers,json=maxProducers,proto3" json:"max_producers,omitempty"`
+	BlockGasLimit       uint64                `protobuf:"varint,3,opt,name=block_gas_limit,json=blockGasLimit,proto3" json:"block_gas_limit,omitempty"`                   // the default block gas limit is used if it is not set
+	MonetaryPolicy      *MonetaryPolicyConfig `protobuf:"bytes,4,opt,name=monetary_policy,json=monetaryPolicy,proto3" json:"monetary_policy,omitempty"`                   // a constant reward without supply cap is used if it is not set
+	VrfActivationHeight uint64                `protobuf:"varint,5,opt,name=vrf_activation_height,json=vrfActivationHeight,proto3" json:"vrf_activation_height,omitempty"` // every block from this height on must carry a VRF proof
+	BlkSizeLimit        uint32                `protobuf:"varint,6,opt,name=blk_size_limit,json=blkSizeLimit,proto3" json:"blk_size_limit,omitempty"`                      // kB. The initial block size limit; 1024 kB is used if it is not set
+	GasPriceFloor       uint64                `protobuf:"varint,7,opt,name=gas_price_floor,json=gasPriceFloor,proto3" json:"gas_price_floor,omitempty"`                   // the initial minimum gas price of a contract transaction
+	MaxMissedRounds     uint32                `protobuf:"varint,8,opt,name=max_missed_rounds,json=maxMissedRounds,proto3" json:"max_missed_rounds,omitempty"`             // producers that mint no block in this many rounds in a row are removed from the dynasty. 0 never removes them
 }
 
 func (x *DynastyConfig) Reset() {
 	*x = DynastyConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[3]
+		mi := &file_config_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -274,7 +486,7 @@ func (x *DynastyConfig) String() string {
 func (*DynastyConfig) ProtoMessage() {}
 
 func (x *DynastyConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[3]
+	mi := &file_config_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -287,7 +499,7 @@ func (x *DynastyConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DynastyConfig.ProtoReflect.Descriptor instead.
 func (*DynastyConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{3}
+	return file_config_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *DynastyConfig) GetProducers() []string {
@@ -304,6 +516,135 @@ func (x *DynastyConfig) GetMaxProducers() uint32 {
 	return 0
 }
 
+func (x *DynastyConfig) GetBlockGasLimit() uint64 {
+	if x != nil {
+		return x.BlockGasLimit
+	}
+	return 0
+}
+
+func (x *DynastyConfig) GetMonetaryPolicy() *MonetaryPolicyConfig {
+	if x != nil {
+		return x.MonetaryPolicy
+	}
+	return nil
+}
+
+func (x *DynastyConfig) GetVrfActivationHeight() uint64 {
+	if x != nil {
+		return x.VrfActivationHeight
+	}
+	return 0
+}
+
+func (x *DynastyConfig) GetBlkSizeLimit() uint32 {
+	if x != nil {
+		return x.BlkSizeLimit
+	}
+	return 0
+}
+
+func (x *DynastyConfig) GetGasPriceFloor() uint64 {
+	if x != nil {
+		return x.GasPriceFloor
+	}
+	return 0
+}
+
+func (x *DynastyConfig) GetMaxMissedRounds() uint32 {
+	if x != nil {
+		return x.MaxMissedRounds
+	}
+	return 0
+}
+
+type MonetaryPolicyConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InitialReward  string `protobuf:"bytes,1,opt,name=initial_reward,json=initialReward,proto3" json:"initial_reward,omitempty"`       // the reward of the genesis block. The default subsidy is used if it is not set
+	Curve          string `protobuf:"bytes,2,opt,name=curve,proto3" json:"curve,omitempty"`                                            // constant, halving or decay
+	Interval       uint64 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`                                     // the number of blocks between two reward reductions
+	DecayPercent   uint64 `protobuf:"varint,4,opt,name=decay_percent,json=decayPercent,proto3" json:"decay_percent,omitempty"`         // the percentage of the reward kept after each interval of the decay curve
+	SupplyCap      string `protobuf:"bytes,5,opt,name=supply_cap,json=supplyCap,proto3" json:"supply_cap,omitempty"`                   // the maximum number of coins ever minted. The supply is not limited if it is not set
+	FeeBurnPercent uint64 `protobuf:"varint,6,opt,name=fee_burn_percent,json=feeBurnPercent,proto3" json:"fee_burn_percent,omitempty"` // the percentage of the gas fees that is burnt instead of rewarded
+}
+
+func (x *MonetaryPolicyConfig) Reset() {
+	*x = MonetaryPolicyConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MonetaryPolicyConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MonetaryPolicyConfig) ProtoMessage() {}
+
+func (x *MonetaryPolicyConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MonetaryPolicyConfig.ProtoReflect.Descriptor instead.
+func (*MonetaryPolicyConfig) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MonetaryPolicyConfig) GetInitialReward() string {
+	if x != nil {
+		return x.InitialReward
+	}
+	return ""
+}
+
+func (x *MonetaryPolicyConfig) GetCurve() string {
+	if x != nil {
+		return x.Curve
+	}
+	return ""
+}
+
+func (x *MonetaryPolicyConfig) GetInterval() uint64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *MonetaryPolicyConfig) GetDecayPercent() uint64 {
+	if x != nil {
+		return x.DecayPercent
+	}
+	return 0
+}
+
+func (x *MonetaryPolicyConfig) GetSupplyCap() string {
+	if x != nil {
+		return x.SupplyCap
+	}
+	return ""
+}
+
+func (x *MonetaryPolicyConfig) GetFeeBurnPercent() uint64 {
+	if x != nil {
+		return x.FeeBurnPercent
+	}
+	return 0
+}
+
 type CliConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -317,7 +658,7 @@ type CliConfig struct {
 func (x *CliConfig) Reset() {
 	*x = CliConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_proto_msgTypes[4]
+		mi := &file_config_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -330,7 +671,7 @@ func (x *CliConfig) String() string {
 func (*CliConfig) ProtoMessage() {}
 
 func (x *CliConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[4]
+	mi := &file_config_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -343,7 +684,7 @@ func (x *CliConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CliConfig.ProtoReflect.Descriptor instead.
 func (*CliConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{4}
+	return file_config_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CliConfig) GetAddress() string {
@@ -380,44 +721,113 @@ var file_config_proto_rawDesc = []byte{
 	0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
 	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x70, 0x62, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x22, 0x57, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x65,
-	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
-	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x22, 0xcc, 0x02, 0x0a, 0x0a, 0x4e, 0x6f,
-	0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x64, 0x62, 0x50, 0x61, 0x74, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x70, 0x63,
-	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x70, 0x63,
-	0x50, 0x6f, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x78, 0x5f, 0x70, 0x6f, 0x6f,
-	0x6c, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74,
-	0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x6c,
-	0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0c, 0x62, 0x6c, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x12, 0x21, 0x0a, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50,
-	0x61, 0x74, 0x68, 0x12, 0x38, 0x0a, 0x18, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x70,
-	0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x50, 0x6f,
-	0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x29, 0x0a,
-	0x10, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
-	0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x52, 0x0a, 0x0d, 0x44, 0x79, 0x6e, 0x61,
-	0x73, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x70,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
-	0x6d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x22, 0x55, 0x0a, 0x09,
-	0x43, 0x6c, 0x69, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x69, 0x6e,
+	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x4a, 0x04, 0x08, 0x05,
+	0x10, 0x06, 0x22, 0xbe, 0x04, 0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x70, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x22, 0x0a, 0x0d, 0x74, 0x78, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x6c, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x62, 0x6c, 0x6b,
+	0x53, 0x69, 0x7a, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x38, 0x0a, 0x18,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x70, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x67, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x67, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x12, 0x41, 0x0a, 0x0d, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x70, 0x62, 0x2e, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c,
+	0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x37, 0x0a, 0x09,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x70, 0x62, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x64, 0x69, 0x73, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x73, 0x6b, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x70, 0x73, 0x6b, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x65, 0x65, 0x72, 0x5f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x12, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0d, 0x70, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x6e, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x13, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x65, 0x65, 0x72, 0x44, 0x65, 0x6e, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x22, 0xa6, 0x01, 0x0a, 0x12, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x53, 0x79, 0x6e, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x12, 0x2b, 0x0a, 0x11, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x27, 0x0a,
+	0x0f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0x7f, 0x0a, 0x0f,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x12, 0x0a, 0x04, 0x6d, 0x64, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6d,
+	0x64, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x03, 0x64, 0x68, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x64, 0x6e, 0x73, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x64,
+	0x6e, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x68,
+	0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x64, 0x68, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0xf1, 0x02,
+	0x0a, 0x0d, 0x44, 0x79, 0x6e, 0x61, 0x73, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65,
+	0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x67, 0x61, 0x73, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x6d, 0x6f,
+	0x6e, 0x65, 0x74, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x70, 0x62, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x74, 0x61, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x0e, 0x6d, 0x6f, 0x6e, 0x65, 0x74, 0x61, 0x72, 0x79, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x32, 0x0a, 0x15, 0x76, 0x72, 0x66, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x13, 0x76, 0x72, 0x66, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x6c, 0x6b, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0c, 0x62, 0x6c, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x26, 0x0a,
+	0x0f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x6c, 0x6f, 0x6f, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x69, 0x73,
+	0x73, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0f, 0x6d, 0x61, 0x78, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x73, 0x22, 0xdd, 0x01, 0x0a, 0x14, 0x4d, 0x6f, 0x6e, 0x65, 0x74, 0x61, 0x72, 0x79, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x65, 0x77, 0x61, 0x72,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x75, 0x72, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x63, 0x75, 0x72, 0x76, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65, 0x63, 0x61, 0x79, 0x5f, 0x70, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x65, 0x63, 0x61,
+	0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x70, 0x70,
+	0x6c, 0x79, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x75,
+	0x70, 0x70, 0x6c, 0x79, 0x43, 0x61, 0x70, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x65, 0x65, 0x5f, 0x62,
+	0x75, 0x72, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x66, 0x65, 0x65, 0x42, 0x75, 0x72, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x22, 0x55, 0x0a, 0x09, 0x43, 0x6c, 0x69, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -432,22 +842,28 @@ func file_config_proto_rawDescGZIP() []byte {
 	return file_config_proto_rawDescData
 }
 
-var file_config_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_config_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_config_proto_goTypes = []interface{}{
-	(*Config)(nil),          // 0: configpb.Config
-	(*ConsensusConfig)(nil), // 1: configpb.ConsensusConfig
-	(*NodeConfig)(nil),      // 2: configpb.NodeConfig
-	(*DynastyConfig)(nil),   // 3: configpb.DynastyConfig
-	(*CliConfig)(nil),       // 4: configpb.CliConfig
+	(*Config)(nil),               // 0: configpb.Config
+	(*ConsensusConfig)(nil),      // 1: configpb.ConsensusConfig
+	(*NodeConfig)(nil),           // 2: configpb.NodeConfig
+	(*SnapshotSyncConfig)(nil),   // 3: configpb.SnapshotSyncConfig
+	(*DiscoveryConfig)(nil),      // 4: configpb.DiscoveryConfig
+	(*DynastyConfig)(nil),        // 5: configpb.DynastyConfig
+	(*MonetaryPolicyConfig)(nil), // 6: configpb.MonetaryPolicyConfig
+	(*CliConfig)(nil),            // 7: configpb.CliConfig
 }
 var file_config_proto_depIdxs = []int32{
 	1, // 0: configpb.Config.consensus_config:type_name -> configpb.ConsensusConfig
 	2, // 1: configpb.Config.node_config:type_name -> configpb.NodeConfig
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 2: configpb.NodeConfig.snapshot_sync:type_name -> configpb.SnapshotSyncConfig
+	4, // 3: configpb.NodeConfig.discovery:type_name -> configpb.DiscoveryConfig
+	6, // 4: configpb.DynastyConfig.monetary_policy:type_name -> configpb.MonetaryPolicyConfig
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_config_proto_init() }
@@ -493,7 +909,7 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DynastyConfig); i {
+			switch v := v.(*SnapshotSyncConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -505,6 +921,42 @@ func file_config_proto_init() {
 			}
 		}
 		file_config_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscoveryConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DynastyConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MonetaryPolicyConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CliConfig); i {
 			case 0:
 				return &v.state
@@ -523,7 +975,7 @@ func file_config_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,177 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package consensus
+
+import (
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+
+	"github.com/dappley/go-dappley/common/clock"
+	"github.com/dappley/go-dappley/common/deadline"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/core/blockproducerinfo"
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/logic/transactionpool"
+)
+
+// DevEngine is a single-node consensus engine for local development. It seals a block as soon as a transaction
+// arrives and treats every block as irreversible
+type DevEngine struct {
+	producer    *blockproducerinfo.BlockProducerInfo
+	producerKey string
+	dynasty     *Dynasty
+	txPool      *transactionpool.TransactionPool
+	txCh        chan bool
+	stopCh      chan bool
+	clock       clock.Clock
+}
+
+// NewDevEngine returns a new dev engine that seals blocks with the local producer
+func NewDevEngine(producer *blockproducerinfo.BlockProducerInfo) *DevEngine {
+	return &DevEngine{
+		producer: producer,
+		dynasty:  NewDynastyWithConfigProducers([]string{producer.Beneficiary()}, 1),
+		txCh:     make(chan bool, 1),
+		stopCh:   make(chan bool, 1),
+		clock:    clock.NewRealClock(),
+	}
+}
+
+// SetClock sets the clock that drives block production
+func (dev *DevEngine) SetClock(c clock.Clock) {
+	dev.clock = c
+}
+
+// SetKey sets the producer key
+func (dev *DevEngine) SetKey(key string) {
+	dev.producerKey = key
+}
+
+// SetTxPool subscribes the engine to new transactions of the pool
+func (dev *DevEngine) SetTxPool(txPool *transactionpool.TransactionPool) {
+	dev.txPool = txPool
+	txPool.EventBus.Subscribe(transactionpool.NewTransactionTopic, dev.onNewTransaction)
+}
+
+// onNewTransaction wakes up the block production
+func (dev *DevEngine) onNewTransaction(tx *transaction.Transaction) {
+	select {
+	case dev.txCh <- true:
+	default:
+	}
+}
+
+// SetDynasty sets the dynasty
+func (dev *DevEngine) SetDynasty(dynasty *Dynasty) {
+	dev.dynasty = dynasty
+}
+
+// GetDynasty returns the dynasty
+func (dev *DevEngine) GetDynasty() *Dynasty {
+	return dev.dynasty
+}
+
+// GetProducers returns the local producer
+func (dev *DevEngine) GetProducers() []string {
+	return []string{dev.producer.Beneficiary()}
+}
+
+// GetProducerAddress returns the local producer's address
+func (dev *DevEngine) GetProducerAddress() string {
+	return dev.producer.Beneficiary()
+}
+
+// Stop stops the current produce block process
+func (dev *DevEngine) Stop() {
+	logger.Info("DevEngine stops...")
+	dev.stopCh <- true
+}
+
+// ProduceBlock seals a block once a transaction arrives. Transactions left in the pool are picked up every second
+func (dev *DevEngine) ProduceBlock(ProduceBlockFunc func(process func(*block.Block), deadline deadline.Deadline)) {
+	ticker := dev.clock.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-dev.txCh:
+		case <-ticker.Chan():
+			if dev.txPool == nil || dev.txPool.GetNumOfTxInPool() == 0 {
+				continue
+			}
+		case <-dev.stopCh:
+			return
+		}
+		dl := deadline.NewDeadlineWithClock(dev.clock.Now().UnixNano()/deadline.NanoSecsInMilliSec+maxMintingTimeInMs, dev.clock)
+		ProduceBlockFunc(dev.hashAndSign, dl)
+		return
+	}
+}
+
+// IsProducedLocally returns if the local producer produced the block
+func (dev *DevEngine) IsProducedLocally(blk *block.Block) bool {
+	if blk != nil {
+		return dev.producer.Produced(blk)
+	}
+	return false
+}
+
+// hashAndSign signs the block
+func (dev *DevEngine) hashAndSign(blk *block.Block) {
+	signBlock(blk, dev.producerKey)
+}
+
+// Validate checks that the block is signed by the local producer
+func (dev *DevEngine) Validate(blk *block.Block) bool {
+	if blk == nil || blk.GetHash() == nil {
+		logger.Warn("DevEngine: block is empty!")
+		return false
+	}
+
+	signer, err := blockSigner(blk)
+	if err != nil || signer != dev.producer.Beneficiary() {
+		logger.Warn("DevEngine: the block is not signed by the local producer.")
+		return false
+	}
+
+	return isBeneficiary(blk, dev.producer.Beneficiary())
+}
+
+// ChangeDynasty does nothing since the dev engine has a single producer
+func (dev *DevEngine) ChangeDynasty(height uint64) {}
+
+// IsElectionEnabled returns false since the dev engine has a single producer
+func (dev *DevEngine) IsElectionEnabled() bool {
+	return false
+}
+
+// GetMinConfirmationNum returns 0 so that every block is irreversible once it is added
+func (dev *DevEngine) GetMinConfirmationNum() int {
+	return 0
+}
+
+// IsBypassingLibCheck returns true since there is only one producer
+func (dev *DevEngine) IsBypassingLibCheck() bool {
+	return true
+}
+
+// GetTotalProducersNum returns the total number of producers
+func (dev *DevEngine) GetTotalProducersNum() int {
+	return 1
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package consensus
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/dappley/go-dappley/common/deadline"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/core/blockproducerinfo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDevEngine_ProduceBlock(t *testing.T) {
+	producer := account.NewAccount()
+	dev := NewDevEngine(blockproducerinfo.NewBlockProducerInfo(producer.GetAddress().String()))
+	dev.SetKey(hex.EncodeToString(producer.GetKeyPair().GetPrivateKeyBytes()))
+
+	sealed := make(chan *block.Block, 1)
+	go dev.ProduceBlock(func(process func(*block.Block), deadline deadline.Deadline) {
+		blk := newSignedBlock(producer, time.Now().Unix())
+		process(blk)
+		sealed <- blk
+	})
+
+	// a new transaction seals a block right away
+	dev.onNewTransaction(nil)
+	select {
+	case blk := <-sealed:
+		assert.True(t, dev.Validate(blk))
+		assert.False(t, dev.Validate(newSignedBlock(account.NewAccount(), time.Now().Unix())))
+	case <-time.After(time.Second / 2):
+		t.Fatal("no block is sealed after a transaction arrives")
+	}
+
+	assert.Equal(t, 0, dev.GetMinConfirmationNum())
+	assert.True(t, dev.IsBypassingLibCheck())
+	assert.False(t, dev.IsElectionEnabled())
+}
@@ -19,10 +19,13 @@
 package consensus
 
 import (
-	"bytes"
+	"crypto/rand"
+	"io"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/dappley/go-dappley/common/clock"
 	"github.com/dappley/go-dappley/common/deadline"
 	"github.com/dappley/go-dappley/config"
 
@@ -32,6 +35,7 @@ import (
 	"github.com/dappley/go-dappley/core/blockproducerinfo"
 
 	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/core/transaction"
 	"github.com/dappley/go-dappley/logic/lblock"
 
 	"github.com/dappley/go-dappley/core/account"
@@ -50,15 +54,24 @@ type DPOS struct {
 	dynasty         *Dynasty
 	slot            *lru.Cache
 	lastProduceTime int64
-	replacement     []*DynastyReplacement
 	filePath        string
+	evidences       map[string]transaction.Transaction
+	evidenceMutex   *sync.Mutex
+	chain           ChainReader
+	seeds           *lru.Cache
+	clock           clock.Clock
+	vrfRand         io.Reader
 }
 
 //NewDPOS returns a new DPOS instance
 func NewDPOS(producer *blockproducerinfo.BlockProducerInfo) *DPOS {
 	dpos := &DPOS{
-		producer: producer,
-		stopCh:   make(chan bool, 1),
+		producer:      producer,
+		stopCh:        make(chan bool, 1),
+		evidences:     make(map[string]transaction.Transaction),
+		evidenceMutex: &sync.Mutex{},
+		clock:         clock.NewRealClock(),
+		vrfRand:       rand.Reader,
 	}
 
 	slot, err := lru.New(128)
@@ -66,6 +79,12 @@ func NewDPOS(producer *blockproducerinfo.BlockProducerInfo) *DPOS {
 		logger.Panic(err)
 	}
 	dpos.slot = slot
+
+	seeds, err := lru.New(seedCacheSize)
+	if err != nil {
+		logger.Panic(err)
+	}
+	dpos.seeds = seeds
 	return dpos
 }
 
@@ -74,6 +93,11 @@ func (dpos *DPOS) SetFilePath(path string) {
 	dpos.filePath = path
 }
 
+//SetClock sets the clock that drives block production
+func (dpos *DPOS) SetClock(c clock.Clock) {
+	dpos.clock = c
+}
+
 //SetKey sets the producer key
 func (dpos *DPOS) SetKey(key string) {
 	dpos.producerKey = key
@@ -113,13 +137,12 @@ func (dpos *DPOS) Stop() {
 
 //ProduceBlock starts producing block according to dpos consensus
 func (dpos *DPOS) ProduceBlock(ProduceBlockFunc func(process func(*block.Block), deadline deadline.Deadline)) {
-	ticker := time.NewTicker(time.Second).C
+	ticker := dpos.clock.NewTicker(time.Second)
+	defer ticker.Stop()
 	for {
 		select {
-		case now := <-ticker:
-			if dpos.dynasty.IsMyTurn(dpos.producer.Beneficiary(), now.Unix()) {
-				dl := deadline.NewDeadline(now.UnixNano()/deadline.NanoSecsInMilliSec + maxMintingTimeInMs)
-				ProduceBlockFunc(dpos.hashAndSign, dl)
+		case now := <-ticker.Chan():
+			if dpos.TryProduceBlock(now, ProduceBlockFunc) {
 				return
 			}
 		case <-dpos.stopCh:
@@ -128,6 +151,17 @@ func (dpos *DPOS) ProduceBlock(ProduceBlockFunc func(process func(*block.Block),
 	}
 }
 
+//TryProduceBlock produces a block if now is in the time slot of the local producer. It returns false if it is not the
+//local producer's turn
+func (dpos *DPOS) TryProduceBlock(now time.Time, ProduceBlockFunc func(process func(*block.Block), deadline deadline.Deadline)) bool {
+	if !dpos.isMyTurn(dpos.producer.Beneficiary(), now.Unix()) {
+		return false
+	}
+	dl := deadline.NewDeadlineWithClock(now.UnixNano()/deadline.NanoSecsInMilliSec+maxMintingTimeInMs, dpos.clock)
+	ProduceBlockFunc(dpos.hashAndSign, dl)
+	return true
+}
+
 //IsProducedLocally returns if the local producer produced the block
 func (dpos *DPOS) IsProducedLocally(blk *block.Block) bool {
 	if blk != nil {
@@ -138,23 +172,24 @@ func (dpos *DPOS) IsProducedLocally(blk *block.Block) bool {
 
 //hashAndSign signs the block
 func (dpos *DPOS) hashAndSign(blk *block.Block) {
-	hash := lblock.CalculateHash(blk)
-	blk.SetHash(hash)
-	ok := lblock.SignBlock(blk, dpos.producerKey)
-	if !ok {
-		logger.Warn("DPoS: failed to sign the new block.")
-	}
+	dpos.generateVrfProof(blk)
+	signBlock(blk, dpos.producerKey)
 }
 
 // Validate checks that the block fulfills the dpos requirement and accepts the block in the time slot
 func (dpos *DPOS) Validate(block *block.Block) bool {
-	producerIsValid := dpos.verifyProducer(block)
+	producerIsValid := dpos.VerifyProducer(block)
 	if !producerIsValid {
 		return false
 	}
 
 	if dpos.isDoubleMint(block) {
 		logger.Warn("DPoS: double-minting is detected.")
+		dpos.recordDoubleMint(block)
+		return false
+	}
+
+	if !dpos.verifySlashTransactions(block) {
 		return false
 	}
 
@@ -162,18 +197,39 @@ func (dpos *DPOS) Validate(block *block.Block) bool {
 	return true
 }
 
-// verifyProducer verifies a given block is produced by the valid producer by verifying the signature of the block
-func (dpos *DPOS) verifyProducer(block *block.Block) bool {
+// VerifyProducer verifies a given block is produced by the valid producer by verifying the signature of the block. The
+// producer of the time slot is derived from the chain that leads to the parent of the block
+func (dpos *DPOS) VerifyProducer(block *block.Block) bool {
 	if block == nil {
 		logger.Warn("DPoS: block is empty!")
 		return false
 	}
 
+	producer := dpos.producerOfBlock(block)
+	if !VerifyBlockSignature(block, producer, dpos.dynasty.GetVrfActivationHeight()) {
+		return false
+	}
+
+	if !dpos.isProducerBeneficiary(block) {
+		logger.Warn("DPoS: failed to validate producer.")
+		return false
+	}
+
+	return true
+}
+
+// VerifyHeader verifies that a block header is signed by the producer it names. The time slot of the producer depends on
+// the chain before the block, so it is only checked by VerifyProducer once the parent of the block is known
+func (dpos *DPOS) VerifyHeader(block *block.Block) bool {
+	return VerifyBlockSignature(block, block.GetProducer(), dpos.dynasty.GetVrfActivationHeight())
+}
+
+// VerifyBlockSignature verifies that a block is signed by the producer and that its VRF proof is valid. The proof is
+// required from vrfActivationHeight on. It only reads the block header, so it also verifies the headers of a light client
+func VerifyBlockSignature(block *block.Block, producer string, vrfActivationHeight uint64) bool {
 	hash := block.GetHash()
 	sign := block.GetSign()
 
-	producer := dpos.dynasty.ProducerAtATime(block.GetTimestamp())
-
 	if hash == nil {
 		logger.Warn("DPoS: block hash is empty!")
 		return false
@@ -201,12 +257,7 @@ func (dpos *DPOS) verifyProducer(block *block.Block) bool {
 		return false
 	}
 
-	if !dpos.isProducerBeneficiary(block) {
-		logger.Warn("DPoS: failed to validate producer.")
-		return false
-	}
-
-	return true
+	return verifyVrfProof(block, pubkey, block.GetHeight() >= vrfActivationHeight)
 }
 
 // isProducerBeneficiary is a requirement that ensures the reward is paid to the producer at the time slot
@@ -216,29 +267,7 @@ func (dpos *DPOS) isProducerBeneficiary(block *block.Block) bool {
 		return false
 	}
 
-	producer := dpos.dynasty.ProducerAtATime(block.GetTimestamp())
-	producerAccount := account.NewTransactionAccountByAddress(account.NewAddress(producer))
-	producerHash := producerAccount.GetPubKeyHash()
-	cbtx := block.GetCoinbaseTransaction()
-	if cbtx == nil {
-		logger.Warn("DPoS: coinbase tx is empty.")
-		return false
-	}
-
-	if len(cbtx.Vout) == 0 {
-		logger.Warn("DPoS: coinbase vout is empty.")
-		return false
-	}
-
-	if !(bytes.Compare(producerHash, cbtx.Vout[0].PubKeyHash) == 0) {
-		logger.WithFields(logger.Fields{
-			"height":             block.GetHeight(),
-			"producer(expected)": producer,
-			"producer(actual)":   cbtx.Vout[0].PubKeyHash.GenerateAddress().String(),
-		}).Warn("DPoS: coinbase out hash not right.")
-		return false
-	}
-	return true
+	return isBeneficiary(block, dpos.producerOfBlock(block))
 }
 
 //isDoubleMint returns if the block's producer has already produced a block in the current time slot
@@ -271,58 +300,10 @@ func (dpos *DPOS) GetTotalProducersNum() int {
 	return dpos.dynasty.maxProducers
 }
 
+//ChangeDynasty writes the producers of the dynasty at height to the producer config file
 func (dpos *DPOS) ChangeDynasty(height uint64) {
-	for _, r := range dpos.replacement {
-		if height == r.height {
-			newProducers := dpos.dynasty.producers
-		l:
-			for i := 0; i < len(newProducers); i++ {
-				if newProducers[i] == r.original {
-					if r.kind == 1 {
-						for _, o := range newProducers {
-							if o == r.new {
-								continue l
-							}
-						}
-						newProducers[i] = r.new
-						logger.Info("DPOS: Dynasty change ", r.original, " -> ", r.new)
-					} else if r.kind == 2 {
-						for _, o := range newProducers {
-							if o == r.new {
-								continue l
-							}
-						}
-						newProducers = append(newProducers, r.new)
-						logger.Info("DPOS: Dynasty add ", " -> ", r.new)
-					} else if r.kind == 3 {
-						newProducers = append(newProducers[:i], newProducers[i+1:]...)
-						logger.Info("DPOS: Dynasty delete ", " -> ", r.original)
-					}
-				}
-			}
-			logger.Info("DPOS:", len(newProducers))
-			dpos.dynasty.producers = newProducers
-		}
+	if dpos.filePath == "" {
+		return
 	}
 	config.UpdateProducer(dpos.filePath, dpos.dynasty.producers, height)
-
-}
-func (dpos *DPOS) AddReplacement(original, new string, height uint64, kind int) {
-	for _, p := range dpos.dynasty.producers {
-		if p == original {
-			for i := 0; i < len(dpos.replacement); i++ {
-				if dpos.replacement[i].original == p {
-					dpos.replacement[i].new = new
-					dpos.replacement[i].height = height
-					dpos.replacement[i].kind = kind
-					return
-				}
-			}
-			replacement := NewDynastyReplacement(original, new, height, kind)
-			dpos.replacement = append(dpos.replacement, replacement)
-			return
-
-		}
-	}
-
 }
@@ -19,8 +19,14 @@
 package consensus
 
 import (
+	"encoding/hex"
 	"github.com/dappley/go-dappley/logic/ltransaction"
 	"testing"
+	"time"
+
+	"github.com/dappley/go-dappley/common/clock"
+	"github.com/dappley/go-dappley/common/deadline"
+	"github.com/dappley/go-dappley/core/blockproducerinfo"
 
 	"github.com/dappley/go-dappley/core/transaction"
 
@@ -39,6 +45,43 @@ func TestNewDpos(t *testing.T) {
 	assert.Equal(t, 1, cap(dpos.stopCh))
 }
 
+func TestDPOS_TryProduceBlock(t *testing.T) {
+	producer := account.NewAccount()
+	dpos := NewDPOS(blockproducerinfo.NewBlockProducerInfo(producer.GetAddress().String()))
+	dpos.SetDynasty(NewDynasty([]string{"1MeSBgufmzwpiJNLemUe1emxAussBnz7a7", producer.GetAddress().String()}, 2, defaultTimeBetweenBlk))
+	c := clock.NewVirtualClock(time.Unix(int64(2*defaultTimeBetweenBlk), 0))
+	dpos.SetClock(c)
+
+	produced := 0
+	produce := func(process func(*block.Block), dl deadline.Deadline) {
+		produced++
+		assert.False(t, dl.IsPassed())
+	}
+
+	assert.False(t, dpos.TryProduceBlock(c.Now(), produce))
+	assert.True(t, dpos.TryProduceBlock(c.Now().Add(defaultTimeBetweenBlk*time.Second), produce))
+	assert.Equal(t, 1, produced)
+}
+
+func TestDPOS_ProduceBlockWithClock(t *testing.T) {
+	producer := account.NewAccount()
+	dpos := NewDPOS(blockproducerinfo.NewBlockProducerInfo(producer.GetAddress().String()))
+	dpos.SetDynasty(NewDynasty([]string{producer.GetAddress().String()}, 1, defaultTimeBetweenBlk))
+	c := clock.NewVirtualClock(time.Unix(int64(defaultTimeBetweenBlk)-1, 0))
+	dpos.SetClock(c)
+
+	producedAt := make(chan int64, 1)
+	go dpos.ProduceBlock(func(process func(*block.Block), dl deadline.Deadline) {
+		producedAt <- c.Now().Unix()
+	})
+	for c.NumOfTimers() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	c.Advance(time.Second)
+	assert.Equal(t, int64(defaultTimeBetweenBlk), <-producedAt)
+}
+
 func TestDpos_beneficiaryIsProducer(t *testing.T) {
 	producers := []string{
 		"121yKAXeG4cw6uaGCBYjWk9yTWmMkhcoDD",
@@ -145,3 +188,24 @@ func FakeNewBlockWithTimestamp(t int64, txs []*transaction.Transaction, parent *
 	blk.SetHash(hash)
 	return blk
 }
+
+func TestDPOS_VerifyHeader(t *testing.T) {
+	producer := account.NewAccount()
+	dpos := NewDPOS(nil)
+	dynasty := NewDynasty([]string{producer.GetAddress().String()}, 1, defaultTimeBetweenBlk)
+	dynasty.SetVrfActivationHeight(100)
+	dpos.SetDynasty(dynasty)
+
+	blk := newSignedBlock(producer, 10)
+	assert.True(t, dpos.VerifyHeader(blk))
+
+	// the header must be signed by the producer that it names
+	forged := block.NewBlockWithTimestamp(nil, nil, 10, producer.GetAddress().String())
+	forged.SetHash(lblock.CalculateHash(forged))
+	lblock.SignBlock(forged, hex.EncodeToString(account.NewAccount().GetKeyPair().GetPrivateKeyBytes()))
+	assert.False(t, dpos.VerifyHeader(forged))
+
+	// a block without signature is rejected
+	blk.SetSignature(nil)
+	assert.False(t, dpos.VerifyHeader(blk))
+}
@@ -19,26 +19,25 @@
 package consensus
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/dappley/go-dappley/core/account"
 	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/logic/lelection"
 	logger "github.com/sirupsen/logrus"
 )
 
 type Dynasty struct {
-	producers      []string
-	maxProducers   int
-	timeBetweenBlk int
-	dynastyTime    int
-}
-
-type DynastyReplacement struct {
-	original string
-	new      string
-	height   uint64
-	kind     int
+	producers           []string
+	maxProducers        int
+	timeBetweenBlk      int
+	dynastyTime         int
+	vrfActivationHeight uint64
+	maxMissedRounds     uint64
 }
 
 const (
@@ -56,15 +55,6 @@ func NewDynasty(producers []string, maxProducers, timeBetweenBlk int) *Dynasty {
 	}
 }
 
-func NewDynastyReplacement(original, new string, height uint64, kind int) *DynastyReplacement {
-	return &DynastyReplacement{
-		original: original,
-		new:      new,
-		height:   height,
-		kind:     kind,
-	}
-}
-
 //NewDynastyWithConfigProducers returns a new dynasty from config file
 func NewDynastyWithConfigProducers(producers []string, maxProducers int) *Dynasty {
 	validProducers := []string{}
@@ -113,6 +103,27 @@ func (dynasty *Dynasty) SetMaxProducers(maxProducers int) {
 	}
 }
 
+//GetVrfActivationHeight returns the height from which on every block must carry a VRF proof
+func (dynasty *Dynasty) GetVrfActivationHeight() uint64 {
+	return dynasty.vrfActivationHeight
+}
+
+//SetVrfActivationHeight sets the height from which on every block must carry a VRF proof
+func (dynasty *Dynasty) SetVrfActivationHeight(height uint64) {
+	dynasty.vrfActivationHeight = height
+}
+
+//GetMaxMissedRounds returns the number of rounds in a row without a block after which a producer is removed. 0 never
+//removes producers
+func (dynasty *Dynasty) GetMaxMissedRounds() uint64 {
+	return dynasty.maxMissedRounds
+}
+
+//SetMaxMissedRounds sets the number of rounds in a row without a block after which a producer is removed
+func (dynasty *Dynasty) SetMaxMissedRounds(maxMissedRounds uint64) {
+	dynasty.maxMissedRounds = maxMissedRounds
+}
+
 //SetTimeBetweenBlk sets the block time
 func (dynasty *Dynasty) SetTimeBetweenBlk(timeBetweenBlk int) {
 	if timeBetweenBlk > 0 {
@@ -187,9 +198,62 @@ func (dynasty *Dynasty) ProducerAtATime(time int64) string {
 	}
 	dynastyTimeElapsed := int(time % int64(dynasty.dynastyTime))
 	index := dynastyTimeElapsed / dynasty.timeBetweenBlk
+	if index >= len(dynasty.producers) {
+		return ""
+	}
 	return dynasty.producers[index]
 }
 
+//GetEpoch returns the index of the dynasty round that the input time falls in
+func (dynasty *Dynasty) GetEpoch(time int64) int64 {
+	return time / int64(dynasty.dynastyTime)
+}
+
+//ShuffleProducers returns the producers ordered by the hash of the seed and the producer address. A nil seed keeps the original order
+func (dynasty *Dynasty) ShuffleProducers(seed []byte) []string {
+	producers := make([]string, len(dynasty.producers))
+	copy(producers, dynasty.producers)
+	if seed == nil {
+		return producers
+	}
+
+	keys := make(map[string][]byte, len(producers))
+	for _, producer := range producers {
+		key := sha256.Sum256(append(append([]byte{}, seed...), []byte(producer)...))
+		keys[producer] = key[:]
+	}
+	sort.SliceStable(producers, func(i, j int) bool {
+		return bytes.Compare(keys[producers[i]], keys[producers[j]]) < 0
+	})
+	return producers
+}
+
+//IsMyTurnWithSeed returns if it is the input producer's turn to produce block when the slot order is shuffled by the seed
+func (dynasty *Dynasty) IsMyTurnWithSeed(producer string, now int64, seed []byte) bool {
+	index := -1
+	for i, m := range dynasty.ShuffleProducers(seed) {
+		if producer == m {
+			index = i
+			break
+		}
+	}
+	return dynasty.isMyTurnByIndex(index, now)
+}
+
+//ProducerAtATimeWithSeed returns the expected producer at the input time when the slot order is shuffled by the seed
+func (dynasty *Dynasty) ProducerAtATimeWithSeed(time int64, seed []byte) string {
+	if time < 0 {
+		return ""
+	}
+	producers := dynasty.ShuffleProducers(seed)
+	dynastyTimeElapsed := int(time % int64(dynasty.dynastyTime))
+	index := dynastyTimeElapsed / dynasty.timeBetweenBlk
+	if index >= len(producers) {
+		return ""
+	}
+	return producers[index]
+}
+
 //find the index of the producer. If not found, return -1
 func (dynasty *Dynasty) GetProducerIndex(producer string) int {
 	for i, m := range dynasty.producers {
@@ -200,6 +264,11 @@ func (dynasty *Dynasty) GetProducerIndex(producer string) int {
 	return -1
 }
 
+//GetTimeBetweenBlk returns the length of a time slot
+func (dynasty *Dynasty) GetTimeBetweenBlk() int {
+	return dynasty.timeBetweenBlk
+}
+
 //GetDynastyTime returns the dynasty time
 func (dynasty *Dynasty) GetDynastyTime() int {
 	return dynasty.dynastyTime
@@ -237,3 +306,53 @@ func (dynasty *Dynasty) IsSettingProducersAllowed(producers []string, maxProduce
 func (dynasty *Dynasty) SetProducers(producers []string) {
 	dynasty.producers = producers
 }
+
+//RemoveProducer removes a producer from the dynasty. It returns false if the producer is not in the dynasty
+func (dynasty *Dynasty) RemoveProducer(producer string) bool {
+	index := dynasty.GetProducerIndex(producer)
+	if index < 0 {
+		return false
+	}
+	producers := make([]string, 0, len(dynasty.producers)-1)
+	producers = append(producers, dynasty.producers[:index]...)
+	dynasty.producers = append(producers, dynasty.producers[index+1:]...)
+	logger.WithFields(logger.Fields{
+		"producer": producer,
+		"list":     dynasty.producers,
+	}).Info("Dynasty: removed a producer from list.")
+	return true
+}
+
+//ApplyChange applies a scheduled change to the dynasty. The original producer must be in the dynasty. It returns false if the change is not applicable
+func (dynasty *Dynasty) ApplyChange(original, new string, kind int) bool {
+	index := dynasty.GetProducerIndex(original)
+	if index < 0 {
+		return false
+	}
+
+	switch kind {
+	case lelection.DynastyChangeReplace:
+		if dynasty.GetProducerIndex(new) >= 0 || !account.NewTransactionAccountByAddress(account.NewAddress(new)).IsValid() {
+			return false
+		}
+		producers := make([]string, len(dynasty.producers))
+		copy(producers, dynasty.producers)
+		producers[index] = new
+		dynasty.producers = producers
+	case lelection.DynastyChangeAdd:
+		if dynasty.AddProducer(new) != nil {
+			return false
+		}
+	case lelection.DynastyChangeRemove:
+		return dynasty.RemoveProducer(original)
+	default:
+		return false
+	}
+	logger.WithFields(logger.Fields{
+		"original": original,
+		"new":      new,
+		"kind":     kind,
+		"list":     dynasty.producers,
+	}).Info("Dynasty: applied a scheduled change.")
+	return true
+}
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/logic/lelection"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -360,3 +361,64 @@ func TestDynasty_CanSetProducers(t *testing.T) {
 	// duplicate producer
 	require.Error(t, dynasty.IsSettingProducersAllowed([]string{producers[0], producers[0]}))
 }
+
+func TestDynasty_RemoveProducer(t *testing.T) {
+	producers := []string{
+		"121yKAXeG4cw6uaGCBYjWk9yTWmMkhcoDD",
+		"1MeSBgufmzwpiJNLemUe1emxAussBnz7a7",
+		"1LCn8D5W7DLV1CbKE3buuJgNJjSeoBw2ct"}
+	dynasty := NewDynasty(append([]string{}, producers...), len(producers), DefaultTimeBetweenBlockIfNoneGiven)
+
+	assert.False(t, dynasty.RemoveProducer("dGDrVKjCG3sdXtDUgWZ7Fp3Q97tLhqWivf"))
+	assert.True(t, dynasty.RemoveProducer(producers[1]))
+	assert.Equal(t, []string{producers[0], producers[2]}, dynasty.GetProducers())
+
+	// the time slot of the removed seat has no producer
+	assert.Equal(t, "", dynasty.ProducerAtATime(int64(2*DefaultTimeBetweenBlockIfNoneGiven)))
+}
+
+func TestDynasty_ApplyChange(t *testing.T) {
+	producers := []string{
+		account.NewAccount().GetAddress().String(),
+		account.NewAccount().GetAddress().String(),
+		account.NewAccount().GetAddress().String()}
+	newProducer := account.NewAccount().GetAddress().String()
+	dynasty := NewDynasty(append([]string{}, producers...), 4, DefaultTimeBetweenBlockIfNoneGiven)
+
+	// the original producer must be in the dynasty and the new producer must not
+	assert.False(t, dynasty.ApplyChange(newProducer, producers[0], lelection.DynastyChangeReplace))
+	assert.False(t, dynasty.ApplyChange(producers[0], producers[1], lelection.DynastyChangeReplace))
+	assert.False(t, dynasty.ApplyChange(producers[0], "invalid", lelection.DynastyChangeAdd))
+	assert.False(t, dynasty.ApplyChange(producers[0], newProducer, 0))
+
+	assert.True(t, dynasty.ApplyChange(producers[0], newProducer, lelection.DynastyChangeReplace))
+	assert.Equal(t, []string{newProducer, producers[1], producers[2]}, dynasty.GetProducers())
+	assert.True(t, dynasty.ApplyChange(producers[1], producers[0], lelection.DynastyChangeAdd))
+	assert.Equal(t, []string{newProducer, producers[1], producers[2], producers[0]}, dynasty.GetProducers())
+	assert.True(t, dynasty.ApplyChange(producers[2], "", lelection.DynastyChangeRemove))
+	assert.Equal(t, []string{newProducer, producers[1], producers[0]}, dynasty.GetProducers())
+}
+
+func TestDynasty_ShuffleProducers(t *testing.T) {
+	producers := []string{
+		"121yKAXeG4cw6uaGCBYjWk9yTWmMkhcoDD",
+		"1MeSBgufmzwpiJNLemUe1emxAussBnz7a7",
+		"1LCn8D5W7DLV1CbKE3buuJgNJjSeoBw2ct"}
+	dynasty := NewDynasty(producers, len(producers), DefaultTimeBetweenBlockIfNoneGiven)
+
+	// a nil seed keeps the round-robin order
+	assert.Equal(t, producers, dynasty.ShuffleProducers(nil))
+	assert.Equal(t, dynasty.ProducerAtATime(0), dynasty.ProducerAtATimeWithSeed(0, nil))
+
+	// the order only depends on the seed
+	shuffled := dynasty.ShuffleProducers([]byte("seed"))
+	assert.Equal(t, shuffled, dynasty.ShuffleProducers([]byte("seed")))
+	assert.ElementsMatch(t, producers, shuffled)
+	assert.Equal(t, producers, dynasty.GetProducers())
+
+	for i, producer := range shuffled {
+		now := int64(i * DefaultTimeBetweenBlockIfNoneGiven)
+		assert.Equal(t, producer, dynasty.ProducerAtATimeWithSeed(now, []byte("seed")))
+		assert.True(t, dynasty.IsMyTurnWithSeed(producer, now, []byte("seed")))
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package consensus
+
+import (
+	"bytes"
+
+	logger "github.com/sirupsen/logrus"
+
+	"github.com/dappley/go-dappley/common/clock"
+	"github.com/dappley/go-dappley/common/deadline"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/logic/lblock"
+)
+
+const (
+	EngineDPOS = "dpos"
+	EngineDev  = "dev"
+	EnginePoA  = "poa"
+)
+
+// Engine decides when the local node produces a block, which blocks are valid and when a block becomes irreversible
+type Engine interface {
+	ProduceBlock(ProduceBlockFunc func(process func(*block.Block), deadline deadline.Deadline))
+	Validate(blk *block.Block) bool
+	Stop()
+	IsProducedLocally(blk *block.Block) bool
+	GetProducerAddress() string
+	GetProducers() []string
+	SetDynasty(dynasty *Dynasty)
+	GetDynasty() *Dynasty
+	ChangeDynasty(height uint64)
+	GetMinConfirmationNum() int
+	IsBypassingLibCheck() bool
+	GetTotalProducersNum() int
+	SetClock(c clock.Clock)
+}
+
+// signBlock hashes the block and signs it with the producer key
+func signBlock(blk *block.Block, key string) {
+	hash := lblock.CalculateHash(blk)
+	blk.SetHash(hash)
+	ok := lblock.SignBlock(blk, key)
+	if !ok {
+		logger.Warn("Consensus: failed to sign the new block.")
+	}
+}
+
+// isBeneficiary returns if the coinbase of the block pays the reward to the producer
+func isBeneficiary(blk *block.Block, producer string) bool {
+	if blk == nil {
+		logger.Warn("Consensus: block is empty.")
+		return false
+	}
+
+	producerAccount := account.NewTransactionAccountByAddress(account.NewAddress(producer))
+	producerHash := producerAccount.GetPubKeyHash()
+	cbtx := blk.GetCoinbaseTransaction()
+	if cbtx == nil {
+		logger.Warn("Consensus: coinbase tx is empty.")
+		return false
+	}
+
+	if len(cbtx.Vout) == 0 {
+		logger.Warn("Consensus: coinbase vout is empty.")
+		return false
+	}
+
+	if !(bytes.Compare(producerHash, cbtx.Vout[0].PubKeyHash) == 0) {
+		logger.WithFields(logger.Fields{
+			"height":             blk.GetHeight(),
+			"producer(expected)": producer,
+			"producer(actual)":   cbtx.Vout[0].PubKeyHash.GenerateAddress().String(),
+		}).Warn("Consensus: coinbase out hash not right.")
+		return false
+	}
+	return true
+}
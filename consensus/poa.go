@@ -0,0 +1,176 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package consensus
+
+import (
+	"time"
+
+	logger "github.com/sirupsen/logrus"
+
+	"github.com/dappley/go-dappley/common/clock"
+	"github.com/dappley/go-dappley/common/deadline"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/core/blockproducerinfo"
+)
+
+// PoA is a proof-of-authority consensus engine. A fixed set of signers from the config takes turns to produce blocks
+type PoA struct {
+	producer    *blockproducerinfo.BlockProducerInfo
+	producerKey string
+	dynasty     *Dynasty
+	stopCh      chan bool
+	clock       clock.Clock
+}
+
+// NewPoA returns a new PoA instance with the signers
+func NewPoA(producer *blockproducerinfo.BlockProducerInfo, signers []string) *PoA {
+	return &PoA{
+		producer: producer,
+		dynasty:  NewDynastyWithConfigProducers(signers, len(signers)),
+		stopCh:   make(chan bool, 1),
+		clock:    clock.NewRealClock(),
+	}
+}
+
+// SetClock sets the clock that drives block production
+func (poa *PoA) SetClock(c clock.Clock) {
+	poa.clock = c
+}
+
+// SetKey sets the producer key
+func (poa *PoA) SetKey(key string) {
+	poa.producerKey = key
+}
+
+// SetDynasty sets the dynasty
+func (poa *PoA) SetDynasty(dynasty *Dynasty) {
+	poa.dynasty = dynasty
+}
+
+// GetDynasty returns the dynasty
+func (poa *PoA) GetDynasty() *Dynasty {
+	return poa.dynasty
+}
+
+// GetProducers returns all signers
+func (poa *PoA) GetProducers() []string {
+	return poa.dynasty.GetProducers()
+}
+
+// ProducerAtATime returns the signer of the time slot
+func (poa *PoA) ProducerAtATime(time int64) string {
+	return poa.dynasty.ProducerAtATime(time)
+}
+
+// GetProducerAddress returns the local producer's address
+func (poa *PoA) GetProducerAddress() string {
+	return poa.producer.Beneficiary()
+}
+
+// Stop stops the current produce block process
+func (poa *PoA) Stop() {
+	logger.Info("PoA stops...")
+	poa.stopCh <- true
+}
+
+// ProduceBlock starts producing block in the time slot of the local signer
+func (poa *PoA) ProduceBlock(ProduceBlockFunc func(process func(*block.Block), deadline deadline.Deadline)) {
+	ticker := poa.clock.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case now := <-ticker.Chan():
+			if poa.dynasty.IsMyTurn(poa.producer.Beneficiary(), now.Unix()) {
+				dl := deadline.NewDeadlineWithClock(now.UnixNano()/deadline.NanoSecsInMilliSec+maxMintingTimeInMs, poa.clock)
+				ProduceBlockFunc(poa.hashAndSign, dl)
+				return
+			}
+		case <-poa.stopCh:
+			return
+		}
+	}
+}
+
+// IsProducedLocally returns if the local producer produced the block
+func (poa *PoA) IsProducedLocally(blk *block.Block) bool {
+	if blk != nil {
+		return poa.producer.Produced(blk)
+	}
+	return false
+}
+
+// hashAndSign signs the block
+func (poa *PoA) hashAndSign(blk *block.Block) {
+	signBlock(blk, poa.producerKey)
+}
+
+// Validate checks that the block is signed by the signer of its time slot
+func (poa *PoA) Validate(blk *block.Block) bool {
+	if blk == nil || blk.GetHash() == nil {
+		logger.Warn("PoA: block is empty!")
+		return false
+	}
+
+	signer, err := blockSigner(blk)
+	if err != nil {
+		logger.WithError(err).Warn("PoA: cannot recover the signer of the block.")
+		return false
+	}
+
+	expected := poa.dynasty.ProducerAtATime(blk.GetTimestamp())
+	if signer != expected {
+		logger.WithFields(logger.Fields{
+			"signer(expected)": expected,
+			"signer(actual)":   signer,
+		}).Warn("PoA: the signer is not the signer in this time slot.")
+		return false
+	}
+
+	for _, tx := range blk.GetTransactions() {
+		if tx.IsSlash() {
+			logger.Warn("PoA: slash transactions are not supported.")
+			return false
+		}
+	}
+
+	return isBeneficiary(blk, expected)
+}
+
+// ChangeDynasty does nothing since the signers are fixed by the config
+func (poa *PoA) ChangeDynasty(height uint64) {}
+
+// IsElectionEnabled returns false since the signers are fixed by the config
+func (poa *PoA) IsElectionEnabled() bool {
+	return false
+}
+
+// GetMinConfirmationNum returns the number of signers that makes a majority
+func (poa *PoA) GetMinConfirmationNum() int {
+	return len(poa.dynasty.GetProducers())/2 + 1
+}
+
+// IsBypassingLibCheck returns if LIB check should be skipped
+func (poa *PoA) IsBypassingLibCheck() bool {
+	return len(poa.dynasty.GetProducers()) < 2
+}
+
+// GetTotalProducersNum returns the total number of signers
+func (poa *PoA) GetTotalProducersNum() int {
+	return len(poa.dynasty.GetProducers())
+}
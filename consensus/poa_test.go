@@ -0,0 +1,59 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package consensus
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/blockproducerinfo"
+	"github.com/dappley/go-dappley/logic/ltransaction"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoA_Validate(t *testing.T) {
+	signer1 := account.NewAccount()
+	signer2 := account.NewAccount()
+	signer3 := account.NewAccount()
+	poa := NewPoA(blockproducerinfo.NewBlockProducerInfo(signer1.GetAddress().String()), []string{
+		signer1.GetAddress().String(),
+		signer2.GetAddress().String(),
+		signer3.GetAddress().String(),
+	})
+
+	assert.True(t, poa.Validate(newSignedBlock(signer1, 0)))
+	assert.True(t, poa.Validate(newSignedBlock(signer2, defaultTimeBetweenBlk)))
+	// not the signer in the time slot
+	assert.False(t, poa.Validate(newSignedBlock(signer2, 0)))
+	// not a signer
+	assert.False(t, poa.Validate(newSignedBlock(account.NewAccount(), 0)))
+
+	// slash transactions are not accepted
+	blk := newSignedBlock(signer1, 0)
+	slashTx := ltransaction.NewSlashTransaction(signer2.GetAddress(), "")
+	blk.SetTransactions(append(blk.GetTransactions(), &slashTx))
+	signBlock(blk, hex.EncodeToString(signer1.GetKeyPair().GetPrivateKeyBytes()))
+	assert.False(t, poa.Validate(blk))
+
+	assert.Equal(t, 2, poa.GetMinConfirmationNum())
+	assert.False(t, poa.IsBypassingLibCheck())
+	assert.Equal(t, 3, poa.GetTotalProducersNum())
+	assert.False(t, poa.IsElectionEnabled())
+}
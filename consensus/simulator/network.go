@@ -0,0 +1,288 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package simulator
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/dappley/go-dappley/common/clock"
+	"github.com/dappley/go-dappley/common/pubsub"
+	"github.com/dappley/go-dappley/network/networkmodel"
+	"github.com/golang/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	logger "github.com/sirupsen/logrus"
+)
+
+// message is a command in flight between two nodes of the simulated network
+type message struct {
+	seq       uint64
+	deliverAt time.Time
+	from      int
+	to        int
+	command   *networkmodel.DappCmd
+}
+
+// link is a directed connection between two nodes
+type link struct {
+	from int
+	to   int
+}
+
+// Network is an in-memory network between the nodes of a simulation. Commands are delivered in the order of their
+// delivery time by the simulation loop, so that a run does not depend on the scheduling of goroutines
+type Network struct {
+	clock        *clock.VirtualClock
+	services     []*NetService
+	queue        []*message
+	seq          uint64
+	defaultDelay time.Duration
+	delays       map[link]time.Duration
+	groups       map[int]int
+	crashed      map[int]bool
+}
+
+// NetService is the network service of one node in the simulated network
+type NetService struct {
+	network     *Network
+	index       int
+	subscribers []pubsub.Subscriber
+}
+
+// NewNetwork returns an in-memory network for numOfNodes nodes that is timed by the virtual clock
+func NewNetwork(numOfNodes int, c *clock.VirtualClock) *Network {
+	network := &Network{
+		clock:   c,
+		delays:  make(map[link]time.Duration),
+		crashed: make(map[int]bool),
+	}
+	for i := 0; i < numOfNodes; i++ {
+		network.services = append(network.services, &NetService{network: network, index: i})
+	}
+	return network
+}
+
+// GetNetService returns the network service of the node with the index
+func (network *Network) GetNetService(index int) *NetService {
+	return network.services[index]
+}
+
+// SetDefaultDelay sets the delay of the links that have no delay of their own
+func (network *Network) SetDefaultDelay(delay time.Duration) {
+	network.defaultDelay = delay
+}
+
+// SetDelay sets the delay of the commands sent from one node to another
+func (network *Network) SetDelay(from, to int, delay time.Duration) {
+	network.delays[link{from, to}] = delay
+}
+
+// GetDelay returns the delay of the commands sent from one node to another
+func (network *Network) GetDelay(from, to int) time.Duration {
+	if delay, ok := network.delays[link{from, to}]; ok {
+		return delay
+	}
+	return network.defaultDelay
+}
+
+// Partition splits the network into the groups of node indexes. Nodes that are in no group are isolated. Commands
+// between groups are dropped, including the ones that are already in flight
+func (network *Network) Partition(groups ...[]int) {
+	network.groups = make(map[int]int)
+	for i := range network.services {
+		network.groups[i] = -1 - i
+	}
+	for g, group := range groups {
+		for _, index := range group {
+			network.groups[index] = g
+		}
+	}
+}
+
+// Heal removes the partition
+func (network *Network) Heal() {
+	network.groups = nil
+}
+
+// Crash stops the node with the index from sending and receiving commands
+func (network *Network) Crash(index int) {
+	network.crashed[index] = true
+}
+
+// Recover brings a crashed node back
+func (network *Network) Recover(index int) {
+	delete(network.crashed, index)
+}
+
+// IsCrashed returns if the node with the index is crashed
+func (network *Network) IsCrashed(index int) bool {
+	return network.crashed[index]
+}
+
+// IsConnected returns if commands sent from one node reach the other
+func (network *Network) IsConnected(from, to int) bool {
+	if network.crashed[from] || network.crashed[to] {
+		return false
+	}
+	if network.groups == nil {
+		return true
+	}
+	return network.groups[from] == network.groups[to]
+}
+
+// NumOfPendingMessages returns the number of commands in flight
+func (network *Network) NumOfPendingMessages() int {
+	return len(network.queue)
+}
+
+// NextDeliveryTime returns the delivery time of the first command in flight; false if there is none
+func (network *Network) NextDeliveryTime() (time.Time, bool) {
+	if len(network.queue) == 0 {
+		return time.Time{}, false
+	}
+	return network.queue[0].deliverAt, true
+}
+
+// deliverDueMessages delivers the commands that are due at the current virtual time, including the ones sent while
+// handling them without delay. It returns the number of delivered commands
+func (network *Network) deliverDueMessages(deliver func(msg *message)) int {
+	delivered := 0
+	now := network.clock.Now()
+	for len(network.queue) > 0 && !network.queue[0].deliverAt.After(now) {
+		msg := network.queue[0]
+		network.queue = network.queue[1:]
+		if !network.IsConnected(msg.from, msg.to) {
+			continue
+		}
+		deliver(msg)
+		delivered++
+	}
+	return delivered
+}
+
+// send queues the command from one node to another after the delay of the link
+func (network *Network) send(from, to int, command *networkmodel.DappCmd) {
+	if from == to || !network.IsConnected(from, to) {
+		return
+	}
+	network.seq++
+	network.queue = append(network.queue, &message{
+		seq:       network.seq,
+		deliverAt: network.clock.Now().Add(network.GetDelay(from, to)),
+		from:      from,
+		to:        to,
+		command:   command,
+	})
+	sort.SliceStable(network.queue, func(i, j int) bool {
+		if !network.queue[i].deliverAt.Equal(network.queue[j].deliverAt) {
+			return network.queue[i].deliverAt.Before(network.queue[j].deliverAt)
+		}
+		return network.queue[i].seq < network.queue[j].seq
+	})
+}
+
+// indexOf returns the index of the node with the peer id; -1 if there is none
+func (network *Network) indexOf(peerId peer.ID) int {
+	for i := range network.services {
+		if GetPeerInfo(i).PeerId == peerId {
+			return i
+		}
+	}
+	return -1
+}
+
+// GetPeerInfo returns the peer info of the node with the index
+func GetPeerInfo(index int) networkmodel.PeerInfo {
+	return networkmodel.PeerInfo{PeerId: peer.ID(fmt.Sprintf("node-%d", index))}
+}
+
+// UnicastNormalPriorityCommand sends the command to the destination
+func (ns *NetService) UnicastNormalPriorityCommand(commandName string, message proto.Message, destination networkmodel.PeerInfo) {
+	ns.unicast(commandName, message, destination)
+}
+
+// UnicastHighProrityCommand sends the command to the destination
+func (ns *NetService) UnicastHighProrityCommand(commandName string, message proto.Message, destination networkmodel.PeerInfo) {
+	ns.unicast(commandName, message, destination)
+}
+
+// BroadcastNormalPriorityCommand sends the command to all other nodes
+func (ns *NetService) BroadcastNormalPriorityCommand(commandName string, message proto.Message) {
+	ns.broadcast(commandName, message)
+}
+
+// BroadcastHighProrityCommand sends the command to all other nodes
+func (ns *NetService) BroadcastHighProrityCommand(commandName string, message proto.Message) {
+	ns.broadcast(commandName, message)
+}
+
+// Listen subscribes to the commands received by the node
+func (ns *NetService) Listen(subscriber pubsub.Subscriber) {
+	ns.subscribers = append(ns.subscribers, subscriber)
+}
+
+// Relay does nothing since broadcast commands already reach every node of the simulated network
+func (ns *NetService) Relay(dappCmd *networkmodel.DappCmd, destination networkmodel.PeerInfo, priority networkmodel.DappCmdPriority) {
+}
+
+func (ns *NetService) unicast(commandName string, message proto.Message, destination networkmodel.PeerInfo) {
+	to := ns.network.indexOf(destination.PeerId)
+	if to < 0 {
+		logger.WithFields(logger.Fields{
+			"peer": destination.PeerId,
+		}).Warn("Simulator: unicast to an unknown peer.")
+		return
+	}
+	command, err := newDappCmd(commandName, message, false)
+	if err != nil {
+		return
+	}
+	ns.network.send(ns.index, to, command)
+}
+
+func (ns *NetService) broadcast(commandName string, message proto.Message) {
+	command, err := newDappCmd(commandName, message, true)
+	if err != nil {
+		return
+	}
+	for to := range ns.network.services {
+		ns.network.send(ns.index, to, command)
+	}
+}
+
+// dispatch runs the handlers of the subscribers of the command
+func (ns *NetService) dispatch(command *networkmodel.DappCmd, source networkmodel.PeerInfo) {
+	for _, subscriber := range ns.subscribers {
+		if handler := subscriber.GetTopicHandler(command.GetName()); handler != nil {
+			handler(networkmodel.NewDappRcvdCmdContext(command, source))
+		}
+	}
+}
+
+func newDappCmd(commandName string, message proto.Message, isBroadcast bool) (*networkmodel.DappCmd, error) {
+	data, err := proto.Marshal(message)
+	if err != nil {
+		logger.WithError(err).WithFields(logger.Fields{
+			"name": commandName,
+		}).Warn("Simulator: failed to marshal the command.")
+		return nil, err
+	}
+	return networkmodel.NewDappCmd(commandName, data, isBroadcast), nil
+}
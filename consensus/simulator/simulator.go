@@ -0,0 +1,326 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package simulator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/dappley/go-dappley/common/clock"
+	"github.com/dappley/go-dappley/consensus"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/block"
+	blockpb "github.com/dappley/go-dappley/core/block/pb"
+	"github.com/dappley/go-dappley/core/blockchain"
+	"github.com/dappley/go-dappley/core/blockproducerinfo"
+	"github.com/dappley/go-dappley/logic/blockproducer"
+	"github.com/dappley/go-dappley/logic/lblockchain"
+	"github.com/dappley/go-dappley/logic/transactionpool"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/golang/protobuf/proto"
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	txPoolLimit  = 128000
+	blkSizeLimit = 100000
+)
+
+// DefaultStartTime is the virtual time at which a simulation starts
+var DefaultStartTime = time.Unix(1600000000, 0)
+
+// Node is a producer of the simulation with its own blockchain, DPoS engine and block producer
+type Node struct {
+	index    int
+	account  *account.Account
+	dpos     *consensus.DPOS
+	bm       *lblockchain.BlockchainManager
+	producer *blockproducer.BlockProducer
+	service  *NetService
+}
+
+// action is a scripted change of the simulation that runs at a virtual time
+type action struct {
+	at  time.Time
+	seq uint64
+	run func()
+}
+
+// Simulator runs a DPoS network of in-process nodes on a virtual clock. Blocks are produced in the time slots of the
+// producers, and commands are delivered after the delays of the network, so that a run with the same script always
+// ends with the same chains
+type Simulator struct {
+	clock       *clock.VirtualClock
+	network     *Network
+	nodes       []*Node
+	actions     []*action
+	seq         uint64
+	nextTick    time.Time
+	downloading map[int]bool
+}
+
+// NewSimulator returns a simulator with numOfNodes producers that take turns every timeBetweenBlk seconds
+func NewSimulator(numOfNodes int, timeBetweenBlk int) *Simulator {
+	c := clock.NewVirtualClock(DefaultStartTime)
+	s := &Simulator{
+		clock:       c,
+		network:     NewNetwork(numOfNodes, c),
+		nextTick:    DefaultStartTime.Add(time.Second),
+		downloading: make(map[int]bool),
+	}
+
+	accounts := make([]*account.Account, numOfNodes)
+	producers := make([]string, numOfNodes)
+	for i := range accounts {
+		accounts[i] = newNodeAccount(i)
+		producers[i] = accounts[i].GetAddress().String()
+	}
+
+	for i, acc := range accounts {
+		s.nodes = append(s.nodes, s.newNode(i, acc, accounts[0].GetAddress(), producers, timeBetweenBlk))
+	}
+	return s
+}
+
+// newNodeAccount returns the account of the node with the index. The key is derived from the index so that every
+// simulation has the same producers
+func newNodeAccount(index int) *account.Account {
+	seed := sha256.Sum256([]byte(fmt.Sprintf("simulator-node-%d", index)))
+	return account.NewAccountByPrivateKey(hex.EncodeToString(seed[:]))
+}
+
+func (s *Simulator) newNode(index int, acc *account.Account, genesisAddr account.Address, producers []string, timeBetweenBlk int) *Node {
+	key := hex.EncodeToString(acc.GetKeyPair().GetPrivateKeyBytes())
+	producerInfo := blockproducerinfo.NewBlockProducerInfo(acc.GetAddress().String())
+
+	dpos := consensus.NewDPOS(producerInfo)
+	dpos.SetKey(key)
+	dpos.SetDynasty(consensus.NewDynasty(append([]string{}, producers...), len(producers), timeBetweenBlk))
+	dpos.SetClock(s.clock)
+	dpos.SetVrfRand(rand.New(rand.NewSource(int64(index))))
+
+	txPool := transactionpool.NewTransactionPool(nil, txPoolLimit)
+	bc := lblockchain.CreateBlockchain(genesisAddr, storage.NewRamStorage(), dpos, txPool, blkSizeLimit)
+	dpos.SetChainReader(bc)
+
+	service := s.network.GetNetService(index)
+	bm := lblockchain.NewBlockchainManager(bc, blockchain.NewBlockPool(nil), service, dpos)
+	bm.RestoreDynasty()
+	bc.SetState(blockchain.BlockchainReady)
+
+	producer := blockproducer.NewBlockProducer(bm, dpos, producerInfo)
+	producer.SetClock(s.clock)
+
+	return &Node{
+		index:    index,
+		account:  acc,
+		dpos:     dpos,
+		bm:       bm,
+		producer: producer,
+		service:  service,
+	}
+}
+
+// GetClock returns the virtual clock of the simulation
+func (s *Simulator) GetClock() *clock.VirtualClock {
+	return s.clock
+}
+
+// GetNetwork returns the simulated network
+func (s *Simulator) GetNetwork() *Network {
+	return s.network
+}
+
+// GetNodes returns the nodes of the simulation
+func (s *Simulator) GetNodes() []*Node {
+	return s.nodes
+}
+
+// GetNode returns the node with the index
+func (s *Simulator) GetNode(index int) *Node {
+	return s.nodes[index]
+}
+
+// At schedules the action to run at the virtual time
+func (s *Simulator) At(at time.Time, run func()) {
+	s.seq++
+	s.actions = append(s.actions, &action{at: at, seq: s.seq, run: run})
+	sort.SliceStable(s.actions, func(i, j int) bool {
+		if !s.actions[i].at.Equal(s.actions[j].at) {
+			return s.actions[i].at.Before(s.actions[j].at)
+		}
+		return s.actions[i].seq < s.actions[j].seq
+	})
+}
+
+// After schedules the action to run once the virtual clock has advanced by d
+func (s *Simulator) After(d time.Duration, run func()) {
+	s.At(s.clock.Now().Add(d), run)
+}
+
+// Run runs the simulation until the virtual clock has advanced by d. Every virtual second the live nodes produce a
+// block if it is their turn, in the order of their indexes
+func (s *Simulator) Run(d time.Duration) {
+	end := s.clock.Now().Add(d)
+	for {
+		next := s.nextEventTime()
+		if next.After(end) {
+			break
+		}
+		s.advanceTo(next)
+		s.runDueActions()
+		s.network.deliverDueMessages(s.deliver)
+		if next.Equal(s.nextTick) {
+			s.produceBlocks(next)
+			s.nextTick = s.nextTick.Add(time.Second)
+			s.network.deliverDueMessages(s.deliver)
+		}
+	}
+	s.advanceTo(end)
+}
+
+// nextEventTime returns the virtual time of the next tick, command delivery or scripted action
+func (s *Simulator) nextEventTime() time.Time {
+	next := s.nextTick
+	if deliverAt, ok := s.network.NextDeliveryTime(); ok && deliverAt.Before(next) {
+		next = deliverAt
+	}
+	if len(s.actions) > 0 && s.actions[0].at.Before(next) {
+		next = s.actions[0].at
+	}
+	return next
+}
+
+func (s *Simulator) advanceTo(t time.Time) {
+	if now := s.clock.Now(); t.After(now) {
+		s.clock.Advance(t.Sub(now))
+	}
+}
+
+func (s *Simulator) runDueActions() {
+	now := s.clock.Now()
+	for len(s.actions) > 0 && !s.actions[0].at.After(now) {
+		a := s.actions[0]
+		s.actions = s.actions[1:]
+		a.run()
+	}
+}
+
+func (s *Simulator) produceBlocks(now time.Time) {
+	for _, node := range s.nodes {
+		if s.network.IsCrashed(node.index) {
+			continue
+		}
+		node.dpos.TryProduceBlock(now, node.producer.ProduceBlock)
+	}
+}
+
+// deliver runs the handlers of the command on the receiving node. A block that is too far ahead makes the node
+// download the chain of the sender, which is done by the simulator instead of a download manager
+func (s *Simulator) deliver(msg *message) {
+	node := s.nodes[msg.to]
+	if msg.command.GetName() == lblockchain.SendBlock && node.isFarBehind(msg.command.GetData()) {
+		s.download(node, s.nodes[msg.from])
+		return
+	}
+	node.service.dispatch(msg.command, GetPeerInfo(msg.from))
+}
+
+// isFarBehind returns if the block in the data is high enough for the node to download the chain
+func (node *Node) isFarBehind(data []byte) bool {
+	pb := &blockpb.Block{}
+	if err := proto.Unmarshal(data, pb); err != nil {
+		return false
+	}
+	blk := &block.Block{}
+	blk.FromProto(pb)
+
+	bc := node.bm.Getblockchain()
+	height := bc.GetMaxHeight()
+	return bc.GetState() == blockchain.BlockchainReady && blk.GetHeight() > height &&
+		blk.GetHeight()-height >= lblockchain.HeightDiffThreshold
+}
+
+// download requests the chain of the source over the simulated network. The chain of the source above the last common
+// block is merged once the request and the response have crossed the links between the nodes, unless they were cut
+// in the meantime. A node downloads from one source at a time
+func (s *Simulator) download(node *Node, source *Node) {
+	if s.downloading[node.index] {
+		return
+	}
+	s.downloading[node.index] = true
+	roundTrip := s.network.GetDelay(node.index, source.index) + s.network.GetDelay(source.index, node.index)
+	s.After(roundTrip, func() {
+		delete(s.downloading, node.index)
+		if s.network.IsConnected(node.index, source.index) && s.network.IsConnected(source.index, node.index) {
+			node.syncFrom(source)
+		}
+	})
+}
+
+func (node *Node) syncFrom(source *Node) {
+	bc := node.bm.Getblockchain()
+	var blks []*block.Block
+	blk, err := source.bm.Getblockchain().GetTailBlock()
+	for err == nil {
+		if local, err := bc.GetBlockByHeight(blk.GetHeight()); err == nil && local.GetHash().Equals(blk.GetHash()) {
+			break
+		}
+		blks = append(blks, blk)
+		blk, err = source.bm.Getblockchain().GetBlockByHash(blk.GetPrevHash())
+	}
+	if err != nil || len(blks) == 0 {
+		return
+	}
+	if err := node.bm.MergeFork(blks, blk.GetHash()); err != nil {
+		logger.WithError(err).WithFields(logger.Fields{
+			"node":   node.index,
+			"source": source.index,
+		}).Warn("Simulator: failed to merge the chain of the source.")
+	}
+}
+
+// GetIndex returns the index of the node
+func (node *Node) GetIndex() int {
+	return node.index
+}
+
+// GetAddress returns the producer address of the node
+func (node *Node) GetAddress() account.Address {
+	return node.account.GetAddress()
+}
+
+// GetDPOS returns the consensus engine of the node
+func (node *Node) GetDPOS() *consensus.DPOS {
+	return node.dpos
+}
+
+// GetBlockchainManager returns the blockchain manager of the node
+func (node *Node) GetBlockchainManager() *lblockchain.BlockchainManager {
+	return node.bm
+}
+
+// GetBlockchain returns the blockchain of the node
+func (node *Node) GetBlockchain() *lblockchain.Blockchain {
+	return node.bm.Getblockchain()
+}
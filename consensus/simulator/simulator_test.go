@@ -0,0 +1,92 @@
+package simulator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/stretchr/testify/assert"
+)
+
+func getTail(t *testing.T, node *Node) *block.Block {
+	tail, err := node.GetBlockchain().GetTailBlock()
+	assert.Nil(t, err)
+	return tail
+}
+
+func runPartitionScript() *Simulator {
+	s := NewSimulator(4, 1)
+	s.GetNetwork().SetDefaultDelay(200 * time.Millisecond)
+	s.After(20*time.Second, func() {
+		s.GetNetwork().Partition([]int{0, 1, 2}, []int{3})
+	})
+	s.After(28*time.Second, func() {
+		s.GetNetwork().Heal()
+	})
+	s.Run(40 * time.Second)
+	return s
+}
+
+func TestSimulator_ProduceBlocks(t *testing.T) {
+	s := NewSimulator(4, 1)
+	s.Run(20 * time.Second)
+
+	tail := getTail(t, s.GetNode(0))
+	assert.True(t, tail.GetHeight() > 15)
+	for _, node := range s.GetNodes() {
+		assert.Equal(t, tail.GetHash(), getTail(t, node).GetHash())
+		assert.Equal(t, tail.GetHeight()-uint64(node.GetDPOS().GetMinConfirmationNum()), node.GetBlockchain().GetLIBHeight())
+	}
+}
+
+func TestSimulator_PartitionAndHeal(t *testing.T) {
+	s := NewSimulator(4, 1)
+	s.GetNetwork().SetDefaultDelay(200 * time.Millisecond)
+	s.Run(20 * time.Second)
+	s.GetNetwork().Partition([]int{0, 1, 2}, []int{3})
+	s.Run(8 * time.Second)
+
+	majorityTail := getTail(t, s.GetNode(0))
+	minorityTail := getTail(t, s.GetNode(3))
+	assert.NotEqual(t, majorityTail.GetHash(), minorityTail.GetHash())
+	assert.True(t, majorityTail.GetHeight() > minorityTail.GetHeight())
+	libHeight := s.GetNode(0).GetBlockchain().GetLIBHeight()
+
+	s.GetNetwork().Heal()
+	s.Run(12*time.Second + 500*time.Millisecond)
+
+	tail := getTail(t, s.GetNode(0))
+	for _, node := range s.GetNodes() {
+		assert.Equal(t, tail.GetHash(), getTail(t, node).GetHash())
+	}
+	assert.True(t, s.GetNode(3).GetBlockchain().GetLIBHeight() > libHeight)
+}
+
+func TestSimulator_CrashAndRecover(t *testing.T) {
+	s := NewSimulator(4, 1)
+	s.Run(8 * time.Second)
+	s.GetNetwork().Crash(3)
+	s.Run(20 * time.Second)
+
+	assert.True(t, getTail(t, s.GetNode(0)).GetHeight()-getTail(t, s.GetNode(3)).GetHeight() >= 10)
+
+	s.GetNetwork().Recover(3)
+	s.Run(8 * time.Second)
+
+	tail := getTail(t, s.GetNode(0))
+	for _, node := range s.GetNodes() {
+		assert.Equal(t, tail.GetHash(), getTail(t, node).GetHash())
+	}
+}
+
+func TestSimulator_Reproducible(t *testing.T) {
+	first := runPartitionScript()
+	second := runPartitionScript()
+
+	for i := range first.GetNodes() {
+		firstTail := getTail(t, first.GetNode(i))
+		secondTail := getTail(t, second.GetNode(i))
+		assert.Equal(t, firstTail.GetHeight(), secondTail.GetHeight())
+		assert.Equal(t, firstTail.GetHash(), secondTail.GetHash())
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package consensus
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/block"
+	blockpb "github.com/dappley/go-dappley/core/block/pb"
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/crypto/keystore/secp256k1"
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/logic/lblock"
+	"github.com/dappley/go-dappley/logic/ltransaction"
+	"github.com/golang/protobuf/proto"
+	logger "github.com/sirupsen/logrus"
+)
+
+// DoubleMintEvidence proves that a producer signed two different blocks in one time slot. Each signed header comes
+// with the hash of its block's transactions so that the header hash can be recomputed without the whole block
+type DoubleMintEvidence struct {
+	Headers   [][]byte `json:"headers"`
+	TxsHashes [][]byte `json:"txs_hashes"`
+}
+
+// NewDoubleMintEvidence returns the evidence of the two blocks. The blocks are ordered by hash so that every node
+// builds the same evidence
+func NewDoubleMintEvidence(blk1, blk2 *block.Block) (*DoubleMintEvidence, error) {
+	if bytes.Compare(blk1.GetHash(), blk2.GetHash()) > 0 {
+		blk1, blk2 = blk2, blk1
+	}
+	evidence := &DoubleMintEvidence{}
+	for _, blk := range []*block.Block{blk1, blk2} {
+		header, err := proto.Marshal(blk.GetHeader().ToProto())
+		if err != nil {
+			return nil, err
+		}
+		evidence.Headers = append(evidence.Headers, header)
+		evidence.TxsHashes = append(evidence.TxsHashes, lblock.HashTransactions(blk))
+	}
+	return evidence, nil
+}
+
+// String returns the evidence in the form carried by a slash transaction
+func (evidence *DoubleMintEvidence) String() string {
+	bytes, _ := json.Marshal(evidence)
+	return string(bytes)
+}
+
+// decodeEvidence returns the two blocks of the evidence; the blocks only contain their headers
+func decodeEvidence(data string) ([]*block.Block, [][]byte, error) {
+	evidence := &DoubleMintEvidence{}
+	if err := json.Unmarshal([]byte(data), evidence); err != nil {
+		return nil, nil, errval.InvalidSlashEvidence
+	}
+	if len(evidence.Headers) != 2 || len(evidence.TxsHashes) != 2 {
+		return nil, nil, errval.InvalidSlashEvidence
+	}
+
+	var blks []*block.Block
+	for _, header := range evidence.Headers {
+		headerPb := &blockpb.BlockHeader{}
+		if err := proto.Unmarshal(header, headerPb); err != nil {
+			return nil, nil, errval.InvalidSlashEvidence
+		}
+		blk := &block.Block{}
+		blk.FromProto(&blockpb.Block{Header: headerPb})
+		blks = append(blks, blk)
+	}
+	return blks, evidence.TxsHashes, nil
+}
+
+// blockSigner returns the address of the account that signed the block hash
+func blockSigner(blk *block.Block) (string, error) {
+	if blk.GetSign() == nil {
+		return "", errval.InvalidSlashEvidence
+	}
+	pubkey, err := secp256k1.RecoverECDSAPublicKey(blk.GetHash(), blk.GetSign())
+	if err != nil {
+		return "", err
+	}
+	if ok, err := account.IsValidPubKey(pubkey[1:]); !ok {
+		return "", err
+	}
+	return account.NewTransactionAccountByPubKey(pubkey[1:]).GetAddress().String(), nil
+}
+
+// VerifySlashTransaction checks that the evidence of the slash transaction proves that the reported producer signed
+// two different blocks in one of its time slots of the current dynasty
+func (dpos *DPOS) VerifySlashTransaction(tx *transaction.Transaction) error {
+	slashTx := &ltransaction.TxSlash{Transaction: tx}
+	if err := slashTx.Verify(nil, 0); err != nil {
+		return err
+	}
+	blks, txsHashes, err := decodeEvidence(slashTx.GetEvidence())
+	if err != nil {
+		return err
+	}
+
+	if bytes.Equal(blks[0].GetHash(), blks[1].GetHash()) {
+		return errval.InvalidSlashEvidence
+	}
+	timeBetweenBlk := int64(dpos.dynasty.timeBetweenBlk)
+	if blks[0].GetTimestamp()/timeBetweenBlk != blks[1].GetTimestamp()/timeBetweenBlk {
+		return errval.InvalidSlashEvidence
+	}
+
+	offender := slashTx.GetOffender().String()
+	if dpos.ProducerAtATime(blks[0].GetTimestamp()) != offender {
+		return errval.InvalidSlashEvidence
+	}
+	for i, blk := range blks {
+		if !bytes.Equal(blk.GetHash(), lblock.CalculateHashWithTxsHash(blk, txsHashes[i])) {
+			return errval.InvalidSlashEvidence
+		}
+		signer, err := blockSigner(blk)
+		if err != nil || signer != offender {
+			return errval.InvalidSlashEvidence
+		}
+	}
+	return nil
+}
+
+// verifySlashTransactions checks the evidence of all slash transactions in the block. A producer can only be
+// reported once per block
+func (dpos *DPOS) verifySlashTransactions(blk *block.Block) bool {
+	offenders := make(map[string]bool)
+	for _, tx := range blk.GetTransactions() {
+		if !tx.IsSlash() {
+			continue
+		}
+		if err := dpos.VerifySlashTransaction(tx); err != nil {
+			logger.WithError(err).Warn("DPoS: the block contains an invalid slash transaction.")
+			return false
+		}
+		offender := (&ltransaction.TxSlash{Transaction: tx}).GetOffender().String()
+		if offenders[offender] {
+			logger.Warn("DPoS: the block reports a producer twice.")
+			return false
+		}
+		offenders[offender] = true
+	}
+	return true
+}
+
+// recordDoubleMint keeps the evidence that blk and the block cached in its time slot were minted by the same
+// producer until it is packed in a block by the local producer
+func (dpos *DPOS) recordDoubleMint(blk *block.Block) {
+	existBlock, exist := dpos.slot.Get(int(blk.GetTimestamp() / int64(dpos.dynasty.timeBetweenBlk)))
+	if !exist {
+		return
+	}
+	producer := dpos.ProducerAtATime(blk.GetTimestamp())
+	evidence, err := NewDoubleMintEvidence(existBlock.(*block.Block), blk)
+	if err != nil {
+		logger.WithError(err).Warn("DPoS: failed to build the double-minting evidence.")
+		return
+	}
+
+	dpos.evidenceMutex.Lock()
+	defer dpos.evidenceMutex.Unlock()
+	key := fmt.Sprintf("%s_%d", producer, blk.GetTimestamp()/int64(dpos.dynasty.timeBetweenBlk))
+	if _, exist := dpos.evidences[key]; exist {
+		return
+	}
+	dpos.evidences[key] = ltransaction.NewSlashTransaction(account.NewAddress(producer), evidence.String())
+	logger.WithFields(logger.Fields{
+		"producer": producer,
+		"height":   blk.GetHeight(),
+	}).Warn("DPoS: recorded the evidence of double-minting.")
+}
+
+// PopSlashTransactions returns the slash transactions of the recorded double mints that are still valid and
+// clears the record
+func (dpos *DPOS) PopSlashTransactions() []*transaction.Transaction {
+	dpos.evidenceMutex.Lock()
+	defer dpos.evidenceMutex.Unlock()
+
+	var txs []*transaction.Transaction
+	for key, tx := range dpos.evidences {
+		slashTx := tx
+		if err := dpos.VerifySlashTransaction(&slashTx); err == nil {
+			txs = append(txs, &slashTx)
+		}
+		delete(dpos.evidences, key)
+	}
+	return txs
+}
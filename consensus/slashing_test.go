@@ -0,0 +1,98 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package consensus
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/core/transaction"
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/logic/lblock"
+	"github.com/dappley/go-dappley/logic/ltransaction"
+	"github.com/stretchr/testify/assert"
+)
+
+func newSignedBlock(producer *account.Account, timestamp int64) *block.Block {
+	cbtx := ltransaction.NewCoinbaseTX(producer.GetAddress(), "", 1, common.NewAmount(0))
+	blk := block.NewBlockWithTimestamp([]*transaction.Transaction{&cbtx}, nil, timestamp, producer.GetAddress().String())
+	blk.SetHash(lblock.CalculateHash(blk))
+	lblock.SignBlock(blk, hex.EncodeToString(producer.GetKeyPair().GetPrivateKeyBytes()))
+	return blk
+}
+
+func TestDPOS_VerifySlashTransaction(t *testing.T) {
+	producer := account.NewAccount()
+	other := account.NewAccount()
+	dpos := NewDPOS(nil)
+	dpos.SetDynasty(NewDynasty([]string{producer.GetAddress().String(), other.GetAddress().String()}, 2, defaultTimeBetweenBlk))
+
+	blk1 := newSignedBlock(producer, 10)
+	blk2 := newSignedBlock(producer, 11)
+	evidence, err := NewDoubleMintEvidence(blk1, blk2)
+	assert.Nil(t, err)
+
+	slashTx := ltransaction.NewSlashTransaction(producer.GetAddress(), evidence.String())
+	assert.Nil(t, dpos.VerifySlashTransaction(&slashTx))
+
+	// the evidence must match the reported producer
+	wrongOffenderTx := ltransaction.NewSlashTransaction(other.GetAddress(), evidence.String())
+	assert.Equal(t, errval.InvalidSlashEvidence, dpos.VerifySlashTransaction(&wrongOffenderTx))
+
+	// the same block twice is not a double mint
+	sameEvidence, err := NewDoubleMintEvidence(blk1, blk1)
+	assert.Nil(t, err)
+	sameTx := ltransaction.NewSlashTransaction(producer.GetAddress(), sameEvidence.String())
+	assert.Equal(t, errval.InvalidSlashEvidence, dpos.VerifySlashTransaction(&sameTx))
+
+	// blocks in different time slots are not a double mint
+	otherSlotEvidence, err := NewDoubleMintEvidence(blk1, newSignedBlock(producer, 20))
+	assert.Nil(t, err)
+	otherSlotTx := ltransaction.NewSlashTransaction(producer.GetAddress(), otherSlotEvidence.String())
+	assert.Equal(t, errval.InvalidSlashEvidence, dpos.VerifySlashTransaction(&otherSlotTx))
+
+	// a tampered header does not match its signature
+	blk3 := newSignedBlock(producer, 12)
+	blk3.SetTimestamp(13)
+	tamperedEvidence, err := NewDoubleMintEvidence(blk1, blk3)
+	assert.Nil(t, err)
+	tamperedTx := ltransaction.NewSlashTransaction(producer.GetAddress(), tamperedEvidence.String())
+	assert.Equal(t, errval.InvalidSlashEvidence, dpos.VerifySlashTransaction(&tamperedTx))
+}
+
+func TestDPOS_RecordDoubleMint(t *testing.T) {
+	producer := account.NewAccount()
+	dpos := NewDPOS(nil)
+	dpos.SetDynasty(NewDynasty([]string{producer.GetAddress().String()}, 1, defaultTimeBetweenBlk))
+
+	blk1 := newSignedBlock(producer, 10)
+	blk2 := newSignedBlock(producer, 11)
+	dpos.cacheBlock(blk1)
+	assert.True(t, dpos.isDoubleMint(blk2))
+	dpos.recordDoubleMint(blk2)
+	dpos.recordDoubleMint(blk2)
+
+	txs := dpos.PopSlashTransactions()
+	assert.Equal(t, 1, len(txs))
+	assert.True(t, txs[0].IsSlash())
+	assert.Empty(t, dpos.PopSlashTransactions())
+}
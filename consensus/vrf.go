@@ -0,0 +1,223 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package consensus
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+
+	logger "github.com/sirupsen/logrus"
+
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/crypto/keystore/secp256k1"
+	"github.com/dappley/go-dappley/crypto/keystore/secp256k1/vrf/secp256k1VRF"
+	errval "github.com/dappley/go-dappley/errors"
+)
+
+const (
+	vrfProofLength = 64 + 65
+	seedCacheSize  = 16
+)
+
+// ChainReader gives the consensus read access to the canonical chain
+type ChainReader interface {
+	GetTailBlock() (*block.Block, error)
+	GetBlockByHash(hash hash.Hash) (*block.Block, error)
+}
+
+// SetChainReader sets the chain used to derive the slot order of each dynasty round
+func (dpos *DPOS) SetChainReader(chain ChainReader) {
+	dpos.chain = chain
+}
+
+// SetVrfRand sets the source of the nonces of the VRF proofs. A fixed source makes the produced blocks reproducible
+func (dpos *DPOS) SetVrfRand(rnd io.Reader) {
+	dpos.vrfRand = rnd
+}
+
+// vrfMessage returns the message that the producer of the block evaluates its VRF on
+func vrfMessage(blk *block.Block) []byte {
+	return blk.GetPrevHash()
+}
+
+// vrfOutput returns the VRF output carried by a proof
+func vrfOutput(proof []byte) []byte {
+	output := sha256.Sum256(proof[64:])
+	return output[:]
+}
+
+// GenerateVrfProof evaluates the VRF of the producer key on the block and puts the proof into the block header
+func GenerateVrfProof(blk *block.Block, producerKey string, rnd io.Reader) error {
+	key, err := secp256k1.HexToECDSAPrivateKey(producerKey)
+	if err != nil {
+		return err
+	}
+	if key == nil {
+		return errval.InvalidPrivateKey
+	}
+	signer, err := secp256k1VRF.NewVRFSigner(key)
+	if err != nil {
+		return err
+	}
+	_, proof := signer.(*secp256k1VRF.PrivateKey).EvaluateWithRand(vrfMessage(blk), rnd)
+	blk.SetVrfProof(proof)
+	return nil
+}
+
+// generateVrfProof evaluates the producer's VRF on the block and puts the proof into the block header. Nothing is done
+// if no producer key is set
+func (dpos *DPOS) generateVrfProof(blk *block.Block) {
+	if dpos.producerKey == "" {
+		return
+	}
+	if err := GenerateVrfProof(blk, dpos.producerKey, dpos.vrfRand); err != nil {
+		logger.WithError(err).Warn("DPoS: cannot generate the VRF proof.")
+	}
+}
+
+// verifyVrfProof checks the VRF proof in the block header against the public key of the block signer. A block without
+// a proof is only accepted if proofs are not required yet, in which case it does not contribute to the slot order
+func verifyVrfProof(blk *block.Block, pubkey []byte, required bool) bool {
+	proof := blk.GetVrfProof()
+	if len(proof) == 0 {
+		if required {
+			logger.Warn("DPoS: the VRF proof is missing.")
+			return false
+		}
+		return true
+	}
+	if len(proof) != vrfProofLength {
+		logger.Warn("DPoS: the VRF proof has an invalid length.")
+		return false
+	}
+
+	pub, err := secp256k1.ToECDSAPublicKey(pubkey)
+	if err != nil {
+		logger.WithError(err).Warn("DPoS: cannot load the public key for VRF.")
+		return false
+	}
+	verifier, err := secp256k1VRF.NewVRFVerifier(pub)
+	if err != nil {
+		logger.WithError(err).Warn("DPoS: cannot create the VRF verifier.")
+		return false
+	}
+	if _, err := verifier.ProofToHash(vrfMessage(blk), proof); err != nil {
+		logger.WithError(err).Warn("DPoS: the VRF proof is invalid.")
+		return false
+	}
+	return true
+}
+
+// parentReader reads the chain that leads to the parent of a block, which is what the seed of its epoch is computed from
+type parentReader struct {
+	chain  ChainReader
+	parent *block.Block
+}
+
+func (reader *parentReader) GetTailBlock() (*block.Block, error) {
+	return reader.parent, nil
+}
+
+func (reader *parentReader) GetBlockByHash(hash hash.Hash) (*block.Block, error) {
+	return reader.chain.GetBlockByHash(hash)
+}
+
+// producerOfBlock returns the expected producer of the block under the slot order derived from the chain that leads to
+// its parent. The order derived from the tail is used if the parent is not known yet
+func (dpos *DPOS) producerOfBlock(blk *block.Block) string {
+	if dpos.chain == nil {
+		return dpos.ProducerAtATime(blk.GetTimestamp())
+	}
+	parent, err := dpos.chain.GetBlockByHash(blk.GetPrevHash())
+	if err != nil {
+		return dpos.ProducerAtATime(blk.GetTimestamp())
+	}
+	seed, _ := GetEpochSeed(&parentReader{dpos.chain, parent}, dpos.dynasty, dpos.dynasty.GetEpoch(blk.GetTimestamp()))
+	return dpos.dynasty.ProducerAtATimeWithSeed(blk.GetTimestamp(), seed)
+}
+
+// getSeed returns the seed that shuffles the slot order of the dynasty round that follows the tail. It combines the VRF
+// outputs of the blocks minted in the first half of the previous round, so that every node has seen them before the
+// round starts. It returns nil if no chain is set or none of those blocks carries a VRF proof
+func (dpos *DPOS) getSeed(epoch int64) []byte {
+	if dpos.chain == nil || epoch <= 0 {
+		return nil
+	}
+	if seed, ok := dpos.seeds.Get(epoch); ok {
+		return seed.([]byte)
+	}
+
+	seed, complete := GetEpochSeed(dpos.chain, dpos.dynasty, epoch)
+	if complete {
+		dpos.seeds.Add(epoch, seed)
+	}
+	return seed
+}
+
+// GetEpochSeed returns the seed that shuffles the slot order of an epoch. It hashes the VRF outputs of the blocks in the
+// first half of the previous epoch, walking back from the tail of the chain. The seed is not final until the tail has
+// passed that half of the epoch
+func GetEpochSeed(chain ChainReader, dynasty *Dynasty, epoch int64) ([]byte, bool) {
+	if epoch <= 0 {
+		return nil, true
+	}
+
+	dynastyTime := int64(dynasty.GetDynastyTime())
+	start := (epoch - 1) * dynastyTime
+	end := start + dynastyTime/2
+
+	blk, err := chain.GetTailBlock()
+	if err != nil {
+		return nil, false
+	}
+	complete := blk.GetTimestamp() >= end
+
+	var outputs [][]byte
+	for blk.GetTimestamp() >= start {
+		if blk.GetTimestamp() < end && len(blk.GetVrfProof()) == vrfProofLength {
+			outputs = append([][]byte{vrfOutput(blk.GetVrfProof())}, outputs...)
+		}
+		if len(blk.GetPrevHash()) == 0 {
+			break
+		}
+		blk, err = chain.GetBlockByHash(blk.GetPrevHash())
+		if err != nil {
+			return nil, false
+		}
+	}
+
+	var seed []byte
+	if len(outputs) > 0 {
+		h := sha256.Sum256(bytes.Join(outputs, []byte{}))
+		seed = h[:]
+	}
+	return seed, complete
+}
+
+// ProducerAtATime returns the expected producer at the input time under the slot order of its dynasty round
+func (dpos *DPOS) ProducerAtATime(time int64) string {
+	return dpos.dynasty.ProducerAtATimeWithSeed(time, dpos.getSeed(dpos.dynasty.GetEpoch(time)))
+}
+
+// isMyTurn returns if it is the input producer's turn under the slot order of the current dynasty round
+func (dpos *DPOS) isMyTurn(producer string, now int64) bool {
+	return dpos.dynasty.IsMyTurnWithSeed(producer, now, dpos.getSeed(dpos.dynasty.GetEpoch(now)))
+}
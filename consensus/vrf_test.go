@@ -0,0 +1,221 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package consensus
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/core/blockproducerinfo"
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/crypto/keystore/secp256k1"
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/logic/ltransaction"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockChain struct {
+	tail   *block.Block
+	blocks map[string]*block.Block
+}
+
+func (chain *mockChain) GetTailBlock() (*block.Block, error) {
+	return chain.tail, nil
+}
+
+func (chain *mockChain) GetBlockByHash(hash hash.Hash) (*block.Block, error) {
+	blk, ok := chain.blocks[hash.String()]
+	if !ok {
+		return nil, errval.BlockDoesNotExist
+	}
+	return blk, nil
+}
+
+func (chain *mockChain) addBlock(blk *block.Block) {
+	chain.blocks[blk.GetHash().String()] = blk
+	chain.tail = blk
+}
+
+func newVrfBlock(dpos *DPOS, producer *account.Account, parent *block.Block, timestamp int64) *block.Block {
+	cbtx := ltransaction.NewCoinbaseTX(producer.GetAddress(), "", 1, common.NewAmount(0))
+	blk := block.NewBlockWithTimestamp([]*transaction.Transaction{&cbtx}, parent, timestamp, producer.GetAddress().String())
+	dpos.hashAndSign(blk)
+	return blk
+}
+
+func TestDPOS_verifyVrfProof(t *testing.T) {
+	producer := account.NewAccount()
+	dpos := NewDPOS(blockproducerinfo.NewBlockProducerInfo(producer.GetAddress().String()))
+	dpos.SetKey(hex.EncodeToString(producer.GetKeyPair().GetPrivateKeyBytes()))
+	dpos.SetDynasty(NewDynasty([]string{producer.GetAddress().String()}, 1, defaultTimeBetweenBlk))
+
+	parent := newSignedBlock(producer, 0)
+	blk := newVrfBlock(dpos, producer, parent, defaultTimeBetweenBlk)
+	assert.Equal(t, vrfProofLength, len(blk.GetVrfProof()))
+	assert.True(t, dpos.Validate(blk))
+
+	pubkey, err := secp256k1.RecoverECDSAPublicKey(blk.GetHash(), blk.GetSign())
+	assert.Nil(t, err)
+	assert.True(t, verifyVrfProof(blk, pubkey, true))
+
+	// a proof of another producer does not verify
+	other := account.NewAccount()
+	otherDpos := NewDPOS(nil)
+	otherDpos.SetKey(hex.EncodeToString(other.GetKeyPair().GetPrivateKeyBytes()))
+	otherBlk := newVrfBlock(otherDpos, other, parent, defaultTimeBetweenBlk)
+	blk.SetVrfProof(otherBlk.GetVrfProof())
+	assert.False(t, verifyVrfProof(blk, pubkey, true))
+
+	// a truncated proof does not verify
+	blk.SetVrfProof(otherBlk.GetVrfProof()[1:])
+	assert.False(t, verifyVrfProof(blk, pubkey, true))
+
+	// a missing proof is only accepted before the activation height
+	blk.SetVrfProof(nil)
+	assert.False(t, verifyVrfProof(blk, pubkey, true))
+	assert.True(t, verifyVrfProof(blk, pubkey, false))
+}
+
+func TestDPOS_VrfActivationHeight(t *testing.T) {
+	producer := account.NewAccount()
+	dpos := NewDPOS(blockproducerinfo.NewBlockProducerInfo(producer.GetAddress().String()))
+	dpos.SetKey(hex.EncodeToString(producer.GetKeyPair().GetPrivateKeyBytes()))
+	dynasty := NewDynasty([]string{producer.GetAddress().String()}, 1, defaultTimeBetweenBlk)
+	dpos.SetDynasty(dynasty)
+
+	parent := newSignedBlock(producer, 0)
+	cbtx := ltransaction.NewCoinbaseTX(producer.GetAddress(), "", 1, common.NewAmount(0))
+	blk := block.NewBlockWithTimestamp([]*transaction.Transaction{&cbtx}, parent, defaultTimeBetweenBlk, producer.GetAddress().String())
+	signBlock(blk, hex.EncodeToString(producer.GetKeyPair().GetPrivateKeyBytes()))
+	assert.Empty(t, blk.GetVrfProof())
+	assert.False(t, dpos.Validate(blk))
+
+	dynasty.SetVrfActivationHeight(blk.GetHeight() + 1)
+	assert.True(t, dpos.Validate(blk))
+}
+
+func TestDPOS_generateVrfProofWithoutKey(t *testing.T) {
+	producer := account.NewAccount()
+	dpos := NewDPOS(blockproducerinfo.NewBlockProducerInfo(producer.GetAddress().String()))
+	dpos.SetDynasty(NewDynasty([]string{producer.GetAddress().String()}, 1, defaultTimeBetweenBlk))
+
+	blk := newVrfBlock(dpos, producer, newSignedBlock(producer, 0), defaultTimeBetweenBlk)
+	assert.Empty(t, blk.GetVrfProof())
+}
+
+func TestDPOS_getSeed(t *testing.T) {
+	producer := account.NewAccount()
+	dpos := NewDPOS(nil)
+	dpos.SetKey(hex.EncodeToString(producer.GetKeyPair().GetPrivateKeyBytes()))
+	dynasty := NewDynasty([]string{
+		producer.GetAddress().String(),
+		account.NewAccount().GetAddress().String(),
+		account.NewAccount().GetAddress().String(),
+	}, 3, defaultTimeBetweenBlk)
+	dpos.SetDynasty(dynasty)
+
+	// without a chain the round-robin order is used
+	assert.Nil(t, dpos.getSeed(2))
+
+	chain := &mockChain{blocks: make(map[string]*block.Block)}
+	dpos.SetChainReader(chain)
+
+	genesis := newSignedBlock(producer, 0)
+	chain.addBlock(genesis)
+	blk1 := newVrfBlock(dpos, producer, genesis, 15)
+	chain.addBlock(blk1)
+
+	// the first half of the previous round is not over yet
+	h := sha256.Sum256(vrfOutput(blk1.GetVrfProof()))
+	assert.Equal(t, h[:], dpos.getSeed(2))
+	_, cached := dpos.seeds.Get(int64(2))
+	assert.False(t, cached)
+
+	blk2 := newVrfBlock(dpos, producer, blk1, 20)
+	chain.addBlock(blk2)
+	blk3 := newVrfBlock(dpos, producer, blk2, 25)
+	chain.addBlock(blk3)
+	blk4 := newVrfBlock(dpos, producer, blk3, 30)
+	chain.addBlock(blk4)
+
+	h = sha256.Sum256(append(vrfOutput(blk1.GetVrfProof()), vrfOutput(blk2.GetVrfProof())...))
+	seed := dpos.getSeed(2)
+	assert.Equal(t, h[:], seed)
+	_, cached = dpos.seeds.Get(int64(2))
+	assert.True(t, cached)
+
+	// the genesis round has no VRF outputs
+	assert.Nil(t, dpos.getSeed(1))
+
+	assert.Equal(t, dynasty.ShuffleProducers(seed)[0], dpos.ProducerAtATime(30))
+	assert.Equal(t, dynasty.ShuffleProducers(seed)[1], dpos.ProducerAtATime(35))
+}
+
+func TestDPOS_producerOfBlock(t *testing.T) {
+	producer := account.NewAccount()
+	other := account.NewAccount()
+	dpos := NewDPOS(nil)
+	dpos.SetKey(hex.EncodeToString(producer.GetKeyPair().GetPrivateKeyBytes()))
+	otherDpos := NewDPOS(nil)
+	otherDpos.SetKey(hex.EncodeToString(other.GetKeyPair().GetPrivateKeyBytes()))
+	dynasty := NewDynasty([]string{
+		producer.GetAddress().String(),
+		other.GetAddress().String(),
+		account.NewAccount().GetAddress().String(),
+	}, 3, defaultTimeBetweenBlk)
+	dpos.SetDynasty(dynasty)
+
+	chain := &mockChain{blocks: make(map[string]*block.Block)}
+	dpos.SetChainReader(chain)
+	genesis := newSignedBlock(producer, 0)
+	chain.addBlock(genesis)
+
+	// two forks whose first blocks of the previous round were minted by different producers
+	forkA := newVrfBlock(dpos, producer, genesis, 15)
+	forkB := newVrfBlock(otherDpos, other, genesis, 15)
+	for i := int64(1); i < 3; i++ {
+		chain.addBlock(forkB)
+		forkB = newVrfBlock(dpos, producer, forkB, 15+i*defaultTimeBetweenBlk)
+		chain.addBlock(forkA)
+		forkA = newVrfBlock(dpos, producer, forkA, 15+i*defaultTimeBetweenBlk)
+	}
+	chain.addBlock(forkB)
+	chain.addBlock(forkA)
+
+	seedA, completeA := GetEpochSeed(chain, dynasty, 2)
+	seedB, completeB := GetEpochSeed(&parentReader{chain, forkB}, dynasty, 2)
+	assert.True(t, completeA)
+	assert.True(t, completeB)
+	assert.NotEqual(t, seedA, seedB)
+
+	// the slot order of a block on the fork that is not the tail is derived from its parent
+	for _, timestamp := range []int64{30, 35, 40} {
+		blk := newVrfBlock(dpos, producer, forkB, timestamp)
+		assert.Equal(t, dynasty.ProducerAtATimeWithSeed(timestamp, seedB), dpos.producerOfBlock(blk))
+	}
+
+	// the order derived from the tail is used while the parent is unknown
+	orphan := newVrfBlock(dpos, producer, newVrfBlock(dpos, producer, forkB, 30), 35)
+	assert.Equal(t, dynasty.ProducerAtATimeWithSeed(35, seedA), dpos.producerOfBlock(orphan))
+}
@@ -21,6 +21,7 @@ import (
 	"bytes"
 
 	accountpb "github.com/dappley/go-dappley/core/account/pb"
+	"github.com/dappley/go-dappley/crypto/keystore"
 	"github.com/golang/protobuf/proto"
 )
 
@@ -31,18 +32,19 @@ type Account struct {
 }
 
 func NewAccount() *Account {
-	account := &Account{}
-	account.key = NewKeyPair()
-	account.address = account.key.GenerateAddress()
-	account.pubKeyHash = newUserPubKeyHash(account.key.GetPublicKey())
-	return account
+	return NewAccountByKey(NewKeyPair())
+}
+
+//NewAccountWithAlgorithm creates an account with a new key pair of the signature algorithm
+func NewAccountWithAlgorithm(alg keystore.Algorithm) *Account {
+	return NewAccountByKey(NewKeyPairWithAlgorithm(alg))
 }
 
 func NewAccountByKey(key *KeyPair) *Account {
 	account := &Account{}
 	account.key = key
 	account.address = account.key.GenerateAddress()
-	account.pubKeyHash = newUserPubKeyHash(account.key.GetPublicKey())
+	account.pubKeyHash = newUserPubKeyHash(account.key.GetPublicKey(), account.key.GetAlgorithm())
 	return account
 }
 
@@ -51,6 +53,12 @@ func NewAccountByPrivateKey(privKey string) *Account {
 	return NewAccountByKey(kp)
 }
 
+//NewAccountByPrivateKeyWithAlgorithm creates an account from a hex private key of the signature algorithm
+func NewAccountByPrivateKeyWithAlgorithm(privKey string, alg keystore.Algorithm) *Account {
+	kp := GenerateKeyPairByPrivateKeyWithAlgorithm(privKey, alg)
+	return NewAccountByKey(kp)
+}
+
 func (a Account) GetKeyPair() *KeyPair {
 	return a.key
 }
@@ -20,25 +20,43 @@ package account
 
 import (
 	"crypto/ecdsa"
+	"encoding/hex"
 
 	accountpb "github.com/dappley/go-dappley/core/account/pb"
+	"github.com/dappley/go-dappley/crypto"
+	"github.com/dappley/go-dappley/crypto/keystore"
 	"github.com/dappley/go-dappley/crypto/keystore/secp256k1"
 	"github.com/golang/protobuf/proto"
 	logger "github.com/sirupsen/logrus"
 )
 
 type KeyPair struct {
+	algorithm  keystore.Algorithm
 	privateKey ecdsa.PrivateKey
-	publicKey  []byte
+	// seckey is the encoded private key of the algorithms other than secp256k1
+	seckey    []byte
+	publicKey []byte
 }
 
 func NewKeyPair() *KeyPair {
 	private, public := newKeyPair()
-	return &KeyPair{private, public}
+	return &KeyPair{algorithm: keystore.SECP256K1, privateKey: private, publicKey: public}
+}
+
+// NewKeyPairWithAlgorithm generates a key pair of the signature algorithm
+func NewKeyPairWithAlgorithm(alg keystore.Algorithm) *KeyPair {
+	if alg == keystore.SECP256K1 {
+		return NewKeyPair()
+	}
+	priv, err := crypto.NewPrivateKey(alg, nil)
+	if err != nil {
+		logger.Panic(err)
+	}
+	return newKeyPairByPrivateKey(alg, priv)
 }
 
 func (kp KeyPair) GenerateAddress() Address {
-	pubKeyHash := newUserPubKeyHash(kp.publicKey)
+	pubKeyHash := newUserPubKeyHash(kp.publicKey, kp.GetAlgorithm())
 	return pubKeyHash.GenerateAddress()
 }
 
@@ -53,14 +71,46 @@ func newKeyPair() (ecdsa.PrivateKey, []byte) {
 	return *private, pubKey[1:]
 }
 
+func newKeyPairByPrivateKey(alg keystore.Algorithm, priv keystore.PrivateKey) *KeyPair {
+	seckey, _ := priv.Encoded()
+	pubKey, _ := priv.PublicKey().Encoded()
+	return &KeyPair{algorithm: alg, seckey: seckey, publicKey: pubKey}
+}
+
+// GetAlgorithm returns the signature algorithm of the key pair
+func (kp *KeyPair) GetAlgorithm() keystore.Algorithm {
+	if kp.algorithm == 0 {
+		return keystore.SECP256K1
+	}
+	return kp.algorithm
+}
+
+// GetPrivateKey returns the ecdsa private key. It is only set for secp256k1 key pairs
 func (kp *KeyPair) GetPrivateKey() ecdsa.PrivateKey {
 	return kp.privateKey
 }
 
+// GetPrivateKeyBytes returns the encoded private key of any signature algorithm
+func (kp *KeyPair) GetPrivateKeyBytes() []byte {
+	if kp.GetAlgorithm() != keystore.SECP256K1 {
+		return kp.seckey
+	}
+	rawBytes, err := secp256k1.FromECDSAPrivateKey(&kp.privateKey)
+	if err != nil {
+		return nil
+	}
+	return rawBytes
+}
+
 func (kp *KeyPair) GetPublicKey() []byte {
 	return kp.publicKey
 }
 
+// Sign signs the hash with the private key of the key pair
+func (kp *KeyPair) Sign(hash []byte) ([]byte, error) {
+	return crypto.Sign(kp.GetAlgorithm(), hash, kp.GetPrivateKeyBytes())
+}
+
 func GenerateKeyPairByPrivateKey(privateKey string) *KeyPair {
 	private, err := secp256k1.HexToECDSAPrivateKey(privateKey)
 	if err != nil {
@@ -68,25 +118,55 @@ func GenerateKeyPairByPrivateKey(privateKey string) *KeyPair {
 	}
 
 	pubKey, _ := secp256k1.FromECDSAPublicKey(&private.PublicKey)
-	return &KeyPair{*private, pubKey[1:]}
+	return &KeyPair{algorithm: keystore.SECP256K1, privateKey: *private, publicKey: pubKey[1:]}
 }
 
-func (kp *KeyPair) ToProto() proto.Message {
-	rawBytes, err := secp256k1.FromECDSAPrivateKey(&kp.privateKey)
+// GenerateKeyPairByPrivateKeyWithAlgorithm restores a key pair from a hex private key of the signature algorithm
+func GenerateKeyPairByPrivateKeyWithAlgorithm(privateKey string, alg keystore.Algorithm) *KeyPair {
+	if alg == keystore.SECP256K1 {
+		return GenerateKeyPairByPrivateKey(privateKey)
+	}
+	seckey, err := hex.DecodeString(privateKey)
 	if err != nil {
+		logger.Panic(err)
+	}
+	priv, err := crypto.NewPrivateKey(alg, seckey)
+	if err != nil {
+		logger.Panic(err)
+	}
+	return newKeyPairByPrivateKey(alg, priv)
+}
+
+func (kp *KeyPair) ToProto() proto.Message {
+	rawBytes := kp.GetPrivateKeyBytes()
+	if rawBytes == nil {
 		logger.Error("Keypair: ToProto: Can not convert private key to bytes")
 	}
-	return &accountpb.KeyPair{
+	kpPb := &accountpb.KeyPair{
 		PrivateKey: rawBytes,
 		PublicKey:  kp.publicKey,
 	}
+	// secp256k1 key pairs leave the algorithm unset to keep their encoding unchanged
+	if kp.GetAlgorithm() != keystore.SECP256K1 {
+		kpPb.Algorithm = uint32(kp.GetAlgorithm())
+	}
+	return kpPb
 }
 
 func (kp *KeyPair) FromProto(pb proto.Message) {
+	kp.algorithm = keystore.Algorithm(pb.(*accountpb.KeyPair).GetAlgorithm())
+	if kp.algorithm == 0 {
+		// key pairs saved before the algorithm was recorded are secp256k1
+		kp.algorithm = keystore.SECP256K1
+	}
+	kp.publicKey = pb.(*accountpb.KeyPair).GetPublicKey()
+	if kp.algorithm != keystore.SECP256K1 {
+		kp.seckey = pb.(*accountpb.KeyPair).GetPrivateKey()
+		return
+	}
 	privKey, err := secp256k1.ToECDSAPrivateKey(pb.(*accountpb.KeyPair).GetPrivateKey())
 	if err != nil {
 		logger.Error("Keypair: FromProto: Can not convert bytes to private key")
 	}
 	kp.privateKey = *privKey
-	kp.publicKey = pb.(*accountpb.KeyPair).GetPublicKey()
 }
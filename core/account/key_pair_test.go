@@ -20,9 +20,13 @@ package account
 
 import (
 	"crypto/ecdsa"
+	"encoding/hex"
 	"testing"
 
 	accountpb "github.com/dappley/go-dappley/core/account/pb"
+	"github.com/dappley/go-dappley/crypto"
+	"github.com/dappley/go-dappley/crypto/hash"
+	"github.com/dappley/go-dappley/crypto/keystore"
 	"github.com/dappley/go-dappley/crypto/keystore/secp256k1"
 	"github.com/golang/protobuf/proto"
 
@@ -101,3 +105,54 @@ func TestGenerateKeyPairByPrivateKey(t *testing.T) {
 
 	_ = GenerateKeyPairByPrivateKey("bb23d2ff19f5b16955e8a24dca34dd520")
 }
+
+func TestNewKeyPairWithAlgorithm(t *testing.T) {
+	tests := []struct {
+		alg     keystore.Algorithm
+		version byte
+		pubLen  int
+	}{
+		{keystore.SECP256K1, versionUser, 64},
+		{keystore.ED25519, versionUserEd25519, 32},
+		{keystore.SCHNORR, versionUserSchnorr, 32},
+	}
+	msg := hash.Sha3256([]byte("dappley"))
+	for _, tt := range tests {
+		t.Run(crypto.AlgorithmName(tt.alg), func(t *testing.T) {
+			kp := NewKeyPairWithAlgorithm(tt.alg)
+			assert.Equal(t, tt.alg, kp.GetAlgorithm())
+			assert.Equal(t, tt.pubLen, len(kp.GetPublicKey()))
+
+			acc := NewAccountByKey(kp)
+			assert.True(t, acc.IsValid())
+			assert.Equal(t, tt.version, acc.GetPubKeyHash()[0])
+			isContract, err := acc.GetPubKeyHash().IsContract()
+			assert.Nil(t, err)
+			assert.False(t, isContract)
+			alg, err := acc.GetPubKeyHash().GetAlgorithm()
+			assert.Nil(t, err)
+			assert.Equal(t, tt.alg, alg)
+
+			sig, err := kp.Sign(msg)
+			assert.Nil(t, err)
+			pubKey := kp.GetPublicKey()
+			if tt.alg == keystore.SECP256K1 {
+				pubKey = append([]byte{4}, pubKey...)
+			}
+			ok, err := crypto.Verify(tt.alg, msg, sig, pubKey)
+			assert.Nil(t, err)
+			assert.True(t, ok)
+
+			rawBytes, err := proto.Marshal(kp.ToProto())
+			assert.Nil(t, err)
+			kpProto := &accountpb.KeyPair{}
+			assert.Nil(t, proto.Unmarshal(rawBytes, kpProto))
+			kp1 := &KeyPair{}
+			kp1.FromProto(kpProto)
+			assert.Equal(t, kp, kp1)
+
+			restored := GenerateKeyPairByPrivateKeyWithAlgorithm(hex.EncodeToString(kp.GetPrivateKeyBytes()), tt.alg)
+			assert.Equal(t, kp.GetPublicKey(), restored.GetPublicKey())
+		})
+	}
+}
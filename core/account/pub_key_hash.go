@@ -5,22 +5,37 @@ import (
 
 	"github.com/btcsuite/btcutil/base58"
 	"github.com/dappley/go-dappley/crypto/hash"
+	"github.com/dappley/go-dappley/crypto/keystore"
 	errval "github.com/dappley/go-dappley/errors"
 )
 
 const versionUser = byte(0x5A)
+const versionUserEd25519 = byte(0x5B)
+const versionUserSchnorr = byte(0x5C)
 const versionContract = byte(0x58)
 const addressChecksumLen = 4
 
 type PubKeyHash []byte
 
-//NewUserPubKeyHash hashes a public key and returns a user type public key hash
-func newUserPubKeyHash(pubKey []byte) PubKeyHash {
+//NewUserPubKeyHash hashes a public key and returns a user type public key hash of the signature algorithm
+func newUserPubKeyHash(pubKey []byte, alg keystore.Algorithm) PubKeyHash {
 	pubKeyHash := generatePubKeyHash(pubKey)
-	pubKeyHash = append([]byte{versionUser}, pubKeyHash...)
+	pubKeyHash = append([]byte{userVersionOf(alg)}, pubKeyHash...)
 	return PubKeyHash(pubKeyHash)
 }
 
+//userVersionOf returns the version byte of user addresses signed with the algorithm
+func userVersionOf(alg keystore.Algorithm) byte {
+	switch alg {
+	case keystore.ED25519:
+		return versionUserEd25519
+	case keystore.SCHNORR:
+		return versionUserSchnorr
+	default:
+		return versionUser
+	}
+}
+
 //NewContractPubKeyHash generates a smart Contract public key hash
 func newContractPubKeyHash() PubKeyHash {
 	pubKeyHash := generatePubKeyHash(NewKeyPair().GetPublicKey())
@@ -48,7 +63,7 @@ func (pkh PubKeyHash) IsContract() (bool, error) {
 		return false, errval.EmptyPublicKeyHash
 	}
 
-	if pkh[0] == versionUser {
+	if pkh[0] == versionUser || pkh[0] == versionUserEd25519 || pkh[0] == versionUserSchnorr {
 		return false, nil
 	}
 
@@ -59,6 +74,24 @@ func (pkh PubKeyHash) IsContract() (bool, error) {
 	return false, errval.InvalidPubKeyHashVersion
 }
 
+//GetAlgorithm returns the signature algorithm of a user public key hash
+func (pkh PubKeyHash) GetAlgorithm() (keystore.Algorithm, error) {
+	if len(pkh) == 0 {
+		return 0, errval.EmptyPublicKeyHash
+	}
+
+	switch pkh[0] {
+	case versionUser:
+		return keystore.SECP256K1, nil
+	case versionUserEd25519:
+		return keystore.ED25519, nil
+	case versionUserSchnorr:
+		return keystore.SCHNORR, nil
+	default:
+		return 0, errval.InvalidPubKeyHashVersion
+	}
+}
+
 //generatePubKeyHash hashes a public key
 func generatePubKeyHash(pubKey []byte) []byte {
 	sha := hash.Sha3256(pubKey)
@@ -23,6 +23,7 @@ import (
 
 	"github.com/btcsuite/btcutil/base58"
 	accountpb "github.com/dappley/go-dappley/core/account/pb"
+	"github.com/dappley/go-dappley/crypto/keystore"
 	"github.com/golang/protobuf/proto"
 )
 
@@ -39,8 +40,13 @@ func NewContractTransactionAccount() *TransactionAccount {
 }
 
 func NewTransactionAccountByPubKey(pubkey []byte) *TransactionAccount {
+	return NewTransactionAccountByPubKeyWithAlgorithm(pubkey, keystore.SECP256K1)
+}
+
+//NewTransactionAccountByPubKeyWithAlgorithm returns the account of a public key of the signature algorithm
+func NewTransactionAccountByPubKeyWithAlgorithm(pubkey []byte, alg keystore.Algorithm) *TransactionAccount {
 	account := &TransactionAccount{}
-	account.pubKeyHash = newUserPubKeyHash(pubkey)
+	account.pubKeyHash = newUserPubKeyHash(pubkey, alg)
 	account.address = account.pubKeyHash.GenerateAddress()
 	return account
 }
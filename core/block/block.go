@@ -69,6 +69,7 @@ func NewBlockByHash(preHash hash.Hash, producer string) *Block  {
 			[]byte{},
 			0,
 			producer,
+			nil,
 		},
 		transactions: []*transaction.Transaction{},
 	}
@@ -107,6 +108,10 @@ func (b *Block) GetProducer() string {
 	return b.header.producer
 }
 
+func (b *Block) GetVrfProof() []byte {
+	return b.header.vrfProof
+}
+
 func (b *Block) GetTransactions() []*transaction.Transaction {
 	return b.transactions
 }
@@ -131,6 +136,10 @@ func (b *Block) SetTimestamp(timestamp int64) {
 	b.header.timestamp = timestamp
 }
 
+func (b *Block) SetVrfProof(proof []byte) {
+	b.header.vrfProof = proof
+}
+
 func (b *Block) SetTransactions(txs []*transaction.Transaction) {
 	b.transactions = txs
 }
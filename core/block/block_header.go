@@ -14,6 +14,7 @@ type BlockHeader struct {
 	signature hash.Hash
 	height    uint64
 	producer  string
+	vrfProof  []byte
 }
 
 func NewBlockHeader(hash hash.Hash, prevHash hash.Hash, nonce int64, timeStamp int64, height uint64) *BlockHeader {
@@ -35,6 +36,7 @@ func (bh *BlockHeader) ToProto() proto.Message {
 		Signature:    bh.signature,
 		Height:       bh.height,
 		Producer:     bh.producer,
+		VrfProof:     bh.vrfProof,
 	}
 }
 
@@ -49,4 +51,5 @@ func (bh *BlockHeader) FromProto(pb proto.Message) {
 	bh.signature = pb.(*blockpb.BlockHeader).GetSignature()
 	bh.height = pb.(*blockpb.BlockHeader).GetHeight()
 	bh.producer = pb.(*blockpb.BlockHeader).GetProducer()
+	bh.vrfProof = pb.(*blockpb.BlockHeader).GetVrfProof()
 }
@@ -164,6 +164,7 @@ func TestBlockHeader_Proto(t *testing.T) {
 		nil,
 		0,
 		"",
+		[]byte("proof"),
 	}
 
 	pb := bh1.ToProto()
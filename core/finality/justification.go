@@ -0,0 +1,142 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package finality
+
+import (
+	"github.com/dappley/go-dappley/common/hash"
+	finalitypb "github.com/dappley/go-dappley/core/finality/pb"
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/golang/protobuf/proto"
+)
+
+var lastJustifiedKey = []byte("lastJustifiedBlockHash")
+
+// Justification proves that a block is irreversible with the precommits of more than 2/3 of the dynasty
+type Justification struct {
+	BlockHash  hash.Hash
+	Height     uint64
+	Precommits []*Vote
+}
+
+// NewJustification returns the justification of the block made of the precommits
+func NewJustification(blockHash hash.Hash, height uint64, precommits []*Vote) *Justification {
+	return &Justification{
+		BlockHash:  blockHash,
+		Height:     height,
+		Precommits: precommits,
+	}
+}
+
+// Quorum returns the number of votes that is more than 2/3 of the producers
+func Quorum(numOfProducers int) int {
+	return numOfProducers*2/3 + 1
+}
+
+// Verify checks that the justification has valid precommits for its block from more than 2/3 of the producers
+func (j *Justification) Verify(producers []string) error {
+	isProducer := make(map[string]bool)
+	for _, producer := range producers {
+		isProducer[producer] = true
+	}
+
+	signed := make(map[string]bool)
+	for _, vote := range j.Precommits {
+		if vote.Type != Precommit || !vote.BlockHash.Equals(j.BlockHash) || vote.Height != j.Height {
+			return errval.InvalidFinalityVote
+		}
+		if !isProducer[vote.Producer] || signed[vote.Producer] {
+			continue
+		}
+		if err := vote.Verify(); err != nil {
+			return err
+		}
+		signed[vote.Producer] = true
+	}
+
+	if len(signed) < Quorum(len(producers)) {
+		return errval.InsufficientPrecommits
+	}
+	return nil
+}
+
+func (j *Justification) ToProto() proto.Message {
+	var precommits []*finalitypb.Vote
+	for _, vote := range j.Precommits {
+		precommits = append(precommits, vote.ToProto().(*finalitypb.Vote))
+	}
+	return &finalitypb.Justification{
+		BlockHash:  j.BlockHash,
+		Height:     j.Height,
+		Precommits: precommits,
+	}
+}
+
+func (j *Justification) FromProto(pb proto.Message) {
+	justificationPb := pb.(*finalitypb.Justification)
+	j.BlockHash = justificationPb.GetBlockHash()
+	j.Height = justificationPb.GetHeight()
+	j.Precommits = nil
+	for _, votePb := range justificationPb.GetPrecommits() {
+		vote := &Vote{}
+		vote.FromProto(votePb)
+		j.Precommits = append(j.Precommits, vote)
+	}
+}
+
+// generate justification storage key in database
+func getJustificationStorageKey(blockHash hash.Hash) []byte {
+	return []byte("justification_" + string(blockHash))
+}
+
+// Save stores the justification next to its block and marks its block as the last justified block
+func (j *Justification) Save(db storage.Storage) error {
+	rawBytes, err := proto.Marshal(j.ToProto())
+	if err != nil {
+		return err
+	}
+	if err := db.Put(getJustificationStorageKey(j.BlockHash), rawBytes); err != nil {
+		return err
+	}
+	return db.Put(lastJustifiedKey, j.BlockHash)
+}
+
+// GetJustification returns the justification of the block from database
+func GetJustification(blockHash hash.Hash, db storage.Storage) (*Justification, error) {
+	rawBytes, err := db.Get(getJustificationStorageKey(blockHash))
+	if err != nil {
+		return nil, errval.JustificationNotFound
+	}
+	justificationPb := &finalitypb.Justification{}
+	if err := proto.Unmarshal(rawBytes, justificationPb); err != nil {
+		return nil, err
+	}
+	j := &Justification{}
+	j.FromProto(justificationPb)
+	return j, nil
+}
+
+// GetLastJustification returns the justification of the last justified block from database
+func GetLastJustification(db storage.Storage) (*Justification, error) {
+	blockHash, err := db.Get(lastJustifiedKey)
+	if err != nil {
+		return nil, errval.JustificationNotFound
+	}
+	return GetJustification(blockHash, db)
+}
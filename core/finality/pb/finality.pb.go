@@ -0,0 +1,368 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.23.0
+// 	protoc        v3.13.0
+// source: github.com/dappley/go-dappley/core/finality/pb/finality.proto
+
+package finalitypb
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type Vote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type      int32  `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	BlockHash []byte `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Height    uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Producer  string `protobuf:"bytes,4,opt,name=producer,proto3" json:"producer,omitempty"`
+	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *Vote) Reset() {
+	*x = Vote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Vote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vote) ProtoMessage() {}
+
+func (x *Vote) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vote.ProtoReflect.Descriptor instead.
+func (*Vote) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Vote) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *Vote) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *Vote) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Vote) GetProducer() string {
+	if x != nil {
+		return x.Producer
+	}
+	return ""
+}
+
+func (x *Vote) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type Justification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHash  []byte  `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Height     uint64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Precommits []*Vote `protobuf:"bytes,3,rep,name=precommits,proto3" json:"precommits,omitempty"`
+}
+
+func (x *Justification) Reset() {
+	*x = Justification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Justification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Justification) ProtoMessage() {}
+
+func (x *Justification) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Justification.ProtoReflect.Descriptor instead.
+func (*Justification) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Justification) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *Justification) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Justification) GetPrecommits() []*Vote {
+	if x != nil {
+		return x.Precommits
+	}
+	return nil
+}
+
+type VoterState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LastPrevoteHeight   uint64 `protobuf:"varint,1,opt,name=last_prevote_height,json=lastPrevoteHeight,proto3" json:"last_prevote_height,omitempty"`
+	LastPrecommitHeight uint64 `protobuf:"varint,2,opt,name=last_precommit_height,json=lastPrecommitHeight,proto3" json:"last_precommit_height,omitempty"`
+	LockedHash          []byte `protobuf:"bytes,3,opt,name=locked_hash,json=lockedHash,proto3" json:"locked_hash,omitempty"`
+	LockedHeight        uint64 `protobuf:"varint,4,opt,name=locked_height,json=lockedHeight,proto3" json:"locked_height,omitempty"`
+}
+
+func (x *VoterState) Reset() {
+	*x = VoterState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoterState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoterState) ProtoMessage() {}
+
+func (x *VoterState) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoterState.ProtoReflect.Descriptor instead.
+func (*VoterState) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VoterState) GetLastPrevoteHeight() uint64 {
+	if x != nil {
+		return x.LastPrevoteHeight
+	}
+	return 0
+}
+
+func (x *VoterState) GetLastPrecommitHeight() uint64 {
+	if x != nil {
+		return x.LastPrecommitHeight
+	}
+	return 0
+}
+
+func (x *VoterState) GetLockedHash() []byte {
+	if x != nil {
+		return x.LockedHash
+	}
+	return nil
+}
+
+func (x *VoterState) GetLockedHeight() uint64 {
+	if x != nil {
+		return x.LockedHeight
+	}
+	return 0
+}
+
+var File_github_com_dappley_go_dappley_core_finality_pb_finality_proto protoreflect.FileDescriptor
+
+var file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_rawDesc = []byte{
+	0x0a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70,
+	0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f,
+	0x63, 0x6f, 0x72, 0x65, 0x2f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x2f, 0x70, 0x62,
+	0x2f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0a, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x70, 0x62, 0x22, 0x8b, 0x01, 0x0a, 0x04,
+	0x56, 0x6f, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x78, 0x0a, 0x0d, 0x4a, 0x75, 0x73,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x30, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x70, 0x62, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x0a, 0x56, 0x6f, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x6f,
+	0x74, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x11, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x65, 0x76, 0x6f, 0x74, 0x65, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x13, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_rawDescOnce sync.Once
+	file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_rawDescData = file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_rawDesc
+)
+
+func file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_rawDescGZIP() []byte {
+	file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_rawDescOnce.Do(func() {
+		file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_rawDescData)
+	})
+	return file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_rawDescData
+}
+
+var file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_goTypes = []interface{}{
+	(*Vote)(nil),          // 0: finalitypb.Vote
+	(*Justification)(nil), // 1: finalitypb.Justification
+	(*VoterState)(nil),    // 2: finalitypb.VoterState
+}
+var file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_depIdxs = []int32{
+	0, // 0: finalitypb.Justification.precommits:type_name -> finalitypb.Vote
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_init() }
+func file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_init() {
+	if File_github_com_dappley_go_dappley_core_finality_pb_finality_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Vote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Justification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoterState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_goTypes,
+		DependencyIndexes: file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_depIdxs,
+		MessageInfos:      file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_msgTypes,
+	}.Build()
+	File_github_com_dappley_go_dappley_core_finality_pb_finality_proto = out.File
+	file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_rawDesc = nil
+	file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_goTypes = nil
+	file_github_com_dappley_go_dappley_core_finality_pb_finality_proto_depIdxs = nil
+}
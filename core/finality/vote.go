@@ -0,0 +1,132 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package finality
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/core/account"
+	finalitypb "github.com/dappley/go-dappley/core/finality/pb"
+	"github.com/dappley/go-dappley/crypto/keystore/secp256k1"
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/util"
+	"github.com/golang/protobuf/proto"
+)
+
+// VoteType is the step of the finality protocol that a vote belongs to
+type VoteType int32
+
+const (
+	// Prevote is cast by a producer for the block it accepts as the tail of its blockchain
+	Prevote VoteType = 1
+	// Precommit is cast by a producer once the block has prevotes from more than 2/3 of the dynasty
+	Precommit VoteType = 2
+)
+
+// Vote is a producer's signed vote for a block
+type Vote struct {
+	Type      VoteType
+	BlockHash hash.Hash
+	Height    uint64
+	Producer  string
+	Signature hash.Hash
+}
+
+// NewVote returns an unsigned vote of the producer for the block
+func NewVote(voteType VoteType, blockHash hash.Hash, height uint64, producer string) *Vote {
+	return &Vote{
+		Type:      voteType,
+		BlockHash: blockHash,
+		Height:    height,
+		Producer:  producer,
+	}
+}
+
+// Hash returns the hash of the vote that the producer signs
+func (v *Vote) Hash() hash.Hash {
+	data := bytes.Join(
+		[][]byte{
+			util.IntToHex(int64(v.Type)),
+			v.BlockHash,
+			util.UintToHex(v.Height),
+			[]byte(v.Producer),
+		},
+		[]byte{},
+	)
+	h := sha256.Sum256(data)
+	return h[:]
+}
+
+// Sign signs the vote with the hex encoded private key of the producer
+func (v *Vote) Sign(key string) error {
+	privData, err := hex.DecodeString(key)
+	if err != nil {
+		return err
+	}
+	signature, err := secp256k1.Sign(v.Hash(), privData)
+	if err != nil {
+		return err
+	}
+	v.Signature = signature
+	return nil
+}
+
+// Verify checks that the vote has a known type and is signed by its producer
+func (v *Vote) Verify() error {
+	if v.Type != Prevote && v.Type != Precommit {
+		return errval.InvalidFinalityVote
+	}
+	if len(v.Signature) == 0 || len(v.BlockHash) == 0 {
+		return errval.InvalidFinalityVote
+	}
+
+	pubkey, err := secp256k1.RecoverECDSAPublicKey(v.Hash(), v.Signature)
+	if err != nil {
+		return errval.InvalidFinalityVote
+	}
+	if ok, _ := account.IsValidPubKey(pubkey[1:]); !ok {
+		return errval.InvalidFinalityVote
+	}
+	if account.NewTransactionAccountByPubKey(pubkey[1:]).GetAddress().String() != v.Producer {
+		return errval.InvalidFinalityVote
+	}
+	return nil
+}
+
+func (v *Vote) ToProto() proto.Message {
+	return &finalitypb.Vote{
+		Type:      int32(v.Type),
+		BlockHash: v.BlockHash,
+		Height:    v.Height,
+		Producer:  v.Producer,
+		Signature: v.Signature,
+	}
+}
+
+func (v *Vote) FromProto(pb proto.Message) {
+	votePb := pb.(*finalitypb.Vote)
+	v.Type = VoteType(votePb.GetType())
+	v.BlockHash = votePb.GetBlockHash()
+	v.Height = votePb.GetHeight()
+	v.Producer = votePb.GetProducer()
+	v.Signature = votePb.GetSignature()
+}
@@ -0,0 +1,98 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package finality
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/dappley/go-dappley/core/account"
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func newSignedVote(voteType VoteType, producer *account.Account, blockHash []byte, height uint64) *Vote {
+	vote := NewVote(voteType, blockHash, height, producer.GetAddress().String())
+	vote.Sign(hex.EncodeToString(producer.GetKeyPair().GetPrivateKeyBytes()))
+	return vote
+}
+
+func TestVote_Verify(t *testing.T) {
+	producer := account.NewAccount()
+	vote := newSignedVote(Prevote, producer, []byte("hash"), 1)
+	assert.Nil(t, vote.Verify())
+
+	// the vote is bound to its block, height and type
+	vote.Height = 2
+	assert.Equal(t, errval.InvalidFinalityVote, vote.Verify())
+	vote.Height = 1
+	vote.Type = Precommit
+	assert.Equal(t, errval.InvalidFinalityVote, vote.Verify())
+
+	// the signer must be the producer of the vote
+	vote = newSignedVote(Prevote, producer, []byte("hash"), 1)
+	vote.Producer = account.NewAccount().GetAddress().String()
+	assert.Equal(t, errval.InvalidFinalityVote, vote.Verify())
+
+	vote = newSignedVote(Prevote, producer, []byte("hash"), 1)
+	newVote := &Vote{}
+	newVote.FromProto(vote.ToProto())
+	assert.Equal(t, vote, newVote)
+}
+
+func TestJustification_Verify(t *testing.T) {
+	producers := []*account.Account{account.NewAccount(), account.NewAccount(), account.NewAccount(), account.NewAccount()}
+	var addresses []string
+	var precommits []*Vote
+	for _, producer := range producers {
+		addresses = append(addresses, producer.GetAddress().String())
+		precommits = append(precommits, newSignedVote(Precommit, producer, []byte("hash"), 1))
+	}
+
+	assert.Nil(t, NewJustification([]byte("hash"), 1, precommits).Verify(addresses))
+	assert.Nil(t, NewJustification([]byte("hash"), 1, precommits[:3]).Verify(addresses))
+	// 2 of 4 producers are not more than 2/3
+	assert.Equal(t, errval.InsufficientPrecommits, NewJustification([]byte("hash"), 1, precommits[:2]).Verify(addresses))
+	// duplicated precommits are counted once
+	duplicated := []*Vote{precommits[0], precommits[0], precommits[1]}
+	assert.Equal(t, errval.InsufficientPrecommits, NewJustification([]byte("hash"), 1, duplicated).Verify(addresses))
+	// precommits of another block are invalid
+	assert.Equal(t, errval.InvalidFinalityVote, NewJustification([]byte("other"), 1, precommits).Verify(addresses))
+}
+
+func TestJustification_Save(t *testing.T) {
+	db := storage.NewRamStorage()
+	defer db.Close()
+
+	_, err := GetLastJustification(db)
+	assert.Equal(t, errval.JustificationNotFound, err)
+
+	producer := account.NewAccount()
+	justification := NewJustification([]byte("hash"), 1, []*Vote{newSignedVote(Precommit, producer, []byte("hash"), 1)})
+	assert.Nil(t, justification.Save(db))
+
+	saved, err := GetJustification([]byte("hash"), db)
+	assert.Nil(t, err)
+	assert.Equal(t, justification, saved)
+
+	last, err := GetLastJustification(db)
+	assert.Nil(t, err)
+	assert.Equal(t, justification, last)
+}
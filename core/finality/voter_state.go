@@ -0,0 +1,88 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package finality
+
+import (
+	"github.com/dappley/go-dappley/common/hash"
+	finalitypb "github.com/dappley/go-dappley/core/finality/pb"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/golang/protobuf/proto"
+)
+
+// VoterState records the heights of the last votes of a producer and the block it is locked on. It is stored before
+// each vote is sent, so that a restarted producer never votes twice at a height or leaves its lock
+type VoterState struct {
+	LastVoted    map[VoteType]uint64
+	LockedHash   hash.Hash
+	LockedHeight uint64
+}
+
+// NewVoterState returns the state of a producer that has not voted yet
+func NewVoterState() *VoterState {
+	return &VoterState{LastVoted: make(map[VoteType]uint64)}
+}
+
+func (s *VoterState) ToProto() proto.Message {
+	return &finalitypb.VoterState{
+		LastPrevoteHeight:   s.LastVoted[Prevote],
+		LastPrecommitHeight: s.LastVoted[Precommit],
+		LockedHash:          s.LockedHash,
+		LockedHeight:        s.LockedHeight,
+	}
+}
+
+func (s *VoterState) FromProto(pb proto.Message) {
+	statePb := pb.(*finalitypb.VoterState)
+	s.LastVoted = map[VoteType]uint64{
+		Prevote:   statePb.GetLastPrevoteHeight(),
+		Precommit: statePb.GetLastPrecommitHeight(),
+	}
+	s.LockedHash = statePb.GetLockedHash()
+	s.LockedHeight = statePb.GetLockedHeight()
+}
+
+// generate voter state storage key in database
+func getVoterStateStorageKey(producer string) []byte {
+	return []byte("voterState_" + producer)
+}
+
+// Save stores the voter state of the producer
+func (s *VoterState) Save(producer string, db storage.Storage) error {
+	rawBytes, err := proto.Marshal(s.ToProto())
+	if err != nil {
+		return err
+	}
+	return db.Put(getVoterStateStorageKey(producer), rawBytes)
+}
+
+// GetVoterState returns the voter state of the producer from database. A producer without a stored state has not
+// voted yet
+func GetVoterState(producer string, db storage.Storage) (*VoterState, error) {
+	rawBytes, err := db.Get(getVoterStateStorageKey(producer))
+	if err != nil {
+		return NewVoterState(), nil
+	}
+	statePb := &finalitypb.VoterState{}
+	if err := proto.Unmarshal(rawBytes, statePb); err != nil {
+		return nil, err
+	}
+	s := &VoterState{}
+	s.FromProto(statePb)
+	return s, nil
+}
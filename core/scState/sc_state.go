@@ -78,11 +78,12 @@ func (ss *ScState) RevertState(blkHash hash.Hash) {
 	stlog, err := ss.cache.GetStateLog(utxo.GetscStateLogKey(blkHash))
 	if err != nil {
 		logger.Warn("get state log failed: ", err)
+		return
 	}
 
 	for address, state := range stlog.Log {
 		for key, value := range state {
-			ss.states[address] = map[string]string{key: value}
+			ss.SetStateValue(address, key, value)
 		}
 	}
 }
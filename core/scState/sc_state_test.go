@@ -120,7 +120,7 @@ func TestScState_RevertState(t *testing.T) {
 	cache := utxo.NewUTXOCache(db)
 
 	stLog := stateLog.NewStateLog()
-	stLog.Log = map[string]map[string]string{"dGDrVKjCG3sdXtDUgWZ7Fp3Q97tLhqWivf": {"Account3": "399"}}
+	stLog.Log = map[string]map[string]string{"dGDrVKjCG3sdXtDUgWZ7Fp3Q97tLhqWivf": {"Account3": "399", "Account4": "499"}}
 	assert.Nil(t, db.Put(util.Str2bytes("scLog"+"blkHash"), stLog.SerializeStateLog()))
 
 	scState := NewScState(cache)
@@ -61,11 +61,11 @@ func MockTxInputs() []transactionbase.TXInput {
 		{util.GenerateRandomAoB(2),
 			6,
 			util.GenerateRandomAoB(2),
-			[]byte("12345678901234567890123456789013")},
+			[]byte("12345678901234567890123456789013"), 0},
 		{util.GenerateRandomAoB(2),
 			2,
 			util.GenerateRandomAoB(2),
-			[]byte("12345678901234567890123456789014")},
+			[]byte("12345678901234567890123456789014"), 0},
 	}
 }
 
@@ -74,11 +74,11 @@ func MockTxInputsWithPubkey(pubkey []byte) []transactionbase.TXInput {
 		{util.GenerateRandomAoB(2),
 			6,
 			util.GenerateRandomAoB(2),
-			pubkey},
+			pubkey, 0},
 		{util.GenerateRandomAoB(2),
 			2,
 			util.GenerateRandomAoB(2),
-			pubkey},
+			pubkey, 0},
 	}
 }
 
@@ -86,7 +86,7 @@ func MockUtxos(inputs []transactionbase.TXInput) []*utxo.UTXO {
 	utxos := make([]*utxo.UTXO, len(inputs))
 
 	for index, input := range inputs {
-		ta := account.NewTransactionAccountByPubKey(input.PubKey)
+		ta := account.NewTransactionAccountByPubKeyWithAlgorithm(input.PubKey, input.GetAlgorithm())
 		utxos[index] = &utxo.UTXO{
 			TXOutput: transactionbase.TXOutput{Value: common.NewAmount(10), PubKeyHash: ta.GetPubKeyHash(), Contract: ""},
 			Txid:     input.Txid,
@@ -162,12 +162,12 @@ func MockUtxoInputs() []transactionbase.TXInput {
 			[]byte("tx1"),
 			0,
 			util.GenerateRandomAoB(2),
-			address1Bytes},
+			address1Bytes, 0},
 		{
 			[]byte("tx1"),
 			1,
 			util.GenerateRandomAoB(2),
-			address1Bytes},
+			address1Bytes, 0},
 	}
 }
 
@@ -0,0 +1,174 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package transaction
+
+import (
+	"sync"
+
+	"github.com/dappley/go-dappley/common"
+	errval "github.com/dappley/go-dappley/errors"
+)
+
+const (
+	// RewardCurveConstant mints the same reward for every block
+	RewardCurveConstant = "constant"
+	// RewardCurveHalving halves the reward after every interval
+	RewardCurveHalving = "halving"
+	// RewardCurveDecay keeps a percentage of the reward after every interval
+	RewardCurveDecay = "decay"
+)
+
+// MonetaryPolicy decides the reward minted by each block, the maximum supply and the part of the gas fees that is burnt
+type MonetaryPolicy struct {
+	initialReward  *common.Amount
+	curve          string
+	interval       uint64
+	decayPercent   uint64
+	supplyCap      *common.Amount
+	feeBurnPercent uint64
+	rewards        []*common.Amount // the reward of each interval
+	emissions      []*common.Amount // the coins minted before each interval
+	mutex          *sync.Mutex
+}
+
+var monetaryPolicy, _ = NewMonetaryPolicy(nil, RewardCurveConstant, 0, 0, nil, 0)
+
+// NewMonetaryPolicy returns a monetary policy. The block reward starts at initialReward, or Subsidy if it is nil, and
+// follows the curve every interval blocks. A nil or zero supplyCap does not limit the supply
+func NewMonetaryPolicy(initialReward *common.Amount, curve string, interval, decayPercent uint64, supplyCap *common.Amount, feeBurnPercent uint64) (*MonetaryPolicy, error) {
+	if curve == "" {
+		curve = RewardCurveConstant
+	}
+	switch curve {
+	case RewardCurveConstant:
+	case RewardCurveHalving:
+		if interval == 0 {
+			return nil, errval.InvalidMonetaryPolicy
+		}
+	case RewardCurveDecay:
+		if interval == 0 || decayPercent >= 100 {
+			return nil, errval.InvalidMonetaryPolicy
+		}
+	default:
+		return nil, errval.InvalidMonetaryPolicy
+	}
+	if feeBurnPercent > 100 {
+		return nil, errval.InvalidMonetaryPolicy
+	}
+	if supplyCap != nil && supplyCap.IsZero() {
+		supplyCap = nil
+	}
+
+	return &MonetaryPolicy{
+		initialReward:  initialReward,
+		curve:          curve,
+		interval:       interval,
+		decayPercent:   decayPercent,
+		supplyCap:      supplyCap,
+		feeBurnPercent: feeBurnPercent,
+		mutex:          &sync.Mutex{},
+	}, nil
+}
+
+// SetMonetaryPolicy sets the monetary policy of the chain
+func SetMonetaryPolicy(policy *MonetaryPolicy) {
+	monetaryPolicy = policy
+}
+
+// GetMonetaryPolicy returns the monetary policy of the chain
+func GetMonetaryPolicy() *MonetaryPolicy {
+	return monetaryPolicy
+}
+
+// GetSupplyCap returns the maximum supply. It returns nil if the supply is not limited
+func (policy *MonetaryPolicy) GetSupplyCap() *common.Amount {
+	return policy.supplyCap
+}
+
+// GetReward returns the reward minted by the block at height
+func (policy *MonetaryPolicy) GetReward(height uint64) *common.Amount {
+	if height == 0 {
+		return policy.GetEmission(0)
+	}
+	reward, _ := policy.GetEmission(height).Sub(policy.GetEmission(height - 1))
+	return reward
+}
+
+// GetEmission returns the coins minted by the blocks from the genesis block up to height
+func (policy *MonetaryPolicy) GetEmission(height uint64) *common.Amount {
+	emission := policy.getScheduledEmission(height)
+	if policy.supplyCap != nil && emission.Cmp(policy.supplyCap) > 0 {
+		return policy.supplyCap
+	}
+	return emission
+}
+
+// SplitFee splits a gas fee into the part rewarded to the producer and the part that is burnt
+func (policy *MonetaryPolicy) SplitFee(fee *common.Amount) (*common.Amount, *common.Amount) {
+	burnt := fee.Times(policy.feeBurnPercent).Div(100)
+	reward, _ := fee.Sub(burnt)
+	return reward, burnt
+}
+
+func (policy *MonetaryPolicy) getInitialReward() *common.Amount {
+	if policy.initialReward == nil {
+		return Subsidy
+	}
+	return policy.initialReward
+}
+
+// getScheduledEmission returns the sum of the rewards of the curve from the genesis block up to height
+func (policy *MonetaryPolicy) getScheduledEmission(height uint64) *common.Amount {
+	if policy.curve == RewardCurveConstant {
+		return policy.getInitialReward().Times(height + 1)
+	}
+
+	policy.mutex.Lock()
+	defer policy.mutex.Unlock()
+	index := height / policy.interval
+	if index >= policy.fillIntervals(index) {
+		last := len(policy.rewards) - 1
+		return policy.emissions[last].Add(policy.rewards[last].Times(policy.interval))
+	}
+	return policy.emissions[index].Add(policy.rewards[index].Times(height - index*policy.interval + 1))
+}
+
+// fillIntervals computes the rewards of the intervals up to index. It stops at the first interval without reward and
+// returns the number of intervals computed
+func (policy *MonetaryPolicy) fillIntervals(index uint64) uint64 {
+	if len(policy.rewards) == 0 {
+		policy.rewards = append(policy.rewards, policy.getInitialReward())
+		policy.emissions = append(policy.emissions, common.NewAmount(0))
+	}
+	for uint64(len(policy.rewards)) <= index {
+		last := len(policy.rewards) - 1
+		if policy.rewards[last].IsZero() {
+			break
+		}
+		var reward *common.Amount
+		if policy.curve == RewardCurveHalving {
+			reward = policy.rewards[last].Div(2)
+		} else {
+			reward = policy.rewards[last].Times(policy.decayPercent).Div(100)
+		}
+		policy.rewards = append(policy.rewards, reward)
+		policy.emissions = append(policy.emissions, policy.emissions[last].Add(policy.rewards[last].Times(policy.interval)))
+	}
+	return uint64(len(policy.rewards))
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package transaction
+
+import (
+	"testing"
+
+	"github.com/dappley/go-dappley/common"
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMonetaryPolicy(t *testing.T) {
+	tests := []struct {
+		name           string
+		curve          string
+		interval       uint64
+		decayPercent   uint64
+		feeBurnPercent uint64
+		err            error
+	}{
+		{"constant", "", 0, 0, 0, nil},
+		{"halving", RewardCurveHalving, 10, 0, 50, nil},
+		{"halving without interval", RewardCurveHalving, 0, 0, 0, errval.InvalidMonetaryPolicy},
+		{"decay", RewardCurveDecay, 10, 90, 100, nil},
+		{"decay without decrease", RewardCurveDecay, 10, 100, 0, errval.InvalidMonetaryPolicy},
+		{"unknown curve", "linear", 10, 0, 0, errval.InvalidMonetaryPolicy},
+		{"invalid burn", RewardCurveConstant, 0, 0, 101, errval.InvalidMonetaryPolicy},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewMonetaryPolicy(common.NewAmount(100), tt.curve, tt.interval, tt.decayPercent, nil, tt.feeBurnPercent)
+			assert.Equal(t, tt.err, err)
+		})
+	}
+}
+
+func TestMonetaryPolicy_GetReward(t *testing.T) {
+	constant, _ := NewMonetaryPolicy(nil, RewardCurveConstant, 0, 0, nil, 0)
+	assert.Equal(t, Subsidy.Uint64(), constant.GetReward(0).Uint64())
+	assert.Equal(t, Subsidy.Uint64(), constant.GetReward(1000).Uint64())
+	assert.Equal(t, Subsidy.Uint64()*11, constant.GetEmission(10).Uint64())
+
+	halving, _ := NewMonetaryPolicy(common.NewAmount(100), RewardCurveHalving, 10, 0, nil, 0)
+	assert.Equal(t, uint64(100), halving.GetReward(9).Uint64())
+	assert.Equal(t, uint64(50), halving.GetReward(10).Uint64())
+	assert.Equal(t, uint64(25), halving.GetReward(25).Uint64())
+	assert.Equal(t, uint64(1000+500+250), halving.GetEmission(29).Uint64())
+	assert.Equal(t, uint64(0), halving.GetReward(100000).Uint64())
+	assert.Equal(t, uint64(1000+500+250+120+60+30+10), halving.GetEmission(100000).Uint64())
+
+	decay, _ := NewMonetaryPolicy(common.NewAmount(100), RewardCurveDecay, 10, 90, nil, 0)
+	assert.Equal(t, uint64(90), decay.GetReward(10).Uint64())
+	assert.Equal(t, uint64(81), decay.GetReward(20).Uint64())
+
+	// no reward is minted once the cap is reached
+	capped, _ := NewMonetaryPolicy(common.NewAmount(100), RewardCurveConstant, 0, 0, common.NewAmount(250), 0)
+	assert.Equal(t, uint64(100), capped.GetReward(1).Uint64())
+	assert.Equal(t, uint64(50), capped.GetReward(2).Uint64())
+	assert.Equal(t, uint64(0), capped.GetReward(3).Uint64())
+	assert.Equal(t, uint64(250), capped.GetEmission(3).Uint64())
+}
+
+func TestMonetaryPolicy_SplitFee(t *testing.T) {
+	policy, _ := NewMonetaryPolicy(nil, RewardCurveConstant, 0, 0, nil, 30)
+	reward, burnt := policy.SplitFee(common.NewAmount(1000))
+	assert.Equal(t, uint64(700), reward.Uint64())
+	assert.Equal(t, uint64(300), burnt.Uint64())
+}
@@ -1,282 +1,627 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.24.0
+// 	protoc        v3.6.1
 // source: github.com/dappley/go-dappley/core/transaction/pb/transaction.proto
 
 package transactionpb
 
 import (
-	fmt "fmt"
 	pb "github.com/dappley/go-dappley/core/transactionbase/pb"
-	proto "github.com/golang/protobuf/proto"
-	math "math"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
 )
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ = proto.Marshal
-var _ = fmt.Errorf
-var _ = math.Inf
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the proto package it is being compiled against.
-// A compilation error at this line likely means your copy of the
-// proto package needs to be updated.
-const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
 
 type Transactions struct {
-	Transactions         []*Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
-}
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (m *Transactions) Reset()         { *m = Transactions{} }
-func (m *Transactions) String() string { return proto.CompactTextString(m) }
-func (*Transactions) ProtoMessage()    {}
-func (*Transactions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4138a4cf34c3b76a, []int{0}
+	Transactions []*Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
 }
 
-func (m *Transactions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Transactions.Unmarshal(m, b)
-}
-func (m *Transactions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Transactions.Marshal(b, m, deterministic)
-}
-func (m *Transactions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Transactions.Merge(m, src)
+func (x *Transactions) Reset() {
+	*x = Transactions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-func (m *Transactions) XXX_Size() int {
-	return xxx_messageInfo_Transactions.Size(m)
+
+func (x *Transactions) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *Transactions) XXX_DiscardUnknown() {
-	xxx_messageInfo_Transactions.DiscardUnknown(m)
+
+func (*Transactions) ProtoMessage() {}
+
+func (x *Transactions) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_Transactions proto.InternalMessageInfo
+// Deprecated: Use Transactions.ProtoReflect.Descriptor instead.
+func (*Transactions) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_rawDescGZIP(), []int{0}
+}
 
-func (m *Transactions) GetTransactions() []*Transaction {
-	if m != nil {
-		return m.Transactions
+func (x *Transactions) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
 	}
 	return nil
 }
 
 type Transaction struct {
-	Id                   []byte         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Vin                  []*pb.TXInput  `protobuf:"bytes,2,rep,name=vin,proto3" json:"vin,omitempty"`
-	Vout                 []*pb.TXOutput `protobuf:"bytes,3,rep,name=vout,proto3" json:"vout,omitempty"`
-	Tip                  []byte         `protobuf:"bytes,4,opt,name=tip,proto3" json:"tip,omitempty"`
-	GasLimit             []byte         `protobuf:"bytes,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
-	GasPrice             []byte         `protobuf:"bytes,6,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
-	Type                 int32          `protobuf:"varint,7,opt,name=type,proto3" json:"type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
-}
-
-func (m *Transaction) Reset()         { *m = Transaction{} }
-func (m *Transaction) String() string { return proto.CompactTextString(m) }
-func (*Transaction) ProtoMessage()    {}
-func (*Transaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4138a4cf34c3b76a, []int{1}
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       []byte         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Vin      []*pb.TXInput  `protobuf:"bytes,2,rep,name=vin,proto3" json:"vin,omitempty"`
+	Vout     []*pb.TXOutput `protobuf:"bytes,3,rep,name=vout,proto3" json:"vout,omitempty"`
+	Tip      []byte         `protobuf:"bytes,4,opt,name=tip,proto3" json:"tip,omitempty"`
+	GasLimit []byte         `protobuf:"bytes,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	GasPrice []byte         `protobuf:"bytes,6,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	Type     int32          `protobuf:"varint,7,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *Transaction) Reset() {
+	*x = Transaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (m *Transaction) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Transaction.Unmarshal(m, b)
-}
-func (m *Transaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Transaction.Marshal(b, m, deterministic)
-}
-func (m *Transaction) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Transaction.Merge(m, src)
-}
-func (m *Transaction) XXX_Size() int {
-	return xxx_messageInfo_Transaction.Size(m)
+func (x *Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *Transaction) XXX_DiscardUnknown() {
-	xxx_messageInfo_Transaction.DiscardUnknown(m)
+
+func (*Transaction) ProtoMessage() {}
+
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_Transaction proto.InternalMessageInfo
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_rawDescGZIP(), []int{1}
+}
 
-func (m *Transaction) GetId() []byte {
-	if m != nil {
-		return m.Id
+func (x *Transaction) GetId() []byte {
+	if x != nil {
+		return x.Id
 	}
 	return nil
 }
 
-func (m *Transaction) GetVin() []*pb.TXInput {
-	if m != nil {
-		return m.Vin
+func (x *Transaction) GetVin() []*pb.TXInput {
+	if x != nil {
+		return x.Vin
 	}
 	return nil
 }
 
-func (m *Transaction) GetVout() []*pb.TXOutput {
-	if m != nil {
-		return m.Vout
+func (x *Transaction) GetVout() []*pb.TXOutput {
+	if x != nil {
+		return x.Vout
 	}
 	return nil
 }
 
-func (m *Transaction) GetTip() []byte {
-	if m != nil {
-		return m.Tip
+func (x *Transaction) GetTip() []byte {
+	if x != nil {
+		return x.Tip
 	}
 	return nil
 }
 
-func (m *Transaction) GetGasLimit() []byte {
-	if m != nil {
-		return m.GasLimit
+func (x *Transaction) GetGasLimit() []byte {
+	if x != nil {
+		return x.GasLimit
 	}
 	return nil
 }
 
-func (m *Transaction) GetGasPrice() []byte {
-	if m != nil {
-		return m.GasPrice
+func (x *Transaction) GetGasPrice() []byte {
+	if x != nil {
+		return x.GasPrice
 	}
 	return nil
 }
 
-func (m *Transaction) GetType() int32 {
-	if m != nil {
-		return m.Type
+func (x *Transaction) GetType() int32 {
+	if x != nil {
+		return x.Type
 	}
 	return 0
 }
 
 type TransactionNode struct {
-	Children             map[string]*Transaction `protobuf:"bytes,1,rep,name=children,proto3" json:"children,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Value                *Transaction            `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	Size                 int64                   `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Children map[string]*Transaction `protobuf:"bytes,1,rep,name=children,proto3" json:"children,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Value    *Transaction            `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Size     int64                   `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (m *TransactionNode) Reset()         { *m = TransactionNode{} }
-func (m *TransactionNode) String() string { return proto.CompactTextString(m) }
-func (*TransactionNode) ProtoMessage()    {}
+func (x *TransactionNode) Reset() {
+	*x = TransactionNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionNode) ProtoMessage() {}
+
+func (x *TransactionNode) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionNode.ProtoReflect.Descriptor instead.
 func (*TransactionNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4138a4cf34c3b76a, []int{2}
+	return file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_rawDescGZIP(), []int{2}
 }
 
-func (m *TransactionNode) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TransactionNode.Unmarshal(m, b)
+func (x *TransactionNode) GetChildren() map[string]*Transaction {
+	if x != nil {
+		return x.Children
+	}
+	return nil
 }
-func (m *TransactionNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TransactionNode.Marshal(b, m, deterministic)
+
+func (x *TransactionNode) GetValue() *Transaction {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *TransactionNode) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
 }
-func (m *TransactionNode) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TransactionNode.Merge(m, src)
+
+type TransactionJournal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vout []*pb.TXOutput `protobuf:"bytes,1,rep,name=vout,proto3" json:"vout,omitempty"`
+}
+
+func (x *TransactionJournal) Reset() {
+	*x = TransactionJournal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-func (m *TransactionNode) XXX_Size() int {
-	return xxx_messageInfo_TransactionNode.Size(m)
+
+func (x *TransactionJournal) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
-func (m *TransactionNode) XXX_DiscardUnknown() {
-	xxx_messageInfo_TransactionNode.DiscardUnknown(m)
+
+func (*TransactionJournal) ProtoMessage() {}
+
+func (x *TransactionJournal) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var xxx_messageInfo_TransactionNode proto.InternalMessageInfo
+// Deprecated: Use TransactionJournal.ProtoReflect.Descriptor instead.
+func (*TransactionJournal) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_rawDescGZIP(), []int{3}
+}
 
-func (m *TransactionNode) GetChildren() map[string]*Transaction {
-	if m != nil {
-		return m.Children
+func (x *TransactionJournal) GetVout() []*pb.TXOutput {
+	if x != nil {
+		return x.Vout
 	}
 	return nil
 }
 
-func (m *TransactionNode) GetValue() *Transaction {
-	if m != nil {
-		return m.Value
+type TransactionReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txid           []byte          `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	Status         uint32          `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	Error          string          `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	GasUsed        uint64          `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	GeneratedTxids [][]byte        `protobuf:"bytes,5,rep,name=generated_txids,json=generatedTxids,proto3" json:"generated_txids,omitempty"`
+	Events         []*ReceiptEvent `protobuf:"bytes,6,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *TransactionReceipt) Reset() {
+	*x = TransactionReceipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionReceipt) ProtoMessage() {}
+
+func (x *TransactionReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionReceipt.ProtoReflect.Descriptor instead.
+func (*TransactionReceipt) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TransactionReceipt) GetTxid() []byte {
+	if x != nil {
+		return x.Txid
 	}
 	return nil
 }
 
-func (m *TransactionNode) GetSize() int64 {
-	if m != nil {
-		return m.Size
+func (x *TransactionReceipt) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
 	}
 	return 0
 }
 
-type TransactionJournal struct {
-	Vout                 []*pb.TXOutput `protobuf:"bytes,1,rep,name=vout,proto3" json:"vout,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+func (x *TransactionReceipt) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
-func (m *TransactionJournal) Reset()         { *m = TransactionJournal{} }
-func (m *TransactionJournal) String() string { return proto.CompactTextString(m) }
-func (*TransactionJournal) ProtoMessage()    {}
-func (*TransactionJournal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4138a4cf34c3b76a, []int{3}
+func (x *TransactionReceipt) GetGasUsed() uint64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
 }
 
-func (m *TransactionJournal) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TransactionJournal.Unmarshal(m, b)
+func (x *TransactionReceipt) GetGeneratedTxids() [][]byte {
+	if x != nil {
+		return x.GeneratedTxids
+	}
+	return nil
 }
-func (m *TransactionJournal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TransactionJournal.Marshal(b, m, deterministic)
+
+func (x *TransactionReceipt) GetEvents() []*ReceiptEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
 }
-func (m *TransactionJournal) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TransactionJournal.Merge(m, src)
+
+type ReceiptEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Topic string `protobuf:"bytes,1,opt,name=topic,proto3" json:"topic,omitempty"`
+	Data  string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 }
-func (m *TransactionJournal) XXX_Size() int {
-	return xxx_messageInfo_TransactionJournal.Size(m)
+
+func (x *ReceiptEvent) Reset() {
+	*x = ReceiptEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-func (m *TransactionJournal) XXX_DiscardUnknown() {
-	xxx_messageInfo_TransactionJournal.DiscardUnknown(m)
+
+func (x *ReceiptEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var xxx_messageInfo_TransactionJournal proto.InternalMessageInfo
+func (*ReceiptEvent) ProtoMessage() {}
 
-func (m *TransactionJournal) GetVout() []*pb.TXOutput {
-	if m != nil {
-		return m.Vout
+func (x *ReceiptEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptEvent.ProtoReflect.Descriptor instead.
+func (*ReceiptEvent) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReceiptEvent) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*Transactions)(nil), "transactionpb.Transactions")
-	proto.RegisterType((*Transaction)(nil), "transactionpb.Transaction")
-	proto.RegisterType((*TransactionNode)(nil), "transactionpb.TransactionNode")
-	proto.RegisterMapType((map[string]*Transaction)(nil), "transactionpb.TransactionNode.ChildrenEntry")
-	proto.RegisterType((*TransactionJournal)(nil), "transactionpb.TransactionJournal")
-}
-
-func init() {
-	proto.RegisterFile("github.com/dappley/go-dappley/core/transaction/pb/transaction.proto", fileDescriptor_4138a4cf34c3b76a)
-}
-
-var fileDescriptor_4138a4cf34c3b76a = []byte{
-	// 375 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x3d, 0x4f, 0xf3, 0x30,
-	0x10, 0xc7, 0xe5, 0xa4, 0xed, 0xd3, 0xba, 0xed, 0x03, 0xf2, 0x64, 0xb5, 0x4b, 0x94, 0x29, 0x43,
-	0x49, 0x10, 0x2c, 0x88, 0x81, 0x81, 0xaa, 0x12, 0x6f, 0x2a, 0x28, 0x42, 0x82, 0x0d, 0x39, 0x89,
-	0x95, 0x5a, 0xa4, 0xb1, 0x15, 0x3b, 0x95, 0xc2, 0x67, 0xe5, 0x93, 0x30, 0x21, 0xbb, 0x2f, 0xa4,
-	0xbc, 0x08, 0xba, 0xdd, 0xf9, 0xff, 0xbb, 0xff, 0x5d, 0xee, 0x02, 0xc7, 0x29, 0x53, 0xb3, 0x32,
-	0xf2, 0x63, 0x3e, 0x0f, 0x12, 0x22, 0x44, 0x46, 0xab, 0x20, 0xe5, 0x07, 0xeb, 0x30, 0xe6, 0x05,
-	0x0d, 0x54, 0x41, 0x72, 0x49, 0x62, 0xc5, 0x78, 0x1e, 0x88, 0xa8, 0x9e, 0xfa, 0xa2, 0xe0, 0x8a,
-	0xa3, 0x7e, 0xed, 0x49, 0x44, 0x83, 0xeb, 0xdd, 0x3c, 0x23, 0x22, 0xe9, 0x27, 0xdf, 0x73, 0x22,
-	0xe9, 0xd2, 0xdb, 0x9d, 0xc2, 0xde, 0xfd, 0x87, 0x20, 0xd1, 0x19, 0xec, 0xd5, 0x40, 0x89, 0x81,
-	0x63, 0x7b, 0xdd, 0xa3, 0x81, 0xbf, 0x35, 0x82, 0x5f, 0x2b, 0x09, 0xb7, 0x78, 0xf7, 0x15, 0xc0,
-	0x6e, 0x4d, 0x45, 0xff, 0xa1, 0xc5, 0x12, 0x0c, 0x1c, 0xe0, 0xf5, 0x42, 0x8b, 0x25, 0x68, 0x04,
-	0xed, 0x05, 0xcb, 0xb1, 0xf5, 0xd5, 0x56, 0xcf, 0xa9, 0xad, 0x1f, 0x2f, 0x73, 0x51, 0xaa, 0x50,
-	0x63, 0x28, 0x80, 0x8d, 0x05, 0x2f, 0x15, 0xb6, 0x0d, 0x3e, 0xfc, 0x16, 0xbf, 0x2d, 0x95, 0xe6,
-	0x0d, 0x88, 0xf6, 0xa1, 0xad, 0x98, 0xc0, 0x0d, 0xd3, 0x4f, 0x87, 0x68, 0x08, 0x3b, 0x29, 0x91,
-	0x4f, 0x19, 0x9b, 0x33, 0x85, 0x9b, 0xe6, 0xbd, 0x9d, 0x12, 0x79, 0xa3, 0xf3, 0xb5, 0x28, 0x0a,
-	0x16, 0x53, 0xdc, 0xda, 0x88, 0x77, 0x3a, 0x47, 0x08, 0x36, 0x54, 0x25, 0x28, 0xfe, 0xe7, 0x00,
-	0xaf, 0x19, 0x9a, 0xd8, 0x7d, 0x03, 0x70, 0xaf, 0xf6, 0x79, 0x53, 0x9e, 0x50, 0x74, 0x01, 0xdb,
-	0xf1, 0x8c, 0x65, 0x49, 0x41, 0xf3, 0xd5, 0xba, 0x46, 0x3f, 0xaf, 0x4b, 0x57, 0xf8, 0xe3, 0x15,
-	0x3e, 0xc9, 0x55, 0x51, 0x85, 0x9b, 0x6a, 0x74, 0x08, 0x9b, 0x0b, 0x92, 0x95, 0x14, 0x5b, 0x0e,
-	0xf8, 0x65, 0xeb, 0x4b, 0x50, 0xcf, 0x28, 0xd9, 0x0b, 0xc5, 0xb6, 0x03, 0x3c, 0x3b, 0x34, 0xf1,
-	0xe0, 0x01, 0xf6, 0xb7, 0x1a, 0xe8, 0xa5, 0x3c, 0xd3, 0xca, 0x1c, 0xa1, 0x13, 0xea, 0x70, 0xf7,
-	0x46, 0xa7, 0xd6, 0x09, 0x70, 0x27, 0x10, 0xd5, 0x94, 0x2b, 0x5e, 0x16, 0x39, 0xc9, 0x36, 0x37,
-	0x02, 0x7f, 0xbc, 0x51, 0xd4, 0x32, 0x7f, 0xde, 0xf1, 0x7b, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbe,
-	0x41, 0x6f, 0xcb, 0x1c, 0x03, 0x00, 0x00,
+func (x *ReceiptEvent) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+var File_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto protoreflect.FileDescriptor
+
+var file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_rawDesc = []byte{
+	0x0a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70,
+	0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f,
+	0x63, 0x6f, 0x72, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x70, 0x62, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x70, 0x62, 0x1a, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70,
+	0x6c, 0x65, 0x79, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x70, 0x62, 0x2f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x4e, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0xdc, 0x01, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x2c, 0x0a, 0x03, 0x76, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x61, 0x73, 0x65,
+	0x70, 0x62, 0x2e, 0x54, 0x58, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x03, 0x76, 0x69, 0x6e, 0x12,
+	0x2f, 0x0a, 0x04, 0x76, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x70,
+	0x62, 0x2e, 0x54, 0x58, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x04, 0x76, 0x6f, 0x75, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x74, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x74,
+	0x69, 0x70, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0xfa, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x30,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x1a, 0x57, 0x0a, 0x0d, 0x43, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x45, 0x0a,
+	0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x6f, 0x75, 0x72,
+	0x6e, 0x61, 0x6c, 0x12, 0x2f, 0x0a, 0x04, 0x76, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x62,
+	0x61, 0x73, 0x65, 0x70, 0x62, 0x2e, 0x54, 0x58, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x04,
+	0x76, 0x6f, 0x75, 0x74, 0x22, 0xcf, 0x01, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x19, 0x0a,
+	0x08, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x0e, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x54, 0x78, 0x69, 0x64,
+	0x73, 0x12, 0x33, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x70,
+	0x62, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x38, 0x0a, 0x0c, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_rawDescOnce sync.Once
+	file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_rawDescData = file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_rawDesc
+)
+
+func file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_rawDescGZIP() []byte {
+	file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_rawDescOnce.Do(func() {
+		file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_rawDescData)
+	})
+	return file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_rawDescData
+}
+
+var file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_goTypes = []interface{}{
+	(*Transactions)(nil),       // 0: transactionpb.Transactions
+	(*Transaction)(nil),        // 1: transactionpb.Transaction
+	(*TransactionNode)(nil),    // 2: transactionpb.TransactionNode
+	(*TransactionJournal)(nil), // 3: transactionpb.TransactionJournal
+	(*TransactionReceipt)(nil), // 4: transactionpb.TransactionReceipt
+	(*ReceiptEvent)(nil),       // 5: transactionpb.ReceiptEvent
+	nil,                        // 6: transactionpb.TransactionNode.ChildrenEntry
+	(*pb.TXInput)(nil),         // 7: transactionbasepb.TXInput
+	(*pb.TXOutput)(nil),        // 8: transactionbasepb.TXOutput
+}
+var file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_depIdxs = []int32{
+	1, // 0: transactionpb.Transactions.transactions:type_name -> transactionpb.Transaction
+	7, // 1: transactionpb.Transaction.vin:type_name -> transactionbasepb.TXInput
+	8, // 2: transactionpb.Transaction.vout:type_name -> transactionbasepb.TXOutput
+	6, // 3: transactionpb.TransactionNode.children:type_name -> transactionpb.TransactionNode.ChildrenEntry
+	1, // 4: transactionpb.TransactionNode.value:type_name -> transactionpb.Transaction
+	8, // 5: transactionpb.TransactionJournal.vout:type_name -> transactionbasepb.TXOutput
+	5, // 6: transactionpb.TransactionReceipt.events:type_name -> transactionpb.ReceiptEvent
+	1, // 7: transactionpb.TransactionNode.ChildrenEntry.value:type_name -> transactionpb.Transaction
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_init() }
+func file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_init() {
+	if File_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Transactions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Transaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionJournal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionReceipt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceiptEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_goTypes,
+		DependencyIndexes: file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_depIdxs,
+		MessageInfos:      file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_msgTypes,
+	}.Build()
+	File_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto = out.File
+	file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_rawDesc = nil
+	file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_goTypes = nil
+	file_github_com_dappley_go_dappley_core_transaction_pb_transaction_proto_depIdxs = nil
 }
@@ -0,0 +1,104 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+package transaction
+
+import (
+	"crypto/sha256"
+
+	"github.com/dappley/go-dappley/core/utxo"
+	errval "github.com/dappley/go-dappley/errors"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+const SignatureCacheLRUCacheLimit = 16384
+
+// sigCache is shared by the transaction pool and block validation so that signatures
+// checked when a transaction enters the pool are not checked again when its block arrives
+var sigCache = NewSignatureCache(SignatureCacheLRUCacheLimit)
+
+// SignatureCache is a bounded LRU cache of signature verification results
+type SignatureCache struct {
+	cache *lru.Cache
+}
+
+// NewSignatureCache returns a signature cache holding at most size results
+func NewSignatureCache(size int) *SignatureCache {
+	cache, _ := lru.New(size)
+	return &SignatureCache{cache}
+}
+
+// GetSignatureCache returns the signature cache shared by the transaction pool and block validation
+func GetSignatureCache() *SignatureCache {
+	return sigCache
+}
+
+// Get returns the cached verification result of the signatures of tx
+func (sc *SignatureCache) Get(tx *Transaction, prevUtxos []*utxo.UTXO) (valid bool, ok bool) {
+	value, ok := sc.cache.Get(signatureCacheKey(tx, prevUtxos))
+	if !ok {
+		return false, false
+	}
+	return value.(bool), true
+}
+
+// Add stores the verification result of the signatures of tx
+func (sc *SignatureCache) Add(tx *Transaction, prevUtxos []*utxo.UTXO, valid bool) {
+	sc.cache.Add(signatureCacheKey(tx, prevUtxos), valid)
+}
+
+// Len returns the number of cached results
+func (sc *SignatureCache) Len() int {
+	return sc.cache.Len()
+}
+
+// Purge removes all cached results
+func (sc *SignatureCache) Purge() {
+	sc.cache.Purge()
+}
+
+// signatureCacheKey commits to everything the signatures are checked against. The hash is recomputed from the
+// transaction rather than taken from its claimed txid, so that a forged transaction reusing the txid of another one
+// cannot change the cached result of the other one. It covers the signatures and the public keys, and the signed
+// hash also covers the public key hashes of the spent utxos
+func signatureCacheKey(tx *Transaction, prevUtxos []*utxo.UTXO) [32]byte {
+	hasher := sha256.New()
+	hasher.Write(tx.Hash())
+	for _, prevUtxo := range prevUtxos {
+		hasher.Write(prevUtxo.PubKeyHash)
+	}
+	var key [32]byte
+	copy(key[:], hasher.Sum(nil))
+	return key
+}
+
+// VerifySignaturesWithCache verifies the signatures of tx, reusing the result of an earlier verification
+func (tx *Transaction) VerifySignaturesWithCache(prevUtxos []*utxo.UTXO) (bool, error) {
+	if valid, ok := sigCache.Get(tx, prevUtxos); ok {
+		if !valid {
+			return false, errval.SignaturesInvalid
+		}
+		return true, nil
+	}
+	result, err := tx.VerifySignatures(prevUtxos)
+	if result {
+		sigCache.Add(tx, prevUtxos, true)
+	} else if err == errval.SignaturesInvalid {
+		sigCache.Add(tx, prevUtxos, false)
+	}
+	return result, err
+}
@@ -0,0 +1,137 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+package transaction
+
+import (
+	"testing"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/transactionbase"
+	"github.com/dappley/go-dappley/core/utxo"
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransaction_VerifySignaturesWithCache(t *testing.T) {
+	acc := account.NewAccount()
+	tx := &Transaction{
+		Vin: []transactionbase.TXInput{
+			{Txid: []byte{0x20, 0x21}, Vout: 0, PubKey: acc.GetKeyPair().GetPublicKey()},
+		},
+		Vout: []transactionbase.TXOutput{
+			{Value: common.NewAmount(10), PubKeyHash: acc.GetPubKeyHash(), Contract: ""},
+		},
+		Tip:  common.NewAmount(1),
+		Type: TxTypeNormal,
+	}
+	utxos := []*utxo.UTXO{
+		{
+			TXOutput: transactionbase.TXOutput{Value: common.NewAmount(11), PubKeyHash: acc.GetPubKeyHash(), Contract: ""},
+			Txid:     []byte{0x20, 0x21},
+			TxIndex:  0,
+			UtxoType: 0,
+		},
+	}
+	tx.ID = tx.Hash()
+	assert.Nil(t, tx.SignWithKeyPair(acc.GetKeyPair(), utxos))
+
+	GetSignatureCache().Purge()
+	_, ok := GetSignatureCache().Get(tx, utxos)
+	assert.False(t, ok)
+
+	success, err := tx.VerifySignaturesWithCache(utxos)
+	assert.True(t, success)
+	assert.Nil(t, err)
+	valid, ok := GetSignatureCache().Get(tx, utxos)
+	assert.True(t, ok)
+	assert.True(t, valid)
+
+	// a different signature under the same txid is not served from the cache
+	validSignature := tx.Vin[0].Signature
+	tx.Vin[0].Signature = append([]byte{}, validSignature...)
+	tx.Vin[0].Signature[0] ^= 0xff
+	success, err = tx.VerifySignaturesWithCache(utxos)
+	assert.False(t, success)
+	assert.Equal(t, errval.SignaturesInvalid, err)
+	success, err = tx.VerifySignaturesWithCache(utxos)
+	assert.False(t, success)
+	assert.Equal(t, errval.SignaturesInvalid, err)
+
+	// empty signatures are not cached
+	tx.Vin[0].Signature = nil
+	success, err = tx.VerifySignaturesWithCache(utxos)
+	assert.False(t, success)
+	assert.Equal(t, errval.SignaturesEmpty, err)
+	assert.Equal(t, 2, GetSignatureCache().Len())
+}
+
+func TestTransaction_VerifySignaturesWithCacheForgedID(t *testing.T) {
+	acc := account.NewAccount()
+	tx := &Transaction{
+		Vin: []transactionbase.TXInput{
+			{Txid: []byte{0x20, 0x21}, Vout: 0, PubKey: acc.GetKeyPair().GetPublicKey()},
+		},
+		Vout: []transactionbase.TXOutput{
+			{Value: common.NewAmount(10), PubKeyHash: acc.GetPubKeyHash(), Contract: ""},
+		},
+		Tip:  common.NewAmount(1),
+		Type: TxTypeNormal,
+	}
+	utxos := []*utxo.UTXO{
+		{
+			TXOutput: transactionbase.TXOutput{Value: common.NewAmount(11), PubKeyHash: acc.GetPubKeyHash(), Contract: ""},
+			Txid:     []byte{0x20, 0x21},
+			TxIndex:  0,
+			UtxoType: 0,
+		},
+	}
+	tx.ID = tx.Hash()
+	assert.Nil(t, tx.SignWithKeyPair(acc.GetKeyPair(), utxos))
+	GetSignatureCache().Purge()
+
+	// a forged transaction reusing the txid and the signatures of tx is rejected and cached under its own contents
+	forged := tx.DeepCopy()
+	forged.Vout[0].PubKeyHash = account.NewAccount().GetPubKeyHash()
+	success, err := forged.VerifySignaturesWithCache(utxos)
+	assert.False(t, success)
+	assert.Equal(t, errval.SignaturesInvalid, err)
+
+	_, ok := GetSignatureCache().Get(tx, utxos)
+	assert.False(t, ok)
+	success, err = tx.VerifySignaturesWithCache(utxos)
+	assert.True(t, success)
+	assert.Nil(t, err)
+	success, err = forged.VerifySignaturesWithCache(utxos)
+	assert.False(t, success)
+	assert.Equal(t, errval.SignaturesInvalid, err)
+}
+
+func TestSignatureCache_Bounded(t *testing.T) {
+	cache := NewSignatureCache(2)
+	txs := []*Transaction{{Tip: common.NewAmount(1)}, {Tip: common.NewAmount(2)}, {Tip: common.NewAmount(3)}}
+	for _, tx := range txs {
+		cache.Add(tx, nil, true)
+	}
+	assert.Equal(t, 2, cache.Len())
+	_, ok := cache.Get(txs[0], nil)
+	assert.False(t, ok)
+	valid, ok := cache.Get(txs[2], nil)
+	assert.True(t, ok)
+	assert.True(t, valid)
+}
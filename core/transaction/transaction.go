@@ -33,7 +33,9 @@ import (
 	transactionpb "github.com/dappley/go-dappley/core/transaction/pb"
 	"github.com/dappley/go-dappley/core/transactionbase"
 	transactionbasepb "github.com/dappley/go-dappley/core/transactionbase/pb"
+	"github.com/dappley/go-dappley/crypto"
 	"github.com/dappley/go-dappley/crypto/byteutils"
+	"github.com/dappley/go-dappley/crypto/keystore"
 	"github.com/dappley/go-dappley/crypto/keystore/secp256k1"
 	"github.com/golang/protobuf/proto"
 	logger "github.com/sirupsen/logrus"
@@ -70,6 +72,10 @@ const (
 	TxTypeReward         TxType = 6
 	TxTypeContractSend   TxType = 7
 	TxTypeProducerChange TxType = 8
+	TxTypeVote           TxType = 9
+	TxTypeUnvote         TxType = 10
+	TxTypeSlash          TxType = 11
+	TxTypeGovernance     TxType = 12
 )
 
 type Transaction struct {
@@ -125,12 +131,21 @@ func (st SendTxParam) TotalCost() *common.Amount {
 
 // Sign signs each input of a Transaction
 func (tx *Transaction) Sign(privKey ecdsa.PrivateKey, prevUtxos []*utxo.UTXO) error {
-	txCopy := tx.TrimmedCopy(false)
 	privData, err := secp256k1.FromECDSAPrivateKey(&privKey)
 	if err != nil {
 		logger.WithError(err).Error("Transaction: failed to get private key.")
 		return err
 	}
+	return tx.signWithAlgorithm(keystore.SECP256K1, privData, prevUtxos)
+}
+
+// SignWithKeyPair signs each input of a Transaction with the signature algorithm of the key pair
+func (tx *Transaction) SignWithKeyPair(keyPair *account.KeyPair, prevUtxos []*utxo.UTXO) error {
+	return tx.signWithAlgorithm(keyPair.GetAlgorithm(), keyPair.GetPrivateKeyBytes(), prevUtxos)
+}
+
+func (tx *Transaction) signWithAlgorithm(alg keystore.Algorithm, privData []byte, prevUtxos []*utxo.UTXO) error {
+	txCopy := tx.TrimmedCopy(false)
 
 	for i, vin := range txCopy.Vin {
 		txCopy.Vin[i].Signature = nil
@@ -140,7 +155,7 @@ func (tx *Transaction) Sign(privKey ecdsa.PrivateKey, prevUtxos []*utxo.UTXO) er
 
 		txCopy.Vin[i].PubKey = oldPubKey
 
-		signature, err := secp256k1.Sign(txCopy.ID, privData)
+		signature, err := crypto.Sign(alg, txCopy.ID, privData)
 		if err != nil {
 			logger.WithError(err).Error("Transaction: failed to create a signature.")
 			return err
@@ -190,6 +205,26 @@ func (tx *Transaction) IsContractSend() bool {
 	return tx.Type == TxTypeContractSend
 }
 
+// IsVote returns true if the transaction locks coins to vote for producer candidates
+func (tx *Transaction) IsVote() bool {
+	return tx.Type == TxTypeVote
+}
+
+// IsUnvote returns true if the transaction withdraws a vote and starts unbonding its coins
+func (tx *Transaction) IsUnvote() bool {
+	return tx.Type == TxTypeUnvote
+}
+
+// IsSlash returns true if the transaction carries the evidence of a producer minting two blocks in one time slot
+func (tx *Transaction) IsSlash() bool {
+	return tx.Type == TxTypeSlash
+}
+
+// IsGovernance returns true if the transaction proposes a chain parameter change or votes on a proposal
+func (tx *Transaction) IsGovernance() bool {
+	return tx.Type == TxTypeGovernance
+}
+
 //GetToHashBytes Get bytes for hash
 func (tx *Transaction) GetToHashBytes() []byte {
 	var tempBytes []byte
@@ -202,6 +237,10 @@ func (tx *Transaction) GetToHashBytes() []byte {
 			vin.PubKey,
 			vin.Signature,
 		}, []byte{})
+		//secp256k1 inputs are hashed without the algorithm so that the ids of the existing transactions do not change
+		if vin.Algorithm > keystore.SECP256K1 {
+			tempBytes = append(tempBytes, byte(vin.Algorithm))
+		}
 	}
 	for _, vout := range tx.Vout {
 		tempBytes = bytes.Join([][]byte{
@@ -249,7 +288,7 @@ func (tx *Transaction) TrimmedCopy(withSignature bool) Transaction {
 		} else {
 			pubkey = nil
 		}
-		inputs = append(inputs, transactionbase.TXInput{vin.Txid, vin.Vout, nil, pubkey})
+		inputs = append(inputs, transactionbase.TXInput{vin.Txid, vin.Vout, nil, pubkey, vin.Algorithm})
 	}
 
 	for _, vout := range tx.Vout {
@@ -266,7 +305,7 @@ func (tx *Transaction) DeepCopy() Transaction {
 	var outputs []transactionbase.TXOutput
 
 	for _, vin := range tx.Vin {
-		inputs = append(inputs, transactionbase.TXInput{vin.Txid, vin.Vout, vin.Signature, vin.PubKey})
+		inputs = append(inputs, transactionbase.TXInput{vin.Txid, vin.Vout, vin.Signature, vin.PubKey, vin.Algorithm})
 	}
 
 	for _, vout := range tx.Vout {
@@ -445,7 +484,7 @@ func (tx *Transaction) GetDefaultFromTransactionAccount() *account.TransactionAc
 		return account.NewContractTransactionAccount()
 	}
 
-	ta := account.NewTransactionAccountByPubKey(vin.PubKey)
+	ta := account.NewTransactionAccountByPubKeyWithAlgorithm(vin.PubKey, vin.GetAlgorithm())
 
 	return ta
 }
@@ -490,15 +529,18 @@ func (tx *Transaction) VerifySignatures(prevUtxos []*utxo.UTXO) (bool, error) {
 		txCopy.ID = txCopy.Hash()
 		txCopy.Vin[i].PubKey = oldPubKey
 
-		originPub := make([]byte, 1+len(vin.PubKey))
-		originPub[0] = 4 // uncompressed point
-		copy(originPub[1:], vin.PubKey)
+		originPub := vin.PubKey
+		if vin.GetAlgorithm() == keystore.SECP256K1 {
+			originPub = make([]byte, 1+len(vin.PubKey))
+			originPub[0] = 4 // uncompressed point
+			copy(originPub[1:], vin.PubKey)
+		}
 
 		if vin.Signature == nil || len(vin.Signature) == 0 {
 			return false, errval.SignaturesEmpty
 		}
 
-		verifyResult, err := secp256k1.Verify(txCopy.ID, vin.Signature, originPub)
+		verifyResult, err := crypto.Verify(vin.GetAlgorithm(), txCopy.ID, vin.Signature, originPub)
 
 		if err != nil || verifyResult == false {
 			return false, errval.SignaturesInvalid
@@ -532,7 +574,7 @@ func (tx *Transaction) VerifyPublicKeyHash(prevUtxos []*utxo.UTXO) (bool, error)
 			return false, err
 		}
 
-		ta := account.NewTransactionAccountByPubKey(vin.PubKey)
+		ta := account.NewTransactionAccountByPubKeyWithAlgorithm(vin.PubKey, vin.GetAlgorithm())
 
 		if !bytes.Equal([]byte(ta.GetPubKeyHash()), []byte(prevUtxos[i].PubKeyHash)) {
 			return false, errval.PublicKeyHashDoesNotMatch
@@ -564,7 +606,7 @@ func (tx *Transaction) Verify(prevUtxos []*utxo.UTXO) error {
 	if !result {
 		return err
 	}
-	result, err = tx.VerifySignatures(prevUtxos)
+	result, err = tx.VerifySignaturesWithCache(prevUtxos)
 	if !result {
 		return err
 	}
@@ -578,3 +620,14 @@ func (tx *Transaction) CheckVinNum() error {
 	}
 	return nil
 }
+
+// PreVerify runs the checks that do not need the utxos of the transaction
+func (tx *Transaction) PreVerify() error {
+	if err := tx.CheckVinNum(); err != nil {
+		return err
+	}
+	if _, err := tx.verifyID(); err != nil {
+		return err
+	}
+	return nil
+}
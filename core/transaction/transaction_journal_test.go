@@ -42,7 +42,7 @@ var tx1 = Transaction{
 
 func TestJournalPutAndGet(t *testing.T) {
 	db := storage.NewRamStorage()
-	vin := transactionbase.TXInput{tx1.ID, 1, nil, nil}
+	vin := transactionbase.TXInput{tx1.ID, 1, nil, nil, 0}
 	err := PutTxJournal(tx1, db)
 	assert.Nil(t, err)
 	vout, err := GetTxOutput(vin, db)
@@ -0,0 +1,148 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+package transaction
+
+import (
+	transactionpb "github.com/dappley/go-dappley/core/transaction/pb"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/golang/protobuf/proto"
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	// TxReceiptStatusFailed means the contract execution returned an error
+	TxReceiptStatusFailed uint32 = 0
+	// TxReceiptStatusSuccess means the contract execution completed
+	TxReceiptStatusSuccess uint32 = 1
+)
+
+// ReceiptEvent is an event emitted by a smart contract during execution
+type ReceiptEvent struct {
+	Topic string
+	Data  string
+}
+
+// TxReceipt records the outcome of executing a contract transaction
+type TxReceipt struct {
+	Txid           []byte
+	Status         uint32
+	Error          string
+	GasUsed        uint64
+	GeneratedTxids [][]byte
+	Events         []*ReceiptEvent
+}
+
+// NewTxReceipt returns a receipt of the execution of tx
+func NewTxReceipt(txid []byte, gasUsed uint64, generatedTxs []*Transaction, events []*ReceiptEvent, execErr error) *TxReceipt {
+	receipt := &TxReceipt{
+		Txid:    txid,
+		Status:  TxReceiptStatusSuccess,
+		GasUsed: gasUsed,
+		Events:  events,
+	}
+	if execErr != nil {
+		receipt.Status = TxReceiptStatusFailed
+		receipt.Error = execErr.Error()
+	}
+	for _, genTx := range generatedTxs {
+		receipt.GeneratedTxids = append(receipt.GeneratedTxids, genTx.ID)
+	}
+	return receipt
+}
+
+// generate receipt storage key in database
+func getReceiptStorageKey(txid []byte) []byte {
+	key := "tx_receipt_" + string(txid)
+	return []byte(key)
+}
+
+// GetTxReceipt returns the receipt of the transaction with txid from database
+func GetTxReceipt(txid []byte, db storage.Storage) (*TxReceipt, error) {
+	value, err := db.Get(getReceiptStorageKey(txid))
+	if err != nil {
+		return nil, err
+	}
+	return DeserializeReceipt(value)
+}
+
+// DeleteTxReceipt removes the receipt of the transaction with txid from database
+func DeleteTxReceipt(txid []byte, db storage.Storage) error {
+	return db.Del(getReceiptStorageKey(txid))
+}
+
+// Save TxReceipt into database
+func (receipt *TxReceipt) Save(db storage.Storage) error {
+	bytes, err := receipt.SerializeReceipt()
+	if err != nil {
+		return err
+	}
+	return db.Put(getReceiptStorageKey(receipt.Txid), bytes)
+}
+
+func (receipt *TxReceipt) IsSuccess() bool {
+	return receipt.Status == TxReceiptStatusSuccess
+}
+
+func (receipt *TxReceipt) SerializeReceipt() ([]byte, error) {
+	rawBytes, err := proto.Marshal(receipt.ToProto())
+	if err != nil {
+		logger.WithError(err).Error("TransactionReceipt: Cannot serialize transactionReceipt!")
+		return nil, err
+	}
+	return rawBytes, nil
+}
+
+func DeserializeReceipt(b []byte) (*TxReceipt, error) {
+	pb := &transactionpb.TransactionReceipt{}
+	err := proto.Unmarshal(b, pb)
+	if err != nil {
+		logger.WithError(err).Error("TransactionReceipt: Cannot deserialize transactionReceipt!")
+		return nil, err
+	}
+	receipt := &TxReceipt{}
+	receipt.FromProto(pb)
+	return receipt, nil
+}
+
+func (receipt *TxReceipt) ToProto() proto.Message {
+	var events []*transactionpb.ReceiptEvent
+	for _, event := range receipt.Events {
+		events = append(events, &transactionpb.ReceiptEvent{Topic: event.Topic, Data: event.Data})
+	}
+	return &transactionpb.TransactionReceipt{
+		Txid:           receipt.Txid,
+		Status:         receipt.Status,
+		Error:          receipt.Error,
+		GasUsed:        receipt.GasUsed,
+		GeneratedTxids: receipt.GeneratedTxids,
+		Events:         events,
+	}
+}
+
+func (receipt *TxReceipt) FromProto(pb proto.Message) {
+	receiptPb := pb.(*transactionpb.TransactionReceipt)
+	receipt.Txid = receiptPb.GetTxid()
+	receipt.Status = receiptPb.GetStatus()
+	receipt.Error = receiptPb.GetError()
+	receipt.GasUsed = receiptPb.GetGasUsed()
+	receipt.GeneratedTxids = receiptPb.GetGeneratedTxids()
+	receipt.Events = nil
+	for _, eventPb := range receiptPb.GetEvents() {
+		receipt.Events = append(receipt.Events, &ReceiptEvent{Topic: eventPb.GetTopic(), Data: eventPb.GetData()})
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+package transaction
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dappley/go-dappley/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTxReceipt(t *testing.T) {
+	genTx := &Transaction{ID: []byte{0x01}}
+	events := []*ReceiptEvent{{Topic: "topic", Data: "data"}}
+
+	receipt := NewTxReceipt([]byte{0x88}, 100, []*Transaction{genTx}, events, nil)
+	assert.True(t, receipt.IsSuccess())
+	assert.Equal(t, "", receipt.Error)
+	assert.Equal(t, uint64(100), receipt.GasUsed)
+	assert.Equal(t, [][]byte{{0x01}}, receipt.GeneratedTxids)
+	assert.Equal(t, events, receipt.Events)
+
+	receipt = NewTxReceipt([]byte{0x88}, 50, nil, nil, errors.New("execution failed"))
+	assert.False(t, receipt.IsSuccess())
+	assert.Equal(t, TxReceiptStatusFailed, receipt.Status)
+	assert.Equal(t, "execution failed", receipt.Error)
+	assert.Nil(t, receipt.GeneratedTxids)
+}
+
+func TestTxReceipt_SaveGetAndDelete(t *testing.T) {
+	db := storage.NewRamStorage()
+	receipt := &TxReceipt{
+		Txid:           []byte{0x88},
+		Status:         TxReceiptStatusSuccess,
+		GasUsed:        20,
+		GeneratedTxids: [][]byte{{0x01}, {0x02}},
+		Events:         []*ReceiptEvent{{Topic: "topic", Data: "data"}},
+	}
+	assert.Nil(t, receipt.Save(db))
+
+	result, err := GetTxReceipt(receipt.Txid, db)
+	assert.Nil(t, err)
+	assert.Equal(t, receipt, result)
+
+	assert.Nil(t, DeleteTxReceipt(receipt.Txid, db))
+	_, err = GetTxReceipt(receipt.Txid, db)
+	assert.NotNil(t, err)
+}
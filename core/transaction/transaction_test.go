@@ -23,6 +23,8 @@ import (
 	"testing"
 
 	"github.com/dappley/go-dappley/core/utxo"
+	"github.com/dappley/go-dappley/crypto"
+	"github.com/dappley/go-dappley/crypto/keystore"
 	"github.com/dappley/go-dappley/crypto/keystore/secp256k1"
 	errval "github.com/dappley/go-dappley/errors"
 
@@ -42,8 +44,8 @@ func getAoB(length int64) []byte {
 
 func GenerateFakeTxInputs() []transactionbase.TXInput {
 	return []transactionbase.TXInput{
-		{getAoB(2), 10, getAoB(2), getAoB(2)},
-		{getAoB(2), 5, getAoB(2), getAoB(2)},
+		{getAoB(2), 10, getAoB(2), getAoB(2), 0},
+		{getAoB(2), 5, getAoB(2), getAoB(2), 0},
 	}
 }
 
@@ -111,7 +113,7 @@ func TestTransaction_MatchRewards(t *testing.T) {
 		{"normal",
 			&Transaction{
 				nil,
-				[]transactionbase.TXInput{{nil, -1, nil, RewardTxData}},
+				[]transactionbase.TXInput{{nil, -1, nil, RewardTxData, 0}},
 				[]transactionbase.TXOutput{{
 					common.NewAmount(1),
 					account.PubKeyHash([]byte{
@@ -132,7 +134,7 @@ func TestTransaction_MatchRewards(t *testing.T) {
 		{"emptyVout",
 			&Transaction{
 				nil,
-				[]transactionbase.TXInput{{nil, -1, nil, RewardTxData}},
+				[]transactionbase.TXInput{{nil, -1, nil, RewardTxData, 0}},
 				[]transactionbase.TXOutput{},
 				common.NewAmount(0),
 				common.NewAmount(0),
@@ -146,7 +148,7 @@ func TestTransaction_MatchRewards(t *testing.T) {
 		{"emptyRewardMap",
 			&Transaction{
 				nil,
-				[]transactionbase.TXInput{{nil, -1, nil, RewardTxData}},
+				[]transactionbase.TXInput{{nil, -1, nil, RewardTxData, 0}},
 				[]transactionbase.TXOutput{{
 					common.NewAmount(1),
 					account.PubKeyHash([]byte{
@@ -167,7 +169,7 @@ func TestTransaction_MatchRewards(t *testing.T) {
 		{"Wrong address",
 			&Transaction{
 				nil,
-				[]transactionbase.TXInput{{nil, -1, nil, RewardTxData}},
+				[]transactionbase.TXInput{{nil, -1, nil, RewardTxData, 0}},
 				[]transactionbase.TXOutput{{
 					common.NewAmount(1),
 					account.PubKeyHash([]byte{
@@ -188,7 +190,7 @@ func TestTransaction_MatchRewards(t *testing.T) {
 		{"Wrong amount",
 			&Transaction{
 				nil,
-				[]transactionbase.TXInput{{nil, -1, nil, RewardTxData}},
+				[]transactionbase.TXInput{{nil, -1, nil, RewardTxData, 0}},
 				[]transactionbase.TXOutput{{
 					common.NewAmount(3),
 					account.PubKeyHash([]byte{
@@ -209,7 +211,7 @@ func TestTransaction_MatchRewards(t *testing.T) {
 		{"twoAddresses",
 			&Transaction{
 				nil,
-				[]transactionbase.TXInput{{nil, -1, nil, RewardTxData}},
+				[]transactionbase.TXInput{{nil, -1, nil, RewardTxData, 0}},
 				[]transactionbase.TXOutput{{
 					common.NewAmount(1),
 					account.PubKeyHash([]byte{
@@ -240,7 +242,7 @@ func TestTransaction_MatchRewards(t *testing.T) {
 		{"MoreRewards",
 			&Transaction{
 				nil,
-				[]transactionbase.TXInput{{nil, -1, nil, RewardTxData}},
+				[]transactionbase.TXInput{{nil, -1, nil, RewardTxData, 0}},
 				[]transactionbase.TXOutput{{
 					common.NewAmount(1),
 					account.PubKeyHash([]byte{
@@ -272,7 +274,7 @@ func TestTransaction_MatchRewards(t *testing.T) {
 		{"MoreVout",
 			&Transaction{
 				nil,
-				[]transactionbase.TXInput{{nil, -1, nil, RewardTxData}},
+				[]transactionbase.TXInput{{nil, -1, nil, RewardTxData, 0}},
 				[]transactionbase.TXOutput{{
 					common.NewAmount(1),
 					account.PubKeyHash([]byte{
@@ -944,6 +946,53 @@ func TestTransaction_Verify(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestTransaction_VerifyWithAlgorithms(t *testing.T) {
+	for _, alg := range []keystore.Algorithm{keystore.SECP256K1, keystore.ED25519, keystore.SCHNORR} {
+		t.Run(crypto.AlgorithmName(alg), func(t *testing.T) {
+			acc := account.NewAccountWithAlgorithm(alg)
+			vinAlg := alg
+			if alg == keystore.SECP256K1 {
+				vinAlg = 0
+			}
+			tx := &Transaction{
+				Vin: []transactionbase.TXInput{
+					{Txid: []byte{0x20, 0x21}, Vout: 0, PubKey: acc.GetKeyPair().GetPublicKey(), Algorithm: vinAlg},
+				},
+				Vout: []transactionbase.TXOutput{
+					{Value: common.NewAmount(10), PubKeyHash: acc.GetPubKeyHash(), Contract: ""},
+				},
+				Tip:  common.NewAmount(1),
+				Type: TxTypeNormal,
+			}
+			utxos := []*utxo.UTXO{
+				{
+					TXOutput: transactionbase.TXOutput{Value: common.NewAmount(11), PubKeyHash: acc.GetPubKeyHash(), Contract: ""},
+					Txid:     []byte{0x20, 0x21},
+					TxIndex:  0,
+					UtxoType: 0,
+				},
+			}
+			txCopy := tx.TrimmedCopy(true)
+			tx.ID = (&txCopy).Hash()
+
+			assert.Nil(t, tx.SignWithKeyPair(acc.GetKeyPair(), utxos))
+			assert.Nil(t, tx.Verify(utxos))
+			assert.Equal(t, acc.GetPubKeyHash(), tx.GetDefaultFromTransactionAccount().GetPubKeyHash())
+
+			// the input must name the algorithm of the address it spends
+			tx.Vin[0].Algorithm = keystore.ED25519
+			if alg == keystore.ED25519 {
+				tx.Vin[0].Algorithm = keystore.SCHNORR
+			}
+			success, err := tx.VerifyPublicKeyHash(utxos)
+			assert.False(t, success)
+			assert.Equal(t, errval.PublicKeyHashDoesNotMatch, err)
+			success, _ = tx.VerifySignatures(utxos)
+			assert.False(t, success)
+		})
+	}
+}
+
 func TestTransaction_CheckVinNum(t *testing.T) {
 	tx := Transaction{
 		ID:   util.GenerateRandomAoB(1),
@@ -961,6 +1010,32 @@ func TestTransaction_CheckVinNum(t *testing.T) {
 	assert.Equal(t, errval.TransactionTooManyVin, tx.CheckVinNum())
 }
 
+func TestTransaction_PreVerify(t *testing.T) {
+	tx := Transaction{
+		Vin: []transactionbase.TXInput{
+			{Txid: []byte{0xc7, 0x4d}, Vout: 10, Signature: []byte{0x01}, PubKey: []byte{0x7c, 0x4d}},
+		},
+		Vout: []transactionbase.TXOutput{
+			{Value: common.NewAmount(1), PubKeyHash: account.PubKeyHash([]byte{0xc6, 0x49})},
+		},
+		Tip:  common.NewAmount(5),
+		Type: TxTypeNormal,
+	}
+	txCopy := tx.TrimmedCopy(true)
+	tx.ID = (&txCopy).Hash()
+	assert.Nil(t, tx.PreVerify())
+
+	tx.Tip = common.NewAmount(6)
+	assert.Equal(t, errval.TransactionIDInvalid, tx.PreVerify())
+
+	txCopy = tx.TrimmedCopy(true)
+	tx.ID = (&txCopy).Hash()
+	for i := 0; i < 50; i++ {
+		tx.Vin = append(tx.Vin, transactionbase.TXInput{})
+	}
+	assert.Equal(t, errval.TransactionTooManyVin, tx.PreVerify())
+}
+
 func TestSendTxParam_TotalCost(t *testing.T) {
 	params := SendTxParam{
 		From:          account.Address{},
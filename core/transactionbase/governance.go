@@ -0,0 +1,79 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+package transactionbase
+
+import (
+	"encoding/json"
+
+	"github.com/dappley/go-dappley/common"
+)
+
+// MinProposalDeposit is the minimum amount a proposal locks in its payload output until its activation height
+var MinProposalDeposit = common.NewAmount(10000000000)
+
+// GovernancePayload is stored in the contract field of the first output of a governance transaction. A proposal
+// names a chain parameter, its new value and its activation height; a vote names the proposal it approves or rejects
+type GovernancePayload struct {
+	Parameter        string `json:"parameter,omitempty"`
+	Value            uint64 `json:"value,omitempty"`
+	ActivationHeight uint64 `json:"activation_height,omitempty"`
+	Proposal         string `json:"proposal,omitempty"`
+	Approve          bool   `json:"approve,omitempty"`
+}
+
+// NewProposalPayload returns the contract field of a proposal to set parameter to value from activationHeight on
+func NewProposalPayload(parameter string, value uint64, activationHeight uint64) string {
+	bytes, _ := json.Marshal(&GovernancePayload{Parameter: parameter, Value: value, ActivationHeight: activationHeight})
+	return string(bytes)
+}
+
+// NewProposalVotePayload returns the contract field of a vote on the proposal with the transaction id proposal
+func NewProposalVotePayload(proposal string, approve bool) string {
+	bytes, _ := json.Marshal(&GovernancePayload{Proposal: proposal, Approve: approve})
+	return string(bytes)
+}
+
+// IsVote returns true if the payload is a vote on a proposal rather than a proposal
+func (payload *GovernancePayload) IsVote() bool {
+	return payload.Proposal != ""
+}
+
+// getDepositUnlockHeight returns the height from which the deposit of a proposal can be spent; 0 if the output does
+// not carry a proposal
+func (out *TXOutput) getDepositUnlockHeight() uint64 {
+	payload, ok := out.GetGovernancePayload()
+	if !ok || payload.IsVote() {
+		return 0
+	}
+	return payload.ActivationHeight + 1
+}
+
+// GetGovernancePayload returns the proposal or the vote carried by the output; false if the output carries neither
+func (out *TXOutput) GetGovernancePayload() (*GovernancePayload, bool) {
+	if !out.isUserPayload() {
+		return nil, false
+	}
+	payload := &GovernancePayload{}
+	if err := json.Unmarshal([]byte(out.Contract), payload); err != nil {
+		return nil, false
+	}
+	if (payload.Parameter == "") == (payload.Proposal == "") {
+		return nil, false
+	}
+	return payload, true
+}
@@ -0,0 +1,96 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+package transactionbase
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// VotePayload is stored in the contract field of the output that locks the coins of a vote
+type VotePayload struct {
+	Candidates []string `json:"candidates"`
+}
+
+// UnbondPayload is stored in the contract field of the output that returns the coins of a vote.
+// The output can only be spent from UnlockHeight on
+type UnbondPayload struct {
+	UnlockHeight uint64 `json:"unlock_height"`
+}
+
+// NewVotePayload returns the contract field of a vote output
+func NewVotePayload(candidates []string) string {
+	bytes, _ := json.Marshal(&VotePayload{Candidates: candidates})
+	return string(bytes)
+}
+
+// NewUnbondPayload returns the contract field of an unbonding output
+func NewUnbondPayload(unlockHeight uint64) string {
+	bytes, _ := json.Marshal(&UnbondPayload{UnlockHeight: unlockHeight})
+	return string(bytes)
+}
+
+// GetVotePayload returns the vote carried by the output; false if the output is not a vote output
+func (out *TXOutput) GetVotePayload() (*VotePayload, bool) {
+	if !out.isUserPayload() {
+		return nil, false
+	}
+	payload := &VotePayload{}
+	if err := json.Unmarshal([]byte(out.Contract), payload); err != nil || len(payload.Candidates) == 0 {
+		return nil, false
+	}
+	return payload, true
+}
+
+// GetUnlockHeight returns the height from which an unbonding output or the deposit of a proposal can be spent; 0 if
+// the output is neither
+func (out *TXOutput) GetUnlockHeight() uint64 {
+	if !out.isUserPayload() {
+		return 0
+	}
+	if unlockHeight := out.getDepositUnlockHeight(); unlockHeight > 0 {
+		return unlockHeight
+	}
+	payload := &UnbondPayload{}
+	if err := json.Unmarshal([]byte(out.Contract), payload); err != nil {
+		return 0
+	}
+	return payload.UnlockHeight
+}
+
+// IsStake returns true if the output is locked by a vote, is still unbonding or holds the deposit of a proposal
+func (out *TXOutput) IsStake() bool {
+	_, isVote := out.GetVotePayload()
+	return isVote || out.GetUnlockHeight() > 0
+}
+
+// IsLockedAt returns true if the output can not be spent by a transaction in the block at height: it is locked by a
+// vote, or it is unbonding or holds the deposit of a proposal up to a later height
+func (out *TXOutput) IsLockedAt(height uint64) bool {
+	_, isVote := out.GetVotePayload()
+	return isVote || out.GetUnlockHeight() > height
+}
+
+// isUserPayload returns true if the output belongs to a user account and carries a json payload
+func (out *TXOutput) isUserPayload() bool {
+	if !strings.HasPrefix(out.Contract, "{") {
+		return false
+	}
+	isContract, err := out.PubKeyHash.IsContract()
+	return err == nil && !isContract
+}
@@ -0,0 +1,81 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+package transactionbase
+
+import (
+	"testing"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTXOutput_Stake(t *testing.T) {
+	userAccount := account.NewTransactionAccountByPubKey([]byte("12345678901234567890123456789012"))
+	contractAccount := account.NewContractTransactionAccount()
+
+	tests := []struct {
+		name         string
+		out          *TXOutput
+		candidates   []string
+		unlockHeight uint64
+		isStake      bool
+	}{
+		{
+			name: "NormalOutput",
+			out:  NewTXOutput(common.NewAmount(10), userAccount),
+		},
+		{
+			name:       "VoteOutput",
+			out:        NewTxOut(common.NewAmount(10), userAccount, NewVotePayload([]string{"a", "b"})),
+			candidates: []string{"a", "b"},
+			isStake:    true,
+		},
+		{
+			name: "VoteOutputWithoutCandidates",
+			out:  NewTxOut(common.NewAmount(10), userAccount, NewVotePayload(nil)),
+		},
+		{
+			name:         "UnbondingOutput",
+			out:          NewTxOut(common.NewAmount(10), userAccount, NewUnbondPayload(100)),
+			unlockHeight: 100,
+			isStake:      true,
+		},
+		{
+			name: "ContractOutput",
+			out:  NewContractTXOutput(contractAccount, NewVotePayload([]string{"a"})),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload, isVote := tt.out.GetVotePayload()
+			assert.Equal(t, tt.candidates != nil, isVote)
+			if isVote {
+				assert.Equal(t, tt.candidates, payload.Candidates)
+			}
+			assert.Equal(t, tt.unlockHeight, tt.out.GetUnlockHeight())
+			assert.Equal(t, tt.isStake, tt.out.IsStake())
+			// only a vote stays locked once the unlock height is reached
+			assert.Equal(t, isVote, tt.out.IsLockedAt(tt.unlockHeight))
+			if tt.unlockHeight > 0 {
+				assert.True(t, tt.out.IsLockedAt(tt.unlockHeight-1))
+			}
+		})
+	}
+}
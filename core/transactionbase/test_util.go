@@ -11,11 +11,11 @@ func MockTxInputs() []TXInput {
 		{util.GenerateRandomAoB(2),
 			6,
 			util.GenerateRandomAoB(2),
-			[]byte("12345678901234567890123456789013")},
+			[]byte("12345678901234567890123456789013"), 0},
 		{util.GenerateRandomAoB(2),
 			2,
 			util.GenerateRandomAoB(2),
-			[]byte("12345678901234567890123456789014")},
+			[]byte("12345678901234567890123456789014"), 0},
 	}
 }
 
@@ -32,8 +32,8 @@ func getAoB(length int64) []byte {
 
 func GenerateFakeTxInputs() []TXInput {
 	return []TXInput{
-		{getAoB(2), 10, getAoB(2), getAoB(2)},
-		{getAoB(2), 5, getAoB(2), getAoB(2)},
+		{getAoB(2), 10, getAoB(2), getAoB(2), 0},
+		{getAoB(2), 5, getAoB(2), getAoB(2), 0},
 	}
 }
 
@@ -20,6 +20,7 @@ package transactionbase
 
 import (
 	transactionbasepb "github.com/dappley/go-dappley/core/transactionbase/pb"
+	"github.com/dappley/go-dappley/crypto/keystore"
 	"github.com/golang/protobuf/proto"
 )
 
@@ -28,6 +29,16 @@ type TXInput struct {
 	Vout      int
 	Signature []byte
 	PubKey    []byte
+	// Algorithm is the signature algorithm of the input; zero means secp256k1
+	Algorithm keystore.Algorithm
+}
+
+// GetAlgorithm returns the signature algorithm used to sign the input
+func (in *TXInput) GetAlgorithm() keystore.Algorithm {
+	if in.Algorithm == 0 {
+		return keystore.SECP256K1
+	}
+	return in.Algorithm
 }
 
 func (in *TXInput) ToProto() proto.Message {
@@ -36,6 +47,7 @@ func (in *TXInput) ToProto() proto.Message {
 		Vout:      int32(in.Vout),
 		Signature: in.Signature,
 		PublicKey: in.PubKey,
+		Algorithm: uint32(in.Algorithm),
 	}
 }
 
@@ -44,4 +56,5 @@ func (in *TXInput) FromProto(pb proto.Message) {
 	in.Vout = int(pb.(*transactionbasepb.TXInput).GetVout())
 	in.Signature = pb.(*transactionbasepb.TXInput).GetSignature()
 	in.PubKey = pb.(*transactionbasepb.TXInput).GetPublicKey()
+	in.Algorithm = keystore.Algorithm(pb.(*transactionbasepb.TXInput).GetAlgorithm())
 }
@@ -22,6 +22,7 @@ import (
 	"testing"
 
 	transactionbasepb "github.com/dappley/go-dappley/core/transactionbase/pb"
+	"github.com/dappley/go-dappley/crypto/keystore"
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 )
@@ -32,6 +33,7 @@ func TestTXInput_Proto(t *testing.T) {
 		1,
 		[]byte("signature"),
 		[]byte("PubKey"),
+		keystore.ED25519,
 	}
 
 	pb := vin.ToProto()
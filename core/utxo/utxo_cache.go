@@ -455,7 +455,7 @@ func (utxoCache *UTXOCache) DelStateLog(scStateLogKey string) error {
 	return nil
 }
 
-func (utxoCache *UTXOCache) GetUTXOsByAmountWithOutRemovedUTXOs(pubKeyHash account.PubKeyHash, amount *common.Amount, utxoTxRemove *UTXOTx) ([]*UTXO, error) {
+func (utxoCache *UTXOCache) GetUTXOsByAmountWithOutRemovedUTXOs(pubKeyHash account.PubKeyHash, amount *common.Amount, utxoTxRemove *UTXOTx, blockHeight uint64) ([]*UTXO, error) {
 	lastUtxokey := utxoCache.getLastUTXOKey(pubKeyHash.String())
 	var utxoSlice []*UTXO
 	utxoAmount := common.NewAmount(0)
@@ -475,6 +475,11 @@ func (utxoCache *UTXOCache) GetUTXOsByAmountWithOutRemovedUTXOs(pubKeyHash accou
 				continue
 			}
 		}
+		// coins locked by votes or still unbonding are not selected to pay for a transaction
+		if utxo.IsLockedAt(blockHeight) {
+			utxoKey = util.Bytes2str(utxo.NextUtxoKey)
+			continue
+		}
 		utxoAmount = utxoAmount.Add(utxo.Value)
 		utxoSlice = append(utxoSlice, utxo)
 		if utxoAmount.Cmp(amount) >= 0 {
@@ -19,7 +19,11 @@
 package crypto
 
 import (
+	"strings"
+
 	"github.com/dappley/go-dappley/crypto/keystore"
+	"github.com/dappley/go-dappley/crypto/keystore/ed25519"
+	"github.com/dappley/go-dappley/crypto/keystore/schnorr"
 	"github.com/dappley/go-dappley/crypto/keystore/secp256k1"
 	errval "github.com/dappley/go-dappley/errors"
 )
@@ -42,6 +46,38 @@ func NewPrivateKey(alg keystore.Algorithm, data []byte) (keystore.PrivateKey, er
 			return nil, err
 		}
 		return priv, nil
+	case keystore.ED25519:
+		priv := new(ed25519.PrivateKey)
+		if len(data) == 0 {
+			return ed25519.GeneratePrivateKey(), nil
+		}
+		if err := priv.Decode(data); err != nil {
+			return nil, err
+		}
+		return priv, nil
+	case keystore.SCHNORR:
+		priv := new(schnorr.PrivateKey)
+		if len(data) == 0 {
+			return schnorr.GeneratePrivateKey(), nil
+		}
+		if err := priv.Decode(data); err != nil {
+			return nil, err
+		}
+		return priv, nil
+	default:
+		return nil, errval.AlgorithmInvalid
+	}
+}
+
+// NewPublicKey returns a publickey with Algorithm
+func NewPublicKey(alg keystore.Algorithm, data []byte) (keystore.PublicKey, error) {
+	switch alg {
+	case keystore.SECP256K1:
+		return secp256k1.NewPublicKey(data), nil
+	case keystore.ED25519:
+		return ed25519.NewPublicKey(data), nil
+	case keystore.SCHNORR:
+		return schnorr.NewPublicKey(data), nil
 	default:
 		return nil, errval.AlgorithmInvalid
 	}
@@ -52,6 +88,10 @@ func NewSignature(alg keystore.Algorithm) (keystore.Signature, error) {
 	switch alg {
 	case keystore.SECP256K1:
 		return new(secp256k1.Signature), nil
+	case keystore.ED25519:
+		return new(ed25519.Signature), nil
+	case keystore.SCHNORR:
+		return new(schnorr.Signature), nil
 	default:
 		return nil, errval.AlgorithmInvalid
 	}
@@ -60,9 +100,72 @@ func NewSignature(alg keystore.Algorithm) (keystore.Signature, error) {
 // CheckAlgorithm check if support the input Algorithm
 func CheckAlgorithm(alg keystore.Algorithm) error {
 	switch alg {
-	case keystore.SECP256K1:
+	case keystore.SECP256K1, keystore.ED25519, keystore.SCHNORR:
 		return nil
 	default:
 		return errval.AlgorithmInvalid
 	}
 }
+
+// Sign signs the hash with the encoded private key of the algorithm
+func Sign(alg keystore.Algorithm, hash []byte, privData []byte) ([]byte, error) {
+	if len(privData) == 0 {
+		return nil, errval.InvalidPrivateKey
+	}
+	priv, err := NewPrivateKey(alg, privData)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := NewSignature(alg)
+	if err != nil {
+		return nil, err
+	}
+	if err := signature.InitSign(priv); err != nil {
+		return nil, err
+	}
+	return signature.Sign(hash)
+}
+
+// Verify verifies the signature of the hash with the encoded public key of the algorithm
+func Verify(alg keystore.Algorithm, hash []byte, sig []byte, pubData []byte) (bool, error) {
+	pub, err := NewPublicKey(alg, pubData)
+	if err != nil {
+		return false, err
+	}
+	signature, err := NewSignature(alg)
+	if err != nil {
+		return false, err
+	}
+	if err := signature.InitVerify(pub); err != nil {
+		return false, err
+	}
+	return signature.Verify(hash, sig)
+}
+
+// AlgorithmName returns the name of a signature algorithm
+func AlgorithmName(alg keystore.Algorithm) string {
+	switch alg {
+	case keystore.SECP256K1:
+		return "secp256k1"
+	case keystore.ED25519:
+		return "ed25519"
+	case keystore.SCHNORR:
+		return "schnorr"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseAlgorithm returns the signature algorithm with the name
+func ParseAlgorithm(name string) (keystore.Algorithm, error) {
+	switch strings.ToLower(name) {
+	case "", "secp256k1", "ecdsa":
+		return keystore.SECP256K1, nil
+	case "ed25519":
+		return keystore.ED25519, nil
+	case "schnorr":
+		return keystore.SCHNORR, nil
+	default:
+		return 0, errval.AlgorithmInvalid
+	}
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/btcsuite/btcutil/base58"
 	"github.com/dappley/go-dappley/crypto/hash"
+	"github.com/dappley/go-dappley/crypto/keystore"
 	"github.com/dappley/go-dappley/crypto/keystore/secp256k1"
 	errval "github.com/dappley/go-dappley/errors"
 	"github.com/stretchr/testify/assert"
@@ -489,3 +490,36 @@ func TestSecp256k1Verify_fail(t *testing.T) {
 		})
 	}
 }
+
+func TestSignAndVerify_Algorithms(t *testing.T) {
+	msg := hash.Sha3256([]byte("dappley"))
+	for _, alg := range []keystore.Algorithm{keystore.SECP256K1, keystore.ED25519, keystore.SCHNORR} {
+		t.Run(AlgorithmName(alg), func(t *testing.T) {
+			priv, err := NewPrivateKey(alg, nil)
+			assert.Nil(t, err)
+			privData, err := priv.Encoded()
+			assert.Nil(t, err)
+			pubData, err := priv.PublicKey().Encoded()
+			assert.Nil(t, err)
+
+			sig, err := Sign(alg, msg, privData)
+			assert.Nil(t, err)
+
+			result, err := Verify(alg, msg, sig, pubData)
+			assert.Nil(t, err)
+			assert.True(t, result)
+
+			result, _ = Verify(alg, hash.Sha3256([]byte("dappley1")), sig, pubData)
+			assert.False(t, result)
+
+			parsedAlg, err := ParseAlgorithm(AlgorithmName(alg))
+			assert.Nil(t, err)
+			assert.Equal(t, alg, parsedAlg)
+		})
+	}
+
+	_, err := Sign(keystore.SCRYPT, msg, []byte{1})
+	assert.Equal(t, errval.AlgorithmInvalid, err)
+	_, err = ParseAlgorithm("rsa")
+	assert.Equal(t, errval.AlgorithmInvalid, err)
+}
@@ -0,0 +1,78 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+package ed25519
+
+import (
+	"crypto/ed25519"
+
+	"github.com/dappley/go-dappley/crypto/utils"
+	errval "github.com/dappley/go-dappley/errors"
+)
+
+const (
+	// PrivateKeyLength private key (seed) length
+	PrivateKeyLength = ed25519.SeedSize
+	// PublicKeyLength public key length
+	PublicKeyLength = ed25519.PublicKeySize
+	// SignatureLength signature length
+	SignatureLength = ed25519.SignatureSize
+)
+
+// NewSeckey generate a ed25519 private key seed
+func NewSeckey() []byte {
+	return utils.RandomCSPRNG(PrivateKeyLength)
+}
+
+// SeckeyVerify check private key seed is ok for ed25519
+func SeckeyVerify(seckey []byte) bool {
+	return len(seckey) == PrivateKeyLength
+}
+
+// GetPublicKey private key seed to public key
+func GetPublicKey(seckey []byte) ([]byte, error) {
+	if !SeckeyVerify(seckey) {
+		return nil, errval.InvalidPrivateKey
+	}
+	pub := ed25519.NewKeyFromSeed(seckey).Public().(ed25519.PublicKey)
+	return []byte(pub), nil
+}
+
+// Sign sign hash with private key seed
+func Sign(msg []byte, seckey []byte) ([]byte, error) {
+	if len(msg) != 32 {
+		return nil, errval.InvalidMsgLen
+	}
+	if !SeckeyVerify(seckey) {
+		return nil, errval.InvalidPrivateKey
+	}
+	return ed25519.Sign(ed25519.NewKeyFromSeed(seckey), msg), nil
+}
+
+// Verify verify with public key
+func Verify(msg []byte, signature []byte, pub []byte) (bool, error) {
+	if len(msg) != 32 {
+		return false, errval.InvalidMsgLen
+	}
+	if len(pub) != PublicKeyLength {
+		return false, errval.InvalidPublicKey
+	}
+	if len(signature) != SignatureLength {
+		return false, errval.InvalidSignature
+	}
+	return ed25519.Verify(pub, msg, signature), nil
+}
@@ -0,0 +1,70 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+package ed25519
+
+import (
+	"testing"
+
+	"github.com/dappley/go-dappley/crypto/hash"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerify(t *testing.T) {
+	seckey := NewSeckey()
+	pub, err := GetPublicKey(seckey)
+	assert.Nil(t, err)
+	assert.Equal(t, PublicKeyLength, len(pub))
+	msg := hash.Sha3256([]byte("dappley"))
+
+	sig, err := Sign(msg, seckey)
+	assert.Nil(t, err)
+	assert.Equal(t, SignatureLength, len(sig))
+
+	ok, err := Verify(msg, sig, pub)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	// tampered message
+	ok, err = Verify(hash.Sha3256([]byte("dappley1")), sig, pub)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	// wrong public key
+	otherPub, _ := GetPublicKey(NewSeckey())
+	ok, _ = Verify(msg, sig, otherPub)
+	assert.False(t, ok)
+
+	// invalid private key
+	_, err = Sign(msg, seckey[:31])
+	assert.NotNil(t, err)
+}
+
+func TestSignature_Interface(t *testing.T) {
+	priv := GeneratePrivateKey()
+	msg := hash.Sha3256([]byte("dappley"))
+
+	signature := new(Signature)
+	assert.Nil(t, signature.InitSign(priv))
+	sig, err := signature.Sign(msg)
+	assert.Nil(t, err)
+
+	assert.Nil(t, signature.InitVerify(priv.PublicKey()))
+	ok, err := signature.Verify(msg, sig)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+}
@@ -0,0 +1,76 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+package ed25519
+
+import (
+	"github.com/dappley/go-dappley/crypto/keystore"
+	"github.com/dappley/go-dappley/crypto/utils"
+	errval "github.com/dappley/go-dappley/errors"
+	logger "github.com/sirupsen/logrus"
+)
+
+// PrivateKey ed25519 privatekey
+type PrivateKey struct {
+	seckey []byte
+}
+
+// GeneratePrivateKey generate a new private key
+func GeneratePrivateKey() *PrivateKey {
+	priv := new(PrivateKey)
+	priv.seckey = NewSeckey()
+	return priv
+}
+
+// Algorithm algorithm name
+func (k *PrivateKey) Algorithm() keystore.Algorithm {
+	return keystore.ED25519
+}
+
+// Encoded encoded to byte
+func (k *PrivateKey) Encoded() ([]byte, error) {
+	return k.seckey, nil
+}
+
+// Decode decode data to key
+func (k *PrivateKey) Decode(data []byte) error {
+	if SeckeyVerify(data) == false {
+		return errval.InvalidPrivateKey
+	}
+	k.seckey = data
+	return nil
+}
+
+// Clear clear key content
+func (k *PrivateKey) Clear() {
+	utils.ZeroBytes(k.seckey)
+}
+
+// PublicKey returns publickey
+func (k *PrivateKey) PublicKey() keystore.PublicKey {
+	pub, err := GetPublicKey(k.seckey)
+	if err != nil {
+		logger.Panic(err)
+		return nil
+	}
+	return NewPublicKey(pub)
+}
+
+// Sign sign hash with privatekey
+func (k *PrivateKey) Sign(hash []byte) ([]byte, error) {
+	return Sign(hash, k.seckey)
+}
@@ -25,6 +25,12 @@ const (
 	// SECP256K1 a type of signer
 	SECP256K1 Algorithm = 1
 
+	// ED25519 a type of signer
+	ED25519 Algorithm = 2
+
+	// SCHNORR a type of signer, BIP340 schnorr signatures over secp256k1
+	SCHNORR Algorithm = 3
+
 	// SCRYPT a type of encrypt
 	SCRYPT Algorithm = 1 << 4
 )
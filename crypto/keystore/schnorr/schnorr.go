@@ -0,0 +1,240 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+package schnorr
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"math/big"
+
+	"github.com/dappley/go-dappley/crypto/keystore/secp256k1"
+	"github.com/dappley/go-dappley/crypto/utils"
+	errval "github.com/dappley/go-dappley/errors"
+)
+
+// BIP340 schnorr signatures over secp256k1
+// see https://github.com/bitcoin/bips/blob/master/bip-0340.mediawiki
+
+const (
+	// PrivateKeyLength private key length
+	PrivateKeyLength = 32
+	// PublicKeyLength x-only public key length
+	PublicKeyLength = 32
+	// SignatureLength signature length
+	SignatureLength = 64
+)
+
+var (
+	curve = secp256k1.S256()
+	// p is the field size and n the group order of secp256k1
+	p = curve.Params().P
+	n = curve.Params().N
+)
+
+// NewSeckey generate a schnorr private key
+func NewSeckey() []byte {
+	var priv []byte
+	for {
+		priv = utils.RandomCSPRNG(PrivateKeyLength)
+		if SeckeyVerify(priv) {
+			break
+		}
+	}
+	return priv
+}
+
+// SeckeyVerify check private key is in range [1, n-1]
+func SeckeyVerify(seckey []byte) bool {
+	if len(seckey) != PrivateKeyLength {
+		return false
+	}
+	d := new(big.Int).SetBytes(seckey)
+	return d.Sign() > 0 && d.Cmp(n) < 0
+}
+
+// GetPublicKey returns the x-only public key of the private key
+func GetPublicKey(seckey []byte) ([]byte, error) {
+	if !SeckeyVerify(seckey) {
+		return nil, errval.InvalidPrivateKey
+	}
+	px, _ := curve.ScalarBaseMult(seckey)
+	return paddedBytes(px), nil
+}
+
+// Sign sign hash with private key
+func Sign(msg []byte, seckey []byte) ([]byte, error) {
+	return sign(msg, seckey, utils.RandomCSPRNG(32))
+}
+
+func sign(msg []byte, seckey []byte, aux []byte) ([]byte, error) {
+	if len(msg) != 32 {
+		return nil, errval.InvalidMsgLen
+	}
+	if !SeckeyVerify(seckey) {
+		return nil, errval.InvalidPrivateKey
+	}
+
+	d := new(big.Int).SetBytes(seckey)
+	px, py := curve.ScalarBaseMult(seckey)
+	if py.Bit(0) == 1 {
+		d.Sub(n, d)
+	}
+	pubBytes := paddedBytes(px)
+
+	t := paddedBytes(d)
+	auxHash := taggedHash("BIP0340/aux", aux)
+	for i := range t {
+		t[i] ^= auxHash[i]
+	}
+
+	k := new(big.Int).SetBytes(taggedHash("BIP0340/nonce", t, pubBytes, msg))
+	k.Mod(k, n)
+	if k.Sign() == 0 {
+		return nil, errval.SignFailed
+	}
+	rx, ry := curve.ScalarBaseMult(paddedBytes(k))
+	if ry.Bit(0) == 1 {
+		k.Sub(n, k)
+	}
+	rBytes := paddedBytes(rx)
+
+	e := challenge(rBytes, pubBytes, msg)
+	s := new(big.Int).Mul(e, d)
+	s.Add(s, k)
+	s.Mod(s, n)
+
+	sig := make([]byte, 0, SignatureLength)
+	sig = append(sig, rBytes...)
+	sig = append(sig, paddedBytes(s)...)
+
+	if ok, _ := Verify(msg, sig, pubBytes); !ok {
+		return nil, errval.SignFailed
+	}
+	return sig, nil
+}
+
+// Verify verify with x-only public key
+func Verify(msg []byte, signature []byte, pub []byte) (bool, error) {
+	if len(msg) != 32 {
+		return false, errval.InvalidMsgLen
+	}
+	if len(pub) != PublicKeyLength {
+		return false, errval.InvalidPublicKey
+	}
+	if len(signature) != SignatureLength {
+		return false, errval.InvalidSignature
+	}
+
+	px, py, ok := liftX(new(big.Int).SetBytes(pub))
+	if !ok {
+		return false, errval.InvalidPublicKey
+	}
+	r := new(big.Int).SetBytes(signature[:32])
+	s := new(big.Int).SetBytes(signature[32:])
+	if r.Cmp(p) >= 0 || s.Cmp(n) >= 0 {
+		return false, nil
+	}
+
+	// R = s*G - e*P
+	e := challenge(signature[:32], pub, msg)
+	sgx, sgy := scalarBaseMult(s)
+	negE := new(big.Int).Sub(n, e)
+	negE.Mod(negE, n)
+	epx, epy := scalarMult(px, py, negE)
+	rx, ry := add(sgx, sgy, epx, epy)
+	if rx == nil || ry.Bit(0) == 1 {
+		return false, nil
+	}
+	return rx.Cmp(r) == 0, nil
+}
+
+// challenge computes e = int(hash_challenge(r || P || m)) mod n
+func challenge(r, pub, msg []byte) *big.Int {
+	e := new(big.Int).SetBytes(taggedHash("BIP0340/challenge", r, pub, msg))
+	return e.Mod(e, n)
+}
+
+func taggedHash(tag string, msgs ...[]byte) []byte {
+	tagHash := sha256.Sum256([]byte(tag))
+	h := sha256.New()
+	h.Write(tagHash[:])
+	h.Write(tagHash[:])
+	for _, msg := range msgs {
+		h.Write(msg)
+	}
+	return h.Sum(nil)
+}
+
+// liftX returns the point with the given x coordinate and an even y coordinate
+func liftX(x *big.Int) (*big.Int, *big.Int, bool) {
+	if x.Cmp(p) >= 0 {
+		return nil, nil, false
+	}
+	// y^2 = x^3 + 7
+	c := new(big.Int).Exp(x, big.NewInt(3), p)
+	c.Add(c, curve.Params().B)
+	c.Mod(c, p)
+	exp := new(big.Int).Add(p, big.NewInt(1))
+	exp.Rsh(exp, 2)
+	y := new(big.Int).Exp(c, exp, p)
+	if new(big.Int).Exp(y, big.NewInt(2), p).Cmp(c) != 0 {
+		return nil, nil, false
+	}
+	if y.Bit(0) == 1 {
+		y.Sub(p, y)
+	}
+	return x, y, true
+}
+
+// scalarBaseMult returns k*G, or nil for the point at infinity
+func scalarBaseMult(k *big.Int) (*big.Int, *big.Int) {
+	return scalarMult(curve.Params().Gx, curve.Params().Gy, k)
+}
+
+// scalarMult returns k*(x,y), or nil for the point at infinity
+func scalarMult(x, y, k *big.Int) (*big.Int, *big.Int) {
+	if k.Sign() == 0 {
+		return nil, nil
+	}
+	return curve.ScalarMult(x, y, paddedBytes(k))
+}
+
+// add returns the sum of two points, treating nil as the point at infinity
+func add(x1, y1, x2, y2 *big.Int) (*big.Int, *big.Int) {
+	if x1 == nil {
+		return x2, y2
+	}
+	if x2 == nil {
+		return x1, y1
+	}
+	if x1.Cmp(x2) == 0 {
+		if y1.Cmp(y2) != 0 {
+			return nil, nil
+		}
+		return curve.Double(x1, y1)
+	}
+	return curve.Add(x1, y1, x2, y2)
+}
+
+func paddedBytes(i *big.Int) []byte {
+	b := i.Bytes()
+	if len(b) >= 32 {
+		return b
+	}
+	return append(bytes.Repeat([]byte{0}, 32-len(b)), b...)
+}
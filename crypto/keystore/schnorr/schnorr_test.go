@@ -0,0 +1,118 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+package schnorr
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/dappley/go-dappley/crypto/hash"
+	"github.com/stretchr/testify/assert"
+)
+
+func decodeHex(s string) []byte {
+	b, _ := hex.DecodeString(s)
+	return b
+}
+
+func TestSign_BIP340Vectors(t *testing.T) {
+	tests := []struct {
+		name   string
+		seckey string
+		pubkey string
+		aux    string
+		msg    string
+		sig    string
+	}{
+		{
+			name:   "vector 0",
+			seckey: "0000000000000000000000000000000000000000000000000000000000000003",
+			pubkey: "F9308A019258C31049344F85F89D5229B531C845836F99B08601F113BCE036F9",
+			aux:    "0000000000000000000000000000000000000000000000000000000000000000",
+			msg:    "0000000000000000000000000000000000000000000000000000000000000000",
+			sig:    "E907831F80848D1069A5371B402410364BDF1C5F8307B0084C55F1CE2DCA821525F66A4A85EA8B71E482A74F382D2CE5EBEEE8FDB2172F477DF4900D310536C0",
+		},
+		{
+			name:   "vector 1",
+			seckey: "B7E151628AED2A6ABF7158809CF4F3C762E7160F38B4DA56A784D9045190CFEF",
+			pubkey: "DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+			aux:    "0000000000000000000000000000000000000000000000000000000000000001",
+			msg:    "243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+			sig:    "6896BD60EEAE296DB48A229FF71DFE071BDE413E6D43F917DC8DCF8C78DE33418906D11AC976ABCCB20B091292BFF4EA897EFCB639EA871CFA95F6DE339E4B0A",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pub, err := GetPublicKey(decodeHex(tt.seckey))
+			assert.Nil(t, err)
+			assert.Equal(t, decodeHex(tt.pubkey), pub)
+
+			sig, err := sign(decodeHex(tt.msg), decodeHex(tt.seckey), decodeHex(tt.aux))
+			assert.Nil(t, err)
+			assert.Equal(t, decodeHex(tt.sig), sig)
+
+			ok, err := Verify(decodeHex(tt.msg), sig, pub)
+			assert.Nil(t, err)
+			assert.True(t, ok)
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	seckey := NewSeckey()
+	pub, err := GetPublicKey(seckey)
+	assert.Nil(t, err)
+	msg := hash.Sha3256([]byte("dappley"))
+
+	sig, err := Sign(msg, seckey)
+	assert.Nil(t, err)
+	assert.Equal(t, SignatureLength, len(sig))
+
+	ok, err := Verify(msg, sig, pub)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	// tampered message
+	ok, err = Verify(hash.Sha3256([]byte("dappley1")), sig, pub)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	// wrong public key
+	otherPub, _ := GetPublicKey(NewSeckey())
+	ok, _ = Verify(msg, sig, otherPub)
+	assert.False(t, ok)
+
+	// malformed signature
+	_, err = Verify(msg, sig[:63], pub)
+	assert.NotNil(t, err)
+}
+
+func TestSignature_Interface(t *testing.T) {
+	priv := GeneratePrivateKey()
+	msg := hash.Sha3256([]byte("dappley"))
+
+	signature := new(Signature)
+	assert.Nil(t, signature.InitSign(priv))
+	sig, err := signature.Sign(msg)
+	assert.Nil(t, err)
+
+	assert.Nil(t, signature.InitVerify(priv.PublicKey()))
+	ok, err := signature.Verify(msg, sig)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+package schnorr
+
+import (
+	"github.com/dappley/go-dappley/crypto/keystore"
+	errval "github.com/dappley/go-dappley/errors"
+)
+
+// Signature signature schnorr
+type Signature struct {
+	privateKey *PrivateKey
+
+	publicKey *PublicKey
+}
+
+// Algorithm schnorr algorithm
+func (s *Signature) Algorithm() keystore.Algorithm {
+	return keystore.SCHNORR
+}
+
+// InitSign schnorr init sign
+func (s *Signature) InitSign(priv keystore.PrivateKey) error {
+	s.privateKey = priv.(*PrivateKey)
+	return nil
+}
+
+// Sign schnorr sign
+func (s *Signature) Sign(data []byte) (out []byte, err error) {
+	if s.privateKey == nil {
+		return nil, errval.GetPvtKeyFirst
+	}
+	return s.privateKey.Sign(data)
+}
+
+// RecoverPublic is not supported by schnorr signatures
+func (s *Signature) RecoverPublic(data []byte, signature []byte) (keystore.PublicKey, error) {
+	return nil, errval.RecoverFailed
+}
+
+// InitVerify schnorr verify init
+func (s *Signature) InitVerify(pub keystore.PublicKey) error {
+	s.publicKey = pub.(*PublicKey)
+	return nil
+}
+
+// Verify schnorr verify
+func (s *Signature) Verify(data []byte, signature []byte) (bool, error) {
+	if s.publicKey == nil {
+		return false, errval.GivePubKeyFirst
+	}
+	return s.publicKey.Verify(data, signature)
+}
@@ -104,9 +104,15 @@ func H2(m []byte) *big.Int {
 
 // Evaluate returns the verifiable unpredictable function evaluated at m
 func (k PrivateKey) Evaluate(m []byte) (index [32]byte, proof []byte) {
+	return k.EvaluateWithRand(m, rand.Reader)
+}
+
+// EvaluateWithRand returns the verifiable unpredictable function evaluated at m. The nonce of the proof is read
+// from rnd
+func (k PrivateKey) EvaluateWithRand(m []byte, rnd io.Reader) (index [32]byte, proof []byte) {
 	nilIndex := [32]byte{}
 	// Prover chooses r <-- [1,N-1]
-	r, _, _, err := generateKeyFromCurve(curve, rand.Reader)
+	r, _, _, err := generateKeyFromCurve(curve, rnd)
 	if err != nil {
 		return nilIndex, nil
 	}
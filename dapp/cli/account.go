@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	acc "github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/crypto"
 	"github.com/dappley/go-dappley/logic"
 	"github.com/dappley/go-dappley/wallet"
 )
@@ -25,12 +26,17 @@ func addAccountCommandHandler(ctx context.Context, account interface{}, flags cm
 		fmt.Println("Error: private key is missing!")
 		return
 	}
+	alg, err := crypto.ParseAlgorithm(*(flags[flagAlgorithm].(*string)))
+	if err != nil {
+		fmt.Println("Error: ", err.Error())
+		return
+	}
 	am, err := logic.GetAccountManager(wallet.GetAccountFilePath())
 	if err != nil {
 		fmt.Println("Error: ", err.Error())
 		return
 	}
-	newAccount := acc.NewAccountByPrivateKey(privKey)
+	newAccount := acc.NewAccountByPrivateKeyWithAlgorithm(privKey, alg)
 	duplicateAccount := am.GetAccountByAddress(newAccount.GetAddress())
 
 	if duplicateAccount != nil {
@@ -33,6 +33,7 @@ import (
 	"github.com/dappley/go-dappley/config"
 	configpb "github.com/dappley/go-dappley/config/pb"
 	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/crypto"
 	"github.com/dappley/go-dappley/logic"
 	rpcpb "github.com/dappley/go-dappley/rpc/pb"
 	"github.com/dappley/go-dappley/util"
@@ -134,6 +135,15 @@ func createAccount(ctx context.Context, c interface{}, flags cmdFlags) *account.
 	prompter := util.NewTerminalPrompter()
 	passphrase := ""
 
+	if err != nil {
+		fmt.Println("Error:", err.Error())
+		return nil
+	}
+	algName := ""
+	if algFlag, ok := flags[flagAlgorithm]; ok {
+		algName = *(algFlag.(*string))
+	}
+	alg, err := crypto.ParseAlgorithm(algName)
 	if err != nil {
 		fmt.Println("Error:", err.Error())
 		return nil
@@ -145,7 +155,7 @@ func createAccount(ctx context.Context, c interface{}, flags cmdFlags) *account.
 			fmt.Println("Error: password cannot be empty!")
 			return nil
 		}
-		account, err := logic.CreateAccountWithPassphrase(passphrase)
+		account, err := logic.CreateAccountWithAlgorithmAndPassphrase(alg, passphrase)
 		if err != nil {
 			fmt.Println("Error:", err.Error())
 			return nil
@@ -157,7 +167,7 @@ func createAccount(ctx context.Context, c interface{}, flags cmdFlags) *account.
 			fmt.Println("Error: password should not be empty!")
 			return nil
 		}
-		account, err := logic.CreateAccountWithPassphrase(passphrase)
+		account, err := logic.CreateAccountWithAlgorithmAndPassphrase(alg, passphrase)
 		if err != nil {
 			fmt.Println("Error:", err.Error())
 			return nil
@@ -181,6 +191,10 @@ func getUTXOsfromAmount(inputUTXOs []*utxo.UTXO, amount *common.Amount, tip *com
 
 	vinRulesCheck := false
 	for i := 0; i < len(inputUTXOs); i++ {
+		// coins locked by a vote can only be withdrawn by an unvote
+		if _, isVote := inputUTXOs[i].GetVotePayload(); isVote {
+			continue
+		}
 		retUtxos = append(retUtxos, inputUTXOs[i])
 		sum = sum.Add(inputUTXOs[i].Value)
 		if vinRules(sum, amount, i, len(inputUTXOs)) {
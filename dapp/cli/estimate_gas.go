@@ -107,4 +107,14 @@ func estimateGasCommandHandler(ctx context.Context, c interface{}, flags cmdFlag
 	gasCount := gasResponse.GasCount
 
 	fmt.Println("Gas estimation: ", common.NewAmountFromBytes(gasCount).String())
+
+	suggestion := gasResponse.GetSuggestion()
+	if suggestion == nil {
+		return
+	}
+	estimatedGas := common.NewAmountFromBytes(gasCount)
+	fmt.Println("Estimated gas fee (low/median/high): ",
+		estimatedGas.Mul(common.NewAmountFromBytes(suggestion.GetLowGasPrice())).String(),
+		estimatedGas.Mul(common.NewAmountFromBytes(suggestion.GetMedianGasPrice())).String(),
+		estimatedGas.Mul(common.NewAmountFromBytes(suggestion.GetHighGasPrice())).String())
 }
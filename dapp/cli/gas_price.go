@@ -24,4 +24,40 @@ func gasPriceCommandHandler(ctx context.Context, account interface{}, flags cmdF
 	}
 	gasPrice := gasPriceResponse.GasPrice
 	fmt.Println("Gas price: ", common.NewAmountFromBytes(gasPrice).String())
+
+	suggestion := gasPriceResponse.GetSuggestion()
+	if suggestion == nil {
+		return
+	}
+	fmt.Println("Suggested gas price (low/median/high): ",
+		common.NewAmountFromBytes(suggestion.GetLowGasPrice()).String(),
+		common.NewAmountFromBytes(suggestion.GetMedianGasPrice()).String(),
+		common.NewAmountFromBytes(suggestion.GetHighGasPrice()).String())
+	fmt.Println("Suggested tip (low/median/high): ",
+		common.NewAmountFromBytes(suggestion.GetLowTip()).String(),
+		common.NewAmountFromBytes(suggestion.GetMedianTip()).String(),
+		common.NewAmountFromBytes(suggestion.GetHighTip()).String())
+}
+
+// getSuggestedGasPrice returns the median gas price suggested by the node
+func getSuggestedGasPrice(ctx context.Context, c interface{}) (*common.Amount, error) {
+	gasPriceResponse, err := c.(rpcpb.RpcServiceClient).RpcGasPrice(ctx, &rpcpb.GasPriceRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return common.NewAmountFromBytes(gasPriceResponse.GetGasPrice()), nil
+}
+
+// getGasPrice returns the gas price set by the flags. The gas price suggested by the node is used if none is set for
+// a transaction with a gas limit
+func getGasPrice(ctx context.Context, c interface{}, gasLimit, gasPrice *common.Amount) (*common.Amount, error) {
+	if !gasPrice.IsZero() || gasLimit.IsZero() {
+		return gasPrice, nil
+	}
+	suggestedGasPrice, err := getSuggestedGasPrice(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Println("Using suggested gas price: ", suggestedGasPrice.String())
+	return suggestedGasPrice, nil
 }
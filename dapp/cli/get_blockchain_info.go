@@ -26,6 +26,7 @@ func getBlockchainInfoCommandHandler(ctx context.Context, account interface{}, f
 		"TailBlockHash": hex.EncodeToString(response.TailBlockHash),
 		"BlockHeight":   response.BlockHeight,
 		"Producers":     response.Producers,
+		"BlockGasLimit": response.BlockGasLimit,
 	}
 
 	blockchainInfo, err := json.MarshalIndent(encodedResponse, "", "  ")
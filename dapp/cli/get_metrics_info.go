@@ -94,3 +94,19 @@ func getMetricsInfoCommandHandler(ctx context.Context, c interface{}, flags cmdF
 		}
 	}
 }
+
+func getProducerStatsCommandHandler(ctx context.Context, c interface{}, flags cmdFlags) {
+	response, err := c.(rpcpb.MetricServiceClient).RpcGetProducerStats(ctx, &rpcpb.MetricsServiceRequest{})
+	if err != nil {
+		printRpcError(err)
+		return
+	}
+	if len(response.GetStats()) == 0 {
+		fmt.Println("No producer stats are found.")
+		return
+	}
+	for _, stats := range response.GetStats() {
+		fmt.Printf("%s produced: %d missed: %d orphaned: %d mean latency: %.2fs missed in a row: %d\n",
+			stats.GetAddress(), stats.GetProduced(), stats.GetMissed(), stats.GetOrphaned(), stats.GetMeanLatency(), stats.GetConsecutiveMissed())
+	}
+}
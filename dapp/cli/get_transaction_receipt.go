@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	rpcpb "github.com/dappley/go-dappley/rpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func getTxReceiptCommandHandler(ctx context.Context, c interface{}, flags cmdFlags) {
+	txid, err := hex.DecodeString(*(flags[flagTxid].(*string)))
+	if err != nil || len(txid) == 0 {
+		fmt.Println("\n Please enter a valid transaction id. Example: cli getTransactionReceipt -txid 8334b4c1...")
+		fmt.Println()
+		return
+	}
+
+	response, err := c.(rpcpb.RpcServiceClient).RpcGetTransactionReceipt(ctx, &rpcpb.GetTransactionReceiptRequest{Txid: txid})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Unavailable:
+			fmt.Println("Error: server is not reachable!")
+		default:
+			fmt.Println("Error:", status.Convert(err).Message())
+		}
+		return
+	}
+
+	receipt := response.GetReceipt()
+	var generatedTxids []string
+	for _, genTxid := range receipt.GetGeneratedTxids() {
+		generatedTxids = append(generatedTxids, hex.EncodeToString(genTxid))
+	}
+	var events []map[string]interface{}
+	for _, event := range receipt.GetEvents() {
+		events = append(events, map[string]interface{}{
+			"Topic": event.GetTopic(),
+			"Data":  event.GetData(),
+		})
+	}
+
+	encodedReceipt := map[string]interface{}{
+		"Txid":           hex.EncodeToString(receipt.GetTxid()),
+		"Status":         receipt.GetStatus(),
+		"Error":          receipt.GetError(),
+		"GasUsed":        receipt.GetGasUsed(),
+		"GeneratedTxids": generatedTxids,
+		"Events":         events,
+	}
+
+	receiptJSON, err := json.MarshalIndent(encodedReceipt, "", "  ")
+	if err != nil {
+		fmt.Println("Error: ", err.Error())
+	}
+
+	fmt.Println(string(receiptJSON))
+}
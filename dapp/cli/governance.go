@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/core/transactionbase"
+	"github.com/dappley/go-dappley/logic/lgovernance"
+	"github.com/dappley/go-dappley/logic/ltransaction"
+	rpcpb "github.com/dappley/go-dappley/rpc/pb"
+)
+
+func proposeCommandHandler(ctx context.Context, c interface{}, flags cmdFlags) {
+	parameter := *(flags[flagParameter].(*string))
+	value := *(flags[flagValue].(*uint64))
+	if !lgovernance.IsValidParameter(parameter, value) {
+		fmt.Println("Error: the parameter or its value is not valid!")
+		return
+	}
+	activationHeight := *(flags[flagActivationHeight].(*uint64))
+	if activationHeight == 0 {
+		fmt.Println("Error: activation height is missing!")
+		return
+	}
+	payload := transactionbase.NewProposalPayload(parameter, value, activationHeight)
+	if txid := sendGovernanceTransaction(ctx, c, flags, payload, transactionbase.MinProposalDeposit); txid != "" {
+		fmt.Println("Proposal is sent! Txid:", txid)
+	}
+}
+
+func voteProposalCommandHandler(ctx context.Context, c interface{}, flags cmdFlags) {
+	proposal := *(flags[flagTxid].(*string))
+	if txid, err := hex.DecodeString(proposal); err != nil || len(txid) == 0 {
+		fmt.Println("Error: please enter the transaction id of the proposal in hex!")
+		return
+	}
+	approve := !*(flags[flagReject].(*bool))
+	payload := transactionbase.NewProposalVotePayload(proposal, approve)
+	if txid := sendGovernanceTransaction(ctx, c, flags, payload, common.NewAmount(0)); txid != "" {
+		fmt.Println("Proposal vote is sent! Txid:", txid)
+	}
+}
+
+// sendGovernanceTransaction sends a governance transaction with payload that locks deposit from the 'from' address and
+// returns its transaction id; empty if it could not be sent
+func sendGovernanceTransaction(ctx context.Context, c interface{}, flags cmdFlags, payload string, deposit *common.Amount) string {
+	fromAddress := *(flags[flagFromAddress].(*string))
+	if !account.NewTransactionAccountByAddress(account.NewAddress(fromAddress)).IsValid() {
+		fmt.Println("Error: 'from' address is not valid!")
+		return ""
+	}
+	tip := common.NewAmount(*(flags[flagTip].(*uint64)))
+
+	senderAccount := getSenderAccount(fromAddress)
+	if senderAccount == nil {
+		return ""
+	}
+	inputUtxos, err := getSortedUtxos(c, fromAddress)
+	if err != nil {
+		printRpcError(err)
+		return ""
+	}
+	txUtxos, err := getUTXOsfromAmount(inputUtxos, deposit, tip, nil, nil)
+	if err != nil {
+		fmt.Println("Error: ", err.Error())
+		return ""
+	}
+
+	sendTxParam := transaction.NewSendTxParam(account.NewAddress(fromAddress), senderAccount.GetKeyPair(),
+		account.NewAddress(fromAddress), deposit, tip, common.NewAmount(0), common.NewAmount(0), "")
+	tx, err := ltransaction.NewGovernanceTransaction(txUtxos, sendTxParam, payload)
+	if err != nil {
+		fmt.Println("Error: ", err.Error())
+		return ""
+	}
+	if err := sendTransaction(ctx, c, &tx); err != nil {
+		printRpcError(err)
+		return ""
+	}
+	return hex.EncodeToString(tx.ID)
+}
+
+func getChainParametersCommandHandler(ctx context.Context, c interface{}, flags cmdFlags) {
+	height := *(flags[flagBlockHeight].(*uint64))
+	response, err := c.(rpcpb.RpcServiceClient).RpcGetChainParameters(ctx, &rpcpb.GetChainParametersRequest{Height: height})
+	if err != nil {
+		printRpcError(err)
+		return
+	}
+	fmt.Println("In force after height:", response.GetHeight())
+	fmt.Println("Block size limit:", response.GetBlockSizeLimit())
+	fmt.Println("Gas price floor:", response.GetGasPriceFloor())
+	fmt.Println("Max producers:", response.GetMaxProducers())
+	fmt.Println("Time between blocks:", response.GetTimeBetweenBlk())
+	fmt.Println("Quorum:", response.GetQuorum())
+	for _, proposal := range response.GetProposals() {
+		fmt.Println("Proposal:", hex.EncodeToString(proposal.GetTxid()),
+			"parameter:", proposal.GetParameter(),
+			"value:", proposal.GetValue(),
+			"activation height:", proposal.GetActivationHeight(),
+			"approvals:", strings.Join(proposal.GetApprovals(), ","))
+	}
+}
@@ -6,7 +6,7 @@ import (
 	"fmt"
 
 	"github.com/dappley/go-dappley/core/account"
-	"github.com/dappley/go-dappley/crypto/keystore/secp256k1"
+	errval "github.com/dappley/go-dappley/errors"
 	"github.com/dappley/go-dappley/logic"
 	"github.com/dappley/go-dappley/util"
 	"github.com/dappley/go-dappley/wallet"
@@ -67,10 +67,9 @@ func listAddressesCommandHandler(ctx context.Context, c interface{}, flags cmdFl
 		privateKeyList := []string{}
 		for _, addr := range addressList {
 			keyPair := am.GetKeyPairByAddress(account.NewAddress(addr))
-			pvk := keyPair.GetPrivateKey()
-			privateKey, err := secp256k1.FromECDSAPrivateKey(&pvk)
-			if err != nil {
-				fmt.Println("Error: ", err.Error())
+			privateKey := keyPair.GetPrivateKeyBytes()
+			if privateKey == nil {
+				fmt.Println("Error: ", errval.InvalidPrivateKey.Error())
 				return
 			}
 			privateKeyList = append(privateKeyList, hex.EncodeToString(privateKey))
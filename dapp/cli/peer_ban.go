@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	rpcpb "github.com/dappley/go-dappley/rpc/pb"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func getBannedPeersCommandHandler(ctx context.Context, account interface{}, flags cmdFlags) {
+	response, err := account.(rpcpb.AdminServiceClient).RpcGetBannedPeers(ctx, &rpcpb.GetBannedPeersRequest{})
+	if err != nil {
+		printPeerBanError(err)
+		return
+	}
+	fmt.Println(proto.MarshalTextString(response))
+}
+
+func banPeerCommandHandler(ctx context.Context, account interface{}, flags cmdFlags) {
+	req := &rpcpb.BanPeerRequest{
+		PeerId:   *(flags[flagPeerId].(*string)),
+		Duration: int64(*(flags[flagBanDuration].(*int))),
+	}
+	if _, err := account.(rpcpb.AdminServiceClient).RpcBanPeer(ctx, req); err != nil {
+		printPeerBanError(err)
+		return
+	}
+	fmt.Println("Peer is banned!")
+}
+
+func unbanPeerCommandHandler(ctx context.Context, account interface{}, flags cmdFlags) {
+	req := &rpcpb.UnbanPeerRequest{
+		PeerId: *(flags[flagPeerId].(*string)),
+	}
+	if _, err := account.(rpcpb.AdminServiceClient).RpcUnbanPeer(ctx, req); err != nil {
+		printPeerBanError(err)
+		return
+	}
+	fmt.Println("Peer is unbanned!")
+}
+
+func printPeerBanError(err error) {
+	switch status.Code(err) {
+	case codes.Unavailable:
+		fmt.Println("Error: server is not reachable!")
+	default:
+		fmt.Println("Error: ", status.Convert(err).Message())
+	}
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	rpcpb "github.com/dappley/go-dappley/rpc/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+func getPeerFilterCommandHandler(ctx context.Context, account interface{}, flags cmdFlags) {
+	response, err := account.(rpcpb.AdminServiceClient).RpcGetPeerFilter(ctx, &rpcpb.GetPeerFilterRequest{})
+	if err != nil {
+		printPeerBanError(err)
+		return
+	}
+	fmt.Println(proto.MarshalTextString(response))
+}
+
+func setPeerAllowlistCommandHandler(ctx context.Context, account interface{}, flags cmdFlags) {
+	req := &rpcpb.SetPeerAllowlistRequest{
+		PeerIds: splitPeerIds(*(flags[flagPeerIds].(*string))),
+	}
+	if _, err := account.(rpcpb.AdminServiceClient).RpcSetPeerAllowlist(ctx, req); err != nil {
+		printPeerBanError(err)
+		return
+	}
+	fmt.Println("Peer allowlist is updated!")
+}
+
+func setPeerDenylistCommandHandler(ctx context.Context, account interface{}, flags cmdFlags) {
+	req := &rpcpb.SetPeerDenylistRequest{
+		PeerIds: splitPeerIds(*(flags[flagPeerIds].(*string))),
+	}
+	if _, err := account.(rpcpb.AdminServiceClient).RpcSetPeerDenylist(ctx, req); err != nil {
+		printPeerBanError(err)
+		return
+	}
+	fmt.Println("Peer denylist is updated!")
+}
+
+//splitPeerIds returns the peer ids of a comma separated list. An empty list returns no peer id
+func splitPeerIds(peerIds string) []string {
+	var result []string
+	for _, peerId := range strings.Split(peerIds, ",") {
+		if peerId = strings.TrimSpace(peerId); peerId != "" {
+			result = append(result, peerId)
+		}
+	}
+	return result
+}
@@ -106,6 +106,11 @@ func sendCommandHandler(ctx context.Context, c interface{}, flags cmdFlags) {
 	if flags[flagGasPrice] != nil {
 		gasPrice = common.NewAmount(*(flags[flagGasPrice].(*uint64)))
 	}
+	gasPrice, err = getGasPrice(ctx, c, gasLimit, gasPrice)
+	if err != nil {
+		printRpcError(err)
+		return
+	}
 	tx_utxos, err := getUTXOsfromAmount(inputUtxos, common.NewAmount(uint64(*(flags[flagAmount].(*int)))), tip, gasLimit, gasPrice)
 	if err != nil {
 		fmt.Println("Error: ", err.Error())
@@ -204,6 +209,11 @@ func sendAmountCommandHandler(ctx context.Context, c interface{}, flags cmdFlags
 	if flags[flagGasPrice] != nil {
 		gasPrice = common.NewAmount(*(flags[flagGasPrice].(*uint64)))
 	}
+	gasPrice, err := getGasPrice(ctx, c, gasLimit, gasPrice)
+	if err != nil {
+		printRpcError(err)
+		return
+	}
 
 	/*
 		response, err := logic.GetUtxoStream(c.(rpcpb.RpcServiceClient), &rpcpb.GetUTXORequest{
@@ -28,6 +28,21 @@ const (
 	cliGetBlockByHeight  = "getBlockByHeight"
 	cliGenerateSeed      = "generateSeed"
 	cliConfigGenerator   = "generateConfig"
+	cliGetTxReceipt      = "getTransactionReceipt"
+	cliVote              = "vote"
+	cliUnvote            = "unvote"
+	cliGetCandidates     = "getCandidates"
+	cliGetVotes          = "getVotes"
+	cliGetProducerStats  = "getProducerStats"
+	cliPropose           = "propose"
+	cliVoteProposal      = "voteProposal"
+	cliGetChainParams    = "getChainParameters"
+	cliGetBannedPeers    = "getBannedPeers"
+	cliBanPeer           = "banPeer"
+	cliUnbanPeer         = "unbanPeer"
+	cliGetPeerFilter     = "getPeerFilter"
+	cliSetPeerAllowlist  = "setPeerAllowlist"
+	cliSetPeerDenylist   = "setPeerDenylist"
 )
 
 //flag names
@@ -53,6 +68,15 @@ const (
 	flagValue            = "value"
 	flagBlockHeight      = "height"
 	flagGenerateConfig   = "generateConfig"
+	flagTxid             = "txid"
+	flagAlgorithm        = "algorithm"
+	flagCandidates       = "candidates"
+	flagParameter        = "parameter"
+	flagActivationHeight = "activationHeight"
+	flagReject           = "reject"
+	flagPeerId           = "peerId"
+	flagBanDuration      = "duration"
+	flagPeerIds          = "peerIds"
 )
 
 type valueType int
@@ -103,6 +127,21 @@ var cmdList = []string{
 	cliGetBlockByHeight,
 	cliGenerateSeed,
 	cliConfigGenerator,
+	cliGetTxReceipt,
+	cliVote,
+	cliUnvote,
+	cliGetCandidates,
+	cliGetVotes,
+	cliGetProducerStats,
+	cliPropose,
+	cliVoteProposal,
+	cliGetChainParams,
+	cliGetBannedPeers,
+	cliBanPeer,
+	cliUnbanPeer,
+	cliGetPeerFilter,
+	cliSetPeerAllowlist,
+	cliSetPeerDenylist,
 }
 
 //configure input parameters/flags for each command
@@ -209,7 +248,7 @@ var cmdFlagsMap = map[string][]flagPars{
 			flagGasPrice,
 			uint64(0),
 			valueTypeUint64,
-			"Gas price of smart contract execution.",
+			"Gas price of smart contract execution. The median price suggested by the node is used if it is not set.",
 		},
 	},
 	cliSendAmount: {
@@ -259,15 +298,29 @@ var cmdFlagsMap = map[string][]flagPars{
 			flagGasPrice,
 			uint64(0),
 			valueTypeUint64,
-			"Gas price of smart contract execution.",
+			"Gas price of smart contract execution. The median price suggested by the node is used if it is not set.",
 		},
 	},
-	cliAddAccount: {flagPars{
-		flagKey,
+	cliCreateAccount: {flagPars{
+		flagAlgorithm,
 		"",
 		valueTypeString,
-		"Private key of account to be added.",
+		"Signature algorithm of the account: secp256k1 (default), ed25519 or schnorr.",
 	}},
+	cliAddAccount: {
+		flagPars{
+			flagKey,
+			"",
+			valueTypeString,
+			"Private key of account to be added.",
+		},
+		flagPars{
+			flagAlgorithm,
+			"",
+			valueTypeString,
+			"Signature algorithm of the private key: secp256k1 (default), ed25519 or schnorr.",
+		},
+	},
 	cliDeleteAccount: {
 		flagPars{
 			flagKey,
@@ -288,6 +341,38 @@ var cmdFlagsMap = map[string][]flagPars{
 		valueTypeString,
 		"Full Address. Eg. /ip4/127.0.0.1/tcp/12345/ipfs/QmT5oB6xHSunc64Aojoxa6zg9uH31ajiAVyNfCdBZiwFTV",
 	}},
+	cliBanPeer: {
+		flagPars{
+			flagPeerId,
+			"",
+			valueTypeString,
+			"Peer id. Eg. QmT5oB6xHSunc64Aojoxa6zg9uH31ajiAVyNfCdBZiwFTV",
+		},
+		flagPars{
+			flagBanDuration,
+			0,
+			valueTypeInt,
+			"Ban duration in seconds. Eg. 3600. 24 hours if omitted",
+		},
+	},
+	cliUnbanPeer: {flagPars{
+		flagPeerId,
+		"",
+		valueTypeString,
+		"Peer id. Eg. QmT5oB6xHSunc64Aojoxa6zg9uH31ajiAVyNfCdBZiwFTV",
+	}},
+	cliSetPeerAllowlist: {flagPars{
+		flagPeerIds,
+		"",
+		valueTypeString,
+		"Comma separated peer ids. All peers that are not denied are allowed if omitted. Eg. QmT5oB6xHSunc64Aojoxa6zg9uH31ajiAVyNfCdBZiwFTV",
+	}},
+	cliSetPeerDenylist: {flagPars{
+		flagPeerIds,
+		"",
+		valueTypeString,
+		"Comma separated peer ids. No peer is denied if omitted. Eg. QmT5oB6xHSunc64Aojoxa6zg9uH31ajiAVyNfCdBZiwFTV",
+	}},
 	cliListAddresses: {flagPars{
 		flagListPrivateKey,
 		false,
@@ -375,6 +460,136 @@ var cmdFlagsMap = map[string][]flagPars{
 		},
 	},
 	cliGenerateSeed: {},
+	cliGetTxReceipt: {
+		flagPars{
+			flagTxid,
+			"",
+			valueTypeString,
+			"Transaction id in hex. Eg. 8334b4c19091ae7582506eec5b84bfeb4a5e101042e40b403490c4ceb33897ba",
+		},
+	},
+	cliVote: {
+		flagPars{
+			flagFromAddress,
+			"",
+			valueTypeString,
+			"Voter's account address. Eg. 1MeSBgufmzwpiJNLemUe1emxAussBnz7a7",
+		},
+		flagPars{
+			flagCandidates,
+			"",
+			valueTypeString,
+			"Comma separated addresses of the candidates. Eg. 1MeSBgufmzwpiJNLemUe1emxAussBnz7a7,dGDrVKjCG3sdXtDUgWZ7Fp3Q97tLhqWivf",
+		},
+		flagPars{
+			flagAmount,
+			0,
+			valueTypeInt,
+			"The amount to lock for the vote.",
+		},
+		flagPars{
+			flagTip,
+			uint64(0),
+			valueTypeUint64,
+			"Tip to miner.",
+		},
+	},
+	cliUnvote: {
+		flagPars{
+			flagFromAddress,
+			"",
+			valueTypeString,
+			"Voter's account address. Eg. 1MeSBgufmzwpiJNLemUe1emxAussBnz7a7",
+		},
+		flagPars{
+			flagTxid,
+			"",
+			valueTypeString,
+			"Transaction id of the vote in hex. Eg. 8334b4c19091ae7582506eec5b84bfeb4a5e101042e40b403490c4ceb33897ba",
+		},
+		flagPars{
+			flagTip,
+			uint64(0),
+			valueTypeUint64,
+			"Tip to miner.",
+		},
+	},
+	cliGetCandidates:    {},
+	cliGetProducerStats: {},
+	cliPropose: {
+		flagPars{
+			flagFromAddress,
+			"",
+			valueTypeString,
+			"Proposer's account address. Eg. 1MeSBgufmzwpiJNLemUe1emxAussBnz7a7",
+		},
+		flagPars{
+			flagParameter,
+			"",
+			valueTypeString,
+			"The chain parameter to change: blockSizeLimit, gasPriceFloor, maxProducers or timeBetweenBlk.",
+		},
+		flagPars{
+			flagValue,
+			uint64(0),
+			valueTypeUint64,
+			"The new value of the parameter.",
+		},
+		flagPars{
+			flagActivationHeight,
+			uint64(0),
+			valueTypeUint64,
+			"The height of the block after which the proposal is tallied and applied.",
+		},
+		flagPars{
+			flagTip,
+			uint64(0),
+			valueTypeUint64,
+			"Tip to miner.",
+		},
+	},
+	cliVoteProposal: {
+		flagPars{
+			flagFromAddress,
+			"",
+			valueTypeString,
+			"Producer's account address. Eg. 1MeSBgufmzwpiJNLemUe1emxAussBnz7a7",
+		},
+		flagPars{
+			flagTxid,
+			"",
+			valueTypeString,
+			"Transaction id of the proposal in hex. Eg. 8334b4c19091ae7582506eec5b84bfeb4a5e101042e40b403490c4ceb33897ba",
+		},
+		flagPars{
+			flagReject,
+			false,
+			boolType,
+			"Reject the proposal instead of approving it.",
+		},
+		flagPars{
+			flagTip,
+			uint64(0),
+			valueTypeUint64,
+			"Tip to miner.",
+		},
+	},
+	cliGetChainParams: {
+		flagPars{
+			flagBlockHeight,
+			uint64(0),
+			valueTypeUint64,
+			"The height of the block after which the parameters are in force. Eg. 100. The tail block if omitted",
+		},
+	},
+	cliGetVotes: {
+		flagPars{
+			flagAddress,
+			"",
+			valueTypeString,
+			"Voter's account address. Eg. 1MeSBgufmzwpiJNLemUe1emxAussBnz7a7",
+		},
+	},
 }
 
 //map the callback function to each command
@@ -400,6 +615,21 @@ var cmdHandlers = map[string]commandHandlersWithType{
 	cliGetMetricsInfo:    {metricsRpcService, getMetricsInfoCommandHandler},
 	cliGetBlockByHeight:  {rpcService, getBlockByHeightCommandHandler},
 	cliGenerateSeed:      {adminRpcService, generateSeedCommandHandler},
+	cliGetTxReceipt:      {rpcService, getTxReceiptCommandHandler},
+	cliVote:              {rpcService, voteCommandHandler},
+	cliUnvote:            {rpcService, unvoteCommandHandler},
+	cliGetCandidates:     {rpcService, getCandidatesCommandHandler},
+	cliGetVotes:          {rpcService, getVotesCommandHandler},
+	cliGetProducerStats:  {metricsRpcService, getProducerStatsCommandHandler},
+	cliPropose:           {rpcService, proposeCommandHandler},
+	cliVoteProposal:      {rpcService, voteProposalCommandHandler},
+	cliGetChainParams:    {rpcService, getChainParametersCommandHandler},
+	cliGetBannedPeers:    {adminRpcService, getBannedPeersCommandHandler},
+	cliBanPeer:           {adminRpcService, banPeerCommandHandler},
+	cliUnbanPeer:         {adminRpcService, unbanPeerCommandHandler},
+	cliGetPeerFilter:     {adminRpcService, getPeerFilterCommandHandler},
+	cliSetPeerAllowlist:  {adminRpcService, setPeerAllowlistCommandHandler},
+	cliSetPeerDenylist:   {adminRpcService, setPeerDenylistCommandHandler},
 
 	cliConfigGenerator: {adminRpcService, configGeneratorCommandHandler},
 }
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/transaction"
+	transactionpb "github.com/dappley/go-dappley/core/transaction/pb"
+	"github.com/dappley/go-dappley/core/utxo"
+	"github.com/dappley/go-dappley/logic"
+	"github.com/dappley/go-dappley/logic/ltransaction"
+	rpcpb "github.com/dappley/go-dappley/rpc/pb"
+	"github.com/dappley/go-dappley/wallet"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// unvoteHeightMargin leaves time for an unvote transaction to be packed before its unlock height becomes too early
+const unvoteHeightMargin uint64 = 100
+
+func voteCommandHandler(ctx context.Context, c interface{}, flags cmdFlags) {
+	fromAddress := *(flags[flagFromAddress].(*string))
+	if !account.NewTransactionAccountByAddress(account.NewAddress(fromAddress)).IsValid() {
+		fmt.Println("Error: 'from' address is not valid!")
+		return
+	}
+	var candidates []string
+	for _, candidate := range strings.Split(*(flags[flagCandidates].(*string)), ",") {
+		if candidate = strings.TrimSpace(candidate); candidate != "" {
+			candidates = append(candidates, candidate)
+		}
+	}
+	if len(candidates) == 0 {
+		fmt.Println("Error: candidates are missing!")
+		return
+	}
+	amount := common.NewAmount(uint64(*(flags[flagAmount].(*int))))
+	if amount.IsZero() {
+		fmt.Println("Error: amount must be greater than 0!")
+		return
+	}
+	tip := common.NewAmount(*(flags[flagTip].(*uint64)))
+
+	senderAccount := getSenderAccount(fromAddress)
+	if senderAccount == nil {
+		return
+	}
+	inputUtxos, err := getSortedUtxos(c, fromAddress)
+	if err != nil {
+		printRpcError(err)
+		return
+	}
+	txUtxos, err := getUTXOsfromAmount(inputUtxos, amount, tip, nil, nil)
+	if err != nil {
+		fmt.Println("Error: ", err.Error())
+		return
+	}
+
+	sendTxParam := transaction.NewSendTxParam(account.NewAddress(fromAddress), senderAccount.GetKeyPair(),
+		account.NewAddress(fromAddress), amount, tip, common.NewAmount(0), common.NewAmount(0), "")
+	tx, err := ltransaction.NewVoteTransaction(txUtxos, sendTxParam, candidates)
+	if err != nil {
+		fmt.Println("Error: ", err.Error())
+		return
+	}
+	if err := sendTransaction(ctx, c, &tx); err != nil {
+		printRpcError(err)
+		return
+	}
+	fmt.Println("Vote is sent! Txid:", hex.EncodeToString(tx.ID))
+}
+
+func unvoteCommandHandler(ctx context.Context, c interface{}, flags cmdFlags) {
+	fromAddress := *(flags[flagFromAddress].(*string))
+	if !account.NewTransactionAccountByAddress(account.NewAddress(fromAddress)).IsValid() {
+		fmt.Println("Error: 'from' address is not valid!")
+		return
+	}
+	txid, err := hex.DecodeString(*(flags[flagTxid].(*string)))
+	if err != nil || len(txid) == 0 {
+		fmt.Println("Error: please enter the transaction id of the vote in hex!")
+		return
+	}
+	tip := common.NewAmount(*(flags[flagTip].(*uint64)))
+
+	senderAccount := getSenderAccount(fromAddress)
+	if senderAccount == nil {
+		return
+	}
+	inputUtxos, err := getSortedUtxos(c, fromAddress)
+	if err != nil {
+		printRpcError(err)
+		return
+	}
+	var stake *utxo.UTXO
+	for _, u := range inputUtxos {
+		if _, isVote := u.GetVotePayload(); isVote && bytes.Equal(u.Txid, txid) {
+			stake = u
+		}
+	}
+	if stake == nil {
+		fmt.Println("Error: the vote is not found!")
+		return
+	}
+
+	info, err := c.(rpcpb.RpcServiceClient).RpcGetBlockchainInfo(ctx, &rpcpb.GetBlockchainInfoRequest{})
+	if err != nil {
+		printRpcError(err)
+		return
+	}
+	unlockHeight := info.GetBlockHeight() + ltransaction.UnbondingDelay + unvoteHeightMargin
+	tx, err := ltransaction.NewUnvoteTransaction(stake, senderAccount.GetKeyPair(), tip, unlockHeight)
+	if err != nil {
+		fmt.Println("Error: ", err.Error())
+		return
+	}
+	if err := sendTransaction(ctx, c, &tx); err != nil {
+		printRpcError(err)
+		return
+	}
+	fmt.Println("Unvote is sent! The coins can be spent from height", unlockHeight)
+}
+
+func getCandidatesCommandHandler(ctx context.Context, c interface{}, flags cmdFlags) {
+	response, err := c.(rpcpb.RpcServiceClient).RpcGetCandidates(ctx, &rpcpb.GetCandidatesRequest{})
+	if err != nil {
+		printRpcError(err)
+		return
+	}
+	fmt.Println("Next election height:", response.GetNextElectionHeight())
+	for i, candidate := range response.GetCandidates() {
+		fmt.Printf("%d. %s votes: %s\n", i+1, candidate.GetAddress(), common.NewAmountFromBytes(candidate.GetVotes()).String())
+	}
+}
+
+func getVotesCommandHandler(ctx context.Context, c interface{}, flags cmdFlags) {
+	address := *(flags[flagAddress].(*string))
+	response, err := c.(rpcpb.RpcServiceClient).RpcGetVotes(ctx, &rpcpb.GetVotesRequest{Address: address})
+	if err != nil {
+		printRpcError(err)
+		return
+	}
+	if len(response.GetVotes()) == 0 {
+		fmt.Println("No vote is found.")
+		return
+	}
+	for _, vote := range response.GetVotes() {
+		fmt.Println("Txid:", hex.EncodeToString(vote.GetTxid()),
+			"amount:", common.NewAmountFromBytes(vote.GetAmount()).String(),
+			"height:", vote.GetHeight(),
+			"candidates:", strings.Join(vote.GetCandidates(), ","))
+	}
+}
+
+func getSenderAccount(address string) *account.Account {
+	am, err := logic.GetAccountManager(wallet.GetAccountFilePath())
+	if err != nil {
+		fmt.Println("Error: ", err.Error())
+		return nil
+	}
+	senderAccount := am.GetAccountByAddress(account.NewAddress(address))
+	if senderAccount == nil {
+		fmt.Println("Error: invalid account address.")
+	}
+	return senderAccount
+}
+
+func getSortedUtxos(c interface{}, address string) ([]*utxo.UTXO, error) {
+	response, err := logic.GetUtxoStream(c.(rpcpb.RpcServiceClient), &rpcpb.GetUTXORequest{Address: address})
+	if err != nil {
+		return nil, err
+	}
+	var utxos []*utxo.UTXO
+	for _, u := range response.GetUtxos() {
+		utxo := utxo.UTXO{}
+		utxo.FromProto(u)
+		utxos = append(utxos, &utxo)
+	}
+	sort.Sort(utxoSlice(utxos))
+	return utxos, nil
+}
+
+func sendTransaction(ctx context.Context, c interface{}, tx *transaction.Transaction) error {
+	_, err := c.(rpcpb.RpcServiceClient).RpcSendTransaction(ctx, &rpcpb.SendTransactionRequest{Transaction: tx.ToProto().(*transactionpb.Transaction)})
+	return err
+}
+
+func printRpcError(err error) {
+	switch status.Code(err) {
+	case codes.Unavailable:
+		fmt.Println("Error: server is not reachable!")
+	default:
+		fmt.Println("Error:", status.Convert(err).Message())
+	}
+}
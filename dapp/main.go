@@ -19,6 +19,7 @@
 package main
 
 import (
+	"encoding/hex"
 	"flag"
 	"github.com/dappley/go-dappley/core/account"
 	"github.com/dappley/go-dappley/core/blockchain"
@@ -26,9 +27,12 @@ import (
 	"github.com/dappley/go-dappley/core/transaction"
 	"github.com/dappley/go-dappley/logic/blockproducer"
 	"github.com/dappley/go-dappley/logic/lblockchain"
+	"github.com/dappley/go-dappley/logic/lfinality"
+	"github.com/dappley/go-dappley/logic/lightclient"
 	"github.com/dappley/go-dappley/logic/ltransaction"
 	"github.com/dappley/go-dappley/logic/transactionpool"
 
+	"github.com/dappley/go-dappley/common"
 	"github.com/dappley/go-dappley/common/log"
 	"github.com/dappley/go-dappley/logic/downloadmanager"
 	logger "github.com/sirupsen/logrus"
@@ -42,24 +46,28 @@ import (
 
 	"net/http"
 	_ "net/http/pprof"
+	"time"
 
 	"github.com/dappley/go-dappley/metrics/logMetrics"
 	"github.com/dappley/go-dappley/network"
+	"github.com/dappley/go-dappley/network/networkmodel"
 	"github.com/dappley/go-dappley/rpc"
 	"github.com/dappley/go-dappley/storage"
 	"github.com/spf13/viper"
 )
 
 const (
-	producerFilePath = "conf/producer.conf"
-	genesisAddr      = "121yKAXeG4cw6uaGCBYjWk9yTWmMkhcoDD"
-	configFilePath   = "conf/default.conf"
-	genesisFilePath  = "conf/genesis.conf"
-	peerFilePath     = "conf/peer.conf"
-	peerConfDirPath  = "../dapp/"
-	defaultPassword  = "password"
-	size1kB          = 1024
-	version          = "v0.6.1"
+	producerFilePath    = "conf/producer.conf"
+	genesisAddr         = "121yKAXeG4cw6uaGCBYjWk9yTWmMkhcoDD"
+	configFilePath      = "conf/default.conf"
+	genesisFilePath     = "conf/genesis.conf"
+	peerFilePath        = "conf/peer.conf"
+	peerConfDirPath     = "../dapp/"
+	bannedPeerSuffix    = ".banned"
+	defaultPassword     = "password"
+	size1kB             = 1024
+	defaultBlkSizeLimit = 1024 * size1kB // the block size limit if the genesis configuration does not set it
+	version             = "v0.6.1"
 )
 
 func main() {
@@ -111,29 +119,38 @@ func main() {
 	}
 	peerinfoPath = peerConfDirPath + peerinfoPath
 	peerinfoConf := storage.NewFileLoader(peerinfoPath)
+	bannedPeersConf := storage.NewFileLoader(peerinfoPath + bannedPeerSuffix)
 
 	//setup
 	db := storage.OpenDatabase(conf.GetNodeConfig().GetDbPath())
 	defer db.Close()
-	node, err := initNode(conf, peerinfoConf)
-	if err != nil {
+	node := initNode(conf, peerinfoConf, bannedPeersConf)
+	if err := initPrivateNetwork(node, conf.GetNodeConfig()); err != nil {
+		logger.WithError(err).Error("Invalid private network in the configurations! Exiting...")
 		return
-	} else {
-		defer node.Stop()
 	}
 
 	//create blockchain
-	conss, _ := initConsensus(genesisConf, conf)
-	conss.SetFilePath(producerFilePath)
+	conss := initConsensus(genesisConf, conf)
 	txPoolLimit := conf.GetNodeConfig().GetTxPoolLimit() * size1kB
-	blkSizeLimit := conf.GetNodeConfig().GetBlkSizeLimit() * size1kB
+	blkSizeLimit := genesisConf.GetBlkSizeLimit() * size1kB
+	if blkSizeLimit == 0 {
+		blkSizeLimit = defaultBlkSizeLimit
+	}
 	txPool := transactionpool.NewTransactionPool(node, txPoolLimit)
+	if dev, ok := conss.(*consensus.DevEngine); ok {
+		dev.SetTxPool(txPool)
+	}
 	//utxo.NewPool()
 	initYaml()
+	if err := initMonetaryPolicy(genesisConf.GetMonetaryPolicy()); err != nil {
+		logger.WithError(err).Error("Invalid monetary policy in the genesis configurations! Exiting...")
+		return
+	}
 
 	var LIBBlk *block.Block = nil
 	var bc *lblockchain.Blockchain
-	err = lblockchain.DataCheckingAndRecovery(db)
+	err := lblockchain.DataCheckingAndRecovery(db)
 	if err != nil {
 		bc, err = logic.CreateBlockchain(account.NewAddress(genesisAddr), db, conss, txPool, int(blkSizeLimit))
 		if err != nil {
@@ -152,8 +169,27 @@ func main() {
 		return
 	}
 
+	if genesisConf.GetBlockGasLimit() > 0 {
+		bc.SetBlockGasLimit(genesisConf.GetBlockGasLimit())
+	}
+
+	if dpos, ok := conss.(*consensus.DPOS); ok {
+		dpos.SetChainReader(bc)
+		bc.EnableFinality()
+	}
 	bc.SetState(blockchain.BlockchainInit)
 	bm := lblockchain.NewBlockchainManager(bc, blockchain.NewBlockPool(LIBBlk), node, conss)
+	genesisParams := bm.GetChainParameters()
+	genesisParams.BlockSizeLimit = int(blkSizeLimit)
+	genesisParams.GasPriceFloor = genesisConf.GetGasPriceFloor()
+	bm.RestoreChainParameters(genesisParams)
+	bm.RestoreDynasty()
+
+	node.SetChainInfo(bc)
+	if err := startNode(node, conf); err != nil {
+		return
+	}
+	defer node.Stop()
 
 	//start mining
 	logic.SaveAccount()
@@ -170,8 +206,19 @@ func main() {
 	producer := blockproducerinfo.NewBlockProducerInfo(conf.GetConsensusConfig().GetMinerAddress())
 	blockProducer := blockproducer.NewBlockProducer(bm, conss, producer)
 
+	if bc.IsFinalityEnabled() {
+		finalityManager := lfinality.NewFinalityManager(bm, node, conss, conf.GetConsensusConfig().GetMinerAddress(), conf.GetConsensusConfig().GetPrivateKey())
+		finalityManager.Start()
+		defer finalityManager.Stop()
+	}
+
 	downloadManager := downloadmanager.NewDownloadManager(node, bm, len(conss.GetProducers()), blockProducer)
+	if err := initSnapshotSync(downloadManager, nodeConf.GetSnapshotSync()); err != nil {
+		logger.WithError(err).Error("Invalid snapshot sync in the configurations! Exiting...")
+		return
+	}
 	downloadManager.Start()
+	lightclient.NewServer(bm.Getblockchain(), node)
 
 	bm.Getblockchain().SetState(blockchain.BlockchainReady)
 	bm.RequestDownloadBlockchain()
@@ -187,32 +234,143 @@ func main() {
 	select {}
 }
 
-func initConsensus(conf *configpb.DynastyConfig, generalConf *configpb.Config) (*consensus.DPOS, *consensus.Dynasty) {
+//initSnapshotSync sets the interval of the served state snapshots and enables the snapshot sync of a new node
+func initSnapshotSync(downloadManager *downloadmanager.DownloadManager, conf *configpb.SnapshotSyncConfig) error {
+	if conf == nil {
+		return nil
+	}
+	if conf.GetInterval() > 0 {
+		downloadManager.SetSnapshotInterval(conf.GetInterval())
+	}
+	if !conf.GetEnabled() {
+		return nil
+	}
+	checkpointRoot, err := hex.DecodeString(conf.GetCheckpointRoot())
+	if err != nil {
+		return err
+	}
+	return downloadManager.EnableSnapshotSync(&downloadmanager.SnapshotSyncConfig{
+		CheckpointHeight: conf.GetCheckpointHeight(),
+		CheckpointRoot:   checkpointRoot,
+	})
+}
+
+//initMonetaryPolicy sets the block reward schedule, the supply cap and the fee burning defined in the genesis file
+func initMonetaryPolicy(conf *configpb.MonetaryPolicyConfig) error {
+	if conf == nil {
+		return nil
+	}
+	var initialReward, supplyCap *common.Amount
+	var err error
+	if conf.GetInitialReward() != "" {
+		if initialReward, err = common.NewAmountFromString(conf.GetInitialReward()); err != nil {
+			return err
+		}
+	}
+	if conf.GetSupplyCap() != "" {
+		if supplyCap, err = common.NewAmountFromString(conf.GetSupplyCap()); err != nil {
+			return err
+		}
+	}
+	policy, err := transaction.NewMonetaryPolicy(initialReward, conf.GetCurve(), conf.GetInterval(), conf.GetDecayPercent(), supplyCap, conf.GetFeeBurnPercent())
+	if err != nil {
+		return err
+	}
+	transaction.SetMonetaryPolicy(policy)
+	return nil
+}
+
+func initConsensus(conf *configpb.DynastyConfig, generalConf *configpb.Config) consensus.Engine {
 	//set up consensus
-	conss := consensus.NewDPOS(blockproducerinfo.NewBlockProducerInfo(generalConf.GetConsensusConfig().GetMinerAddress()))
-	dynasty := consensus.NewDynastyWithConfigProducers(conf.GetProducers(), (int)(conf.GetMaxProducers()))
-	conss.SetDynasty(dynasty)
-	conss.SetKey(generalConf.GetConsensusConfig().GetPrivateKey())
+	consensusConf := generalConf.GetConsensusConfig()
+	producer := blockproducerinfo.NewBlockProducerInfo(consensusConf.GetMinerAddress())
+	var conss consensus.Engine
+	switch consensusConf.GetEngine() {
+	case consensus.EngineDev:
+		dev := consensus.NewDevEngine(producer)
+		dev.SetKey(consensusConf.GetPrivateKey())
+		conss = dev
+	case consensus.EnginePoA:
+		signers := consensusConf.GetSigners()
+		if len(signers) == 0 {
+			signers = conf.GetProducers()
+		}
+		poa := consensus.NewPoA(producer, signers)
+		poa.SetKey(consensusConf.GetPrivateKey())
+		conss = poa
+	default:
+		dpos := consensus.NewDPOS(producer)
+		dynasty := consensus.NewDynastyWithConfigProducers(conf.GetProducers(), (int)(conf.GetMaxProducers()))
+		dynasty.SetVrfActivationHeight(conf.GetVrfActivationHeight())
+		dynasty.SetMaxMissedRounds(uint64(conf.GetMaxMissedRounds()))
+		dpos.SetDynasty(dynasty)
+		dpos.SetKey(consensusConf.GetPrivateKey())
+		dpos.SetFilePath(producerFilePath)
+		conss = dpos
+	}
 	logger.WithFields(logger.Fields{
-		"miner_address": generalConf.GetConsensusConfig().GetMinerAddress(),
+		"engine":        consensusConf.GetEngine(),
+		"miner_address": consensusConf.GetMinerAddress(),
 	}).Info("Consensus is configured.")
-	return conss, dynasty
+	return conss
+}
+
+func initNode(conf *configpb.Config, peerinfoConf *storage.FileLoader, bannedPeersConf *storage.FileLoader) *network.Node {
+	node := network.NewNode(peerinfoConf, conf.GetNodeConfig().GetSeed())
+	node.SetBannedPeersConf(bannedPeersConf)
+	if conf.GetNodeConfig().GetGossip() {
+		node.EnableGossip(network.NewGossipConfig(map[string]networkmodel.DappCmdPriority{
+			lblockchain.SendBlock:             networkmodel.HighPriorityCommand,
+			transactionpool.BroadcastTx:       networkmodel.NormalPriorityCommand,
+			transactionpool.BroadcastBatchTxs: networkmodel.NormalPriorityCommand,
+		}))
+	}
+	if discoveryConf := conf.GetNodeConfig().GetDiscovery(); discoveryConf.GetMdns() || discoveryConf.GetDht() {
+		discoveryConfig := network.NewDiscoveryConfig(discoveryConf.GetMdns(), discoveryConf.GetDht())
+		if discoveryConf.GetMdnsInterval() > 0 {
+			discoveryConfig.MdnsInterval = time.Duration(discoveryConf.GetMdnsInterval()) * time.Second
+		}
+		if discoveryConf.GetDhtInterval() > 0 {
+			discoveryConfig.DhtInterval = time.Duration(discoveryConf.GetDhtInterval()) * time.Second
+		}
+		node.EnableDiscovery(discoveryConfig)
+	}
+	return node
 }
 
-func initNode(conf *configpb.Config, peerinfoConf *storage.FileLoader) (*network.Node, error) {
+//initPrivateNetwork sets the pre-shared key of the private network and the peers that the node may connect to
+func initPrivateNetwork(node *network.Node, conf *configpb.NodeConfig) error {
+	if conf.GetPsk() != "" {
+		if err := node.SetPrivateNetworkKey(conf.GetPsk()); err != nil {
+			return err
+		}
+	}
+
+	allowlist, err := network.DecodePeerIds(conf.GetPeerAllowlist())
+	if err != nil {
+		return err
+	}
+	denylist, err := network.DecodePeerIds(conf.GetPeerDenylist())
+	if err != nil {
+		return err
+	}
+	node.GetNetwork().SetPeerAllowlist(allowlist)
+	node.GetNetwork().SetPeerDenylist(denylist)
+	return nil
+}
 
+//startNode starts the node once it knows the chain that it announces to its peers
+func startNode(node *network.Node, conf *configpb.Config) error {
 	nodeConfig := conf.GetNodeConfig()
-	seeds := nodeConfig.GetSeed()
 	port := nodeConfig.GetPort()
 	key := nodeConfig.GetKey()
 
-	node := network.NewNode(peerinfoConf, seeds)
 	err := node.Start(int(port), key)
 	if err != nil {
 		logger.Error(err)
-		return nil, err
+		return err
 	}
-	return node, nil
+	return nil
 }
 
 func printVersion() {
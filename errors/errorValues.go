@@ -25,6 +25,7 @@ var (
 	InvalidPubKeyHashVersion       = errors.New("invalid public key hash version")
 	InvalidKey                     = errors.New("key is invalid")
 	VoutNotFound                   = errors.New("vout not found in current transaction")
+	ReceiptNotFound                = errors.New("transaction receipt not found")
 	TransactionIDInvalid           = errors.New("ID is invalid")
 	TransactionAmountInvalid       = errors.New("amount is invalid")
 	TransactionGasLimitInvalid     = errors.New("gas limit is invalid")
@@ -76,12 +77,23 @@ var (
 	EmptyBlocks                    = errors.New("received no block")
 	PeerNotFound                   = errors.New("peerId not in checklist")
 	MismatchResponse               = errors.New("response is not for waiting command")
+	BlocksNotMerged                = errors.New("downloaded blocks were not added to the blockchain")
+	StateSnapshotAboveLIB          = errors.New("state snapshot is only created at or below the lib")
+	InvalidStateSnapshot           = errors.New("state snapshot does not match its manifest")
+	StateSnapshotRateLimited       = errors.New("a state snapshot was created too recently")
+	MissingSnapshotCheckpoint      = errors.New("state snapshot sync requires a checkpoint height and root")
+	BlockchainNotEmpty             = errors.New("blockchain holds blocks after the genesis block")
+	TransactionNotFound            = errors.New("transaction not found in the main chain")
+	InvalidLightBlockHeader        = errors.New("block header does not link to the chain or is not signed by the producer of its slot")
+	InvalidTransactionProof        = errors.New("transaction proof does not match the block header")
+	LightClientRequestTimeout      = errors.New("the peer did not answer the light client request in time")
 	SimulatedStorageFailure        = errors.New("simulated storage failure")
 	BlockDoesNotExist              = errors.New("block does not exist in db")
 	BlockDoesNotFound              = errors.New("the block was not found after lib")
 	PrevHashVerifyFailed           = errors.New("prevhash verify failed")
 	TransactionVerifyFailed        = errors.New("transaction verification failed")
 	ProducerNotEnough              = errors.New("producer number is less than ConsensusSize")
+	InvalidBlockProducer           = errors.New("block is not produced by the producer of its time slot")
 	LibPolicyNil                   = errors.New("libPolicy is nil")
 	ContractErrorSyntax            = errors.New("contract syntax error")
 	SubsidyCheckFail               = errors.New("transaction: subsidy check failed")
@@ -101,6 +113,14 @@ var (
 	PingServiceNotStarted          = errors.New("can not stop a ping service that was never started")
 	ConnectionsFull                = errors.New("connection is full")
 	StreamAlreadyConnected         = errors.New("stream is already connected")
+	PeerBanned                     = errors.New("peer is banned")
+	PeerNotAllowed                 = errors.New("peer is not allowed by the peer filter")
+	InvalidPrivateNetworkKey       = errors.New("private network key must be 32 bytes in hex")
+	InvalidBanDuration             = errors.New("ban duration is negative")
+	IncompatibleProtocolVersion    = errors.New("incompatible protocol version")
+	GenesisHashMismatch            = errors.New("genesis hash does not match")
+	HandshakeExpected              = errors.New("handshake is expected as the first command")
+	DecompressedLengthTooLong      = errors.New("decompressed length is too long")
 	LengthTooShort                 = errors.New("message length is too short")
 	InvalidMessageFormat           = errors.New("invalid message format")
 	CheckSumIncorrect              = errors.New("incorrect checksum")
@@ -116,4 +136,24 @@ var (
 	InnerExecutionFailed           = errors.New("multi execution failed")
 	AddressNotFound                = errors.New("address not found in local accounts")
 	FileNotFound                   = errors.New("file not found")
+	InvalidVotePayload             = errors.New("invalid vote: candidates are missing, duplicated or invalid")
+	InvalidUnvotePayload           = errors.New("invalid unvote: unlock height is too early")
+	StakeLocked                    = errors.New("the output is locked by a vote and can only be spent by an unvote transaction")
+	StakeUnbonding                 = errors.New("the output is still unbonding")
+	VoteNotFound                   = errors.New("vote not found")
+	InvalidSlashEvidence           = errors.New("invalid slashing evidence")
+	InvalidFinalityVote            = errors.New("invalid finality vote")
+	InsufficientPrecommits         = errors.New("precommits from more than 2/3 of the dynasty are required")
+	JustificationNotFound          = errors.New("justification not found")
+	BlockNotOnChain                = errors.New("the block is not on the canonical chain")
+	InvalidDynastyChange           = errors.New("invalid dynasty change: the payload, the height or the kind is invalid")
+	UnauthorizedDynastyChange      = errors.New("unauthorized dynasty change: the signer is not the producer being changed")
+	DynastyNotFound                = errors.New("dynasty not found")
+	InvalidMonetaryPolicy          = errors.New("invalid monetary policy: the curve, the interval or a percentage is invalid")
+	InvalidGovernancePayload       = errors.New("invalid governance payload: the parameter, the value or the activation height is invalid")
+	ProposalNotFound               = errors.New("proposal not found or its voting is closed")
+	TooManyProposals               = errors.New("too many proposals are open for votes")
+	InsufficientDeposit            = errors.New("the deposit of the proposal is below the minimum deposit")
+	ChainParametersNotFound        = errors.New("chain parameters not found")
+	GasPriceTooLow                 = errors.New("gas price is below the gas price floor")
 )
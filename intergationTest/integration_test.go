@@ -729,7 +729,7 @@ func CreateProducer(producerAddr, addr account.Address, db *storage.RamStorage,
 	consensus.On("Validate", mock.Anything).Return(true)
 	consensus.On("ChangeDynasty", mock.Anything).Return(true)
 	consensus.On("SetDynasty", mock.Anything).Return(true)
-	consensus.On("AddReplacement", mock.Anything).Return(true)
+	consensus.On("GetDynasty").Return(nil)
 	bc := lblockchain.CreateBlockchain(addr, db, libPolicy, txPool, 100000)
 	bm := lblockchain.NewBlockchainManager(bc, blockchain.NewBlockPool(nil), node, consensus)
 
@@ -764,7 +764,7 @@ func TestDoubleMint(t *testing.T) {
 
 	dynasty := consensus.NewDynasty([]string{validProducerAddr}, len([]string{validProducerAddr}), 15)
 	producerHash := validProducerAccount.GetPubKeyHash()
-	tx := &transaction.Transaction{nil, []transactionbase.TXInput{{[]byte{}, -1, nil, nil}}, []transactionbase.TXOutput{{common.NewAmount(0), account.PubKeyHash(producerHash), ""}}, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), 0, transaction.TxTypeNormal}
+	tx := &transaction.Transaction{nil, []transactionbase.TXInput{{[]byte{}, -1, nil, nil, 0}}, []transactionbase.TXOutput{{common.NewAmount(0), account.PubKeyHash(producerHash), ""}}, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), 0, transaction.TxTypeNormal}
 
 	for i := 0; i < 3; i++ {
 		blk := createValidBlock([]*transaction.Transaction{tx}, validProducerKey, validProducerAddr, parent)
@@ -922,7 +922,7 @@ func TestUpdate(t *testing.T) {
 	var dependentTx1 = &transaction.Transaction{
 		ID: nil,
 		Vin: []transactionbase.TXInput{
-			{util.GenerateRandomAoB(1), 1, nil, ta1.GetKeyPair().GetPublicKey()},
+			{util.GenerateRandomAoB(1), 1, nil, ta1.GetKeyPair().GetPublicKey(), 0},
 		},
 		Vout: []transactionbase.TXOutput{
 			{common.NewAmount(5), ta1.GetPubKeyHash(), ""},
@@ -935,7 +935,7 @@ func TestUpdate(t *testing.T) {
 	var dependentTx2 = &transaction.Transaction{
 		ID: nil,
 		Vin: []transactionbase.TXInput{
-			{dependentTx1.ID, 1, nil, ta2.GetKeyPair().GetPublicKey()},
+			{dependentTx1.ID, 1, nil, ta2.GetKeyPair().GetPublicKey(), 0},
 		},
 		Vout: []transactionbase.TXOutput{
 			{common.NewAmount(5), ta3.GetPubKeyHash(), ""},
@@ -948,7 +948,7 @@ func TestUpdate(t *testing.T) {
 	var dependentTx3 = &transaction.Transaction{
 		ID: nil,
 		Vin: []transactionbase.TXInput{
-			{dependentTx2.ID, 0, nil, ta3.GetKeyPair().GetPublicKey()},
+			{dependentTx2.ID, 0, nil, ta3.GetKeyPair().GetPublicKey(), 0},
 		},
 		Vout: []transactionbase.TXOutput{
 			{common.NewAmount(1), ta4.GetPubKeyHash(), ""},
@@ -960,8 +960,8 @@ func TestUpdate(t *testing.T) {
 	var dependentTx4 = &transaction.Transaction{
 		ID: nil,
 		Vin: []transactionbase.TXInput{
-			{dependentTx2.ID, 1, nil, ta4.GetKeyPair().GetPublicKey()},
-			{dependentTx3.ID, 0, nil, ta4.GetKeyPair().GetPublicKey()},
+			{dependentTx2.ID, 1, nil, ta4.GetKeyPair().GetPublicKey(), 0},
+			{dependentTx3.ID, 0, nil, ta4.GetKeyPair().GetPublicKey(), 0},
 		},
 		Vout: []transactionbase.TXOutput{
 			{common.NewAmount(3), ta1.GetPubKeyHash(), ""},
@@ -973,8 +973,8 @@ func TestUpdate(t *testing.T) {
 	var dependentTx5 = &transaction.Transaction{
 		ID: nil,
 		Vin: []transactionbase.TXInput{
-			{dependentTx1.ID, 0, nil, ta1.GetKeyPair().GetPublicKey()},
-			{dependentTx4.ID, 0, nil, ta1.GetKeyPair().GetPublicKey()},
+			{dependentTx1.ID, 0, nil, ta1.GetKeyPair().GetPublicKey(), 0},
+			{dependentTx4.ID, 0, nil, ta1.GetKeyPair().GetPublicKey(), 0},
 		},
 		Vout: []transactionbase.TXOutput{
 			{common.NewAmount(4), ta5.GetPubKeyHash(), ""},
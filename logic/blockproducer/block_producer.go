@@ -1,10 +1,9 @@
 package blockproducer
 
 import (
-	"time"
-
 	"github.com/dappley/go-dappley/common/log"
 
+	"github.com/dappley/go-dappley/common/clock"
 	"github.com/dappley/go-dappley/common/deadline"
 	"github.com/dappley/go-dappley/core/blockchain"
 
@@ -17,6 +16,8 @@ import (
 	"github.com/dappley/go-dappley/core/scState"
 	"github.com/dappley/go-dappley/core/transaction"
 	"github.com/dappley/go-dappley/logic/lblockchain"
+	"github.com/dappley/go-dappley/logic/lelection"
+	"github.com/dappley/go-dappley/logic/lgovernance"
 	"github.com/dappley/go-dappley/logic/ltransaction"
 	"github.com/dappley/go-dappley/logic/lutxo"
 	"github.com/dappley/go-dappley/vm"
@@ -29,6 +30,7 @@ type BlockProducer struct {
 	producer  *blockproducerinfo.BlockProducerInfo
 	stopCh    chan bool
 	isRunning bool
+	clock     clock.Clock
 }
 
 //NewBlockProducer returns a new block producer instance
@@ -39,9 +41,15 @@ func NewBlockProducer(bm *lblockchain.BlockchainManager, con Consensus, producer
 		producer:  producer,
 		stopCh:    make(chan bool, 1),
 		isRunning: false,
+		clock:     clock.NewRealClock(),
 	}
 }
 
+//SetClock sets the clock used to timestamp new blocks
+func (bp *BlockProducer) SetClock(c clock.Clock) {
+	bp.clock = c
+}
+
 //Start starts the block producing process
 func (bp *BlockProducer) Start() {
 	// clear stop channel buffer
@@ -63,7 +71,7 @@ func (bp *BlockProducer) Start() {
 				bp.isRunning = false
 				return
 			default:
-				bp.con.ProduceBlock(bp.produceBlock)
+				bp.con.ProduceBlock(bp.ProduceBlock)
 			}
 		}
 	}()
@@ -85,8 +93,8 @@ func (bp *BlockProducer) GetProduceBlockStatus() bool {
 	return bp.isRunning
 }
 
-//produceBlock produces a new block and add it to blockchain
-func (bp *BlockProducer) produceBlock(processFunc func(*block.Block), deadline deadline.Deadline) {
+//ProduceBlock produces a new block and add it to blockchain
+func (bp *BlockProducer) ProduceBlock(processFunc func(*block.Block), deadline deadline.Deadline) {
 	// Do not produce block if block pool is syncing
 	bp.bm.Getblockchain().GetBlockMutex().Lock()
 	if bp.bm.Getblockchain().GetState() != blockchain.BlockchainReady {
@@ -116,7 +124,7 @@ func (bp *BlockProducer) produceBlock(processFunc func(*block.Block), deadline d
 	bp.producer.BlockProduceStart()
 	defer bp.producer.BlockProduceFinish()
 
-	logger.Infof("BlockProducerer: producing block... ***time is %v***", time.Now().Unix())
+	logger.Infof("BlockProducerer: producing block... ***time is %v***", bp.clock.Now().Unix())
 
 	ctx := bp.prepareBlock(deadline)
 
@@ -143,7 +151,7 @@ func (bp *BlockProducer) prepareBlock(deadline deadline.Deadline) *lblockchain.B
 	// Retrieve all valid transactions from tx pool
 	utxoIndex := lutxo.NewUTXOIndex(bp.bm.Getblockchain().GetUtxoCache())
 
-	validTxs, state := bp.collectTransactions(utxoIndex, parentBlock, deadline)
+	validTxs, state, receipts := bp.collectTransactions(utxoIndex, parentBlock, deadline)
 
 	totalTips := bp.calculateTips(validTxs)
 	cbtx := ltransaction.NewCoinbaseTX(account.NewAddress(bp.producer.Beneficiary()), "", bp.bm.Getblockchain().GetMaxHeight()+1, totalTips)
@@ -156,16 +164,20 @@ func (bp *BlockProducer) prepareBlock(deadline deadline.Deadline) *lblockchain.B
 		"valid_txs": len(validTxs),
 	}).Info("BlockProducer: prepared a block.")
 
-	ctx := lblockchain.BlockContext{Block: block.NewBlock(validTxs, parentBlock, bp.producer.Beneficiary()), UtxoIndex: utxoIndex, State: state}
+	ctx := lblockchain.BlockContext{Block: block.NewBlockWithTimestamp(validTxs, parentBlock, bp.clock.Now().Unix(), bp.producer.Beneficiary()), UtxoIndex: utxoIndex, State: state, Receipts: receipts}
 	return &ctx
 }
 
 //collectTransactions pack transactions from transaction pool to a new block
-func (bp *BlockProducer) collectTransactions(utxoIndex *lutxo.UTXOIndex, parentBlk *block.Block, deadline deadline.Deadline) ([]*transaction.Transaction, *scState.ScState) {
+func (bp *BlockProducer) collectTransactions(utxoIndex *lutxo.UTXOIndex, parentBlk *block.Block, deadline deadline.Deadline) ([]*transaction.Transaction, *scState.ScState, []*transaction.TxReceipt) {
 
 	var validTxs []*transaction.Transaction
+	var receipts []*transaction.TxReceipt
 	totalSize := 0
 	count := 0
+	var totalGasUsed uint64
+	blkGasLimit := bp.bm.Getblockchain().GetBlockGasLimit()
+	gasPriceFloor := bp.bm.Getblockchain().GetGasPriceFloor()
 
 	engine := vm.NewV8Engine()
 	defer engine.DestroyEngine()
@@ -174,35 +186,72 @@ func (bp *BlockProducer) collectTransactions(utxoIndex *lutxo.UTXOIndex, parentB
 
 	contractState := scState.NewScState(bp.bm.Getblockchain().GetUtxoCache())
 
+	// report the producers caught minting twice in one time slot
+	if pool, ok := bp.con.(SlashingEvidencePool); ok {
+		for _, slashTx := range pool.PopSlashTransactions() {
+			if err := lelection.ApplyTransaction(contractState, slashTx, currBlkHeight); err != nil {
+				logger.Warn("collectTransactions warn: apply slash error: ", err)
+				continue
+			}
+			validTxs = append(validTxs, slashTx)
+			if !utxoIndex.UpdateUtxo(slashTx) {
+				logger.Warn("collectTransactions warn: update utxo error")
+			}
+		}
+	}
+
 	for totalSize < bp.bm.Getblockchain().GetBlockSizeLimit() && bp.bm.Getblockchain().GetTxPool().GetNumOfTxInPool() > 0 && !deadline.IsPassed() {
 
-		txNode, err := bp.bm.Getblockchain().GetTxPool().PopTransactionWithMostTips(utxoIndex)
+		txNode, err := bp.bm.Getblockchain().GetTxPool().PopTransactionWithMostTips(utxoIndex, currBlkHeight)
 		if err != nil {
 			break
 		}
 		if txNode == nil {
 			continue
 		}
+		ctx := ltransaction.NewTxContract(txNode.Value)
+		if ctx != nil && ctx.GasPrice.Uint64() < gasPriceFloor {
+			logger.WithFields(logger.Fields{
+				"gas_price":       ctx.GasPrice.Uint64(),
+				"gas_price_floor": gasPriceFloor,
+			}).Warn("BlockProducer: contract transaction is dropped because its gas price is below the gas price floor.")
+			continue
+		}
+		if ctx != nil && blkGasLimit > 0 && totalGasUsed+ctx.GasLimit.Uint64() > blkGasLimit {
+			if ctx.GasLimit.Uint64() > blkGasLimit {
+				logger.WithFields(logger.Fields{
+					"gas_limit":       ctx.GasLimit.Uint64(),
+					"block_gas_limit": blkGasLimit,
+				}).Warn("BlockProducer: contract transaction is dropped because its gas limit exceeds the block gas limit.")
+				continue
+			}
+			// the transaction does not fit in the remaining gas of this block, leave it to the next block
+			bp.bm.Getblockchain().GetTxPool().Rollback(*txNode.Value)
+			break
+		}
+
 		totalSize += txNode.Size
 		count++
 
-		ctx := ltransaction.NewTxContract(txNode.Value)
 		if ctx != nil {
 			minerAddr := account.NewAddress(bp.producer.Beneficiary())
-			gasCount, generatedTxs, err := ltransaction.VerifyAndCollectContractOutput(utxoIndex, ctx, contractState, engine, currBlkHeight, parentBlk, rewards, bp.bm.Getblockchain().GetDb())
+			gasCount, generatedTxs, receipt, err := ltransaction.VerifyAndCollectContractOutput(utxoIndex, ctx, contractState, engine, currBlkHeight, parentBlk, rewards, bp.bm.Getblockchain().GetDb())
 			if err != nil {
 				logger.Warn("VerifyAndCollectContractOutput error: ", err)
 				continue
 			}
+			totalGasUsed += gasCount
 
 			if grtx, exists := ltransaction.NewGasRewardTx(account.NewTransactionAccountByAddress(minerAddr), currBlkHeight, common.NewAmount(gasCount), ctx.GasPrice, count); exists {
 				generatedTxs = append(generatedTxs, &grtx)
 			}
+			ltransaction.BurnGasFee(contractState, common.NewAmount(gasCount).Mul(ctx.GasPrice))
 
 			if gctx, exists := ltransaction.NewGasChangeTx(ctx.GetDefaultFromTransactionAccount(), currBlkHeight, common.NewAmount(gasCount), ctx.GasLimit, ctx.GasPrice, count); exists {
 				generatedTxs = append(generatedTxs, &gctx)
 			}
 			validTxs = append(validTxs, txNode.Value)
+			receipts = append(receipts, receipt)
 
 			if generatedTxs != nil {
 				validTxs = append(validTxs, generatedTxs...)
@@ -211,6 +260,14 @@ func (bp *BlockProducer) collectTransactions(utxoIndex *lutxo.UTXOIndex, parentB
 				}
 			}
 		} else {
+			if err := lelection.ApplyTransaction(contractState, txNode.Value, currBlkHeight); err != nil {
+				logger.Warn("collectTransactions warn: apply vote error: ", err)
+				continue
+			}
+			if err := lgovernance.ApplyTransaction(contractState, txNode.Value, currBlkHeight); err != nil {
+				logger.Warn("collectTransactions warn: apply governance error: ", err)
+				continue
+			}
 			validTxs = append(validTxs, txNode.Value)
 			if !utxoIndex.UpdateUtxo(txNode.Value) {
 				logger.Warn("collectTransactions warn: update utxo error")
@@ -226,7 +283,7 @@ func (bp *BlockProducer) collectTransactions(utxoIndex *lutxo.UTXOIndex, parentB
 			logger.Warn("collectTransactions warn: rewards update utxo error")
 		}
 	}
-	return validTxs, contractState
+	return validTxs, contractState, receipts
 }
 
 //calculateTips calculate how much tips are earned from the input transactions
@@ -258,12 +315,7 @@ func (bp *BlockProducer) addBlockToBlockchain(ctx *lblockchain.BlockContext) {
 
 	for _, tx := range ctx.Block.GetTransactions() {
 		if tx.CreateTime > 0 {
-			TxAddToBlockCost.Update((time.Now().UnixNano()/1e6 - tx.CreateTime) / 1e3)
-		}
-
-		if tx.IsChangeProducter() {
-
-			bp.bm.SetNewDynastyByString(tx.Vout[0].Contract, tx.Vout[0].PubKeyHash.GenerateAddress().String())
+			TxAddToBlockCost.Update((bp.clock.Now().UnixNano()/1e6 - tx.CreateTime) / 1e3)
 		}
 	}
 
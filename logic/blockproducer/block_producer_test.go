@@ -2,13 +2,19 @@ package blockproducer
 
 import (
 	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/common/deadline"
 	"github.com/dappley/go-dappley/consensus"
 	"github.com/dappley/go-dappley/core/account"
 	"github.com/dappley/go-dappley/core/blockproducerinfo"
+	"github.com/dappley/go-dappley/core/scState"
 	"github.com/dappley/go-dappley/core/transaction"
 	"github.com/dappley/go-dappley/core/transactionbase"
+	"github.com/dappley/go-dappley/core/utxo"
+	"github.com/dappley/go-dappley/logic/lblock"
 	"github.com/dappley/go-dappley/logic/lblockchain"
 	"github.com/dappley/go-dappley/logic/lblockchain/mocks"
+	"github.com/dappley/go-dappley/logic/ltransaction"
+	"github.com/dappley/go-dappley/logic/lutxo"
 	"github.com/dappley/go-dappley/logic/transactionpool"
 	"github.com/dappley/go-dappley/storage"
 	"github.com/dappley/go-dappley/util"
@@ -129,3 +135,78 @@ func TestCalculateTips(t *testing.T) {
 
 	assert.Equal(t, common.NewAmount(30), blockProducer.calculateTips(txs))
 }
+
+const gasLimitTestContract = "'use strict';var Test=function(){};Test.prototype={};module.exports=new Test();"
+
+//newContractDeployTx returns a transaction deploying the test contract from a new funded account
+func newContractDeployTx(t *testing.T, utxoIndex *lutxo.UTXOIndex, tip uint64, gasLimit uint64) *transaction.Transaction {
+	sender := account.NewAccount()
+	funding := transactionbase.TXOutput{Value: common.NewAmount(10000000), PubKeyHash: sender.GetPubKeyHash()}
+	fundingTxid := util.GenerateRandomAoB(32)
+	utxoIndex.AddUTXO(funding, fundingTxid, 0)
+
+	prevUtxos := []*utxo.UTXO{utxo.NewUTXO(funding, fundingTxid, 0, utxo.UtxoNormal)}
+	sendTxParam := transaction.NewSendTxParam(sender.GetAddress(), sender.GetKeyPair(), account.NewAddress(""), common.NewAmount(1),
+		common.NewAmount(tip), common.NewAmount(gasLimit), common.NewAmount(1), gasLimitTestContract)
+	tx, err := ltransaction.NewNormalUTXOTransaction(prevUtxos, sendTxParam)
+	assert.Nil(t, err)
+	return &tx
+}
+
+func containsTx(txs []*transaction.Transaction, tx *transaction.Transaction) bool {
+	for _, candidate := range txs {
+		if string(candidate.ID) == string(tx.ID) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestBlockProducer_PrepareBlockGasLimit(t *testing.T) {
+	libPolicy := &mocks.LIBPolicy{}
+	libPolicy.On("GetMinConfirmationNum").Return(6)
+	libPolicy.On("IsBypassingLibCheck").Return(true)
+	acc := account.NewAccount()
+	db := storage.NewRamStorage()
+	bc := lblockchain.CreateBlockchain(acc.GetAddress(), db, libPolicy, transactionpool.NewTransactionPool(nil, 128000), 100000)
+	producer := blockproducerinfo.NewBlockProducerInfo(acc.GetAddress().String())
+	con := consensus.NewDPOS(producer)
+	bm := lblockchain.NewBlockchainManager(bc, nil, nil, con)
+	blockProducer := NewBlockProducer(bm, con, producer)
+
+	utxoIndex := lutxo.NewUTXOIndex(bc.GetUtxoCache())
+	baseGas, _ := ltransaction.NewTxContract(newContractDeployTx(t, utxoIndex, 0, 0)).GasCountOfTxBase()
+	blkGasLimit := 2 * baseGas.Uint64()
+	bc.SetBlockGasLimit(blkGasLimit)
+
+	//the transactions are packed by tip: the first one can never fit in a block, the second one fits and consumes the
+	//base gas of a deployment, then the third one does not fit in the remaining gas of the block
+	overLimitTx := newContractDeployTx(t, utxoIndex, 30, blkGasLimit+1)
+	fittingTx := newContractDeployTx(t, utxoIndex, 20, baseGas.Uint64())
+	remainingTx := newContractDeployTx(t, utxoIndex, 10, blkGasLimit)
+	assert.Nil(t, utxoIndex.Save())
+	for _, tx := range []*transaction.Transaction{overLimitTx, fittingTx, remainingTx} {
+		bc.GetTxPool().Push(*tx)
+	}
+
+	ctx := blockProducer.prepareBlock(deadline.NewUnlimitedDeadline())
+	assert.NotNil(t, ctx)
+	txs := ctx.Block.GetTransactions()
+	assert.True(t, containsTx(txs, fittingTx))
+	assert.False(t, containsTx(txs, overLimitTx))
+	assert.False(t, containsTx(txs, remainingTx))
+
+	//the transaction over the block gas limit is dropped and the one that did not fit is left to the next block
+	poolTxs := bc.GetTxPool().GetTransactions()
+	assert.False(t, containsTx(poolTxs, overLimitTx))
+	assert.True(t, containsTx(poolTxs, remainingTx))
+
+	//the block is valid under the block gas limit and rejected under a lower one
+	parentBlk, err := bc.GetTailBlock()
+	assert.Nil(t, err)
+	verify := func(limit uint64) bool {
+		return lblock.VerifyTransactions(ctx.Block, lutxo.NewUTXOIndex(bc.GetUtxoCache()), scState.NewScState(bc.GetUtxoCache()), parentBlk, db, limit)
+	}
+	assert.True(t, verify(blkGasLimit))
+	assert.False(t, verify(baseGas.Uint64()-1))
+}
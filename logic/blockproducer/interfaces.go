@@ -3,9 +3,15 @@ package blockproducer
 import (
 	"github.com/dappley/go-dappley/common/deadline"
 	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/core/transaction"
 )
 
 type Consensus interface {
 	Validate(blk *block.Block) bool
 	ProduceBlock(ProduceBlockFunc func(process func(*block.Block), deadline deadline.Deadline))
 }
+
+// SlashingEvidencePool is implemented by the consensus engines that collect evidence against misbehaving producers
+type SlashingEvidencePool interface {
+	PopSlashTransactions() []*transaction.Transaction
+}
@@ -52,9 +52,15 @@ const (
 	DownloadStatusSyncCommonBlocks int = 1
 	DownloadStatusDownloading      int = 2
 	DownloadStatusIdle             int = 3
+	DownloadStatusSyncHeaders      int = 4
+	DownloadStatusFetchingBlocks   int = 5
+	DownloadStatusSyncSnapshot     int = 6
 
 	CheckMaxWaitTime    time.Duration = 5
 	DownloadMaxWaitTime time.Duration = 180
+	HeadersMaxWaitTime  time.Duration = 30
+	WindowMaxWaitTime   time.Duration = 10
+	SnapshotMaxWaitTime time.Duration = 10
 	MaxRetryCount       int           = 3
 	MinRequestHashesNum int           = 20
 
@@ -64,6 +70,15 @@ const (
 	GetBlocksResponse       = "GetBlocksResponse"
 	GetCommonBlocksRequest  = "GetCommonBlocksRequest"
 	GetCommonBlocksResponse = "GetCommonBlocksResponse"
+	GetBlockHeadersRequest  = "GetBlockHeadersRequest"
+	GetBlockHeadersResponse = "GetBlockHeadersResponse"
+	GetBlockBodiesRequest   = "GetBlockBodiesRequest"
+	GetBlockBodiesResponse  = "GetBlockBodiesResponse"
+
+	GetStateSnapshotManifestRequest  = "GetStateSnapshotManifestRequest"
+	GetStateSnapshotManifestResponse = "GetStateSnapshotManifestResponse"
+	GetStateSnapshotChunkRequest     = "GetStateSnapshotChunkRequest"
+	GetStateSnapshotChunkResponse    = "GetStateSnapshotChunkResponse"
 
 	maxGetBlocksNum = 10
 )
@@ -76,6 +91,14 @@ var (
 		GetBlocksResponse,
 		GetCommonBlocksRequest,
 		GetCommonBlocksResponse,
+		GetBlockHeadersRequest,
+		GetBlockHeadersResponse,
+		GetBlockBodiesRequest,
+		GetBlockBodiesResponse,
+		GetStateSnapshotManifestRequest,
+		GetStateSnapshotManifestResponse,
+		GetStateSnapshotChunkRequest,
+		GetStateSnapshotChunkResponse,
 		network.TopicOnStreamStop,
 	}
 )
@@ -125,6 +148,12 @@ type DownloadManager struct {
 	finishCh              chan bool
 	numOfMinRequestHashes int
 	bp                    *blockproducer.BlockProducer
+	parallel              *parallelDownload
+	applyMutex            sync.Mutex
+	snapshotConfig        *SnapshotSyncConfig
+	snapshot              *snapshotSync
+	snapshotTried         bool
+	snapshotServer        *snapshotServer
 }
 
 func NewDownloadManager(node NetService, bm *lblockchain.BlockchainManager, numOfProducers int, bp *blockproducer.BlockProducer) *DownloadManager {
@@ -142,6 +171,7 @@ func NewDownloadManager(node NetService, bm *lblockchain.BlockchainManager, numO
 		finishCh:              nil,
 		numOfMinRequestHashes: numOfProducers,
 		bp:                    bp,
+		snapshotServer:        newSnapshotServer(DefaultSnapshotInterval),
 	}
 	if downloadManager.numOfMinRequestHashes < MinRequestHashesNum {
 		downloadManager.numOfMinRequestHashes = MinRequestHashesNum
@@ -202,6 +232,22 @@ func (downloadManager *DownloadManager) GetTopicHandler(topic string) pubsub.Top
 		return downloadManager.GetCommonBlockRequestHandler
 	case GetCommonBlocksResponse:
 		return downloadManager.GetCommonBlockResponseHandler
+	case GetBlockHeadersRequest:
+		return downloadManager.GetBlockHeadersRequestHandler
+	case GetBlockHeadersResponse:
+		return downloadManager.GetBlockHeadersResponseHandler
+	case GetBlockBodiesRequest:
+		return downloadManager.GetBlockBodiesRequestHandler
+	case GetBlockBodiesResponse:
+		return downloadManager.GetBlockBodiesResponseHandler
+	case GetStateSnapshotManifestRequest:
+		return downloadManager.GetStateSnapshotManifestRequestHandler
+	case GetStateSnapshotManifestResponse:
+		return downloadManager.GetStateSnapshotManifestResponseHandler
+	case GetStateSnapshotChunkRequest:
+		return downloadManager.GetStateSnapshotChunkRequestHandler
+	case GetStateSnapshotChunkResponse:
+		return downloadManager.GetStateSnapshotChunkResponseHandler
 	}
 	return nil
 }
@@ -308,6 +354,13 @@ func (downloadManager *DownloadManager) validateReturnBlocks(blocksPb *networkpb
 	return downloadManager.downloadingPeer, nil
 }
 
+//reportPeer reports a peer that violated the protocol if the node keeps a score of the peers
+func (downloadManager *DownloadManager) reportPeer(peerInfo networkmodel.PeerInfo, violation networkmodel.Violation) {
+	if reporter, ok := downloadManager.node.(PeerReporter); ok {
+		reporter.ReportPeer(peerInfo, violation)
+	}
+}
+
 func (downloadManager *DownloadManager) GetBlocksDataHandler(blocksPb *networkpb.ReturnBlocks, peerInfo networkmodel.PeerInfo) {
 	returnBlocksLogger := logger.WithFields(logger.Fields{
 		"name": "GetBlocksResponse",
@@ -318,6 +371,9 @@ func (downloadManager *DownloadManager) GetBlocksDataHandler(blocksPb *networkpb
 	if err != nil {
 		returnBlocksLogger.WithFields(logger.Fields{"error": err}).Error("DownloadManager:")
 		downloadManager.mutex.Unlock()
+		if err == errval.EmptyBlocks {
+			downloadManager.reportPeer(peerInfo, networkmodel.ViolationInvalidBlocks)
+		}
 		return
 	}
 
@@ -338,6 +394,7 @@ func (downloadManager *DownloadManager) GetBlocksDataHandler(blocksPb *networkpb
 				"height": block.GetHeight(),
 				"hash":   block.GetHash(),
 			}).Warn("DownloadManager: verify block failed.")
+			downloadManager.reportPeer(peerInfo, networkmodel.ViolationInvalidBlocks)
 			return
 		}
 
@@ -355,11 +412,7 @@ func (downloadManager *DownloadManager) GetBlocksDataHandler(blocksPb *networkpb
 	defer downloadManager.mutex.Unlock()
 	if downloadManager.bm.Getblockchain().GetMaxHeight() >= checkingPeer.height {
 		downloadManager.finishDownload()
-		lib, _ := downloadManager.bm.Getblockchain().GetBlockByHeight(checkingPeer.libHeight)
-		downloadManager.bm.Getblockchain().SetLIBHash(lib.GetHash())
-		logger.WithFields(logger.Fields{
-			"lib_hash": lib.GetHash(),
-		}).Info("DownloadManager: finishing download blocks.")
+		downloadManager.setPeerLIB(checkingPeer.libHeight)
 		return
 	}
 
@@ -412,6 +465,15 @@ func (downloadManager *DownloadManager) DisconnectPeer(peerId peer.ID) {
 	}
 
 	delete(downloadManager.peersInfo, peerId)
+	if downloadManager.status == DownloadStatusFetchingBlocks {
+		downloadManager.releasePeerWindows(peerId)
+		downloadManager.assignBlockWindows()
+		return
+	}
+	if downloadManager.status == DownloadStatusSyncSnapshot {
+		downloadManager.removeSnapshotPeer(peerId)
+		return
+	}
 	if downloadManager.status != DownloadStatusInit {
 		if downloadManager.downloadingPeer.peerid == peerId {
 			downloadManager.status = DownloadStatusInit
@@ -520,7 +582,12 @@ func (downloadManager *DownloadManager) startGetCommonBlocks(retryCount int) {
 		downloadManager.bp.Stop()
 	}
 
+	if downloadManager.startSnapshotSync() {
+		return
+	}
+
 	downloadManager.status = DownloadStatusSyncCommonBlocks
+	downloadManager.parallel = nil
 	highestPeer := downloadManager.selectHighestPeer()
 
 	if highestPeer.peerid == downloadManager.node.GetHostPeerInfo().PeerId {
@@ -581,7 +648,11 @@ func (downloadManager *DownloadManager) checkGetCommonBlocksResult(blockHeaders
 		logger.Warnf("checkGetCommonBlocksResult: common height %v", commonBlock.GetHeight())
 		downloadManager.commonHeight = commonBlock.GetHeight()
 		downloadManager.currentCmd = nil
-		downloadManager.startDownload(0)
+		if downloadManager.supportsParallelSync(downloadManager.downloadingPeer.peerid) {
+			downloadManager.startSyncHeaders()
+		} else {
+			downloadManager.startDownload(0)
+		}
 	} else {
 		blockHeaders := downloadManager.GetCommonBlockCheckPoint(
 			blockHeaders[findIndex].GetHeight(),
@@ -662,6 +733,8 @@ func (downloadManager *DownloadManager) finishDownload() {
 	downloadManager.status = DownloadStatusIdle
 	downloadManager.downloadingPeer = nil
 	downloadManager.currentCmd = nil
+	downloadManager.parallel = nil
+	downloadManager.snapshot = nil
 	downloadManager.finishCh <- true
 
 	if downloadManager.bp != nil {
@@ -670,6 +743,23 @@ func (downloadManager *DownloadManager) finishDownload() {
 	}
 }
 
+//setPeerLIB makes the block at the LIB height reported by the peer the LIB. The peer is not trusted when finality is
+//enabled, as the LIB then only moves with a verified justification
+func (downloadManager *DownloadManager) setPeerLIB(libHeight uint64) {
+	bc := downloadManager.bm.Getblockchain()
+	if bc.IsFinalityEnabled() {
+		return
+	}
+	lib, err := bc.GetBlockByHeight(libHeight)
+	if err != nil {
+		return
+	}
+	bc.SetLIBHash(lib.GetHash())
+	logger.WithFields(logger.Fields{
+		"lib_hash": lib.GetHash(),
+	}).Info("DownloadManager: finishing download blocks.")
+}
+
 func (downloadManager *DownloadManager) canStartDownload() bool {
 	for _, peerInfo := range downloadManager.peersInfo {
 		if peerInfo.status == PeerStatusInit {
@@ -64,7 +64,7 @@ func createTestBlockchains(size int, portStart int) ([]*lblockchain.BlockchainMa
 	consensus.On("Validate", mock.Anything).Return(true)
 	consensus.On("ChangeDynasty", mock.Anything).Return(true)
 	consensus.On("SetDynasty", mock.Anything).Return(true)
-	consensus.On("AddReplacement", mock.Anything).Return(true)
+	consensus.On("GetDynasty").Return(nil)
 	for i := 0; i < size; i++ {
 		rfl := storage.NewRamFileLoader(confDir, "dl"+strconv.Itoa(i)+".conf")
 		node := network.NewNode(rfl.File, nil)
@@ -485,6 +485,19 @@ func TestDownloadManager_StartDownloadRequestListener(t *testing.T) {
 	assert.True(t, result)
 }
 
+func TestDownloadManager_setPeerLIB(t *testing.T) {
+	bc := lblockchain.GenerateMockBlockchainWithCoinbaseTxOnly(10)
+	downloadManager := NewDownloadManager(newFakeNetService(1), newParallelTestManager(bc, nil), 0, nil)
+	lib, _ := bc.GetBlockByHeight(6)
+	downloadManager.setPeerLIB(6)
+	assert.Equal(t, lib.GetHash(), bc.GetLIBHash())
+
+	//the lib height of the peer is not trusted with finality enabled
+	bc.EnableFinality()
+	downloadManager.setPeerLIB(8)
+	assert.Equal(t, lib.GetHash(), bc.GetLIBHash())
+}
+
 func deleteConfFolderFiles() error {
 	dir, err := ioutil.ReadDir(confDir)
 	if err != nil {
@@ -4,8 +4,19 @@ import (
 	"github.com/dappley/go-dappley/common/pubsub"
 	"github.com/dappley/go-dappley/network/networkmodel"
 	"github.com/golang/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/peer"
 )
 
+// CapabilityProvider is implemented by the network services that negotiate optional protocols with their peers
+type CapabilityProvider interface {
+	GetPeerCapabilities(peerId peer.ID) networkmodel.Capability
+}
+
+// PeerReporter is implemented by the network services that keep a score of the peers that violate the protocol
+type PeerReporter interface {
+	ReportPeer(peerInfo networkmodel.PeerInfo, violation networkmodel.Violation)
+}
+
 type NetService interface {
 	GetPeers() []networkmodel.PeerInfo
 	GetHostPeerInfo() networkmodel.PeerInfo
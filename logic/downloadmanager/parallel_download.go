@@ -0,0 +1,634 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package downloadmanager
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/common/log"
+	"github.com/dappley/go-dappley/core/block"
+	blockpb "github.com/dappley/go-dappley/core/block/pb"
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/logic/lblock"
+	"github.com/dappley/go-dappley/network/networkmodel"
+	networkpb "github.com/dappley/go-dappley/network/pb"
+	"github.com/golang/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	maxGetBlockHeadersNum = 500
+	blockWindowSize       = 16
+	maxGetBlockBodiesNum  = blockWindowSize
+	maxWindowsPerPeer     = 2
+	maxWindowTimeouts     = 2
+	maxWindowMisses       = 2
+)
+
+//blockWindow is a range of consecutive blocks whose bodies are downloaded from one peer at a time
+type blockWindow struct {
+	hashes       []hash.Hash
+	startHeight  uint64
+	peerid       peer.ID
+	msgId        int32
+	blocks       []*block.Block
+	missingPeers map[peer.ID]bool
+}
+
+//parallelDownload is the state of a headers first download whose block bodies are fetched from many peers
+type parallelDownload struct {
+	hashes        []hash.Hash
+	lastHash      hash.Hash
+	nextHeight    uint64
+	targetHeight  uint64
+	headersMsgId  int32
+	headersCount  uint64
+	windows       []*blockWindow
+	windowsByHash map[string]*blockWindow
+	nextApply     int
+	peerLoad      map[peer.ID]int
+	peerTimeouts  map[peer.ID]int
+}
+
+func newParallelDownload(commonHash hash.Hash, commonHeight uint64, targetHeight uint64) *parallelDownload {
+	return &parallelDownload{
+		lastHash:      commonHash,
+		nextHeight:    commonHeight + 1,
+		targetHeight:  targetHeight,
+		windowsByHash: make(map[string]*blockWindow),
+		peerLoad:      make(map[peer.ID]int),
+		peerTimeouts:  make(map[peer.ID]int),
+	}
+}
+
+//endHeight returns the height of the last block in the window
+func (window *blockWindow) endHeight() uint64 {
+	return window.startHeight + uint64(len(window.hashes)) - 1
+}
+
+//isInFlight returns if the window is requested from a peer and its blocks are not received yet
+func (window *blockWindow) isInFlight() bool {
+	return window.peerid != "" && window.blocks == nil
+}
+
+//supportsParallelSync returns if a peer serves block headers and block bodies by hash
+func (downloadManager *DownloadManager) supportsParallelSync(peerId peer.ID) bool {
+	if provider, ok := downloadManager.node.(CapabilityProvider); ok {
+		return provider.GetPeerCapabilities(peerId).Has(networkmodel.CapabilityParallelSync)
+	}
+	return false
+}
+
+//startSyncHeaders downloads the headers after the common block from the highest peer
+func (downloadManager *DownloadManager) startSyncHeaders() {
+	if downloadManager.status != DownloadStatusSyncCommonBlocks {
+		return
+	}
+
+	commonBlock, err := downloadManager.bm.Getblockchain().GetBlockByHeight(downloadManager.commonHeight)
+	if err != nil {
+		logger.WithError(err).Warn("DownloadManager: get common block failed.")
+		downloadManager.startDownload(0)
+		return
+	}
+
+	downloadManager.status = DownloadStatusSyncHeaders
+	downloadManager.parallel = newParallelDownload(commonBlock.GetHash(), commonBlock.GetHeight(), downloadManager.downloadingPeer.height)
+	if downloadManager.parallel.nextHeight > downloadManager.parallel.targetHeight {
+		downloadManager.startFetchBlocks()
+		return
+	}
+	downloadManager.sendGetBlockHeadersCommand(0)
+}
+
+func (downloadManager *DownloadManager) sendGetBlockHeadersCommand(retryCount int) {
+	parallel := downloadManager.parallel
+	downloadManager.msgId++
+	msgId := downloadManager.msgId
+
+	count := parallel.targetHeight - parallel.nextHeight + 1
+	if count > maxGetBlockHeadersNum {
+		count = maxGetBlockHeadersNum
+	}
+	parallel.headersMsgId = msgId
+	parallel.headersCount = count
+
+	peerInfo := networkmodel.PeerInfo{PeerId: downloadManager.downloadingPeer.peerid}
+	request := &networkpb.GetBlockHeaders{StartHeight: parallel.nextHeight, Count: uint32(count)}
+	downloadManager.node.UnicastHighProrityCommand(GetBlockHeadersRequest, request, peerInfo)
+
+	headersTimer := time.NewTimer(HeadersMaxWaitTime * time.Second)
+	go func() {
+		defer log.CrashHandler()
+
+		<-headersTimer.C
+		headersTimer.Stop()
+		downloadManager.CheckGetBlockHeadersCommand(msgId, retryCount)
+	}()
+}
+
+//CheckGetBlockHeadersCommand resends a headers request that was not answered in time
+func (downloadManager *DownloadManager) CheckGetBlockHeadersCommand(msgId int32, retryCount int) {
+	downloadManager.mutex.Lock()
+	defer downloadManager.mutex.Unlock()
+
+	if downloadManager.status != DownloadStatusSyncHeaders || downloadManager.parallel.headersMsgId != msgId {
+		return
+	}
+
+	if retryCount >= MaxRetryCount {
+		downloadManager.restartWithoutPeer(downloadManager.downloadingPeer.peerid)
+		return
+	}
+	downloadManager.sendGetBlockHeadersCommand(retryCount + 1)
+}
+
+//restartWithoutPeer marks a peer as failed and starts the download again from the highest remaining peer
+func (downloadManager *DownloadManager) restartWithoutPeer(peerId peer.ID) {
+	if peerInfo, ok := downloadManager.peersInfo[peerId]; ok {
+		peerInfo.status = PeerStatusFailed
+	}
+	downloadManager.status = DownloadStatusInit
+	downloadManager.downloadingPeer = nil
+	downloadManager.currentCmd = nil
+	downloadManager.startGetCommonBlocks(0)
+}
+
+func (downloadManager *DownloadManager) GetBlockHeadersDataHandler(headersPb *networkpb.ReturnBlockHeaders, peerInfo networkmodel.PeerInfo) {
+	downloadManager.mutex.Lock()
+	defer downloadManager.mutex.Unlock()
+
+	if downloadManager.status != DownloadStatusSyncHeaders ||
+		downloadManager.downloadingPeer.peerid != peerInfo.PeerId ||
+		downloadManager.parallel.nextHeight != headersPb.GetStartHeight() {
+		logger.WithFields(logger.Fields{
+			"name": "GetBlockHeadersResponse",
+		}).Info("DownloadManager: response is not for waiting command.")
+		return
+	}
+
+	parallel := downloadManager.parallel
+	headers := headersPb.GetBlockHeaders()
+	if len(headers) == 0 {
+		if len(parallel.hashes) == 0 {
+			downloadManager.restartWithoutPeer(peerInfo.PeerId)
+			return
+		}
+		downloadManager.startFetchBlocks()
+		return
+	}
+
+	if uint64(len(headers)) > parallel.headersCount {
+		headers = headers[:parallel.headersCount]
+	}
+
+	txsHashes := headersPb.GetTxsHashes()
+	for index, header := range headers {
+		if header.GetHeight() != parallel.nextHeight ||
+			bytes.Compare(header.GetPreviousHash(), parallel.lastHash) != 0 ||
+			index >= len(txsHashes) ||
+			!downloadManager.verifyHeader(header, txsHashes[index]) {
+			logger.WithFields(logger.Fields{
+				"height": header.GetHeight(),
+			}).Warn("DownloadManager: received headers are invalid or do not link to the chain.")
+			downloadManager.reportPeer(peerInfo, networkmodel.ViolationInvalidBlocks)
+			downloadManager.restartWithoutPeer(peerInfo.PeerId)
+			return
+		}
+		parallel.hashes = append(parallel.hashes, header.GetHash())
+		parallel.lastHash = header.GetHash()
+		parallel.nextHeight++
+	}
+
+	if parallel.nextHeight > parallel.targetHeight || uint64(len(headers)) < parallel.headersCount {
+		downloadManager.startFetchBlocks()
+		return
+	}
+	downloadManager.sendGetBlockHeadersCommand(0)
+}
+
+//verifyHeader returns if the hash of a header matches its fields and the hash of its transactions and if it is signed by
+//its producer
+func (downloadManager *DownloadManager) verifyHeader(headerPb *blockpb.BlockHeader, txsHash []byte) bool {
+	blk := &block.Block{}
+	blk.FromProto(&blockpb.Block{Header: headerPb})
+	return downloadManager.bm.VerifyBlockHeader(blk, txsHash)
+}
+
+//startFetchBlocks splits the downloaded headers into windows and requests their bodies from all peers
+func (downloadManager *DownloadManager) startFetchBlocks() {
+	parallel := downloadManager.parallel
+	downloadManager.status = DownloadStatusFetchingBlocks
+	startHeight := parallel.nextHeight - uint64(len(parallel.hashes))
+
+	for start := 0; start < len(parallel.hashes); start += blockWindowSize {
+		end := start + blockWindowSize
+		if end > len(parallel.hashes) {
+			end = len(parallel.hashes)
+		}
+		window := &blockWindow{
+			hashes:       parallel.hashes[start:end],
+			startHeight:  startHeight + uint64(start),
+			missingPeers: make(map[peer.ID]bool),
+		}
+		parallel.windows = append(parallel.windows, window)
+		parallel.windowsByHash[string(window.hashes[0])] = window
+	}
+
+	logger.WithFields(logger.Fields{
+		"num_of_headers": len(parallel.hashes),
+		"num_of_windows": len(parallel.windows),
+	}).Info("DownloadManager: start to fetch blocks.")
+
+	if len(parallel.windows) == 0 {
+		downloadManager.completeParallelDownload()
+		return
+	}
+	downloadManager.assignBlockWindows()
+}
+
+//assignBlockWindows requests the windows that are not downloaded from the least loaded peers at the right height
+func (downloadManager *DownloadManager) assignBlockWindows() {
+	parallel := downloadManager.parallel
+	inFlight := 0
+	pending := 0
+
+	for _, window := range parallel.windows[parallel.nextApply:] {
+		if window.blocks != nil {
+			continue
+		}
+		if window.peerid == "" {
+			if peerInfo := downloadManager.selectWindowPeer(window); peerInfo != nil {
+				downloadManager.sendGetBlockBodiesCommand(window, peerInfo.peerid)
+			}
+		}
+		if window.isInFlight() {
+			inFlight++
+		} else {
+			pending++
+		}
+	}
+
+	if inFlight == 0 && pending > 0 {
+		logger.Warn("DownloadManager: no peer is able to serve the remaining blocks.")
+		downloadManager.restartWithoutPeer(downloadManager.downloadingPeer.peerid)
+	}
+}
+
+//selectWindowPeer returns the peer that has all blocks of the window and timed out the least, then the least loaded one.
+//The peers that replied that they do not have the blocks of the window are skipped
+func (downloadManager *DownloadManager) selectWindowPeer(window *blockWindow) *PeerBlockInfo {
+	parallel := downloadManager.parallel
+	var selected *PeerBlockInfo
+	for _, peerInfo := range downloadManager.peersInfo {
+		if peerInfo.status != PeerStatusReady || peerInfo.height < window.endHeight() {
+			continue
+		}
+		load := parallel.peerLoad[peerInfo.peerid]
+		if load >= maxWindowsPerPeer || window.missingPeers[peerInfo.peerid] || !downloadManager.supportsParallelSync(peerInfo.peerid) {
+			continue
+		}
+		if selected == nil {
+			selected = peerInfo
+			continue
+		}
+		timeouts, selectedTimeouts := parallel.peerTimeouts[peerInfo.peerid], parallel.peerTimeouts[selected.peerid]
+		if timeouts < selectedTimeouts || (timeouts == selectedTimeouts && load < parallel.peerLoad[selected.peerid]) {
+			selected = peerInfo
+		}
+	}
+	return selected
+}
+
+func (downloadManager *DownloadManager) sendGetBlockBodiesCommand(window *blockWindow, peerId peer.ID) {
+	parallel := downloadManager.parallel
+	downloadManager.msgId++
+	msgId := downloadManager.msgId
+
+	window.peerid = peerId
+	window.msgId = msgId
+	parallel.peerLoad[peerId]++
+
+	blkHashes := make([][]byte, len(window.hashes))
+	for index, hash := range window.hashes {
+		blkHashes[index] = hash
+	}
+	downloadManager.node.UnicastHighProrityCommand(GetBlockBodiesRequest, &networkpb.GetBlockBodies{BlockHashes: blkHashes}, networkmodel.PeerInfo{PeerId: peerId})
+
+	windowTimer := time.NewTimer(WindowMaxWaitTime * time.Second)
+	go func() {
+		defer log.CrashHandler()
+
+		<-windowTimer.C
+		windowTimer.Stop()
+		downloadManager.CheckBlockWindow(parallel, window, msgId)
+	}()
+}
+
+//releaseWindow makes a window available to be requested from another peer
+func (downloadManager *DownloadManager) releaseWindow(window *blockWindow) {
+	downloadManager.parallel.peerLoad[window.peerid]--
+	window.peerid = ""
+}
+
+//releasePeerWindows makes the windows requested from a peer available to the other peers
+func (downloadManager *DownloadManager) releasePeerWindows(peerId peer.ID) {
+	for _, window := range downloadManager.parallel.windows {
+		if window.isInFlight() && window.peerid == peerId {
+			downloadManager.releaseWindow(window)
+		}
+	}
+}
+
+//CheckBlockWindow reassigns a window whose peer did not return the blocks in time
+func (downloadManager *DownloadManager) CheckBlockWindow(parallel *parallelDownload, window *blockWindow, msgId int32) {
+	downloadManager.mutex.Lock()
+	defer downloadManager.mutex.Unlock()
+
+	if downloadManager.status != DownloadStatusFetchingBlocks || downloadManager.parallel != parallel {
+		return
+	}
+	if !window.isInFlight() || window.msgId != msgId {
+		return
+	}
+
+	logger.WithFields(logger.Fields{
+		"peer_id":      window.peerid,
+		"start_height": window.startHeight,
+	}).Warn("DownloadManager: peer is too slow, reassign the blocks to another peer.")
+	downloadManager.markSlowPeer(window.peerid)
+	downloadManager.releaseWindow(window)
+	downloadManager.assignBlockWindows()
+}
+
+//markSlowPeer stops requesting blocks from a peer that timed out too many times
+func (downloadManager *DownloadManager) markSlowPeer(peerId peer.ID) {
+	downloadManager.parallel.peerTimeouts[peerId]++
+	if downloadManager.parallel.peerTimeouts[peerId] < maxWindowTimeouts {
+		return
+	}
+	if peerInfo, ok := downloadManager.peersInfo[peerId]; ok {
+		peerInfo.status = PeerStatusFailed
+	}
+}
+
+//markMissingWindow records a peer that does not have the blocks of a window. The blocks were promised by the headers
+//peer, so the download restarts without it once too many peers miss them. It returns true if the download restarted
+func (downloadManager *DownloadManager) markMissingWindow(window *blockWindow, peerId peer.ID) bool {
+	window.missingPeers[peerId] = true
+	if len(window.missingPeers) < maxWindowMisses {
+		return false
+	}
+
+	logger.WithFields(logger.Fields{
+		"peer_id":      downloadManager.downloadingPeer.peerid,
+		"start_height": window.startHeight,
+	}).Warn("DownloadManager: peers do not have the blocks of the headers, restart without the headers peer.")
+	downloadManager.restartWithoutPeer(downloadManager.downloadingPeer.peerid)
+	return true
+}
+
+func (downloadManager *DownloadManager) GetBlockBodiesDataHandler(bodiesPb *networkpb.ReturnBlockBodies, peerInfo networkmodel.PeerInfo) {
+	returnBodiesLogger := logger.WithFields(logger.Fields{
+		"name": "GetBlockBodiesResponse",
+	})
+
+	downloadManager.mutex.Lock()
+	if downloadManager.status != DownloadStatusFetchingBlocks || len(bodiesPb.GetBlockHashes()) == 0 {
+		downloadManager.mutex.Unlock()
+		return
+	}
+	parallel := downloadManager.parallel
+	window, ok := parallel.windowsByHash[string(bodiesPb.GetBlockHashes()[0])]
+	if !ok || !window.isInFlight() || window.peerid != peerInfo.PeerId {
+		returnBodiesLogger.Info("DownloadManager: response is not for waiting command.")
+		downloadManager.mutex.Unlock()
+		return
+	}
+	msgId := window.msgId
+	downloadManager.mutex.Unlock()
+
+	blocks, violated := downloadManager.verifyWindowBlocks(window, bodiesPb.GetBlocks())
+	if violated {
+		downloadManager.reportPeer(peerInfo, networkmodel.ViolationInvalidBlocks)
+	}
+
+	downloadManager.mutex.Lock()
+	if downloadManager.parallel != parallel || window.msgId != msgId || !window.isInFlight() {
+		downloadManager.mutex.Unlock()
+		return
+	}
+	if blocks == nil {
+		downloadManager.releaseWindow(window)
+		if violated {
+			if failedPeer, ok := downloadManager.peersInfo[peerInfo.PeerId]; ok {
+				failedPeer.status = PeerStatusFailed
+			}
+		} else if downloadManager.markMissingWindow(window, peerInfo.PeerId) {
+			downloadManager.mutex.Unlock()
+			return
+		}
+		downloadManager.assignBlockWindows()
+		downloadManager.mutex.Unlock()
+		return
+	}
+	parallel.peerLoad[window.peerid]--
+	window.blocks = blocks
+	downloadManager.assignBlockWindows()
+	downloadManager.mutex.Unlock()
+
+	downloadManager.applyBlockWindows(parallel)
+}
+
+//verifyWindowBlocks returns the blocks of a window if they match its headers. It also returns if the peer sent invalid
+//blocks. A peer that returns fewer blocks does not have them, which is not a violation of the peer. The producers of
+//the headers have been verified already; their time slots are checked when the blocks are merged after their parents
+func (downloadManager *DownloadManager) verifyWindowBlocks(window *blockWindow, blockPbs []*blockpb.Block) ([]*block.Block, bool) {
+	if len(blockPbs) != len(window.hashes) {
+		return nil, false
+	}
+
+	blocks := make([]*block.Block, len(blockPbs))
+	for index, blockPb := range blockPbs {
+		blk := &block.Block{}
+		blk.FromProto(blockPb)
+
+		if bytes.Compare(blk.GetHash(), window.hashes[index]) != 0 || !lblock.VerifyHash(blk) {
+			logger.WithFields(logger.Fields{
+				"height": blk.GetHeight(),
+				"hash":   blk.GetHash(),
+			}).Warn("DownloadManager: verify block failed.")
+			return nil, true
+		}
+		blocks[index] = blk
+	}
+	return blocks, false
+}
+
+//applyBlockWindows adds the downloaded windows to the blockchain in the order of their heights
+func (downloadManager *DownloadManager) applyBlockWindows(parallel *parallelDownload) {
+	downloadManager.applyMutex.Lock()
+	defer downloadManager.applyMutex.Unlock()
+
+	for {
+		downloadManager.mutex.Lock()
+		if downloadManager.parallel != parallel || parallel.nextApply >= len(parallel.windows) || parallel.windows[parallel.nextApply].blocks == nil {
+			downloadManager.mutex.Unlock()
+			return
+		}
+		window := parallel.windows[parallel.nextApply]
+		parallel.nextApply++
+		downloadManager.mutex.Unlock()
+
+		forkBlks := make([]*block.Block, len(window.blocks))
+		for index, blk := range window.blocks {
+			forkBlks[len(window.blocks)-1-index] = blk
+		}
+		logger.Infof("DownloadManager: apply blocks from %v to %v.", window.startHeight, window.endHeight())
+
+		err := downloadManager.bm.MergeFork(forkBlks, window.blocks[0].GetPrevHash())
+		if err == nil && bytes.Compare(downloadManager.bm.Getblockchain().GetTailBlockHash(), window.hashes[len(window.hashes)-1]) != 0 {
+			err = errval.BlocksNotMerged
+		}
+
+		downloadManager.mutex.Lock()
+		if downloadManager.parallel != parallel {
+			downloadManager.mutex.Unlock()
+			return
+		}
+		if err != nil {
+			logger.WithError(err).Warn("DownloadManager: merge fork failed.")
+			downloadManager.finishDownload()
+			downloadManager.mutex.Unlock()
+			return
+		}
+		if parallel.nextApply == len(parallel.windows) {
+			downloadManager.completeParallelDownload()
+			downloadManager.mutex.Unlock()
+			return
+		}
+		downloadManager.mutex.Unlock()
+	}
+}
+
+//completeParallelDownload finishes the download and sets the lib of the highest peer
+func (downloadManager *DownloadManager) completeParallelDownload() {
+	libHeight := downloadManager.downloadingPeer.libHeight
+	downloadManager.finishDownload()
+	downloadManager.setPeerLIB(libHeight)
+}
+
+func (downloadManager *DownloadManager) GetBlockHeadersRequestHandler(input interface{}) {
+	command := input.(*networkmodel.DappRcvdCmdContext)
+
+	param := &networkpb.GetBlockHeaders{}
+	if err := proto.Unmarshal(command.GetData(), param); err != nil {
+		logger.WithFields(logger.Fields{
+			"name": "GetBlockHeadersRequest",
+		}).Info("DownloadManager: parse data failed.")
+		return
+	}
+
+	downloadManager.SendGetBlockHeadersResponse(param.GetStartHeight(), param.GetCount(), command.GetSource())
+}
+
+//SendGetBlockHeadersResponse returns the headers of the main chain from the start height
+func (downloadManager *DownloadManager) SendGetBlockHeadersResponse(startHeight uint64, count uint32, destination networkmodel.PeerInfo) {
+	if count > maxGetBlockHeadersNum {
+		count = maxGetBlockHeadersNum
+	}
+
+	var headerPbs []*blockpb.BlockHeader
+	var txsHashes [][]byte
+	for height := startHeight; height < startHeight+uint64(count); height++ {
+		blk, err := downloadManager.bm.Getblockchain().GetBlockByHeight(height)
+		if err != nil {
+			break
+		}
+		headerPbs = append(headerPbs, blk.GetHeader().ToProto().(*blockpb.BlockHeader))
+		txsHashes = append(txsHashes, lblock.HashTransactions(blk))
+	}
+
+	result := &networkpb.ReturnBlockHeaders{StartHeight: startHeight, BlockHeaders: headerPbs, TxsHashes: txsHashes}
+	downloadManager.node.UnicastHighProrityCommand(GetBlockHeadersResponse, result, destination)
+}
+
+func (downloadManager *DownloadManager) GetBlockHeadersResponseHandler(input interface{}) {
+	command := input.(*networkmodel.DappRcvdCmdContext)
+
+	param := &networkpb.ReturnBlockHeaders{}
+	if err := proto.Unmarshal(command.GetData(), param); err != nil {
+		logger.WithFields(logger.Fields{
+			"name": "GetBlockHeadersResponse",
+		}).Info("DownloadManager: parse data failed.")
+		return
+	}
+
+	downloadManager.GetBlockHeadersDataHandler(param, command.GetSource())
+}
+
+func (downloadManager *DownloadManager) GetBlockBodiesRequestHandler(input interface{}) {
+	command := input.(*networkmodel.DappRcvdCmdContext)
+
+	param := &networkpb.GetBlockBodies{}
+	if err := proto.Unmarshal(command.GetData(), param); err != nil {
+		logger.WithFields(logger.Fields{
+			"name": "GetBlockBodiesRequest",
+		}).Info("DownloadManager: parse data failed.")
+		return
+	}
+
+	downloadManager.SendGetBlockBodiesResponse(param.GetBlockHashes(), command.GetSource())
+}
+
+//SendGetBlockBodiesResponse returns the requested blocks until the first one that is not found
+func (downloadManager *DownloadManager) SendGetBlockBodiesResponse(blockHashes [][]byte, destination networkmodel.PeerInfo) {
+	if len(blockHashes) > maxGetBlockBodiesNum {
+		blockHashes = blockHashes[:maxGetBlockBodiesNum]
+	}
+
+	var blockPbs []*blockpb.Block
+	for _, blkHash := range blockHashes {
+		blk, err := downloadManager.bm.Getblockchain().GetBlockByHash(blkHash)
+		if err != nil {
+			break
+		}
+		blockPbs = append(blockPbs, blk.ToProto().(*blockpb.Block))
+	}
+
+	result := &networkpb.ReturnBlockBodies{BlockHashes: blockHashes, Blocks: blockPbs}
+	downloadManager.node.UnicastHighProrityCommand(GetBlockBodiesResponse, result, destination)
+}
+
+func (downloadManager *DownloadManager) GetBlockBodiesResponseHandler(input interface{}) {
+	command := input.(*networkmodel.DappRcvdCmdContext)
+
+	param := &networkpb.ReturnBlockBodies{}
+	if err := proto.Unmarshal(command.GetData(), param); err != nil {
+		logger.WithFields(logger.Fields{
+			"name": "GetBlockBodiesResponse",
+		}).Info("DownloadManager: parse data failed.")
+		return
+	}
+
+	downloadManager.GetBlockBodiesDataHandler(param, command.GetSource())
+}
@@ -0,0 +1,394 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package downloadmanager
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/common/pubsub"
+	"github.com/dappley/go-dappley/consensus"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/block"
+	blockpb "github.com/dappley/go-dappley/core/block/pb"
+	"github.com/dappley/go-dappley/core/blockchain"
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/logic/lblock"
+	"github.com/dappley/go-dappley/logic/lblockchain"
+	"github.com/dappley/go-dappley/logic/lblockchain/mocks"
+	"github.com/dappley/go-dappley/logic/ltransaction"
+	"github.com/dappley/go-dappley/logic/transactionpool"
+	"github.com/dappley/go-dappley/network"
+	"github.com/dappley/go-dappley/network/networkmodel"
+	networkpb "github.com/dappley/go-dappley/network/pb"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/golang/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const multiPortParallelDownload int = 10400
+
+type sentCommand struct {
+	name    string
+	message proto.Message
+}
+
+type fakeNetService struct {
+	peers []networkmodel.PeerInfo
+	sent  map[peer.ID][]sentCommand
+	mutex sync.Mutex
+}
+
+func newFakeNetService(numPeers int) *fakeNetService {
+	ns := &fakeNetService{sent: make(map[peer.ID][]sentCommand)}
+	for i := 0; i < numPeers; i++ {
+		ns.peers = append(ns.peers, networkmodel.PeerInfo{PeerId: peer.ID(fmt.Sprintf("peer%d", i))})
+	}
+	return ns
+}
+
+func (ns *fakeNetService) GetPeers() []networkmodel.PeerInfo                    { return ns.peers }
+func (ns *fakeNetService) GetHostPeerInfo() networkmodel.PeerInfo               { return networkmodel.PeerInfo{} }
+func (ns *fakeNetService) Listen(subscriber pubsub.Subscriber)                  {}
+func (ns *fakeNetService) BroadcastNormalPriorityCommand(string, proto.Message) {}
+func (ns *fakeNetService) BroadcastHighProrityCommand(string, proto.Message)    {}
+
+func (ns *fakeNetService) GetPeerCapabilities(peerId peer.ID) networkmodel.Capability {
+	return networkmodel.CapabilityParallelSync | networkmodel.CapabilitySnapshotSync
+}
+
+func (ns *fakeNetService) UnicastNormalPriorityCommand(commandName string, message proto.Message, destination networkmodel.PeerInfo) {
+	ns.mutex.Lock()
+	defer ns.mutex.Unlock()
+	ns.sent[destination.PeerId] = append(ns.sent[destination.PeerId], sentCommand{commandName, message})
+}
+
+func (ns *fakeNetService) UnicastHighProrityCommand(commandName string, message proto.Message, destination networkmodel.PeerInfo) {
+	ns.UnicastNormalPriorityCommand(commandName, message, destination)
+}
+
+func (ns *fakeNetService) getLastSent(peerId peer.ID) sentCommand {
+	ns.mutex.Lock()
+	defer ns.mutex.Unlock()
+	sent := ns.sent[peerId]
+	if len(sent) == 0 {
+		return sentCommand{}
+	}
+	return sent[len(sent)-1]
+}
+
+func newParallelTestManager(bc *lblockchain.Blockchain, node lblockchain.NetService) *lblockchain.BlockchainManager {
+	consensus := &mocks.Consensus{}
+	consensus.On("Validate", mock.Anything).Return(true)
+	consensus.On("ChangeDynasty", mock.Anything).Return(true)
+	consensus.On("SetDynasty", mock.Anything).Return(true)
+	consensus.On("GetDynasty").Return(nil)
+	return lblockchain.NewBlockchainManager(bc, blockchain.NewBlockPool(nil), node, consensus)
+}
+
+//vrfChain reads the blockchain that ends at tail
+type vrfChain struct {
+	bc   *lblockchain.Blockchain
+	tail *block.Block
+}
+
+func (chain *vrfChain) GetTailBlock() (*block.Block, error) {
+	return chain.tail, nil
+}
+
+func (chain *vrfChain) GetBlockByHash(hash hash.Hash) (*block.Block, error) {
+	return chain.bc.GetBlockByHash(hash)
+}
+
+//newVrfBlockchain returns a blockchain whose blocks carry VRF proofs and are signed by the producer of their time slot
+func newVrfBlockchain(producers []*account.Account, dynasty *consensus.Dynasty, size int) *lblockchain.Blockchain {
+	libPolicy := &mocks.LIBPolicy{}
+	libPolicy.On("GetMinConfirmationNum").Return(6)
+	libPolicy.On("IsBypassingLibCheck").Return(true)
+	bc := lblockchain.CreateBlockchain(producers[0].GetAddress(), storage.NewRamStorage(), libPolicy, transactionpool.NewTransactionPool(nil, 128000), 100000)
+
+	accounts := make(map[string]*account.Account)
+	for _, producer := range producers {
+		accounts[producer.GetAddress().String()] = producer
+	}
+	for i := 0; i < size; i++ {
+		parent, _ := bc.GetTailBlock()
+		timestamp := parent.GetTimestamp() + int64(dynasty.GetTimeBetweenBlk())
+		seed, _ := consensus.GetEpochSeed(&vrfChain{bc, parent}, dynasty, dynasty.GetEpoch(timestamp))
+		producer := accounts[dynasty.ProducerAtATimeWithSeed(timestamp, seed)]
+
+		cbtx := ltransaction.NewCoinbaseTX(producer.GetAddress(), "", parent.GetHeight()+1, common.NewAmount(0))
+		blk := block.NewBlockWithTimestamp([]*transaction.Transaction{&cbtx}, parent, timestamp, producer.GetAddress().String())
+		key := hex.EncodeToString(producer.GetKeyPair().GetPrivateKeyBytes())
+		consensus.GenerateVrfProof(blk, key, rand.Reader)
+		blk.SetHash(lblock.CalculateHash(blk))
+		lblock.SignBlock(blk, key)
+		bc.AddBlockContextToTail(lblockchain.PrepareBlockContext(bc, blk))
+	}
+	return bc
+}
+
+func getWindowBodies(t *testing.T, bc *lblockchain.Blockchain, window *blockWindow) *networkpb.ReturnBlockBodies {
+	bodies := &networkpb.ReturnBlockBodies{}
+	for _, blkHash := range window.hashes {
+		blk, err := bc.GetBlockByHash(blkHash)
+		assert.Nil(t, err)
+		bodies.BlockHashes = append(bodies.BlockHashes, blkHash)
+		bodies.Blocks = append(bodies.Blocks, blk.ToProto().(*blockpb.Block))
+	}
+	return bodies
+}
+
+func getBlockHeaders(bc *lblockchain.Blockchain, startHeight uint64, endHeight uint64) *networkpb.ReturnBlockHeaders {
+	headers := &networkpb.ReturnBlockHeaders{StartHeight: startHeight}
+	for height := startHeight; height <= endHeight; height++ {
+		blk, _ := bc.GetBlockByHeight(height)
+		headers.BlockHeaders = append(headers.BlockHeaders, blk.GetHeader().ToProto().(*blockpb.BlockHeader))
+		headers.TxsHashes = append(headers.TxsHashes, lblock.HashTransactions(blk))
+	}
+	return headers
+}
+
+func TestDownloadManager_ParallelFetchBlocks(t *testing.T) {
+	sourceBc := lblockchain.GenerateMockBlockchainWithCoinbaseTxOnly(40)
+	bc := lblockchain.CopyMockBlockchain(sourceBc, 8)
+	ns := newFakeNetService(3)
+	downloadManager := NewDownloadManager(ns, newParallelTestManager(bc, nil), 0, nil)
+	downloadManager.finishCh = make(chan bool, 1)
+	for _, peerInfo := range ns.peers {
+		downloadManager.peersInfo[peerInfo.PeerId] = &PeerBlockInfo{peerid: peerInfo.PeerId, height: 40, status: PeerStatusReady}
+	}
+	headersPeer := ns.peers[0].PeerId
+	downloadManager.downloadingPeer = downloadManager.peersInfo[headersPeer]
+	downloadManager.status = DownloadStatusSyncCommonBlocks
+	downloadManager.commonHeight = 8
+
+	//the headers after the common block are requested from the highest peer
+	downloadManager.startSyncHeaders()
+	request := ns.getLastSent(headersPeer)
+	assert.Equal(t, GetBlockHeadersRequest, request.name)
+	assert.Equal(t, uint64(9), request.message.(*networkpb.GetBlockHeaders).GetStartHeight())
+	assert.Equal(t, uint32(32), request.message.(*networkpb.GetBlockHeaders).GetCount())
+
+	downloadManager.GetBlockHeadersDataHandler(getBlockHeaders(sourceBc, 9, 40), networkmodel.PeerInfo{PeerId: headersPeer})
+
+	//the bodies are split into windows that are fetched from different peers
+	assert.Equal(t, DownloadStatusFetchingBlocks, downloadManager.status)
+	windows := downloadManager.parallel.windows
+	assert.Equal(t, 2, len(windows))
+	assert.Equal(t, uint64(9), windows[0].startHeight)
+	assert.Equal(t, uint64(25), windows[1].startHeight)
+	assert.NotEqual(t, windows[0].peerid, windows[1].peerid)
+	for _, window := range windows {
+		assert.Equal(t, GetBlockBodiesRequest, ns.getLastSent(window.peerid).name)
+	}
+
+	//a window that times out is reassigned to another peer
+	slowPeer := windows[0].peerid
+	downloadManager.CheckBlockWindow(downloadManager.parallel, windows[0], windows[0].msgId)
+	assert.NotEqual(t, slowPeer, windows[0].peerid)
+	assert.NotEqual(t, peer.ID(""), windows[0].peerid)
+
+	//the late response of the slow peer is ignored
+	downloadManager.GetBlockBodiesDataHandler(getWindowBodies(t, sourceBc, windows[0]), networkmodel.PeerInfo{PeerId: slowPeer})
+	assert.Nil(t, windows[0].blocks)
+
+	//the blocks are added to the blockchain in the order of their heights
+	downloadManager.GetBlockBodiesDataHandler(getWindowBodies(t, sourceBc, windows[1]), networkmodel.PeerInfo{PeerId: windows[1].peerid})
+	assert.Equal(t, uint64(8), bc.GetMaxHeight())
+
+	downloadManager.GetBlockBodiesDataHandler(getWindowBodies(t, sourceBc, windows[0]), networkmodel.PeerInfo{PeerId: windows[0].peerid})
+	assert.Equal(t, uint64(40), bc.GetMaxHeight())
+	assert.Equal(t, sourceBc.GetTailBlockHash(), bc.GetTailBlockHash())
+	assert.Equal(t, DownloadStatusIdle, downloadManager.status)
+	assert.True(t, <-downloadManager.finishCh)
+}
+
+func TestDownloadManager_ParallelFetchVrfBlocks(t *testing.T) {
+	producers := []*account.Account{account.NewAccount(), account.NewAccount(), account.NewAccount()}
+	addresses := []string{producers[0].GetAddress().String(), producers[1].GetAddress().String(), producers[2].GetAddress().String()}
+	sourceBc := newVrfBlockchain(producers, consensus.NewDynasty(addresses, len(addresses), 15), 40)
+
+	bc := lblockchain.CopyMockBlockchain(sourceBc, 8)
+	dynasty := consensus.NewDynasty(addresses, len(addresses), 15)
+	dynasty.SetVrfActivationHeight(1)
+	dpos := consensus.NewDPOS(nil)
+	dpos.SetDynasty(dynasty)
+	dpos.SetChainReader(bc)
+	ns := newFakeNetService(3)
+	downloadManager := NewDownloadManager(ns, lblockchain.NewBlockchainManager(bc, blockchain.NewBlockPool(nil), nil, dpos), 0, nil)
+	downloadManager.finishCh = make(chan bool, 1)
+	for _, peerInfo := range ns.peers {
+		downloadManager.peersInfo[peerInfo.PeerId] = &PeerBlockInfo{peerid: peerInfo.PeerId, height: 40, status: PeerStatusReady}
+	}
+	headersPeer := ns.peers[0].PeerId
+	downloadManager.downloadingPeer = downloadManager.peersInfo[headersPeer]
+	downloadManager.status = DownloadStatusSyncCommonBlocks
+	downloadManager.commonHeight = 8
+	downloadManager.startSyncHeaders()
+	downloadManager.GetBlockHeadersDataHandler(getBlockHeaders(sourceBc, 9, 40), networkmodel.PeerInfo{PeerId: headersPeer})
+	windows := downloadManager.parallel.windows
+	assert.Equal(t, 2, len(windows))
+
+	//the time slots of the blocks of a later window depend on the blocks before it, so the window is accepted before
+	//its parent is known and its producers are verified when it is merged
+	downloadManager.GetBlockBodiesDataHandler(getWindowBodies(t, sourceBc, windows[1]), networkmodel.PeerInfo{PeerId: windows[1].peerid})
+	assert.NotNil(t, windows[1].blocks)
+	assert.Equal(t, uint64(8), bc.GetMaxHeight())
+
+	downloadManager.GetBlockBodiesDataHandler(getWindowBodies(t, sourceBc, windows[0]), networkmodel.PeerInfo{PeerId: windows[0].peerid})
+	assert.Equal(t, uint64(40), bc.GetMaxHeight())
+	assert.Equal(t, sourceBc.GetTailBlockHash(), bc.GetTailBlockHash())
+	for _, peerInfo := range ns.peers {
+		assert.Equal(t, PeerStatusReady, downloadManager.peersInfo[peerInfo.PeerId].status)
+	}
+	assert.Equal(t, DownloadStatusIdle, downloadManager.status)
+	assert.True(t, <-downloadManager.finishCh)
+}
+
+func TestDownloadManager_ParallelInvalidHeaders(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(headers *networkpb.ReturnBlockHeaders)
+	}{
+		{
+			name: "NotLinked",
+			modify: func(headers *networkpb.ReturnBlockHeaders) {
+				headers.BlockHeaders[0].PreviousHash = []byte("unknown")
+			},
+		},
+		{
+			name: "ForgedHash",
+			modify: func(headers *networkpb.ReturnBlockHeaders) {
+				headers.BlockHeaders[1].Nonce++
+			},
+		},
+		{
+			name: "MissingTxsHash",
+			modify: func(headers *networkpb.ReturnBlockHeaders) {
+				headers.TxsHashes = headers.TxsHashes[:1]
+			},
+		},
+	}
+
+	sourceBc := lblockchain.GenerateMockBlockchainWithCoinbaseTxOnly(20)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ns := newFakeNetService(1)
+			downloadManager := NewDownloadManager(ns, newParallelTestManager(lblockchain.CopyMockBlockchain(sourceBc, 8), nil), 0, nil)
+			downloadManager.finishCh = make(chan bool, 1)
+			headersPeer := ns.peers[0].PeerId
+			downloadManager.peersInfo[headersPeer] = &PeerBlockInfo{peerid: headersPeer, height: 20, status: PeerStatusReady}
+			downloadManager.downloadingPeer = downloadManager.peersInfo[headersPeer]
+			downloadManager.status = DownloadStatusSyncCommonBlocks
+			downloadManager.commonHeight = 8
+			downloadManager.startSyncHeaders()
+
+			headers := getBlockHeaders(sourceBc, 9, 20)
+			tt.modify(headers)
+			downloadManager.GetBlockHeadersDataHandler(headers, networkmodel.PeerInfo{PeerId: headersPeer})
+
+			//the peer is dropped and the download finishes as no other peer is higher
+			assert.Equal(t, PeerStatusFailed, downloadManager.peersInfo[headersPeer].status)
+			assert.Equal(t, DownloadStatusIdle, downloadManager.status)
+			assert.True(t, <-downloadManager.finishCh)
+		})
+	}
+}
+
+func TestDownloadManager_ParallelMissingBlocks(t *testing.T) {
+	sourceBc := lblockchain.GenerateMockBlockchainWithCoinbaseTxOnly(20)
+	ns := newFakeNetService(3)
+	downloadManager := NewDownloadManager(ns, newParallelTestManager(lblockchain.CopyMockBlockchain(sourceBc, 8), nil), 0, nil)
+	downloadManager.finishCh = make(chan bool, 1)
+	for _, peerInfo := range ns.peers {
+		downloadManager.peersInfo[peerInfo.PeerId] = &PeerBlockInfo{peerid: peerInfo.PeerId, height: 20, status: PeerStatusReady}
+	}
+	headersPeer := ns.peers[0].PeerId
+	downloadManager.downloadingPeer = downloadManager.peersInfo[headersPeer]
+	downloadManager.status = DownloadStatusSyncCommonBlocks
+	downloadManager.commonHeight = 8
+	downloadManager.startSyncHeaders()
+	//the headers peer does not serve the bodies
+	downloadManager.peersInfo[headersPeer].height = 19
+	downloadManager.GetBlockHeadersDataHandler(getBlockHeaders(sourceBc, 9, 20), networkmodel.PeerInfo{PeerId: headersPeer})
+	window := downloadManager.parallel.windows[0]
+
+	//a peer that does not have the blocks is not asked for them again and is not marked as slow
+	missingPeer := window.peerid
+	notFound := &networkpb.ReturnBlockBodies{BlockHashes: [][]byte{window.hashes[0]}}
+	downloadManager.GetBlockBodiesDataHandler(notFound, networkmodel.PeerInfo{PeerId: missingPeer})
+	assert.NotEqual(t, missingPeer, window.peerid)
+	assert.NotEqual(t, peer.ID(""), window.peerid)
+	assert.Equal(t, 0, downloadManager.parallel.peerTimeouts[missingPeer])
+	assert.Equal(t, PeerStatusReady, downloadManager.peersInfo[missingPeer].status)
+
+	//the headers peer is dropped once another peer does not have the blocks either
+	downloadManager.GetBlockBodiesDataHandler(notFound, networkmodel.PeerInfo{PeerId: window.peerid})
+	assert.Equal(t, PeerStatusFailed, downloadManager.peersInfo[headersPeer].status)
+	assert.Equal(t, PeerStatusReady, downloadManager.peersInfo[missingPeer].status)
+	assert.Equal(t, DownloadStatusIdle, downloadManager.status)
+	assert.True(t, <-downloadManager.finishCh)
+}
+
+func TestMultiPeerParallelDownload(t *testing.T) {
+	defer deleteConfFolderFiles()
+	sourceBc := lblockchain.GenerateMockBlockchainWithCoinbaseTxOnly(5)
+	lblockchain.AddBlockToGeneratedBlockchain(sourceBc, 100)
+
+	nodes := make([]*network.Node, 5)
+	downloadManagers := make([]*DownloadManager, 5)
+	for i := range nodes {
+		rfl := storage.NewRamFileLoader(confDir, "dl"+strconv.Itoa(i)+".conf")
+		nodes[i] = network.NewNode(rfl.File, nil)
+		nodes[i].Start(multiPortParallelDownload+i, "")
+		bc := sourceBc
+		if i == 0 {
+			bc = lblockchain.CopyMockBlockchain(sourceBc, 5)
+		}
+		downloadManagers[i] = NewDownloadManager(nodes[i], newParallelTestManager(bc, nodes[i]), 0, nil)
+		downloadManagers[i].Start()
+	}
+	for i := 1; i < len(nodes); i++ {
+		nodes[0].GetNetwork().ConnectToSeed(nodes[i].GetHostPeerInfo())
+	}
+
+	bm := downloadManagers[0].bm
+	finishCh := make(chan bool, 1)
+	bm.Getblockchain().SetState(blockchain.BlockchainDownloading)
+	downloadManagers[0].StartDownloadBlockchain(finishCh)
+	select {
+	case <-finishCh:
+	case <-time.After(60 * time.Second):
+		t.Fatal("download did not finish")
+	}
+	bm.Getblockchain().SetState(blockchain.BlockchainReady)
+
+	assert.Equal(t, sourceBc.GetMaxHeight(), bm.Getblockchain().GetMaxHeight())
+	assert.Equal(t, sourceBc.GetTailBlockHash(), bm.Getblockchain().GetTailBlockHash())
+}
@@ -0,0 +1,608 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package downloadmanager
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/common/log"
+	"github.com/dappley/go-dappley/core/block"
+	blockpb "github.com/dappley/go-dappley/core/block/pb"
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/logic/lblock"
+	"github.com/dappley/go-dappley/logic/lblockchain"
+	lblockchainpb "github.com/dappley/go-dappley/logic/lblockchain/pb"
+	"github.com/dappley/go-dappley/network/networkmodel"
+	"github.com/golang/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	DefaultSnapshotInterval uint64 = 1000
+
+	maxCachedSnapshots          = 2
+	maxChunksPerPeer            = 2
+	minSnapshotCreationInterval = 60 * time.Second
+	minManifestRequestInterval  = SnapshotMaxWaitTime * time.Second
+)
+
+//SnapshotSyncConfig sets the state snapshot that a new node trusts. A snapshot is only accepted if its root matches the
+//checkpoint root. The peers can not prove that a snapshot belongs to the chain without the blocks before it, so a
+//snapshot is never trusted because many peers serve it
+type SnapshotSyncConfig struct {
+	CheckpointHeight uint64
+	CheckpointRoot   hash.Hash
+}
+
+//snapshotOffer is a manifest served by a peer together with the block that it was taken at
+type snapshotOffer struct {
+	manifest *lblockchainpb.StateSnapshotManifest
+	block    *block.Block
+}
+
+//snapshotSync is the state of the download of a state snapshot from many peers
+type snapshotSync struct {
+	requested   map[peer.ID]bool
+	offers      map[peer.ID]*snapshotOffer
+	accepted    *snapshotOffer
+	peers       map[peer.ID]bool
+	chunks      [][]byte
+	chunkPeers  []peer.ID
+	chunkMsgIds []int32
+	peerLoad    map[peer.ID]int
+	received    int
+}
+
+//snapshotServer keeps the latest snapshots that the node served so that their chunks are exported only once. Creating
+//a snapshot reads the whole state, so the server limits how often snapshots are created and manifests are requested
+type snapshotServer struct {
+	interval     uint64
+	snapshots    []*lblockchain.StateSnapshot
+	lastCreated  time.Time
+	lastRequests map[peer.ID]time.Time
+	mutex        sync.Mutex
+}
+
+func newSnapshotServer(interval uint64) *snapshotServer {
+	return &snapshotServer{interval: interval, lastRequests: make(map[peer.ID]time.Time)}
+}
+
+//isServable returns if the node serves the snapshot at the height. Only the heights at a multiple of the interval and at
+//or below the lib are served
+func (server *snapshotServer) isServable(height uint64, libHeight uint64) bool {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	if height == 0 || height > libHeight {
+		return false
+	}
+	return server.interval == 0 || height%server.interval == 0
+}
+
+//allowRequest returns false if the peer already requested a manifest recently. The peers whose last request is old
+//enough are forgotten
+func (server *snapshotServer) allowRequest(peerId peer.ID, now time.Time) bool {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	for id, lastRequest := range server.lastRequests {
+		if now.Sub(lastRequest) >= minManifestRequestInterval {
+			delete(server.lastRequests, id)
+		}
+	}
+	if _, ok := server.lastRequests[peerId]; ok {
+		return false
+	}
+	server.lastRequests[peerId] = now
+	return true
+}
+
+//latestHeight returns the highest snapshot height at or below the lib
+func (server *snapshotServer) latestHeight(libHeight uint64) uint64 {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	if server.interval == 0 {
+		return libHeight
+	}
+	return libHeight - libHeight%server.interval
+}
+
+//getSnapshot returns the snapshot at the height and creates it if it is not cached. At most one snapshot is created in
+//every minSnapshotCreationInterval
+func (server *snapshotServer) getSnapshot(bc *lblockchain.Blockchain, height uint64) (*lblockchain.StateSnapshot, error) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	for _, snapshot := range server.snapshots {
+		if snapshot.Manifest.GetHeight() == height {
+			return snapshot, nil
+		}
+	}
+
+	if time.Since(server.lastCreated) < minSnapshotCreationInterval {
+		return nil, errval.StateSnapshotRateLimited
+	}
+	snapshot, err := bc.CreateStateSnapshot(height)
+	if err != nil {
+		return nil, err
+	}
+	server.lastCreated = time.Now()
+	server.snapshots = append(server.snapshots, snapshot)
+	if len(server.snapshots) > maxCachedSnapshots {
+		server.snapshots = server.snapshots[1:]
+	}
+	return snapshot, nil
+}
+
+//getSnapshotByRoot returns the cached snapshot with the root
+func (server *snapshotServer) getSnapshotByRoot(root hash.Hash) *lblockchain.StateSnapshot {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+
+	for _, snapshot := range server.snapshots {
+		if bytes.Compare(snapshot.Manifest.GetRoot(), root) == 0 {
+			return snapshot
+		}
+	}
+	return nil
+}
+
+//SetSnapshotInterval sets the number of blocks between two snapshots that the node serves
+func (downloadManager *DownloadManager) SetSnapshotInterval(interval uint64) {
+	downloadManager.snapshotServer.mutex.Lock()
+	defer downloadManager.snapshotServer.mutex.Unlock()
+	downloadManager.snapshotServer.interval = interval
+}
+
+//EnableSnapshotSync makes a node without blocks download the checkpoint state snapshot before it downloads the
+//remaining blocks
+func (downloadManager *DownloadManager) EnableSnapshotSync(config *SnapshotSyncConfig) error {
+	if config.CheckpointHeight == 0 || len(config.CheckpointRoot) == 0 {
+		return errval.MissingSnapshotCheckpoint
+	}
+
+	downloadManager.mutex.Lock()
+	defer downloadManager.mutex.Unlock()
+	downloadManager.snapshotConfig = config
+	return nil
+}
+
+//supportsSnapshotSync returns if a peer serves state snapshots
+func (downloadManager *DownloadManager) supportsSnapshotSync(peerId peer.ID) bool {
+	if provider, ok := downloadManager.node.(CapabilityProvider); ok {
+		return provider.GetPeerCapabilities(peerId).Has(networkmodel.CapabilitySnapshotSync)
+	}
+	return false
+}
+
+//startSnapshotSync requests the snapshot manifests from the peers once if the blockchain only holds the genesis block.
+//It returns false if the blocks are downloaded from the genesis block instead
+func (downloadManager *DownloadManager) startSnapshotSync() bool {
+	config := downloadManager.snapshotConfig
+	if config == nil || downloadManager.snapshotTried || downloadManager.bm.Getblockchain().GetMaxHeight() != 0 {
+		return false
+	}
+	downloadManager.snapshotTried = true
+
+	snapshot := &snapshotSync{
+		requested: make(map[peer.ID]bool),
+		offers:    make(map[peer.ID]*snapshotOffer),
+		peers:     make(map[peer.ID]bool),
+		peerLoad:  make(map[peer.ID]int),
+	}
+	for _, peerInfo := range downloadManager.peersInfo {
+		if peerInfo.status == PeerStatusReady && peerInfo.libHeight > 0 && peerInfo.libHeight >= config.CheckpointHeight &&
+			downloadManager.supportsSnapshotSync(peerInfo.peerid) {
+			snapshot.requested[peerInfo.peerid] = true
+		}
+	}
+	if len(snapshot.requested) == 0 {
+		logger.Info("DownloadManager: not enough peers serve state snapshots, download all blocks.")
+		return false
+	}
+
+	downloadManager.status = DownloadStatusSyncSnapshot
+	downloadManager.snapshot = snapshot
+	for peerId := range snapshot.requested {
+		request := &lblockchainpb.GetStateSnapshotManifest{Height: config.CheckpointHeight}
+		downloadManager.node.UnicastHighProrityCommand(GetStateSnapshotManifestRequest, request, networkmodel.PeerInfo{PeerId: peerId})
+	}
+
+	manifestTimer := time.NewTimer(SnapshotMaxWaitTime * time.Second)
+	go func() {
+		defer log.CrashHandler()
+
+		<-manifestTimer.C
+		manifestTimer.Stop()
+		downloadManager.CheckStateSnapshotManifests(snapshot)
+	}()
+	return true
+}
+
+//CheckStateSnapshotManifests selects a snapshot from the manifests received in time
+func (downloadManager *DownloadManager) CheckStateSnapshotManifests(snapshot *snapshotSync) {
+	downloadManager.mutex.Lock()
+	defer downloadManager.mutex.Unlock()
+
+	if downloadManager.status != DownloadStatusSyncSnapshot || downloadManager.snapshot != snapshot || snapshot.accepted != nil {
+		return
+	}
+	downloadManager.selectStateSnapshot(true)
+}
+
+//stopSnapshotSync gives up the snapshot and downloads all blocks instead
+func (downloadManager *DownloadManager) stopSnapshotSync() {
+	logger.Warn("DownloadManager: state snapshot sync failed, download all blocks.")
+	downloadManager.snapshot = nil
+	downloadManager.status = DownloadStatusInit
+	downloadManager.startGetCommonBlocks(0)
+}
+
+func (downloadManager *DownloadManager) GetStateSnapshotManifestDataHandler(manifestPb *lblockchainpb.ReturnStateSnapshotManifest, peerInfo networkmodel.PeerInfo) {
+	downloadManager.mutex.Lock()
+	defer downloadManager.mutex.Unlock()
+
+	snapshot := downloadManager.snapshot
+	if downloadManager.status != DownloadStatusSyncSnapshot || snapshot == nil || snapshot.accepted != nil ||
+		!snapshot.requested[peerInfo.PeerId] {
+		logger.WithFields(logger.Fields{
+			"name": "GetStateSnapshotManifestResponse",
+		}).Info("DownloadManager: response is not for waiting command.")
+		return
+	}
+	if _, ok := snapshot.offers[peerInfo.PeerId]; ok {
+		return
+	}
+
+	offer := downloadManager.verifyStateSnapshotManifest(manifestPb)
+	if offer == nil && manifestPb.GetManifest() != nil {
+		logger.WithFields(logger.Fields{
+			"peer_id": peerInfo.PeerId,
+		}).Warn("DownloadManager: received state snapshot manifest is invalid.")
+		downloadManager.reportPeer(peerInfo, networkmodel.ViolationInvalidBlocks)
+	}
+	snapshot.offers[peerInfo.PeerId] = offer
+	downloadManager.selectStateSnapshot(len(snapshot.offers) == len(snapshot.requested))
+}
+
+//verifyStateSnapshotManifest returns the offer of a manifest that commits to its block
+func (downloadManager *DownloadManager) verifyStateSnapshotManifest(manifestPb *lblockchainpb.ReturnStateSnapshotManifest) *snapshotOffer {
+	manifest := manifestPb.GetManifest()
+	if manifest == nil || manifestPb.GetBlock() == nil {
+		return nil
+	}
+
+	blk := &block.Block{}
+	blk.FromProto(manifestPb.GetBlock())
+	if !lblock.VerifyHash(blk) ||
+		blk.GetHeight() != manifest.GetHeight() ||
+		bytes.Compare(blk.GetHash(), manifest.GetBlockHash()) != 0 ||
+		bytes.Compare(lblockchain.GetStateSnapshotRoot(manifest), manifest.GetRoot()) != 0 {
+		return nil
+	}
+	return &snapshotOffer{manifest: manifest, block: blk}
+}
+
+//selectStateSnapshot accepts the offer that matches the checkpoint. The blocks are downloaded from the genesis block if
+//no peer serves it once all manifests are received
+func (downloadManager *DownloadManager) selectStateSnapshot(final bool) {
+	snapshot := downloadManager.snapshot
+	config := downloadManager.snapshotConfig
+
+	for _, offer := range snapshot.offers {
+		if offer != nil && bytes.Compare(offer.manifest.GetRoot(), config.CheckpointRoot) == 0 {
+			downloadManager.acceptStateSnapshot(offer)
+			return
+		}
+	}
+	if final {
+		downloadManager.stopSnapshotSync()
+	}
+}
+
+//acceptStateSnapshot downloads the chunks of a snapshot from the peers that serve it
+func (downloadManager *DownloadManager) acceptStateSnapshot(offer *snapshotOffer) {
+	snapshot := downloadManager.snapshot
+	snapshot.accepted = offer
+	for peerId, peerOffer := range snapshot.offers {
+		if peerOffer != nil && bytes.Compare(peerOffer.manifest.GetRoot(), offer.manifest.GetRoot()) == 0 {
+			snapshot.peers[peerId] = true
+		}
+	}
+
+	numOfChunks := len(offer.manifest.GetChunkHashes())
+	snapshot.chunks = make([][]byte, numOfChunks)
+	snapshot.chunkPeers = make([]peer.ID, numOfChunks)
+	snapshot.chunkMsgIds = make([]int32, numOfChunks)
+
+	logger.WithFields(logger.Fields{
+		"height":         offer.manifest.GetHeight(),
+		"num_of_chunks":  numOfChunks,
+		"num_of_sources": len(snapshot.peers),
+	}).Info("DownloadManager: start to fetch the state snapshot.")
+
+	if numOfChunks == 0 {
+		downloadManager.importStateSnapshot()
+		return
+	}
+	downloadManager.assignStateSnapshotChunks()
+}
+
+//assignStateSnapshotChunks requests the chunks that are not downloaded from the least loaded peers
+func (downloadManager *DownloadManager) assignStateSnapshotChunks() {
+	snapshot := downloadManager.snapshot
+	inFlight := 0
+	pending := 0
+
+	for index := range snapshot.chunks {
+		if snapshot.chunks[index] != nil {
+			continue
+		}
+		if snapshot.chunkPeers[index] == "" {
+			if peerId := downloadManager.selectChunkPeer(); peerId != "" {
+				downloadManager.sendGetStateSnapshotChunkCommand(index, peerId)
+			}
+		}
+		if snapshot.chunkPeers[index] != "" {
+			inFlight++
+		} else {
+			pending++
+		}
+	}
+
+	if inFlight == 0 && pending > 0 {
+		downloadManager.stopSnapshotSync()
+	}
+}
+
+//selectChunkPeer returns the least loaded peer that serves the snapshot
+func (downloadManager *DownloadManager) selectChunkPeer() peer.ID {
+	snapshot := downloadManager.snapshot
+	var selected peer.ID
+	for peerId := range snapshot.peers {
+		load := snapshot.peerLoad[peerId]
+		if load >= maxChunksPerPeer {
+			continue
+		}
+		if selected == "" || load < snapshot.peerLoad[selected] {
+			selected = peerId
+		}
+	}
+	return selected
+}
+
+func (downloadManager *DownloadManager) sendGetStateSnapshotChunkCommand(index int, peerId peer.ID) {
+	snapshot := downloadManager.snapshot
+	downloadManager.msgId++
+	msgId := downloadManager.msgId
+
+	snapshot.chunkPeers[index] = peerId
+	snapshot.chunkMsgIds[index] = msgId
+	snapshot.peerLoad[peerId]++
+
+	request := &lblockchainpb.GetStateSnapshotChunk{Root: snapshot.accepted.manifest.GetRoot(), Index: uint32(index)}
+	downloadManager.node.UnicastHighProrityCommand(GetStateSnapshotChunkRequest, request, networkmodel.PeerInfo{PeerId: peerId})
+
+	chunkTimer := time.NewTimer(SnapshotMaxWaitTime * time.Second)
+	go func() {
+		defer log.CrashHandler()
+
+		<-chunkTimer.C
+		chunkTimer.Stop()
+		downloadManager.CheckStateSnapshotChunk(snapshot, index, msgId)
+	}()
+}
+
+//CheckStateSnapshotChunk stops downloading from a peer that did not return a chunk in time
+func (downloadManager *DownloadManager) CheckStateSnapshotChunk(snapshot *snapshotSync, index int, msgId int32) {
+	downloadManager.mutex.Lock()
+	defer downloadManager.mutex.Unlock()
+
+	if downloadManager.status != DownloadStatusSyncSnapshot || downloadManager.snapshot != snapshot {
+		return
+	}
+	if snapshot.chunks[index] != nil || snapshot.chunkMsgIds[index] != msgId || snapshot.chunkPeers[index] == "" {
+		return
+	}
+
+	logger.WithFields(logger.Fields{
+		"peer_id": snapshot.chunkPeers[index],
+		"index":   index,
+	}).Warn("DownloadManager: peer is too slow, reassign the state snapshot chunk to another peer.")
+	downloadManager.removeSnapshotPeer(snapshot.chunkPeers[index])
+}
+
+//removeSnapshotPeer stops waiting for a manifest or for chunks from a peer
+func (downloadManager *DownloadManager) removeSnapshotPeer(peerId peer.ID) {
+	snapshot := downloadManager.snapshot
+	if snapshot.accepted == nil {
+		if _, ok := snapshot.offers[peerId]; snapshot.requested[peerId] && !ok {
+			snapshot.offers[peerId] = nil
+			downloadManager.selectStateSnapshot(len(snapshot.offers) == len(snapshot.requested))
+		}
+		return
+	}
+
+	delete(snapshot.peers, peerId)
+	for index, chunkPeer := range snapshot.chunkPeers {
+		if chunkPeer == peerId && snapshot.chunks[index] == nil {
+			snapshot.chunkPeers[index] = ""
+			snapshot.peerLoad[peerId]--
+		}
+	}
+	downloadManager.assignStateSnapshotChunks()
+}
+
+func (downloadManager *DownloadManager) GetStateSnapshotChunkDataHandler(chunkPb *lblockchainpb.ReturnStateSnapshotChunk, peerInfo networkmodel.PeerInfo) {
+	downloadManager.mutex.Lock()
+	defer downloadManager.mutex.Unlock()
+
+	snapshot := downloadManager.snapshot
+	index := int(chunkPb.GetIndex())
+	if downloadManager.status != DownloadStatusSyncSnapshot || snapshot == nil || snapshot.accepted == nil ||
+		bytes.Compare(chunkPb.GetRoot(), snapshot.accepted.manifest.GetRoot()) != 0 ||
+		index >= len(snapshot.chunks) || snapshot.chunks[index] != nil || snapshot.chunkPeers[index] != peerInfo.PeerId {
+		logger.WithFields(logger.Fields{
+			"name": "GetStateSnapshotChunkResponse",
+		}).Info("DownloadManager: response is not for waiting command.")
+		return
+	}
+
+	if len(chunkPb.GetData()) == 0 {
+		downloadManager.removeSnapshotPeer(peerInfo.PeerId)
+		return
+	}
+	if !lblockchain.VerifyStateSnapshotChunk(snapshot.accepted.manifest, index, chunkPb.GetData()) {
+		logger.WithFields(logger.Fields{
+			"peer_id": peerInfo.PeerId,
+			"index":   index,
+		}).Warn("DownloadManager: received state snapshot chunk does not match the manifest.")
+		downloadManager.reportPeer(peerInfo, networkmodel.ViolationInvalidBlocks)
+		downloadManager.removeSnapshotPeer(peerInfo.PeerId)
+		return
+	}
+
+	snapshot.chunks[index] = chunkPb.GetData()
+	snapshot.peerLoad[peerInfo.PeerId]--
+	snapshot.received++
+	if snapshot.received == len(snapshot.chunks) {
+		downloadManager.importStateSnapshot()
+		return
+	}
+	downloadManager.assignStateSnapshotChunks()
+}
+
+//importStateSnapshot imports the downloaded snapshot and downloads the blocks after it
+func (downloadManager *DownloadManager) importStateSnapshot() {
+	accepted := downloadManager.snapshot.accepted
+	stateSnapshot := &lblockchain.StateSnapshot{Manifest: accepted.manifest, Chunks: downloadManager.snapshot.chunks}
+	if err := downloadManager.bm.ImportStateSnapshot(stateSnapshot, accepted.block); err != nil {
+		logger.WithError(err).Warn("DownloadManager: import the state snapshot failed.")
+		downloadManager.stopSnapshotSync()
+		return
+	}
+
+	logger.WithFields(logger.Fields{
+		"height": accepted.block.GetHeight(),
+		"hash":   accepted.block.GetHash(),
+	}).Info("DownloadManager: state snapshot is imported, download the remaining blocks.")
+	downloadManager.snapshot = nil
+	downloadManager.status = DownloadStatusInit
+	downloadManager.startGetCommonBlocks(0)
+}
+
+func (downloadManager *DownloadManager) GetStateSnapshotManifestRequestHandler(input interface{}) {
+	command := input.(*networkmodel.DappRcvdCmdContext)
+
+	param := &lblockchainpb.GetStateSnapshotManifest{}
+	if err := proto.Unmarshal(command.GetData(), param); err != nil {
+		logger.WithFields(logger.Fields{
+			"name": "GetStateSnapshotManifestRequest",
+		}).Info("DownloadManager: parse data failed.")
+		return
+	}
+
+	downloadManager.SendGetStateSnapshotManifestResponse(param.GetHeight(), command.GetSource())
+}
+
+//SendGetStateSnapshotManifestResponse returns the manifest of the snapshot at the height, or of the latest snapshot if
+//the height is 0. The response is empty if the height is not served, if the peer requested a manifest too recently or
+//if the node can not create the snapshot
+func (downloadManager *DownloadManager) SendGetStateSnapshotManifestResponse(height uint64, destination networkmodel.PeerInfo) {
+	bc := downloadManager.bm.Getblockchain()
+	server := downloadManager.snapshotServer
+	libHeight := bc.GetLIBHeight()
+	if height == 0 {
+		height = server.latestHeight(libHeight)
+	}
+
+	result := &lblockchainpb.ReturnStateSnapshotManifest{}
+	if !server.allowRequest(destination.PeerId, time.Now()) {
+		logger.WithFields(logger.Fields{
+			"peer_id": destination.PeerId,
+		}).Info("DownloadManager: peer requested a state snapshot manifest too recently.")
+	} else if server.isServable(height, libHeight) {
+		snapshot, err := server.getSnapshot(bc, height)
+		if err != nil {
+			logger.WithError(err).WithFields(logger.Fields{
+				"height": height,
+			}).Warn("DownloadManager: create the state snapshot failed.")
+		} else if blk, err := bc.GetBlockByHash(snapshot.Manifest.GetBlockHash()); err == nil {
+			result.Manifest = snapshot.Manifest
+			result.Block = blk.ToProto().(*blockpb.Block)
+		}
+	}
+	downloadManager.node.UnicastHighProrityCommand(GetStateSnapshotManifestResponse, result, destination)
+}
+
+func (downloadManager *DownloadManager) GetStateSnapshotManifestResponseHandler(input interface{}) {
+	command := input.(*networkmodel.DappRcvdCmdContext)
+
+	param := &lblockchainpb.ReturnStateSnapshotManifest{}
+	if err := proto.Unmarshal(command.GetData(), param); err != nil {
+		logger.WithFields(logger.Fields{
+			"name": "GetStateSnapshotManifestResponse",
+		}).Info("DownloadManager: parse data failed.")
+		return
+	}
+
+	downloadManager.GetStateSnapshotManifestDataHandler(param, command.GetSource())
+}
+
+func (downloadManager *DownloadManager) GetStateSnapshotChunkRequestHandler(input interface{}) {
+	command := input.(*networkmodel.DappRcvdCmdContext)
+
+	param := &lblockchainpb.GetStateSnapshotChunk{}
+	if err := proto.Unmarshal(command.GetData(), param); err != nil {
+		logger.WithFields(logger.Fields{
+			"name": "GetStateSnapshotChunkRequest",
+		}).Info("DownloadManager: parse data failed.")
+		return
+	}
+
+	downloadManager.SendGetStateSnapshotChunkResponse(param.GetRoot(), param.GetIndex(), command.GetSource())
+}
+
+//SendGetStateSnapshotChunkResponse returns a chunk of a served snapshot. The data is empty if the snapshot is not cached
+func (downloadManager *DownloadManager) SendGetStateSnapshotChunkResponse(root hash.Hash, index uint32, destination networkmodel.PeerInfo) {
+	result := &lblockchainpb.ReturnStateSnapshotChunk{Root: root, Index: index}
+	if snapshot := downloadManager.snapshotServer.getSnapshotByRoot(root); snapshot != nil && int(index) < len(snapshot.Chunks) {
+		result.Data = snapshot.Chunks[index]
+	}
+	downloadManager.node.UnicastHighProrityCommand(GetStateSnapshotChunkResponse, result, destination)
+}
+
+func (downloadManager *DownloadManager) GetStateSnapshotChunkResponseHandler(input interface{}) {
+	command := input.(*networkmodel.DappRcvdCmdContext)
+
+	param := &lblockchainpb.ReturnStateSnapshotChunk{}
+	if err := proto.Unmarshal(command.GetData(), param); err != nil {
+		logger.WithFields(logger.Fields{
+			"name": "GetStateSnapshotChunkResponse",
+		}).Info("DownloadManager: parse data failed.")
+		return
+	}
+
+	downloadManager.GetStateSnapshotChunkDataHandler(param, command.GetSource())
+}
@@ -0,0 +1,218 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package downloadmanager
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	blockpb "github.com/dappley/go-dappley/core/block/pb"
+	"github.com/dappley/go-dappley/core/blockchain"
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/logic/lblockchain"
+	lblockchainpb "github.com/dappley/go-dappley/logic/lblockchain/pb"
+	"github.com/dappley/go-dappley/network"
+	"github.com/dappley/go-dappley/network/networkmodel"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+const multiPortSnapshotSync int = 10410
+
+//newSnapshotSourceBlockchain returns a blockchain of 40 blocks whose lib is at the height 30
+func newSnapshotSourceBlockchain() *lblockchain.Blockchain {
+	sourceBc := lblockchain.GenerateMockBlockchainWithCoinbaseTxOnly(40)
+	lib, _ := sourceBc.GetBlockByHeight(30)
+	sourceBc.SetLIBHash(lib.GetHash())
+	return sourceBc
+}
+
+func newSnapshotTestManager(t *testing.T, sourceBc *lblockchain.Blockchain, ns *fakeNetService, config *SnapshotSyncConfig) *DownloadManager {
+	downloadManager := NewDownloadManager(ns, newParallelTestManager(lblockchain.CopyMockBlockchain(sourceBc, 0), nil), 0, nil)
+	downloadManager.finishCh = make(chan bool, 1)
+	assert.Nil(t, downloadManager.EnableSnapshotSync(config))
+	for _, peerInfo := range ns.peers {
+		downloadManager.peersInfo[peerInfo.PeerId] = &PeerBlockInfo{peerid: peerInfo.PeerId, height: 40, libHeight: 30, status: PeerStatusReady}
+	}
+	downloadManager.status = DownloadStatusInit
+	downloadManager.startGetCommonBlocks(0)
+
+	assert.Equal(t, DownloadStatusSyncSnapshot, downloadManager.status)
+	for _, peerInfo := range ns.peers {
+		request := ns.getLastSent(peerInfo.PeerId)
+		assert.Equal(t, GetStateSnapshotManifestRequest, request.name)
+		assert.Equal(t, config.CheckpointHeight, request.message.(*lblockchainpb.GetStateSnapshotManifest).GetHeight())
+	}
+	return downloadManager
+}
+
+func getSnapshotManifest(t *testing.T, bc *lblockchain.Blockchain, snapshot *lblockchain.StateSnapshot) *lblockchainpb.ReturnStateSnapshotManifest {
+	blk, err := bc.GetBlockByHeight(snapshot.Manifest.GetHeight())
+	assert.Nil(t, err)
+	return &lblockchainpb.ReturnStateSnapshotManifest{Manifest: snapshot.Manifest, Block: blk.ToProto().(*blockpb.Block)}
+}
+
+func TestDownloadManager_SnapshotSync(t *testing.T) {
+	sourceBc := newSnapshotSourceBlockchain()
+	snapshot, err := sourceBc.CreateStateSnapshot(30)
+	assert.Nil(t, err)
+	oldSnapshot, err := sourceBc.CreateStateSnapshot(20)
+	assert.Nil(t, err)
+
+	ns := newFakeNetService(2)
+	config := &SnapshotSyncConfig{CheckpointHeight: 30, CheckpointRoot: snapshot.Manifest.GetRoot()}
+	downloadManager := newSnapshotTestManager(t, sourceBc, ns, config)
+
+	//a snapshot that does not match the checkpoint is not downloaded
+	peer0, peer1 := ns.peers[0].PeerId, ns.peers[1].PeerId
+	downloadManager.GetStateSnapshotManifestDataHandler(getSnapshotManifest(t, sourceBc, oldSnapshot), ns.peers[0])
+	assert.Nil(t, downloadManager.snapshot.accepted)
+
+	downloadManager.GetStateSnapshotManifestDataHandler(getSnapshotManifest(t, sourceBc, snapshot), ns.peers[1])
+	assert.NotNil(t, downloadManager.snapshot.accepted)
+	request := ns.getLastSent(peer1)
+	assert.Equal(t, GetStateSnapshotChunkRequest, request.name)
+	assert.Equal(t, GetStateSnapshotManifestRequest, ns.getLastSent(peer0).name)
+
+	//the imported snapshot is followed by the download of the remaining blocks
+	chunkRequest := request.message.(*lblockchainpb.GetStateSnapshotChunk)
+	downloadManager.GetStateSnapshotChunkDataHandler(&lblockchainpb.ReturnStateSnapshotChunk{
+		Root:  chunkRequest.GetRoot(),
+		Index: chunkRequest.GetIndex(),
+		Data:  snapshot.Chunks[chunkRequest.GetIndex()],
+	}, ns.peers[1])
+
+	bc := downloadManager.bm.Getblockchain()
+	assert.Equal(t, uint64(30), bc.GetMaxHeight())
+	assert.Equal(t, uint64(30), bc.GetLIBHeight())
+	assert.Nil(t, downloadManager.snapshot)
+	assert.Equal(t, DownloadStatusSyncCommonBlocks, downloadManager.status)
+	assert.Equal(t, GetCommonBlocksRequest, ns.getLastSent(downloadManager.downloadingPeer.peerid).name)
+}
+
+func TestDownloadManager_SnapshotSyncForgedChunk(t *testing.T) {
+	sourceBc := newSnapshotSourceBlockchain()
+	snapshot, err := sourceBc.CreateStateSnapshot(30)
+	assert.Nil(t, err)
+
+	ns := newFakeNetService(1)
+	config := &SnapshotSyncConfig{CheckpointHeight: 30, CheckpointRoot: snapshot.Manifest.GetRoot()}
+	downloadManager := newSnapshotTestManager(t, sourceBc, ns, config)
+	downloadManager.GetStateSnapshotManifestDataHandler(getSnapshotManifest(t, sourceBc, snapshot), ns.peers[0])
+	assert.NotNil(t, downloadManager.snapshot.accepted)
+
+	//a chunk that does not match the manifest drops the peer and the blocks are downloaded from the genesis block once
+	//no peer serves the snapshot
+	downloadManager.GetStateSnapshotChunkDataHandler(&lblockchainpb.ReturnStateSnapshotChunk{
+		Root:  snapshot.Manifest.GetRoot(),
+		Index: 0,
+		Data:  []byte("forged"),
+	}, ns.peers[0])
+	assert.Nil(t, downloadManager.snapshot)
+	assert.Equal(t, uint64(0), downloadManager.bm.Getblockchain().GetMaxHeight())
+	assert.Equal(t, DownloadStatusSyncCommonBlocks, downloadManager.status)
+}
+
+func TestDownloadManager_EnableSnapshotSync(t *testing.T) {
+	downloadManager := NewDownloadManager(newFakeNetService(0), newParallelTestManager(newSnapshotSourceBlockchain(), nil), 0, nil)
+
+	//a snapshot is only trusted if it matches a checkpoint
+	assert.Equal(t, errval.MissingSnapshotCheckpoint, downloadManager.EnableSnapshotSync(&SnapshotSyncConfig{}))
+	assert.Equal(t, errval.MissingSnapshotCheckpoint, downloadManager.EnableSnapshotSync(&SnapshotSyncConfig{CheckpointHeight: 30}))
+	assert.Nil(t, downloadManager.snapshotConfig)
+	assert.Nil(t, downloadManager.EnableSnapshotSync(&SnapshotSyncConfig{CheckpointHeight: 30, CheckpointRoot: []byte("root")}))
+	assert.NotNil(t, downloadManager.snapshotConfig)
+}
+
+func TestDownloadManager_SendStateSnapshotManifest(t *testing.T) {
+	ns := newFakeNetService(4)
+	downloadManager := NewDownloadManager(ns, newParallelTestManager(newSnapshotSourceBlockchain(), nil), 0, nil)
+	downloadManager.SetSnapshotInterval(10)
+	getManifest := func(height uint64, peerInfo networkmodel.PeerInfo) *lblockchainpb.StateSnapshotManifest {
+		downloadManager.SendGetStateSnapshotManifestResponse(height, peerInfo)
+		response := ns.getLastSent(peerInfo.PeerId)
+		assert.Equal(t, GetStateSnapshotManifestResponse, response.name)
+		return response.message.(*lblockchainpb.ReturnStateSnapshotManifest).GetManifest()
+	}
+
+	//only the heights at a multiple of the interval and at or below the lib are served
+	assert.Nil(t, getManifest(25, ns.peers[0]))
+	assert.Nil(t, getManifest(40, ns.peers[1]))
+	manifest := getManifest(30, ns.peers[2])
+	assert.NotNil(t, manifest)
+	assert.Equal(t, uint64(30), manifest.GetHeight())
+
+	//a peer can not request manifests again right away
+	assert.Nil(t, getManifest(30, ns.peers[2]))
+
+	//the cached snapshot is served while another snapshot is not created right after the first one
+	assert.Equal(t, manifest, getManifest(0, ns.peers[3]))
+	downloadManager.snapshotServer.lastRequests = make(map[peer.ID]time.Time)
+	assert.Nil(t, getManifest(20, ns.peers[3]))
+
+	downloadManager.snapshotServer.lastCreated = time.Time{}
+	downloadManager.snapshotServer.lastRequests = make(map[peer.ID]time.Time)
+	assert.Equal(t, uint64(20), getManifest(20, ns.peers[3]).GetHeight())
+}
+
+func TestMultiPeerSnapshotSync(t *testing.T) {
+	defer deleteConfFolderFiles()
+	sourceBc := newSnapshotSourceBlockchain()
+
+	nodes := make([]*network.Node, 3)
+	downloadManagers := make([]*DownloadManager, 3)
+	for i := range nodes {
+		rfl := storage.NewRamFileLoader(confDir, "dl"+strconv.Itoa(i)+".conf")
+		nodes[i] = network.NewNode(rfl.File, nil)
+		nodes[i].Start(multiPortSnapshotSync+i, "")
+		bc := sourceBc
+		if i == 0 {
+			bc = lblockchain.CopyMockBlockchain(sourceBc, 0)
+		}
+		downloadManagers[i] = NewDownloadManager(nodes[i], newParallelTestManager(bc, nodes[i]), 0, nil)
+		downloadManagers[i].SetSnapshotInterval(10)
+		downloadManagers[i].Start()
+	}
+	for i := 1; i < len(nodes); i++ {
+		nodes[0].GetNetwork().ConnectToSeed(nodes[i].GetHostPeerInfo())
+	}
+
+	snapshot, err := sourceBc.CreateStateSnapshot(30)
+	assert.Nil(t, err)
+	assert.Nil(t, downloadManagers[0].EnableSnapshotSync(&SnapshotSyncConfig{CheckpointHeight: 30, CheckpointRoot: snapshot.Manifest.GetRoot()}))
+	bm := downloadManagers[0].bm
+	finishCh := make(chan bool, 1)
+	bm.Getblockchain().SetState(blockchain.BlockchainDownloading)
+	downloadManagers[0].StartDownloadBlockchain(finishCh)
+	select {
+	case <-finishCh:
+	case <-time.After(60 * time.Second):
+		t.Fatal("download did not finish")
+	}
+	bm.Getblockchain().SetState(blockchain.BlockchainReady)
+
+	//only the blocks after the snapshot are downloaded
+	assert.Equal(t, sourceBc.GetTailBlockHash(), bm.Getblockchain().GetTailBlockHash())
+	_, err = bm.Getblockchain().GetBlockByHeight(30)
+	assert.Nil(t, err)
+	_, err = bm.Getblockchain().GetBlockByHeight(10)
+	assert.NotNil(t, err)
+}
@@ -10,6 +10,8 @@ import (
 
 	"github.com/dappley/go-dappley/core/scState"
 	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/logic/lelection"
+	"github.com/dappley/go-dappley/logic/lgovernance"
 	"github.com/dappley/go-dappley/logic/ltransaction"
 	"github.com/dappley/go-dappley/logic/lutxo"
 
@@ -17,6 +19,7 @@ import (
 
 	"github.com/dappley/go-dappley/common"
 	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/core/account"
 	"github.com/dappley/go-dappley/core/block"
 	"github.com/dappley/go-dappley/crypto/keystore/secp256k1"
 	"github.com/dappley/go-dappley/crypto/sha3"
@@ -57,14 +60,20 @@ func CalculateHashWithoutNonce(b *block.Block) hash.Hash {
 }
 
 func CalculateHashWithNonce(b *block.Block) hash.Hash {
+	return CalculateHashWithTxsHash(b, HashTransactions(b))
+}
+
+// CalculateHashWithTxsHash calculates the hash of a block header given the hash of the block's transactions
+func CalculateHashWithTxsHash(b *block.Block, txsHash []byte) hash.Hash {
 	data := bytes.Join(
 		[][]byte{
 			b.GetPrevHash(),
-			HashTransactions(b),
+			txsHash,
 			util.IntToHex(b.GetTimestamp()),
 			//util.IntToHex(targetBits),
 			util.IntToHex(b.GetNonce()),
 			[]byte(b.GetProducer()),
+			b.GetVrfProof(),
 		},
 		[]byte{},
 	)
@@ -106,25 +115,48 @@ func VerifyHash(b *block.Block) bool {
 	return bytes.Compare(b.GetHash(), CalculateHash(b)) == 0
 }
 
-func VerifyTransactions(b *block.Block, utxoIndex *lutxo.UTXOIndex, contractState *scState.ScState, parentBlk *block.Block, db storage.Storage) bool {
+// VerifySignature returns true if the signature of the block recovers a valid public key from its hash. Whether the
+// signer is the producer of the time slot depends on the chain that a node follows and is checked by the consensus
+func VerifySignature(b *block.Block) bool {
+	pubkey, err := secp256k1.RecoverECDSAPublicKey(b.GetHash(), b.GetSign())
+	if err != nil || len(pubkey) == 0 {
+		return false
+	}
+	ok, _ := account.IsValidPubKey(pubkey[1:])
+	return ok
+}
+
+// VerifyTransactions verifies the transactions in the block. A blkGasLimit of 0 skips the block gas limit check
+func VerifyTransactions(b *block.Block, utxoIndex *lutxo.UTXOIndex, contractState *scState.ScState, parentBlk *block.Block, db storage.Storage, blkGasLimit uint64) bool {
+	_, ok := VerifyTransactionsAndCollectReceipts(b, utxoIndex, contractState, parentBlk, db, blkGasLimit)
+	return ok
+}
+
+// VerifyTransactionsAndCollectReceipts verifies the transactions in the block and returns the receipts of the contract executions
+func VerifyTransactionsAndCollectReceipts(b *block.Block, utxoIndex *lutxo.UTXOIndex, contractState *scState.ScState, parentBlk *block.Block, db storage.Storage, blkGasLimit uint64) ([]*transaction.TxReceipt, bool) {
 	if len(b.GetTransactions()) == 0 {
 		logger.WithFields(logger.Fields{
 			"hash":   b.GetHash(),
 			"height": b.GetHeight(),
 		}).Warn("Block: there is no transaction to verify in this block.")
-		return false
+		return nil, false
 	}
 
 	var coinbaseTx *transaction.Transaction
 	totalTip := common.NewAmount(0)
 	totalGasFee := common.NewAmount(0)
 	var actualGasList []uint64
+	var totalGasUsed uint64
 	var rewardTX *transaction.Transaction
 	// originContractGenTxs: generated by contract in tx list
 	var originContractGenTxs []*transaction.Transaction
 	rewards := make(map[string]string)
 	// currentContractGenTXs: generated by contract execution in validation process
 	var currentContractGenTXs []*transaction.Transaction
+	var receipts []*transaction.TxReceipt
+
+	// check the signatures of independent transactions in parallel; the serial pass below reuses the cached results
+	ltransaction.VerifySignaturesInParallel(utxoIndex, b.GetTransactions())
 
 	scEngine := vm.NewV8Engine()
 	defer scEngine.DestroyEngine()
@@ -139,7 +171,7 @@ L:
 					"hash":   b.GetHash(),
 					"height": b.GetHeight(),
 				}).Warn("Block: contains more than 1 reward transaction.")
-				return false
+				return nil, false
 			}
 			rewardTX = tx
 			if !utxoIndex.UpdateUtxo(tx) {
@@ -156,25 +188,37 @@ L:
 				"hash":   b.GetHash(),
 				"height": b.GetHeight(),
 			}).Warn(err.Error())
-			return false
+			return nil, false
 		}
 
 		ctx := ltransaction.NewTxContract(tx)
 		if ctx != nil {
 			// Run the contract and collect generated transactions
-			gasCount, generatedTxs, err := ltransaction.VerifyAndCollectContractOutput(utxoIndex, ctx, contractState, scEngine, b.GetHeight(), parentBlk, rewards, db)
+			gasCount, generatedTxs, receipt, err := ltransaction.VerifyAndCollectContractOutput(utxoIndex, ctx, contractState, scEngine, b.GetHeight(), parentBlk, rewards, db)
 			if err != nil {
 				logger.WithFields(logger.Fields{
 					"hash":   b.GetHash(),
 					"height": b.GetHeight(),
 				}).Warn(err.Error())
-				return false
+				return nil, false
 			}
 			if generatedTxs != nil {
 				currentContractGenTXs = append(currentContractGenTXs, generatedTxs...)
 			}
+			totalGasUsed += gasCount
+			if blkGasLimit > 0 && totalGasUsed > blkGasLimit {
+				logger.WithFields(logger.Fields{
+					"hash":            b.GetHash(),
+					"height":          b.GetHeight(),
+					"gas_used":        totalGasUsed,
+					"block_gas_limit": blkGasLimit,
+				}).Warn("Block: contract executions exceed the block gas limit.")
+				return nil, false
+			}
+			receipts = append(receipts, receipt)
 			totalGasFee = totalGasFee.Add(tx.GasLimit.Mul(tx.GasPrice))
 			actualGasList = append(actualGasList, gasCount*tx.GasPrice.Uint64())
+			ltransaction.BurnGasFee(contractState, common.NewAmount(gasCount).Mul(tx.GasPrice))
 		} else {
 			// tx is a normal transactions
 			if err := ltransaction.VerifyTransaction(utxoIndex, tx, b.GetHeight()); err != nil {
@@ -182,7 +226,21 @@ L:
 					"hash":   b.GetHash(),
 					"height": b.GetHeight(),
 				}).Warn(err.Error())
-				return false
+				return nil, false
+			}
+			if err := lelection.ApplyTransaction(contractState, tx, b.GetHeight()); err != nil {
+				logger.WithFields(logger.Fields{
+					"hash":   b.GetHash(),
+					"height": b.GetHeight(),
+				}).Warn(err.Error())
+				return nil, false
+			}
+			if err := lgovernance.ApplyTransaction(contractState, tx, b.GetHeight()); err != nil {
+				logger.WithFields(logger.Fields{
+					"hash":   b.GetHash(),
+					"height": b.GetHeight(),
+				}).Warn(err.Error())
+				return nil, false
 			}
 			if !utxoIndex.UpdateUtxo(tx) {
 				logger.Warn("VerifyTransactions warn.")
@@ -194,7 +252,7 @@ L:
 					"hash":   b.GetHash(),
 					"height": b.GetHeight(),
 				}).Warn("Block: contains more than 1 coinbase transaction.")
-				return false
+				return nil, false
 			}
 			coinbaseTx = tx
 		}
@@ -204,15 +262,15 @@ L:
 			"hash":   b.GetHash(),
 			"height": b.GetHeight(),
 		}).Warn("Block: missing coinbase tx.")
-		return false
+		return nil, false
 	} else {
 		coinbaseAmount := coinbaseTx.Vout[0].Value
-		if coinbaseAmount == nil || coinbaseAmount.Cmp(transaction.Subsidy.Add(totalTip)) != 0 {
+		if coinbaseAmount == nil || coinbaseAmount.Cmp(transaction.GetMonetaryPolicy().GetReward(b.GetHeight()).Add(totalTip)) != 0 {
 			logger.WithFields(logger.Fields{
 				"hash":   b.GetHash(),
 				"height": b.GetHeight(),
 			}).Warn("Block: coinbase reward is not right.")
-			return false
+			return nil, false
 		}
 	}
 
@@ -221,7 +279,7 @@ L:
 			"hash":   b.GetHash(),
 			"height": b.GetHeight(),
 		}).Warn("Block: txs with gas cannot be verified.")
-		return false
+		return nil, false
 	}
 
 	// Assert that any contract-incurred transactions matches the ones generated from contract execution
@@ -230,24 +288,54 @@ L:
 			"hash":   b.GetHash(),
 			"height": b.GetHeight(),
 		}).Warn("Block: reward tx cannot be verified.")
-		return false
+		return nil, false
 	}
 	if len(originContractGenTxs) > 0 && !verifyGeneratedTXs(utxoIndex, originContractGenTxs, currentContractGenTXs) {
 		logger.WithFields(logger.Fields{
 			"hash":   b.GetHash(),
 			"height": b.GetHeight(),
 		}).Warn("Block: generated tx cannot be verified.")
-		return false
+		return nil, false
 	}
-	return true
+	return receipts, true
 }
 
 // verifyGeneratedTXs verify that transactions generated by gas reward or change is same with its inputs
+// VerifyGasPriceFloor returns false if a contract transaction in the block pays a gas price below gasPriceFloor
+func VerifyGasPriceFloor(b *block.Block, gasPriceFloor uint64) bool {
+	for _, tx := range b.GetTransactions() {
+		if tx.IsContract() && tx.GasPrice != nil && tx.GasPrice.Uint64() < gasPriceFloor {
+			logger.WithFields(logger.Fields{
+				"hash":            b.GetHash(),
+				"height":          b.GetHeight(),
+				"gas_price":       tx.GasPrice.Uint64(),
+				"gas_price_floor": gasPriceFloor,
+			}).Warn("Block: contract transaction pays a gas price below the gas price floor.")
+			return false
+		}
+	}
+	return true
+}
+
 func verifyGasTxs(blockTxs []*transaction.Transaction, totalGasFee *common.Amount, actualGasList []uint64) bool {
 	if totalGasFee.IsZero() && len(actualGasList) == 0 {
 		return true
 	}
 	var err error
+	policy := transaction.GetMonetaryPolicy()
+	// fees that are burnt completely have no reward transaction
+	var rewardedGasList []uint64
+	for _, fee := range actualGasList {
+		if reward, _ := policy.SplitFee(common.NewAmount(fee)); !reward.IsZero() {
+			rewardedGasList = append(rewardedGasList, fee)
+			continue
+		}
+		totalGasFee, err = totalGasFee.Sub(common.NewAmount(fee))
+		if err != nil {
+			return false
+		}
+	}
+	actualGasList = rewardedGasList
 	for _, tx := range blockTxs {
 		adaptedTx := transaction.NewTxAdapter(tx)
 		if adaptedTx.IsGasRewardTx() {
@@ -258,9 +346,9 @@ func verifyGasTxs(blockTxs []*transaction.Transaction, totalGasFee *common.Amoun
 			}
 			isFound := false
 			for i, gasCount := range actualGasList {
-				if gasCount == rewardValue.Uint64() {
+				if reward, _ := policy.SplitFee(common.NewAmount(gasCount)); reward.Cmp(rewardValue) == 0 {
 					actualGasList = append(actualGasList[:i], actualGasList[i+1:]...)
-					totalGasFee, err = totalGasFee.Sub(rewardValue)
+					totalGasFee, err = totalGasFee.Sub(common.NewAmount(gasCount))
 					if err != nil {
 						return false
 					}
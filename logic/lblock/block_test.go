@@ -102,8 +102,8 @@ func TestBlock_VerifyTransactions(t *testing.T) {
 	generatedTX := &transaction.Transaction{
 		generatedTxId,
 		[]transactionbase.TXInput{
-			{[]byte("prevtxid"), 0, []byte("txid"), []byte(contractTA.GetPubKeyHash())},
-			{[]byte("prevtxid"), 1, []byte("txid"), []byte(contractTA.GetPubKeyHash())},
+			{[]byte("prevtxid"), 0, []byte("txid"), []byte(contractTA.GetPubKeyHash()), 0},
+			{[]byte("prevtxid"), 1, []byte("txid"), []byte(contractTA.GetPubKeyHash()), 0},
 		},
 		[]transactionbase.TXOutput{
 			*transactionbase.NewTxOut(common.NewAmount(23), userTA, ""),
@@ -134,6 +134,18 @@ func TestBlock_VerifyTransactions(t *testing.T) {
 	ltransaction.NewTxDecorator(&dependentTx2).Sign(account.GenerateKeyPairByPrivateKey(prikey2).GetPrivateKey(), tx1Utxos[ta2.GetPubKeyHash().String()])
 	ltransaction.NewTxDecorator(&dependentTx3).Sign(account.GenerateKeyPairByPrivateKey(prikey1).GetPrivateKey(), []*utxo.UTXO{&tx2Utxo1})
 
+	producer := account.NewAccount()
+	nonProducer := account.NewAccount()
+	newProducerChangeTx := func(signer *account.Account) (*transaction.Transaction, map[string][]*utxo.UTXO) {
+		fund := &utxo.UTXO{*transactionbase.NewTXOutput(common.NewAmount(10), account.NewTransactionAccountByAddress(signer.GetAddress())), util.GenerateRandomAoB(32), 0, utxo.UtxoNormal, []byte{}, []byte{}}
+		sendTxParam := transaction.NewSendTxParam(signer.GetAddress(), signer.GetKeyPair(), producer.GetAddress(), common.NewAmount(1), common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), `{"height":5,"addresses":"","kind":3}`)
+		tx, err := ltransaction.NewProducerChangeUTXOTransaction([]*utxo.UTXO{fund}, sendTxParam)
+		assert.Nil(t, err)
+		return &tx, map[string][]*utxo.UTXO{signer.GetPubKeyHash().String(): {fund}}
+	}
+	producerChangeTx, producerChangeUtxos := newProducerChangeTx(producer)
+	forgedChangeTx, forgedChangeUtxos := newProducerChangeTx(nonProducer)
+
 	tests := []struct {
 		name  string
 		txs   []*transaction.Transaction
@@ -155,6 +167,7 @@ func TestBlock_VerifyTransactions(t *testing.T) {
 					0,
 					util.GenerateRandomAoB(2),
 					address1Bytes,
+					0,
 				}},
 				Vout: core.MockUtxoOutputsWithInputs(),
 				Tip:  common.NewAmount(5),
@@ -174,6 +187,18 @@ func TestBlock_VerifyTransactions(t *testing.T) {
 			tx1Utxos,
 			false,
 		},
+		{
+			"producer change tx",
+			[]*transaction.Transaction{producerChangeTx},
+			producerChangeUtxos,
+			true,
+		},
+		{
+			"producer change tx signed by a non-producer",
+			[]*transaction.Transaction{forgedChangeTx},
+			forgedChangeUtxos,
+			false,
+		},
 		{
 			"reward tx",
 			[]*transaction.Transaction{&rewardTX},
@@ -236,7 +261,7 @@ func TestBlock_VerifyTransactions(t *testing.T) {
 			coninbaseTx := ltransaction.NewCoinbaseTX(address1TA.GetAddress(), "", parentBlk.GetHeight()+1, totalTip)
 			tt.txs = append(tt.txs, &coninbaseTx)
 			blk := block.NewBlock(tt.txs, parentBlk, "")
-			assert.Equal(t, tt.ok, VerifyTransactions(blk, utxoIndex, scState, parentBlk, db))
+			assert.Equal(t, tt.ok, VerifyTransactions(blk, utxoIndex, scState, parentBlk, db, 0))
 		})
 	}
 }
@@ -511,7 +536,7 @@ func NewTransactionByVin(vinTxId []byte, vinVout int, vinPubkey []byte, voutValu
 	tx := transaction.Transaction{
 		ID: nil,
 		Vin: []transactionbase.TXInput{
-			{vinTxId, vinVout, nil, vinPubkey},
+			{vinTxId, vinVout, nil, vinPubkey, 0},
 		},
 		Vout: []transactionbase.TXOutput{
 			{common.NewAmount(voutValue), voutPubKeyHash, ""},
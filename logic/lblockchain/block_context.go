@@ -3,6 +3,7 @@ package lblockchain
 import (
 	"github.com/dappley/go-dappley/core/block"
 	"github.com/dappley/go-dappley/core/scState"
+	"github.com/dappley/go-dappley/core/transaction"
 	"github.com/dappley/go-dappley/logic/lutxo"
 )
 
@@ -10,4 +11,5 @@ type BlockContext struct {
 	Block     *block.Block
 	UtxoIndex *lutxo.UTXOIndex
 	State     *scState.ScState
+	Receipts  []*transaction.TxReceipt
 }
@@ -32,6 +32,7 @@ import (
 	"github.com/dappley/go-dappley/common/hash"
 	"github.com/dappley/go-dappley/core/block"
 	"github.com/dappley/go-dappley/core/blockchain"
+	"github.com/dappley/go-dappley/core/finality"
 	"github.com/dappley/go-dappley/logic/lblock"
 
 	"github.com/dappley/go-dappley/core/account"
@@ -48,6 +49,8 @@ var UtxoSaveHash = []byte("utxoSaved")
 var (
 	// DefaultGasPrice default price of per gas
 	DefaultGasPrice uint64 = 1
+	// DefaultBlockGasLimit default total gas that the contract executions in a block can consume
+	DefaultBlockGasLimit uint64 = 50000000
 )
 
 type Blockchain struct {
@@ -59,11 +62,20 @@ type Blockchain struct {
 	eventManager *scState.EventManager
 	blkSizeLimit int
 	mutex        *sync.Mutex
+	gasOracle    *GasPriceOracle
+	blkGasLimit  uint64
+	finality     bool
+	gasFloor     uint64
 }
 
 // CreateBlockchain creates a new blockchain db
 func CreateBlockchain(address account.Address, db storage.Storage, libPolicy LIBPolicy, txPool *transactionpool.TransactionPool, blkSizeLimit int) *Blockchain {
-	genesis := NewGenesisBlock(address, transaction.Subsidy)
+	genesis := NewGenesisBlock(address, transaction.GetMonetaryPolicy().GetReward(0))
+	return createBlockchainWithGenesis(genesis, db, libPolicy, txPool, blkSizeLimit)
+}
+
+//createBlockchainWithGenesis creates a new blockchain db that starts from the genesis block
+func createBlockchainWithGenesis(genesis *block.Block, db storage.Storage, libPolicy LIBPolicy, txPool *transactionpool.TransactionPool, blkSizeLimit int) *Blockchain {
 	bc := &Blockchain{
 		blockchain.NewBlockchain(genesis.GetHash(), genesis.GetHash()),
 		db,
@@ -73,6 +85,10 @@ func CreateBlockchain(address account.Address, db storage.Storage, libPolicy LIB
 		scState.NewEventManager(),
 		blkSizeLimit,
 		&sync.Mutex{},
+		NewGasPriceOracle(GasPriceOracleBlocks),
+		DefaultBlockGasLimit,
+		false,
+		0,
 	}
 	utxoIndex := lutxo.NewUTXOIndex(bc.GetUtxoCache())
 	utxoIndex.UpdateUtxos(genesis.GetTransactions())
@@ -101,6 +117,10 @@ func GetBlockchain(db storage.Storage, libPolicy LIBPolicy, txPool *transactionp
 		scState.NewEventManager(),
 		blkSizeLimit,
 		&sync.Mutex{},
+		NewGasPriceOracle(GasPriceOracleBlocks),
+		DefaultBlockGasLimit,
+		false,
+		0,
 	}
 
 	lib, err := bc.getLIB(bc.GetMaxHeight())
@@ -158,6 +178,26 @@ func (bc *Blockchain) GetBlockSizeLimit() int {
 	return bc.blkSizeLimit
 }
 
+// SetBlockGasLimit sets the total gas that the contract executions in a block can consume
+func (bc *Blockchain) SetBlockGasLimit(limit uint64) {
+	bc.blkGasLimit = limit
+}
+
+// GetBlockGasLimit returns the total gas that the contract executions in a block can consume
+func (bc *Blockchain) GetBlockGasLimit() uint64 {
+	return bc.blkGasLimit
+}
+
+// SetGasPriceFloor sets the minimum gas price of the contract transactions in a block
+func (bc *Blockchain) SetGasPriceFloor(floor uint64) {
+	bc.gasFloor = floor
+}
+
+// GetGasPriceFloor returns the minimum gas price of the contract transactions in a block
+func (bc *Blockchain) GetGasPriceFloor() uint64 {
+	return bc.gasFloor
+}
+
 func (bc *Blockchain) GetTailBlock() (*block.Block, error) {
 	hash := bc.GetTailBlockHash()
 	return bc.GetBlockByHash(hash)
@@ -185,6 +225,14 @@ func (bc *Blockchain) GetLIBHeight() uint64 {
 	return block.GetHeight()
 }
 
+func (bc *Blockchain) GetGenesisHash() hash.Hash {
+	hash, err := bc.db.Get(util.UintToHex(0))
+	if err != nil {
+		return nil
+	}
+	return hash
+}
+
 func (bc *Blockchain) GetBlockByHash(hash hash.Hash) (*block.Block, error) {
 	rawBytes, err := bc.db.Get(hash)
 	if err != nil {
@@ -268,6 +316,10 @@ func (bc *Blockchain) Iterator() *Blockchain {
 		nil,
 		bc.blkSizeLimit,
 		bc.mutex,
+		nil,
+		bc.blkGasLimit,
+		bc.finality,
+		bc.gasFloor,
 	}
 }
 
@@ -333,9 +385,33 @@ func (bc *Blockchain) AddBlockToDb(blk *block.Block) error {
 			return err
 		}
 	}
+	if err := bc.indexTransactions(blk); err != nil {
+		logger.WithError(err).Warn("Blockchain: failed to index blk transactions in database!")
+		return err
+	}
 	return nil
 }
 
+//AddReceiptsToDb records the receipts of contract executions in the database
+func (bc *Blockchain) AddReceiptsToDb(receipts []*transaction.TxReceipt) error {
+	for _, receipt := range receipts {
+		if err := receipt.Save(bc.db); err != nil {
+			logger.WithError(err).Warn("Blockchain: failed to add transaction receipt into database!")
+			return err
+		}
+	}
+	return nil
+}
+
+//GetTxReceipt returns the receipt of a contract transaction that has been packed into the blockchain
+func (bc *Blockchain) GetTxReceipt(txid []byte) (*transaction.TxReceipt, error) {
+	receipt, err := transaction.GetTxReceipt(txid, bc.db)
+	if err != nil {
+		return nil, errval.ReceiptNotFound
+	}
+	return receipt, nil
+}
+
 func (bc *Blockchain) IsHigherThanBlockchain(block *block.Block) bool {
 	return block.GetHeight() > bc.GetMaxHeight()
 }
@@ -383,8 +459,11 @@ func (bc *Blockchain) Rollback(index *lutxo.UTXOIndex, targetHash hash.Hash, scS
 		// rollback the transactions in reverse order
 		for i := len(block.GetTransactions()) - 1; i >= 0; i-- {
 			tx := block.GetTransactions()[i]
+			if err := transaction.DeleteTxReceipt(tx.ID, bc.db); err != nil {
+				logger.WithError(err).Debug("Blockchain: no receipt to remove during rollback.")
+			}
 			adaptedTx := transaction.NewTxAdapter(tx)
-			if !adaptedTx.IsCoinbase() && !adaptedTx.IsRewardTx() && !adaptedTx.IsGasRewardTx() && !adaptedTx.IsGasChangeTx() {
+			if !adaptedTx.IsCoinbase() && !adaptedTx.IsRewardTx() && !adaptedTx.IsGasRewardTx() && !adaptedTx.IsGasChangeTx() && !adaptedTx.IsSlash() {
 				bc.txPool.Rollback(*tx)
 			}
 		}
@@ -435,9 +514,17 @@ func (bc *Blockchain) SetLIBHash(hash hash.Hash) {
 	bc.bc.SetLIBHash(hash)
 }
 
-// GasPrice returns gas price in current blockchain
+// GasPrice returns the median gas price suggested by the gas price oracle
 func (bc *Blockchain) GasPrice() uint64 {
-	return DefaultGasPrice
+	return bc.SuggestGasPrice().MedianGasPrice
+}
+
+// SuggestGasPrice returns the gas price and tip suggestions sampled from the recent blocks and the transaction pool
+func (bc *Blockchain) SuggestGasPrice() *GasPriceSuggestion {
+	if bc.gasOracle == nil {
+		return newGasPriceSuggestion(nil, nil)
+	}
+	return bc.gasOracle.Suggest(bc)
 }
 
 func (bc *Blockchain) CheckMinProducerPolicy(blk *block.Block) bool {
@@ -491,6 +578,9 @@ func (bc *Blockchain) isAliveProducerSufficient(blk *block.Block) bool {
 }
 
 func (bc *Blockchain) updateLIB(currBlkHeight uint64) {
+	if bc.finality {
+		return
+	}
 	libHash, err := bc.getLIB(currBlkHeight)
 	if err != nil {
 		logger.Warn("updateLIB failed")
@@ -499,6 +589,58 @@ func (bc *Blockchain) updateLIB(currBlkHeight uint64) {
 	bc.SetLIBHash(libHash)
 }
 
+//EnableFinality makes the LIB advance only when a block is justified by the precommits of the producers
+func (bc *Blockchain) EnableFinality() {
+	bc.finality = true
+	justification, err := finality.GetLastJustification(bc.db)
+	if err != nil {
+		return
+	}
+	bc.SetLIBHash(justification.BlockHash)
+}
+
+//IsFinalityEnabled returns if the LIB is decided by finality votes
+func (bc *Blockchain) IsFinalityEnabled() bool {
+	return bc.finality
+}
+
+//Finalize verifies the justification against the producers, stores it next to its block and makes the block the LIB
+func (bc *Blockchain) Finalize(justification *finality.Justification, producers []string) error {
+	if err := justification.Verify(producers); err != nil {
+		return err
+	}
+
+	bc.mutex.Lock()
+	defer bc.mutex.Unlock()
+
+	blk, err := bc.GetBlockByHeight(justification.Height)
+	if err != nil {
+		return err
+	}
+	if !blk.GetHash().Equals(justification.BlockHash) {
+		return errval.BlockNotOnChain
+	}
+	if lib, err := bc.GetLIB(); err == nil && lib.GetHeight() >= justification.Height {
+		return nil
+	}
+
+	if err := justification.Save(bc.db); err != nil {
+		return err
+	}
+	bc.SetLIBHash(justification.BlockHash)
+
+	logger.WithFields(logger.Fields{
+		"height": justification.Height,
+		"hash":   justification.BlockHash.String(),
+	}).Info("Blockchain: finalized a block.")
+	return nil
+}
+
+//GetJustification returns the justification that made the block irreversible
+func (bc *Blockchain) GetJustification(hash hash.Hash) (*finality.Justification, error) {
+	return finality.GetJustification(hash, bc.db)
+}
+
 func (bc *Blockchain) getLIB(currBlkHeight uint64) (hash.Hash, error) {
 	if bc.libPolicy == nil {
 		return []byte{}, errval.LibPolicyNil
@@ -545,7 +687,7 @@ func DataCheckingAndRecovery(db storage.Storage) error {
 		return err
 	}
 	if !bytes.Equal(uHash, tbHash) { //checking and recovering utxo and scState
-		logger.Info("Incomplete data found, recovering utxo and scState...")
+		logger.Info("Incomplete data found, recovering utxo, scState and receipts...")
 		getBlock := func(hash hash.Hash) *block.Block {
 			rawBytes, err := db.Get(hash)
 			if err != nil {
@@ -557,12 +699,19 @@ func DataCheckingAndRecovery(db storage.Storage) error {
 		parentBlk := getBlock(blk.GetPrevHash())
 		contractStates := scState.NewScState(utxo.NewUTXOCache(db))
 		utxo := lutxo.NewUTXOIndex(utxo.NewUTXOCache(db))
-		if !lblock.VerifyTransactions(blk, utxo, contractStates, parentBlk, db) {
+		// the tail block has already been accepted, so its gas is not checked against the block gas limit again
+		receipts, ok := lblock.VerifyTransactionsAndCollectReceipts(blk, utxo, contractStates, parentBlk, db, 0)
+		if !ok {
 			logger.Warn("get check utxo failed")
 		}
 		if err = contractStates.Save(tbHash); err != nil { //recover scState
 			logger.Warn(err)
 		}
+		for _, receipt := range receipts { //recover receipts
+			if err = receipt.Save(db); err != nil {
+				logger.Warn(err)
+			}
+		}
 
 		utxo.SelfCheckingUTXO()
 		err := utxo.Save()
@@ -584,12 +733,18 @@ func DataCheckingAndRecovery(db storage.Storage) error {
 
 func (bc *Blockchain) saveDataToDb(ctx *BlockContext) error {
 	//Note that changing the code order will cause data checking and recovery errors
-	err := bc.AddBlockToDb(ctx.Block) //1.save block
+	err := bc.AddBlockToDb(ctx.Block) //1.save block and receipts
 	if err != nil {
 		logger.Warn("Failed to add block to db.")
 		return err
 	}
 
+	err = bc.AddReceiptsToDb(ctx.Receipts)
+	if err != nil {
+		logger.Warn("Failed to add receipts to db.")
+		return err
+	}
+
 	err = bc.setTailBlockHash(ctx.Block.GetHash()) //2.save tail block hash
 	if err != nil {
 		logger.Error("Failed to set tail block hash!")
@@ -18,7 +18,8 @@
 package lblockchain
 
 import (
-	"encoding/json"
+	"bytes"
+	"reflect"
 
 	"github.com/dappley/go-dappley/common"
 	errval "github.com/dappley/go-dappley/errors"
@@ -32,6 +33,8 @@ import (
 	"github.com/dappley/go-dappley/core/scState"
 	"github.com/dappley/go-dappley/logic/lblock"
 	lblockchainpb "github.com/dappley/go-dappley/logic/lblockchain/pb"
+	"github.com/dappley/go-dappley/logic/lelection"
+	"github.com/dappley/go-dappley/logic/lgovernance"
 	"github.com/dappley/go-dappley/logic/lutxo"
 	"github.com/dappley/go-dappley/network/networkmodel"
 	"github.com/dappley/go-dappley/storage"
@@ -45,16 +48,13 @@ const (
 	RequestBlock        = "requestBlock"
 )
 
-type Dynasty struct {
-	Height   uint64 `json:"height"`
-	Producer string `json:"addresses"`
-	Kind     int    `json:"kind"`
-}
-
 var (
 	bmSubscribedTopics = []string{
 		SendBlock,
 		RequestBlock,
+		SendCompactBlock,
+		GetBlockTxs,
+		SendBlockTxs,
 	}
 )
 
@@ -64,6 +64,8 @@ type BlockchainManager struct {
 	consensus         Consensus
 	downloadRequestCh chan chan bool
 	netService        NetService
+	liveness          *LivenessTracker
+	compactRelay      *compactBlockRelay
 }
 
 func NewBlockchainManager(blockchain *Blockchain, blockpool *blockchain.BlockPool, service NetService, consensus Consensus) *BlockchainManager {
@@ -73,6 +75,10 @@ func NewBlockchainManager(blockchain *Blockchain, blockpool *blockchain.BlockPoo
 		netService:        service,
 		consensus:         consensus,
 		downloadRequestCh: make(chan chan bool, 100),
+		compactRelay:      newCompactBlockRelay(),
+	}
+	if blockchain != nil {
+		bm.liveness = NewLivenessTracker(blockchain.GetDb(), blockchain.GetMaxHeight())
 	}
 	bm.ListenToNetService()
 	return bm
@@ -80,22 +86,328 @@ func NewBlockchainManager(blockchain *Blockchain, blockpool *blockchain.BlockPoo
 func (bm *BlockchainManager) GetDownloadRequestCh() chan chan bool {
 	return bm.downloadRequestCh
 }
-func (bm *BlockchainManager) SetNewDynasty(original, new string, height uint64, kind int) {
-	bm.consensus.AddReplacement(original, new, height, kind)
+
+//GetProducerStats returns the block production counters of the producers
+func (bm *BlockchainManager) GetProducerStats() []*ProducerStats {
+	return bm.liveness.GetProducerStats()
+}
+
+//CheckDynast updates the dynasty after the block at height was added: slashed producers and producers that missed too
+//many rounds are removed, the block is counted in the producer stats, the governance proposals activated at height are
+//tallied, a new dynasty is elected on election heights and the changes scheduled for height are applied. The producers
+//are stored whenever they change
+func (bm *BlockchainManager) CheckDynast(height uint64) {
+	dynasty := bm.consensus.GetDynasty()
+	if dynasty == nil {
+		return
+	}
+	bm.slashProducers(height)
+	bm.deactivateMissingProducers(height)
+	bm.trackLiveness(height)
+	bm.applyGovernance(height)
+	if bm.isElectionEnabled() {
+		if lelection.IsElectionHeight(height) {
+			bm.electDynasty(height)
+		}
+		bm.applyDynastyChanges(height)
+	}
+	bm.consensus.ChangeDynasty(height)
+	bm.saveDynasty(height)
 }
 
-func (bm *BlockchainManager) SetNewDynastyByString(info, original string) {
-	var dynasty Dynasty
-	err := json.Unmarshal([]byte(info), &dynasty)
+//RestoreDynasty sets the producers of the dynasty to the ones stored for the tail block. The current producers are
+//stored as the initial dynasty if none was stored before
+func (bm *BlockchainManager) RestoreDynasty() {
+	dynasty := bm.consensus.GetDynasty()
+	if dynasty == nil {
+		return
+	}
+	producers, _, err := bm.blockchain.GetDynasty(bm.blockchain.GetMaxHeight())
 	if err != nil {
-		logger.Warn(err.Error())
+		if err := bm.blockchain.SaveDynasty(0, dynasty.GetProducers()); err != nil {
+			logger.WithError(err).Warn("BlockchainManager: failed to save the initial dynasty.")
+		}
+		return
 	}
-	bm.consensus.AddReplacement(original, dynasty.Producer, dynasty.Height, dynasty.Kind)
-	logger.Info("change", dynasty.Kind)
+	dynasty.SetProducers(producers)
+	logger.WithFields(logger.Fields{
+		"producers": producers,
+	}).Info("BlockchainManager: restored the dynasty.")
 }
 
-func (bm *BlockchainManager) CheckDynast(height uint64) {
-	bm.consensus.ChangeDynasty(height)
+//RestoreChainParameters sets the chain parameters to the ones stored for the tail block. The genesis parameters are
+//applied and stored as the initial parameters if none were stored before
+func (bm *BlockchainManager) RestoreChainParameters(genesisParams *ChainParameters) {
+	if bm.consensus.GetDynasty() == nil {
+		return
+	}
+	params, _, err := bm.blockchain.GetChainParameters(bm.blockchain.GetMaxHeight())
+	if err != nil {
+		bm.setChainParameters(genesisParams)
+		if err := bm.blockchain.SaveChainParameters(0, genesisParams); err != nil {
+			logger.WithError(err).Warn("BlockchainManager: failed to save the initial chain parameters.")
+		}
+		return
+	}
+	bm.setChainParameters(params)
+	logger.WithFields(logger.Fields{
+		"params": params,
+	}).Info("BlockchainManager: restored the chain parameters.")
+}
+
+//GetChainParameters returns the chain parameters currently in force
+func (bm *BlockchainManager) GetChainParameters() *ChainParameters {
+	params := &ChainParameters{
+		BlockSizeLimit: bm.blockchain.GetBlockSizeLimit(),
+		GasPriceFloor:  bm.blockchain.GetGasPriceFloor(),
+	}
+	if dynasty := bm.consensus.GetDynasty(); dynasty != nil {
+		params.MaxProducers = dynasty.GetMaxProducers()
+		params.TimeBetweenBlk = dynasty.GetTimeBetweenBlk()
+	}
+	return params
+}
+
+//setChainParameters applies params to the blockchain and the dynasty
+func (bm *BlockchainManager) setChainParameters(params *ChainParameters) {
+	bm.blockchain.SetBlockSizeLimit(params.BlockSizeLimit)
+	bm.blockchain.SetGasPriceFloor(params.GasPriceFloor)
+	if dynasty := bm.consensus.GetDynasty(); dynasty != nil {
+		dynasty.SetMaxProducers(params.MaxProducers)
+		dynasty.SetTimeBetweenBlk(params.TimeBetweenBlk)
+	}
+}
+
+//applyGovernance tallies the proposals activated at height with the votes of the current producers and applies the
+//approved ones. The chain parameters are stored whenever a proposal passes
+func (bm *BlockchainManager) applyGovernance(height uint64) {
+	state := scState.NewScState(bm.blockchain.GetUtxoCache())
+	proposals := lgovernance.GetActivatedProposals(state, height)
+	if len(proposals) == 0 {
+		return
+	}
+	producers := bm.consensus.GetDynasty().GetProducers()
+	params := bm.GetChainParameters()
+	passed := false
+	for _, proposal := range proposals {
+		if !proposal.IsApproved(state, producers) {
+			logger.WithFields(logger.Fields{
+				"height":    height,
+				"proposal":  proposal.ID,
+				"approvals": len(proposal.GetApprovals(state, producers)),
+				"quorum":    lgovernance.Quorum(len(producers)),
+			}).Info("BlockchainManager: a governance proposal did not reach the quorum.")
+			continue
+		}
+		params.Set(proposal.Parameter, proposal.Value)
+		passed = true
+		logger.WithFields(logger.Fields{
+			"height":    height,
+			"proposal":  proposal.ID,
+			"parameter": proposal.Parameter,
+			"value":     proposal.Value,
+		}).Info("BlockchainManager: a governance proposal passed.")
+	}
+	if !passed {
+		return
+	}
+	bm.setChainParameters(params)
+	if err := bm.blockchain.SaveChainParameters(height, params); err != nil {
+		logger.WithError(err).Warn("BlockchainManager: failed to save the chain parameters.")
+	}
+}
+
+//revertChainParameters drops the chain parameters stored for the blocks above height and restores the ones in force
+//at height
+func (bm *BlockchainManager) revertChainParameters(height uint64) {
+	if err := bm.blockchain.DeleteChainParametersAbove(height); err != nil {
+		logger.WithError(err).Warn("BlockchainManager: failed to delete the reverted chain parameters.")
+	}
+	params, _, err := bm.blockchain.GetChainParameters(height)
+	if err != nil {
+		return
+	}
+	bm.setChainParameters(params)
+}
+
+//ImportStateSnapshot imports a state snapshot into a blockchain that only holds the genesis block and loads the
+//consensus state at the block of the snapshot
+func (bm *BlockchainManager) ImportStateSnapshot(snapshot *StateSnapshot, blk *block.Block) error {
+	if err := bm.blockchain.ImportStateSnapshot(snapshot, blk); err != nil {
+		return err
+	}
+	bm.revertChainParameters(blk.GetHeight())
+	bm.revertDynasty(blk.GetHeight())
+	return nil
+}
+
+//trackLiveness counts the block at height and the slots missed before it
+func (bm *BlockchainManager) trackLiveness(height uint64) {
+	blk, err := bm.blockchain.GetBlockByHeight(height)
+	if err != nil {
+		return
+	}
+	parent, err := bm.blockchain.GetBlockByHash(blk.GetPrevHash())
+	if err != nil {
+		parent = nil
+	}
+	var libHeight uint64
+	if lib, err := bm.blockchain.GetLIB(); err == nil {
+		libHeight = lib.GetHeight()
+	}
+	slots, _ := bm.consensus.(SlotPolicy)
+	bm.liveness.Apply(blk, parent, slots, bm.consensus.GetDynasty(), libHeight)
+}
+
+//revertDynasty drops the producers stored for the blocks above height and restores the dynasty at height
+func (bm *BlockchainManager) revertDynasty(height uint64) {
+	dynasty := bm.consensus.GetDynasty()
+	if dynasty == nil {
+		return
+	}
+	if err := bm.blockchain.DeleteDynastiesAbove(height); err != nil {
+		logger.WithError(err).Warn("BlockchainManager: failed to delete the reverted dynasties.")
+	}
+	producers, _, err := bm.blockchain.GetDynasty(height)
+	if err != nil {
+		return
+	}
+	dynasty.SetProducers(producers)
+}
+
+//applyDynastyChanges applies the dynasty changes scheduled for height
+func (bm *BlockchainManager) applyDynastyChanges(height uint64) {
+	dynasty := bm.consensus.GetDynasty()
+	state := scState.NewScState(bm.blockchain.GetUtxoCache())
+	for _, change := range lelection.GetDynastyChanges(state, height) {
+		if !dynasty.ApplyChange(change.Original, change.New, change.Kind) {
+			logger.WithFields(logger.Fields{
+				"height":   height,
+				"original": change.Original,
+				"new":      change.New,
+				"kind":     change.Kind,
+			}).Warn("BlockchainManager: skipped a dynasty change that is not applicable.")
+		}
+	}
+}
+
+//saveDynasty stores the producers of the dynasty if they differ from the ones stored before height
+func (bm *BlockchainManager) saveDynasty(height uint64) {
+	producers := bm.consensus.GetDynasty().GetProducers()
+	stored, _, err := bm.blockchain.GetDynasty(height)
+	if err == nil && reflect.DeepEqual(stored, producers) {
+		return
+	}
+	if err := bm.blockchain.SaveDynasty(height, producers); err != nil {
+		logger.WithError(err).Warn("BlockchainManager: failed to save the dynasty.")
+	}
+}
+
+//slashProducers removes the producers reported by the slash transactions of the block at height from the dynasty. The
+//removal is stored with the dynasty at height, so that reverting the block restores the producers
+func (bm *BlockchainManager) slashProducers(height uint64) {
+	blk, err := bm.blockchain.GetBlockByHeight(height)
+	if err != nil {
+		return
+	}
+	for _, tx := range blk.GetTransactions() {
+		if tx.IsSlash() {
+			bm.SlashProducer(tx.Vout[0].PubKeyHash.GenerateAddress().String())
+		}
+	}
+}
+
+//SlashProducer removes a producer reported for minting two blocks in one time slot from the dynasty
+func (bm *BlockchainManager) SlashProducer(producer string) {
+	dynasty := bm.consensus.GetDynasty()
+	if dynasty == nil {
+		return
+	}
+	if dynasty.RemoveProducer(producer) {
+		logger.WithFields(logger.Fields{
+			"producer": producer,
+		}).Warn("BlockchainManager: slashed a producer for double-minting.")
+	}
+}
+
+//deactivateMissingProducers removes the producers that minted no block in the last rounds of the chain when the block
+//at height starts a new round. Only the rounds in which the chain grew are counted and the producers must have been in
+//charge for all of them, so every node removes the same producers. The removal is stored with the dynasty at height,
+//so that reverting the block restores the producers
+func (bm *BlockchainManager) deactivateMissingProducers(height uint64) {
+	dynasty := bm.consensus.GetDynasty()
+	maxMissedRounds := dynasty.GetMaxMissedRounds()
+	if maxMissedRounds == 0 || dynasty.GetDynastyTime() <= 0 {
+		return
+	}
+	blk, err := bm.blockchain.GetBlockByHeight(height)
+	if err != nil || height == 0 {
+		return
+	}
+	parent, err := bm.blockchain.GetBlockByHash(blk.GetPrevHash())
+	if err != nil || dynasty.GetEpoch(parent.GetTimestamp()) == dynasty.GetEpoch(blk.GetTimestamp()) {
+		return
+	}
+	_, from, err := bm.blockchain.GetDynasty(height)
+	if err != nil {
+		return
+	}
+
+	minted := make(map[string]bool)
+	rounds := uint64(0)
+	lastEpoch := int64(-1)
+	for current := parent; ; {
+		if current.GetHeight() <= from {
+			return
+		}
+		if epoch := dynasty.GetEpoch(current.GetTimestamp()); epoch != lastEpoch {
+			if rounds == maxMissedRounds {
+				break
+			}
+			rounds++
+			lastEpoch = epoch
+		}
+		minted[current.GetProducer()] = true
+		if current, err = bm.blockchain.GetBlockByHash(current.GetPrevHash()); err != nil {
+			return
+		}
+	}
+
+	for _, producer := range dynasty.GetProducers() {
+		if minted[producer] || len(dynasty.GetProducers()) <= 1 {
+			continue
+		}
+		if dynasty.RemoveProducer(producer) {
+			logger.WithFields(logger.Fields{
+				"producer":      producer,
+				"missed_rounds": maxMissedRounds,
+				"height":        height,
+			}).Warn("BlockchainManager: deactivated a producer that missed too many rounds.")
+		}
+	}
+}
+
+//isElectionEnabled returns if the producers of the consensus engine are elected from votes
+func (bm *BlockchainManager) isElectionEnabled() bool {
+	if policy, ok := bm.consensus.(ElectionPolicy); ok {
+		return policy.IsElectionEnabled()
+	}
+	return true
+}
+
+//electDynasty replaces the producers of the dynasty with the candidates that received the most votes
+func (bm *BlockchainManager) electDynasty(height uint64) {
+	dynasty := bm.consensus.GetDynasty()
+	if dynasty == nil {
+		return
+	}
+	state := scState.NewScState(bm.blockchain.GetUtxoCache())
+	producers := lelection.ElectProducers(state, dynasty.GetProducers(), dynasty.GetMaxProducers())
+	logger.WithFields(logger.Fields{
+		"height":    height,
+		"producers": producers,
+	}).Info("BlockchainManager: elected a new dynasty.")
+	dynasty.SetProducers(producers)
 }
 
 func (bm *BlockchainManager) RequestDownloadBlockchain() {
@@ -148,6 +460,12 @@ func (bm *BlockchainManager) GetTopicHandler(topic string) pubsub.TopicHandler {
 		return bm.SendBlockHandler
 	case RequestBlock:
 		return bm.RequestBlockHandler
+	case SendCompactBlock:
+		return bm.SendCompactBlockHandler
+	case GetBlockTxs:
+		return bm.GetBlockTxsHandler
+	case SendBlockTxs:
+		return bm.SendBlockTxsHandler
 	}
 	return nil
 }
@@ -174,6 +492,49 @@ func (bm *BlockchainManager) VerifyBlock(blk *block.Block) bool {
 	return true
 }
 
+//VerifyBlockHeader returns true if the hash of a block header matches its fields and the hash of its transactions and
+//if the header is signed by its producer. It lets a node check the headers of blocks whose bodies are not downloaded yet
+func (bm *BlockchainManager) VerifyBlockHeader(blk *block.Block, txsHash []byte) bool {
+	if !bytes.Equal(blk.GetHash(), lblock.CalculateHashWithTxsHash(blk, txsHash)) {
+		logger.Warn("BlockchainManager: block header hash verification failed!")
+		return false
+	}
+	if verifier, ok := bm.consensus.(HeaderVerifier); ok {
+		return verifier.VerifyHeader(blk)
+	}
+	return true
+}
+
+//verifyProducer checks the producer of a block whose parent is the tail of the blockchain. A block is validated before
+//its parent is known, so the time slot of its producer is only final once the chain up to its parent has been added
+func (bm *BlockchainManager) verifyProducer(blk *block.Block) bool {
+	if verifier, ok := bm.consensus.(ProducerVerifier); ok {
+		return verifier.VerifyProducer(blk)
+	}
+	return true
+}
+
+//reportMalformedBlock reports the peer that sent a block that failed verification only if the block is malformed
+func (bm *BlockchainManager) reportMalformedBlock(blk *block.Block, pid networkmodel.PeerInfo) {
+	if isMalformedBlock(blk) {
+		bm.reportPeer(pid, networkmodel.ViolationInvalidBlock)
+	}
+}
+
+//isMalformedBlock returns true if the block is invalid on any chain: its hash does not match its content or its
+//signature is malformed. The other checks depend on the chain that the node follows, so an honest peer on another fork
+//or with another dynasty may fail them
+func isMalformedBlock(blk *block.Block) bool {
+	return !lblock.VerifyHash(blk) || !lblock.VerifySignature(blk)
+}
+
+//reportPeer reports a peer that violated the protocol if the net service keeps a score of the peers
+func (bm *BlockchainManager) reportPeer(pid networkmodel.PeerInfo, violation networkmodel.Violation) {
+	if reporter, ok := bm.netService.(PeerReporter); ok {
+		reporter.ReportPeer(pid, violation)
+	}
+}
+
 func (bm *BlockchainManager) Push(blk *block.Block, pid networkmodel.PeerInfo) {
 	logger.WithFields(logger.Fields{
 		"from":   pid.PeerId.String(),
@@ -186,6 +547,7 @@ func (bm *BlockchainManager) Push(blk *block.Block, pid networkmodel.PeerInfo) {
 		return
 	}
 	if !bm.VerifyBlock(blk) {
+		bm.reportMalformedBlock(blk, pid)
 		return
 	}
 
@@ -288,6 +650,9 @@ func (bm *BlockchainManager) MergeFork(forkBlks []*block.Block, forkParentHash h
 	if !ok {
 		return nil
 	}
+	bm.liveness.Revert(parentBlk.GetHeight())
+	bm.revertChainParameters(parentBlk.GetHeight())
+	bm.revertDynasty(parentBlk.GetHeight())
 
 	for i := len(forkBlks) - 1; i >= 0; i-- {
 		if !bm.Getblockchain().CheckMinProducerPolicy(forkBlks[i]) {
@@ -299,22 +664,24 @@ func (bm *BlockchainManager) MergeFork(forkBlks []*block.Block, forkParentHash h
 			"hash":   forkBlks[i].GetHash().String(),
 		}).Info("BlockchainManager: is verifying a block in the fork.")
 
+		if !bm.verifyProducer(forkBlks[i]) {
+			return errval.InvalidBlockProducer
+		}
+
+		if !lblock.VerifyGasPriceFloor(forkBlks[i], bm.blockchain.GetGasPriceFloor()) {
+			return errval.GasPriceTooLow
+		}
+
 		contractStates := scState.NewScState(bm.blockchain.GetUtxoCache())
 
-		if !lblock.VerifyTransactions(forkBlks[i], utxo, contractStates, parentBlk, bm.Getblockchain().GetDb()) {
+		receipts, ok := lblock.VerifyTransactionsAndCollectReceipts(forkBlks[i], utxo, contractStates, parentBlk, bm.Getblockchain().GetDb(), bm.Getblockchain().GetBlockGasLimit())
+		if !ok {
 			return errval.TransactionVerifyFailed
 		}
 
-		ctx := BlockContext{forkBlks[i], utxo, contractStates}
+		ctx := BlockContext{forkBlks[i], utxo, contractStates, receipts}
 
 		err = bm.blockchain.AddBlockContextToTail(&ctx)
-
-		for _, tx := range ctx.Block.GetTransactions() {
-			if tx.IsChangeProducter() {
-				bm.SetNewDynastyByString(tx.Vout[0].Contract, tx.Vout[0].PubKeyHash.GenerateAddress().String())
-			}
-		}
-		bm.CheckDynast(ctx.Block.GetHeight())
 		if err != nil {
 			logger.WithFields(logger.Fields{
 				"error":  err,
@@ -322,6 +689,7 @@ func (bm *BlockchainManager) MergeFork(forkBlks []*block.Block, forkParentHash h
 			}).Error("BlockchainManager: add fork to tail failed.")
 			return nil
 		}
+		bm.CheckDynast(ctx.Block.GetHeight())
 		parentBlk = forkBlks[i]
 	}
 
@@ -364,8 +732,12 @@ func (bm *BlockchainManager) SendBlockToPeer(block *block.Block, pid networkmode
 	bm.netService.UnicastNormalPriorityCommand(SendBlock, block.ToProto(), pid)
 }
 
-//BroadcastBlock broadcasts a block to all peers
+//BroadcastBlock sends a block to all peers; through the gossip mesh if the network relays blocks by gossip, otherwise
+//as a compact block to the peers that support compact block relay
 func (bm *BlockchainManager) BroadcastBlock(block *block.Block) {
+	if bm.relayBlock(block, networkmodel.PeerInfo{}) {
+		return
+	}
 	bm.netService.BroadcastHighProrityCommand(SendBlock, block.ToProto())
 }
 
@@ -393,8 +765,40 @@ func (bm *BlockchainManager) SendBlockHandler(input interface{}) {
 	}
 }
 
+//ValidateMessage checks a gossiped block before it is relayed to other peers
+func (bm *BlockchainManager) ValidateMessage(topic string, data []byte) networkmodel.ValidationResult {
+	if topic != SendBlock {
+		return networkmodel.ValidationAccept
+	}
+
+	blockpb := &blockpb.Block{}
+	if err := proto.Unmarshal(data, blockpb); err != nil {
+		return networkmodel.ValidationReject
+	}
+
+	if bm.blockchain.GetState() != blockchain.BlockchainReady {
+		return networkmodel.ValidationIgnore
+	}
+
+	blk := &block.Block{}
+	blk.FromProto(blockpb)
+	if bm.VerifyBlock(blk) {
+		return networkmodel.ValidationAccept
+	}
+	if isMalformedBlock(blk) {
+		return networkmodel.ValidationReject
+	}
+	return networkmodel.ValidationIgnore
+}
+
 // RevertUtxoAndScStateAtBlockHash returns the previous snapshot of UTXOIndex when the block of given hash was the tail block.
 func RevertUtxoAndScStateAtBlockHash(db storage.Storage, bc *Blockchain, hash hash.Hash) (*lutxo.UTXOIndex, *scState.ScState, error) {
+	return revertUtxoAndScState(db, bc, hash, false)
+}
+
+//revertUtxoAndScState computes the utxo index and the contract states at the block by undoing the blocks after it. The
+//blocks before the LIB are only undone if belowLIB is set
+func revertUtxoAndScState(db storage.Storage, bc *Blockchain, hash hash.Hash, belowLIB bool) (*lutxo.UTXOIndex, *scState.ScState, error) {
 	index := lutxo.NewUTXOIndex(bc.GetUtxoCache())
 	//scState := scState.LoadScStateFromDatabase(db)
 	bci := bc.Iterator()
@@ -411,7 +815,7 @@ func RevertUtxoAndScStateAtBlockHash(db storage.Storage, bc *Blockchain, hash ha
 			break
 		}
 
-		if blk.GetHash().Equals(bc.GetLIBHash()) {
+		if !belowLIB && blk.GetHash().Equals(bc.GetLIBHash()) {
 			return nil, nil, errval.BlockDoesNotFound
 		}
 
@@ -1,6 +1,9 @@
 package lblockchain
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
 	"github.com/dappley/go-dappley/common"
 	"github.com/dappley/go-dappley/common/hash"
 	"github.com/dappley/go-dappley/consensus"
@@ -16,6 +19,8 @@ import (
 	"github.com/dappley/go-dappley/logic/lblock"
 	blockchainMock "github.com/dappley/go-dappley/logic/lblockchain/mocks"
 	lblockchainpb "github.com/dappley/go-dappley/logic/lblockchain/pb"
+	"github.com/dappley/go-dappley/logic/lelection"
+	"github.com/dappley/go-dappley/logic/lgovernance"
 	"github.com/dappley/go-dappley/logic/ltransaction"
 	"github.com/dappley/go-dappley/logic/lutxo"
 	"github.com/dappley/go-dappley/logic/transactionpool"
@@ -183,7 +188,7 @@ func TestGetUTXOIndexAtBlockHash(t *testing.T) {
 	normalTX := ltransaction.NewCoinbaseTX(acc.GetAddress(), "", 1, common.NewAmount(5))
 	normalTX2 := transaction.Transaction{
 		hash.Hash("normal2"),
-		[]transactionbase.TXInput{{normalTX.ID, 0, nil, acc.GetKeyPair().GetPublicKey()}},
+		[]transactionbase.TXInput{{normalTX.ID, 0, nil, acc.GetKeyPair().GetPublicKey(), 0}},
 		[]transactionbase.TXOutput{{common.NewAmount(5), acc.GetPubKeyHash(), ""}},
 		common.NewAmount(0),
 		common.NewAmount(0),
@@ -193,7 +198,7 @@ func TestGetUTXOIndexAtBlockHash(t *testing.T) {
 	}
 	abnormalTX := transaction.Transaction{
 		hash.Hash("abnormal"),
-		[]transactionbase.TXInput{{normalTX.ID, 1, nil, nil}},
+		[]transactionbase.TXInput{{normalTX.ID, 1, nil, nil, 0}},
 		[]transactionbase.TXOutput{{common.NewAmount(5), account.PubKeyHash([]byte("pkh")), ""}},
 		common.NewAmount(0),
 		common.NewAmount(0),
@@ -294,59 +299,177 @@ func TestGetUTXOIndexAtBlockHash(t *testing.T) {
 	}
 }
 
-func TestBlockchainManager_SetNewDynasty(t *testing.T) {
-	// create BlockChain
+func newProducerChangeTx(original *account.Account, height uint64, producer string, kind int) *transaction.Transaction {
+	originalAccount := account.NewTransactionAccountByAddress(original.GetAddress())
+	contract := fmt.Sprintf(`{"height":%d,"addresses":"%s","kind":%d}`, height, producer, kind)
+	return &transaction.Transaction{
+		ID:   util.GenerateRandomAoB(32),
+		Vout: []transactionbase.TXOutput{*transactionbase.NewTxOut(common.NewAmount(1), originalAccount, contract)},
+		Tip:  common.NewAmount(0),
+		Type: transaction.TxTypeProducerChange,
+	}
+}
+
+func TestBlockchainManager_CheckDynast(t *testing.T) {
 	bc := CreateBlockchain(account.NewAddress(""), storage.NewRamStorage(), nil, transactionpool.NewTransactionPool(nil, 100), 100)
 	_, err := bc.GetTailBlock()
 	require.Nil(t, err)
 
-	bp := blockchain.NewBlockPool(nil)
+	accounts := []*account.Account{account.NewAccount(), account.NewAccount(), account.NewAccount()}
+	newProducers := []string{account.NewAccount().GetAddress().String(), account.NewAccount().GetAddress().String()}
+	producers := []string{accounts[0].GetAddress().String(), accounts[1].GetAddress().String(), accounts[2].GetAddress().String()}
 
-	producerInfo := blockproducerinfo.NewBlockProducerInfo("address")
-	conss := consensus.NewDPOS(producerInfo)
-	conss.SetDynasty(consensus.NewDynasty([]string{"address1", "address2", "address3"}, 3, 99))
-	bcm := NewBlockchainManager(bc, bp, nil, conss)
-	assert.Equal(t, []string{"address1", "address2", "address3"}, bcm.consensus.(*consensus.DPOS).GetProducers())
+	conss := consensus.NewDPOS(blockproducerinfo.NewBlockProducerInfo(producers[0]))
+	conss.SetDynasty(consensus.NewDynasty(append([]string{}, producers...), 4, 99))
+	bcm := NewBlockchainManager(bc, blockchain.NewBlockPool(nil), nil, conss)
+	bcm.RestoreDynasty()
 
-	bcm.SetNewDynasty("address1", "newaddress1", 1, 1) // type 1: change producer
-	bcm.SetNewDynasty("address2", "newaddress2", 1, 2) // type 2: append producer
-	bcm.SetNewDynasty("address3", "newaddress3", 2, 3) // type 3: delete producer
+	state := scState.NewScState(bc.GetUtxoCache())
+	require.Nil(t, lelection.ApplyTransaction(state, newProducerChangeTx(accounts[0], 1, newProducers[0], lelection.DynastyChangeReplace), 0))
+	require.Nil(t, lelection.ApplyTransaction(state, newProducerChangeTx(accounts[1], 1, newProducers[1], lelection.DynastyChangeAdd), 0))
+	require.Nil(t, lelection.ApplyTransaction(state, newProducerChangeTx(accounts[2], 2, "", lelection.DynastyChangeRemove), 0))
+	require.Nil(t, state.Save(util.GenerateRandomAoB(32)))
+	assert.Equal(t, 3, len(lelection.GetScheduledChanges(state)))
 
 	bcm.CheckDynast(1)
-	expected := []string{"newaddress1", "address2", "address3", "newaddress2"}
-	assert.Equal(t, expected, bcm.consensus.(*consensus.DPOS).GetProducers())
+	expected := []string{newProducers[0], producers[1], producers[2], newProducers[1]}
+	assert.Equal(t, expected, conss.GetProducers())
 	bcm.CheckDynast(2)
-	expected = []string{"newaddress1", "address2", "newaddress2"}
-	assert.Equal(t, expected, bcm.consensus.(*consensus.DPOS).GetProducers())
+	expected = []string{newProducers[0], producers[1], newProducers[1]}
+	assert.Equal(t, expected, conss.GetProducers())
+
+	stored, height, err := bc.GetDynasty(3)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), height)
+	assert.Equal(t, expected, stored)
+
+	// the changes after the fork parent are reverted
+	bcm.revertDynasty(1)
+	assert.Equal(t, []string{newProducers[0], producers[1], producers[2], newProducers[1]}, conss.GetProducers())
+	bcm.revertDynasty(0)
+	assert.Equal(t, producers, conss.GetProducers())
 }
 
-func TestBlockchainManager_SetNewDynastyByString(t *testing.T) {
-	// create BlockChain
+func TestBlockchainManager_CheckDynastSlash(t *testing.T) {
 	bc := CreateBlockchain(account.NewAddress(""), storage.NewRamStorage(), nil, transactionpool.NewTransactionPool(nil, 100), 100)
-	_, err := bc.GetTailBlock()
+	accounts := []*account.Account{account.NewAccount(), account.NewAccount(), account.NewAccount()}
+	producers := []string{accounts[0].GetAddress().String(), accounts[1].GetAddress().String(), accounts[2].GetAddress().String()}
+
+	conss := consensus.NewDPOS(blockproducerinfo.NewBlockProducerInfo(producers[0]))
+	conss.SetDynasty(consensus.NewDynasty(append([]string{}, producers...), 4, 99))
+	bcm := NewBlockchainManager(bc, blockchain.NewBlockPool(nil), nil, conss)
+	bcm.RestoreDynasty()
+
+	tailBlk, err := bc.GetTailBlock()
 	require.Nil(t, err)
+	slashTx := ltransaction.NewSlashTransaction(accounts[2].GetAddress(), "evidence")
+	blk := block.NewBlock([]*transaction.Transaction{&slashTx}, tailBlk, producers[0])
+	blk.SetHash(lblock.CalculateHash(blk))
+	require.Nil(t, bc.AddBlockContextToTail(PrepareBlockContext(bc, blk)))
 
-	bp := blockchain.NewBlockPool(nil)
+	// the slashed producer is removed with the dynasty of the block
+	bcm.CheckDynast(1)
+	assert.Equal(t, producers[:2], conss.GetProducers())
+	stored, height, err := bc.GetDynasty(1)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), height)
+	assert.Equal(t, producers[:2], stored)
 
-	producerInfo := blockproducerinfo.NewBlockProducerInfo("address")
-	conss := consensus.NewDPOS(producerInfo)
-	conss.SetDynasty(consensus.NewDynasty([]string{"address1", "address2", "address3"}, 3, 99))
-	bcm := NewBlockchainManager(bc, bp, nil, conss)
-	assert.Equal(t, []string{"address1", "address2", "address3"}, bcm.consensus.(*consensus.DPOS).GetProducers())
+	// reverting the block restores the slashed producer
+	bcm.revertDynasty(0)
+	assert.Equal(t, producers, conss.GetProducers())
+}
 
-	json1 := `{"height":1,"addresses":"newaddress1","kind":1}`
-	json2 := `{"height":1,"addresses":"newaddress2","kind":2}`
-	json3 := `{"height":2,"addresses":"newaddress3","kind":3}`
-	bcm.SetNewDynastyByString(json1, "address1") // type 1: change producer
-	bcm.SetNewDynastyByString(json2, "address2") // type 2: append producer
-	bcm.SetNewDynastyByString(json3, "address3") // type 3: delete producer
+func TestBlockchainManager_DeactivateMissingProducers(t *testing.T) {
+	bc := CreateBlockchain(account.NewAddress(""), storage.NewRamStorage(), nil, transactionpool.NewTransactionPool(nil, 100), 100)
+	accounts := []*account.Account{account.NewAccount(), account.NewAccount(), account.NewAccount()}
+	producers := []string{accounts[0].GetAddress().String(), accounts[1].GetAddress().String(), accounts[2].GetAddress().String()}
+
+	conss := consensus.NewDPOS(blockproducerinfo.NewBlockProducerInfo(producers[0]))
+	dynasty := consensus.NewDynasty(append([]string{}, producers...), 3, 10)
+	dynasty.SetMaxMissedRounds(2)
+	conss.SetDynasty(dynasty)
+	bcm := NewBlockchainManager(bc, blockchain.NewBlockPool(nil), nil, conss)
+	bcm.RestoreDynasty()
+
+	// the first two producers mint in every round, the third one never does
+	genesis, err := bc.GetTailBlock()
+	require.Nil(t, err)
+	start := (genesis.GetTimestamp()/30 + 1) * 30
+	addBlock := func(timestamp int64, producer string) {
+		tailBlk, err := bc.GetTailBlock()
+		require.Nil(t, err)
+		blk := block.NewBlockWithTimestamp(nil, tailBlk, timestamp, producer)
+		blk.SetHash(lblock.CalculateHash(blk))
+		require.Nil(t, bc.AddBlockContextToTail(PrepareBlockContext(bc, blk)))
+		bcm.CheckDynast(blk.GetHeight())
+	}
+	for round := int64(0); round < 3; round++ {
+		addBlock(start+round*30, producers[0])
+		addBlock(start+round*30+10, producers[1])
+		assert.Equal(t, producers, conss.GetProducers())
+	}
+
+	// the producer is removed once it missed two full rounds of its dynasty
+	addBlock(start+90, producers[0])
+	assert.Equal(t, producers[:2], conss.GetProducers())
+	stored, height, err := bc.GetDynasty(7)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(7), height)
+	assert.Equal(t, producers[:2], stored)
+
+	// reverting the block restores the producer
+	bcm.revertDynasty(6)
+	assert.Equal(t, producers, conss.GetProducers())
+}
 
+func newGovernanceTx(sender *account.Account, payload string) *transaction.Transaction {
+	senderAccount := account.NewTransactionAccountByAddress(sender.GetAddress())
+	return &transaction.Transaction{
+		ID:   util.GenerateRandomAoB(32),
+		Vout: []transactionbase.TXOutput{*transactionbase.NewTxOut(common.NewAmount(0), senderAccount, payload)},
+		Tip:  common.NewAmount(0),
+		Type: transaction.TxTypeGovernance,
+	}
+}
+
+func TestBlockchainManager_ApplyGovernance(t *testing.T) {
+	bc := CreateBlockchain(account.NewAddress(""), storage.NewRamStorage(), nil, transactionpool.NewTransactionPool(nil, 100), 100)
+	accounts := []*account.Account{account.NewAccount(), account.NewAccount(), account.NewAccount()}
+	producers := []string{accounts[0].GetAddress().String(), accounts[1].GetAddress().String(), accounts[2].GetAddress().String()}
+
+	conss := consensus.NewDPOS(blockproducerinfo.NewBlockProducerInfo(producers[0]))
+	conss.SetDynasty(consensus.NewDynasty(append([]string{}, producers...), 4, 15))
+	bcm := NewBlockchainManager(bc, blockchain.NewBlockPool(nil), nil, conss)
+	bcm.RestoreChainParameters(&ChainParameters{BlockSizeLimit: 100, GasPriceFloor: 2, MaxProducers: 4, TimeBetweenBlk: 15})
+	assert.Equal(t, uint64(2), bc.GetGasPriceFloor())
+
+	state := scState.NewScState(bc.GetUtxoCache())
+	passingTx := newGovernanceTx(accounts[0], transactionbase.NewProposalPayload(lgovernance.ParamGasPriceFloor, 10, 1))
+	require.Nil(t, lgovernance.ApplyTransaction(state, passingTx, 0))
+	require.Nil(t, lgovernance.ApplyTransaction(state, newGovernanceTx(accounts[1], transactionbase.NewProposalPayload(lgovernance.ParamMaxProducers, 3, 1)), 0))
+	for _, voter := range accounts[1:] {
+		require.Nil(t, lgovernance.ApplyTransaction(state, newGovernanceTx(voter, transactionbase.NewProposalVotePayload(hex.EncodeToString(passingTx.ID), true)), 0))
+	}
+	require.Nil(t, state.Save(util.GenerateRandomAoB(32)))
+
+	// only the proposal approved by a quorum of the producers is applied
 	bcm.CheckDynast(1)
-	expected := []string{"newaddress1", "address2", "address3", "newaddress2"}
-	assert.Equal(t, expected, bcm.consensus.(*consensus.DPOS).GetProducers())
-	bcm.CheckDynast(2)
-	expected = []string{"newaddress1", "address2", "newaddress2"}
-	assert.Equal(t, expected, bcm.consensus.(*consensus.DPOS).GetProducers())
+	assert.Equal(t, uint64(10), bc.GetGasPriceFloor())
+	assert.Equal(t, 4, conss.GetDynasty().GetMaxProducers())
+	assert.Equal(t, 100, bc.GetBlockSizeLimit())
+
+	params, height, err := bc.GetChainParameters(2)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), height)
+	assert.Equal(t, &ChainParameters{BlockSizeLimit: 100, GasPriceFloor: 10, MaxProducers: 4, TimeBetweenBlk: 15}, params)
+
+	// the parameters after the fork parent are reverted to the genesis parameters
+	bcm.revertChainParameters(0)
+	assert.Equal(t, uint64(2), bc.GetGasPriceFloor())
+	_, height, err = bc.GetChainParameters(2)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), height)
 }
 
 func TestBlockchainManager_GetSubscribedTopics(t *testing.T) {
@@ -361,6 +484,9 @@ func TestBlockchainManager_GetSubscribedTopics(t *testing.T) {
 	expected := []string{
 		SendBlock,
 		RequestBlock,
+		SendCompactBlock,
+		GetBlockTxs,
+		SendBlockTxs,
 	}
 	assert.Equal(t, expected, bcm.GetSubscribedTopics())
 }
@@ -426,6 +552,7 @@ func TestBlockchainManager_VerifyBlock(t *testing.T) {
 
 	// consensus validate failed
 	b2 := block.NewBlockWithRawInfo([]byte{}, blk.GetHash(), 2, 0, 2, []*transaction.Transaction{})
+	require.Nil(t, consensus.GenerateVrfProof(b2, signKey, rand.Reader))
 	b2.SetHash(lblock.CalculateHash(b2))
 	success := lblock.SignBlock(b2, signKey)
 	assert.True(t, success)
@@ -433,6 +560,7 @@ func TestBlockchainManager_VerifyBlock(t *testing.T) {
 
 	// valid block
 	b3 := block.NewBlockWithRawInfo([]byte{}, blk.GetHash(), 3, 0, 3, txs)
+	require.Nil(t, consensus.GenerateVrfProof(b3, signKey, rand.Reader))
 	b3.SetHash(lblock.CalculateHash(b3))
 	success = lblock.SignBlock(b3, signKey)
 	assert.True(t, success)
@@ -440,6 +568,7 @@ func TestBlockchainManager_VerifyBlock(t *testing.T) {
 
 	// double-minting (b4 has same timestamp as b3)
 	b4 := block.NewBlockWithRawInfo([]byte{}, b3.GetHash(), 4, 0, 4, txs)
+	require.Nil(t, consensus.GenerateVrfProof(b4, signKey, rand.Reader))
 	b4.SetHash(lblock.CalculateHash(b4))
 	success = lblock.SignBlock(b4, signKey)
 	assert.True(t, success)
@@ -511,6 +640,7 @@ func TestBlockchainManager_RequestBlockHandler(t *testing.T) {
 		},
 	}
 	blk := block.NewBlockWithRawInfo([]byte{}, genesis.GetHash(), 0, 0, 2, txs)
+	require.Nil(t, consensus.GenerateVrfProof(blk, signKey, rand.Reader))
 	blk.SetHash(lblock.CalculateHash(blk))
 	ok := lblock.SignBlock(blk, signKey)
 	assert.True(t, ok)
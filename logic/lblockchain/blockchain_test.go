@@ -41,6 +41,7 @@ import (
 	"github.com/dappley/go-dappley/core"
 	"github.com/dappley/go-dappley/core/block"
 	"github.com/dappley/go-dappley/core/blockchain"
+	"github.com/dappley/go-dappley/core/finality"
 	"github.com/dappley/go-dappley/logic/lblock"
 
 	"github.com/dappley/go-dappley/common"
@@ -70,6 +71,7 @@ func TestCreateBlockchain(t *testing.T) {
 	blk, err := bc.Next()
 	assert.Nil(t, err)
 	assert.Empty(t, blk.GetPrevHash())
+	assert.Equal(t, DefaultBlockGasLimit, bc.GetBlockGasLimit())
 }
 
 func TestGetBlockchain(t *testing.T) {
@@ -230,6 +232,27 @@ func TestBlockchain_RollbackToABlock(t *testing.T) {
 
 }
 
+func TestBlockchain_RollbackRemovesReceipts(t *testing.T) {
+	bc := GenerateMockBlockchainWithCoinbaseTxOnly(3)
+	defer bc.db.Close()
+
+	blk, err := bc.GetBlockByHeight(2)
+	assert.Nil(t, err)
+	tailBlk, err := bc.GetTailBlock()
+	assert.Nil(t, err)
+
+	receipt := transaction.NewTxReceipt(tailBlk.GetTransactions()[0].ID, 10, nil, nil, nil)
+	assert.Nil(t, bc.AddReceiptsToDb([]*transaction.TxReceipt{receipt}))
+	result, err := bc.GetTxReceipt(receipt.Txid)
+	assert.Nil(t, err)
+	assert.Equal(t, receipt, result)
+
+	bc.Rollback(lutxo.NewUTXOIndex(bc.GetUtxoCache()), blk.GetHash(), scState.NewScState(bc.GetUtxoCache()))
+
+	_, err = bc.GetTxReceipt(receipt.Txid)
+	assert.Equal(t, errval.ReceiptNotFound, err)
+}
+
 func TestBlockchain_AddBlockToTail(t *testing.T) {
 
 	// Serialized data of an empty block (generated using `utx := NewGenesisBlock(Address{}) hex.EncodeToString(utx.Serialize())`)
@@ -238,7 +261,7 @@ func TestBlockchain_AddBlockToTail(t *testing.T) {
 
 	// Create a blockchain for testing
 	addr := account.NewAddress("dGDrVKjCG3sdXtDUgWZ7Fp3Q97tLhqWivf")
-	bc := &Blockchain{blockchain.NewBlockchain(hash.Hash{}, hash.Hash{}), db, utxo.NewUTXOCache(db), nil, transactionpool.NewTransactionPool(nil, 128), nil, 1000000, &sync.Mutex{}}
+	bc := &Blockchain{blockchain.NewBlockchain(hash.Hash{}, hash.Hash{}), db, utxo.NewUTXOCache(db), nil, transactionpool.NewTransactionPool(nil, 128), nil, 1000000, &sync.Mutex{}, nil, DefaultBlockGasLimit, false, 0}
 	bc.SetState(blockchain.BlockchainInit)
 
 	// Add genesis block
@@ -399,6 +422,10 @@ func TestBlockchain_Iterator(t *testing.T) {
 		nil,
 		1000000,
 		bc.mutex,
+		nil,
+		DefaultBlockGasLimit,
+		false,
+		0,
 	}
 	assert.Equal(t, expected, bc.Iterator())
 }
@@ -807,3 +834,50 @@ func GenerateMockBlockchain(size int) *Blockchain {
 	}
 	return bc
 }
+
+func TestBlockchain_Finalize(t *testing.T) {
+	bc := GenerateMockBlockchainWithCoinbaseTxOnly(3)
+	bc.EnableFinality()
+	tailBlk, err := bc.GetTailBlock()
+	assert.Nil(t, err)
+
+	producer := account.NewAccount()
+	producers := []string{producer.GetAddress().String()}
+	precommit := func(blockHash hash.Hash, height uint64) []*finality.Vote {
+		vote := finality.NewVote(finality.Precommit, blockHash, height, producer.GetAddress().String())
+		assert.Nil(t, vote.Sign(hex.EncodeToString(producer.GetKeyPair().GetPrivateKeyBytes())))
+		return []*finality.Vote{vote}
+	}
+
+	// only a block on the canonical chain can be finalized
+	assert.Equal(t, errval.BlockNotOnChain, bc.Finalize(finality.NewJustification([]byte("hash"), tailBlk.GetHeight(), precommit([]byte("hash"), tailBlk.GetHeight())), producers))
+
+	// a justification without the precommits of the producers is rejected
+	assert.Equal(t, errval.InsufficientPrecommits, bc.Finalize(finality.NewJustification(tailBlk.GetHash(), tailBlk.GetHeight(), nil), producers))
+	assert.NotEqual(t, tailBlk.GetHash(), bc.GetLIBHash())
+
+	justification := finality.NewJustification(tailBlk.GetHash(), tailBlk.GetHeight(), precommit(tailBlk.GetHash(), tailBlk.GetHeight()))
+	assert.Nil(t, bc.Finalize(justification, producers))
+	assert.Equal(t, tailBlk.GetHash(), bc.GetLIBHash())
+
+	saved, err := bc.GetJustification(tailBlk.GetHash())
+	assert.Nil(t, err)
+	assert.Equal(t, justification.BlockHash, saved.BlockHash)
+
+	// the LIB does not move by depth once finality is enabled
+	AddBlockToGeneratedBlockchain(bc, 10)
+	assert.Equal(t, tailBlk.GetHash(), bc.GetLIBHash())
+}
+
+func TestBlockchain_GetTransactionBlock(t *testing.T) {
+	bc := GenerateMockBlockchainWithCoinbaseTxOnly(3)
+
+	blk, err := bc.GetBlockByHeight(2)
+	assert.Nil(t, err)
+	txBlk, err := bc.GetTransactionBlock(blk.GetTransactions()[0].ID)
+	assert.Nil(t, err)
+	assert.Equal(t, blk.GetHash(), txBlk.GetHash())
+
+	_, err = bc.GetTransactionBlock([]byte("unknown"))
+	assert.Equal(t, errval.TransactionNotFound, err)
+}
@@ -0,0 +1,74 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package lblockchain
+
+import (
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/logic/lgovernance"
+)
+
+const chainParametersKeyPrefix = "chainParameters_"
+
+var chainParametersHeightsKey = []byte("chainParametersHeights")
+
+// ChainParameters are the chain parameters that are changed by governance proposals
+type ChainParameters struct {
+	BlockSizeLimit int    `json:"block_size_limit"`
+	GasPriceFloor  uint64 `json:"gas_price_floor"`
+	MaxProducers   int    `json:"max_producers"`
+	TimeBetweenBlk int    `json:"time_between_blk"`
+}
+
+// Set changes the chain parameter named by parameter to value
+func (params *ChainParameters) Set(parameter string, value uint64) {
+	switch parameter {
+	case lgovernance.ParamBlockSizeLimit:
+		params.BlockSizeLimit = int(value)
+	case lgovernance.ParamGasPriceFloor:
+		params.GasPriceFloor = value
+	case lgovernance.ParamMaxProducers:
+		params.MaxProducers = int(value)
+	case lgovernance.ParamTimeBetweenBlk:
+		params.TimeBetweenBlk = int(value)
+	}
+}
+
+// SaveChainParameters stores the chain parameters that are in force after the block at height
+func (bc *Blockchain) SaveChainParameters(height uint64, params *ChainParameters) error {
+	return bc.saveSnapshot(chainParametersKeyPrefix, chainParametersHeightsKey, height, params)
+}
+
+// GetChainParameters returns the chain parameters that are in force after the block at height and the height from
+// which they are in force
+func (bc *Blockchain) GetChainParameters(height uint64) (*ChainParameters, uint64, error) {
+	params := &ChainParameters{}
+	from, found, err := bc.getSnapshot(chainParametersKeyPrefix, chainParametersHeightsKey, height, params)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !found {
+		return nil, 0, errval.ChainParametersNotFound
+	}
+	return params, from, nil
+}
+
+// DeleteChainParametersAbove removes the chain parameters stored for the blocks higher than height
+func (bc *Blockchain) DeleteChainParametersAbove(height uint64) error {
+	return bc.deleteSnapshotsAbove(chainParametersKeyPrefix, chainParametersHeightsKey, height)
+}
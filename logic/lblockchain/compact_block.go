@@ -0,0 +1,355 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package lblockchain
+
+import (
+	"sync"
+	"time"
+
+	"github.com/dappley/go-dappley/core/block"
+	blockpb "github.com/dappley/go-dappley/core/block/pb"
+	"github.com/dappley/go-dappley/core/blockchain"
+	"github.com/dappley/go-dappley/core/transaction"
+	transactionpb "github.com/dappley/go-dappley/core/transaction/pb"
+	"github.com/dappley/go-dappley/logic/lblock"
+	lblockchainpb "github.com/dappley/go-dappley/logic/lblockchain/pb"
+	"github.com/dappley/go-dappley/network/networkmodel"
+	"github.com/golang/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru"
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	SendCompactBlock = "SendCompactBlock"
+	GetBlockTxs      = "GetBlockTxs"
+	SendBlockTxs     = "SendBlockTxs"
+
+	shortTxIdLength       = 6
+	recentBlocksCacheSize = 64
+	maxPartialBlocks      = 16
+	partialBlockTimeout   = 10 * time.Second
+)
+
+//partialBlock is a compact block waiting for the transactions that were not found in the transaction pool
+type partialBlock struct {
+	header  *blockpb.BlockHeader
+	txs     []*transaction.Transaction
+	missing []uint32
+	source  networkmodel.PeerInfo
+}
+
+//compactBlockRelay keeps the compact blocks being rebuilt and the blocks recently relayed to the peers
+type compactBlockRelay struct {
+	partialBlocks map[string]*partialBlock
+	recentBlocks  *lru.Cache
+	mutex         sync.Mutex
+}
+
+func newCompactBlockRelay() *compactBlockRelay {
+	recentBlocks, err := lru.New(recentBlocksCacheSize)
+	if err != nil {
+		logger.WithError(err).Panic("BlockchainManager: Can not initialize lru cache for recent blocks!")
+	}
+	return &compactBlockRelay{
+		partialBlocks: make(map[string]*partialBlock),
+		recentBlocks:  recentBlocks,
+	}
+}
+
+//NewCompactBlock returns the header of a block and the short ids of its transactions. The transactions that peers
+//can not have in their transaction pools are sent in full
+func NewCompactBlock(blk *block.Block) *lblockchainpb.CompactBlock {
+	compactBlock := &lblockchainpb.CompactBlock{
+		Header: blk.GetHeader().ToProto().(*blockpb.BlockHeader),
+	}
+	for i, tx := range blk.GetTransactions() {
+		if isPrefilledTx(tx) {
+			compactBlock.PrefilledTxs = append(compactBlock.PrefilledTxs, &lblockchainpb.PrefilledTransaction{
+				Index: uint32(i),
+				Tx:    tx.ToProto().(*transactionpb.Transaction),
+			})
+			continue
+		}
+		compactBlock.ShortIds = append(compactBlock.ShortIds, getShortTxId(tx.ID))
+	}
+	return compactBlock
+}
+
+//isPrefilledTx returns if a transaction is created by the producer of a block and never enters a transaction pool
+func isPrefilledTx(tx *transaction.Transaction) bool {
+	return tx.IsCoinbase() || tx.IsRewardTx() || tx.IsGasRewardTx() || tx.IsGasChangeTx() || tx.IsContractSend()
+}
+
+func getShortTxId(txid []byte) []byte {
+	if len(txid) < shortTxIdLength {
+		return txid
+	}
+	return txid[:shortTxIdLength]
+}
+
+//relayBlock sends a block to the peers except its source; as a compact block to the peers that support it and in
+//full to the others. It returns false if the net service can not tell the capabilities of the peers or relays blocks
+//through its gossip mesh, which only carries full blocks
+func (bm *BlockchainManager) relayBlock(blk *block.Block, source networkmodel.PeerInfo) bool {
+	if gossip, ok := bm.netService.(GossipNetService); ok && gossip.IsGossipTopic(SendBlock) {
+		return false
+	}
+	provider, ok := bm.netService.(CompactBlockNetService)
+	if !ok {
+		return false
+	}
+	bm.compactRelay.recentBlocks.Add(string(blk.GetHash()), blk)
+
+	compactBlock := NewCompactBlock(blk)
+	for _, peerInfo := range provider.GetPeers() {
+		if peerInfo.PeerId == source.PeerId {
+			continue
+		}
+		if provider.GetPeerCapabilities(peerInfo.PeerId).Has(networkmodel.CapabilityCompactBlocks) {
+			bm.netService.UnicastHighProrityCommand(SendCompactBlock, compactBlock, peerInfo)
+		} else {
+			bm.netService.UnicastHighProrityCommand(SendBlock, blk.ToProto(), peerInfo)
+		}
+	}
+	return true
+}
+
+//SendCompactBlockHandler rebuilds a compact block from the transaction pool and requests the missing transactions
+func (bm *BlockchainManager) SendCompactBlockHandler(input interface{}) {
+	command := input.(*networkmodel.DappRcvdCmdContext)
+
+	compactBlock := &lblockchainpb.CompactBlock{}
+	if err := proto.Unmarshal(command.GetData(), compactBlock); err != nil || compactBlock.GetHeader() == nil {
+		logger.WithError(err).Warn("BlockchainManager: parse compact block failed.")
+		bm.reportPeer(command.GetSource(), networkmodel.ViolationBadPacket)
+		return
+	}
+	if bm.blockchain.GetState() != blockchain.BlockchainReady {
+		return
+	}
+
+	blkHash := compactBlock.GetHeader().GetHash()
+	relay := bm.compactRelay
+	relay.mutex.Lock()
+	_, isPartial := relay.partialBlocks[string(blkHash)]
+	if isPartial || relay.recentBlocks.Contains(string(blkHash)) {
+		relay.mutex.Unlock()
+		return
+	}
+	if _, err := bm.blockchain.GetBlockByHash(blkHash); err == nil {
+		relay.mutex.Unlock()
+		return
+	}
+
+	partial := bm.rebuildCompactBlock(compactBlock, command.GetSource())
+	if partial == nil {
+		relay.mutex.Unlock()
+		logger.Warn("BlockchainManager: compact block is malformed.")
+		bm.reportPeer(command.GetSource(), networkmodel.ViolationInvalidBlock)
+		return
+	}
+	if len(partial.missing) == 0 {
+		relay.mutex.Unlock()
+		bm.completePartialBlock(partial)
+		return
+	}
+	if len(relay.partialBlocks) >= maxPartialBlocks {
+		relay.mutex.Unlock()
+		bm.RequestBlock(blkHash, command.GetSource())
+		return
+	}
+	relay.partialBlocks[string(blkHash)] = partial
+	relay.mutex.Unlock()
+	time.AfterFunc(partialBlockTimeout, func() {
+		bm.expirePartialBlock(partial)
+	})
+
+	logger.WithFields(logger.Fields{
+		"hash":    partial.header.GetHash(),
+		"missing": len(partial.missing),
+	}).Debug("BlockchainManager: requests the missing transactions of a compact block.")
+	bm.netService.UnicastHighProrityCommand(GetBlockTxs, &lblockchainpb.GetBlockTransactions{
+		Hash:    blkHash,
+		Indexes: partial.missing,
+	}, command.GetSource())
+}
+
+//GetBlockTxsHandler sends the requested transactions of a block to a peer
+func (bm *BlockchainManager) GetBlockTxsHandler(input interface{}) {
+	command := input.(*networkmodel.DappRcvdCmdContext)
+
+	request := &lblockchainpb.GetBlockTransactions{}
+	if err := proto.Unmarshal(command.GetData(), request); err != nil {
+		logger.WithError(err).Warn("BlockchainManager: parse block transactions request failed.")
+		return
+	}
+
+	var blk *block.Block
+	if recentBlock, ok := bm.compactRelay.recentBlocks.Get(string(request.GetHash())); ok {
+		blk = recentBlock.(*block.Block)
+	} else if storedBlock, err := bm.blockchain.GetBlockByHash(request.GetHash()); err == nil {
+		blk = storedBlock
+	} else {
+		logger.WithError(err).Warn("BlockchainManager: failed to get the block of the requested transactions.")
+		return
+	}
+
+	response := &lblockchainpb.BlockTransactions{Hash: request.GetHash()}
+	txs := blk.GetTransactions()
+	for _, index := range request.GetIndexes() {
+		if int(index) >= len(txs) {
+			logger.WithFields(logger.Fields{
+				"index": index,
+			}).Warn("BlockchainManager: requested transaction index is out of range.")
+			return
+		}
+		response.Transactions = append(response.Transactions, txs[index].ToProto().(*transactionpb.Transaction))
+	}
+	bm.netService.UnicastHighProrityCommand(SendBlockTxs, response, command.GetSource())
+}
+
+//SendBlockTxsHandler completes a compact block with the transactions sent by its source
+func (bm *BlockchainManager) SendBlockTxsHandler(input interface{}) {
+	command := input.(*networkmodel.DappRcvdCmdContext)
+
+	response := &lblockchainpb.BlockTransactions{}
+	if err := proto.Unmarshal(command.GetData(), response); err != nil {
+		logger.WithError(err).Warn("BlockchainManager: parse block transactions failed.")
+		return
+	}
+
+	relay := bm.compactRelay
+	relay.mutex.Lock()
+	partial, ok := relay.partialBlocks[string(response.GetHash())]
+	if !ok || partial.source.PeerId != command.GetSource().PeerId {
+		relay.mutex.Unlock()
+		return
+	}
+	delete(relay.partialBlocks, string(response.GetHash()))
+	relay.mutex.Unlock()
+
+	if len(response.GetTransactions()) != len(partial.missing) {
+		//fall back to the full block
+		bm.RequestBlock(response.GetHash(), command.GetSource())
+		return
+	}
+	for i, index := range partial.missing {
+		tx := &transaction.Transaction{}
+		tx.FromProto(response.GetTransactions()[i])
+		partial.txs[index] = tx
+	}
+	partial.missing = nil
+	bm.completePartialBlock(partial)
+}
+
+//rebuildCompactBlock places the prefilled transactions and the transactions of the pool matching the short ids. It
+//returns nil if the compact block is malformed
+func (bm *BlockchainManager) rebuildCompactBlock(compactBlock *lblockchainpb.CompactBlock, source networkmodel.PeerInfo) *partialBlock {
+	numOfTxs := len(compactBlock.GetShortIds()) + len(compactBlock.GetPrefilledTxs())
+	partial := &partialBlock{
+		header: compactBlock.GetHeader(),
+		txs:    make([]*transaction.Transaction, numOfTxs),
+		source: source,
+	}
+
+	for _, prefilledTx := range compactBlock.GetPrefilledTxs() {
+		index := int(prefilledTx.GetIndex())
+		if index >= numOfTxs || partial.txs[index] != nil || prefilledTx.GetTx() == nil {
+			return nil
+		}
+		tx := &transaction.Transaction{}
+		tx.FromProto(prefilledTx.GetTx())
+		partial.txs[index] = tx
+	}
+
+	poolTxs := make(map[string]*transaction.Transaction)
+	if bm.blockchain.GetTxPool() != nil {
+		for _, tx := range bm.blockchain.GetTxPool().GetAllTransactions() {
+			shortId := string(getShortTxId(tx.ID))
+			if _, collided := poolTxs[shortId]; collided {
+				//the transaction is requested rather than guessed
+				poolTxs[shortId] = nil
+				continue
+			}
+			poolTxs[shortId] = tx
+		}
+	}
+
+	shortIds := compactBlock.GetShortIds()
+	for index := range partial.txs {
+		if partial.txs[index] != nil {
+			continue
+		}
+		if len(shortIds) == 0 {
+			return nil
+		}
+		if tx := poolTxs[string(shortIds[0])]; tx != nil {
+			txCopy := tx.DeepCopy()
+			partial.txs[index] = &txCopy
+		} else {
+			partial.missing = append(partial.missing, uint32(index))
+		}
+		shortIds = shortIds[1:]
+	}
+	return partial
+}
+
+//completePartialBlock adds a rebuilt block to the blockchain and relays it. A block that does not match its hash is
+//requested in full
+func (bm *BlockchainManager) completePartialBlock(partial *partialBlock) {
+	blk := &block.Block{}
+	blk.FromProto(&blockpb.Block{Header: partial.header})
+	blk.SetTransactions(partial.txs)
+
+	if !lblock.VerifyHash(blk) {
+		logger.WithFields(logger.Fields{
+			"hash": blk.GetHash(),
+		}).Info("BlockchainManager: rebuilt compact block does not match its hash; requests the full block.")
+		bm.RequestBlock(blk.GetHash(), partial.source)
+		return
+	}
+	if !bm.VerifyBlock(blk) {
+		bm.reportMalformedBlock(blk, partial.source)
+		return
+	}
+
+	if !bm.relayBlock(blk, partial.source) {
+		bm.netService.BroadcastHighProrityCommand(SendBlock, blk.ToProto())
+	}
+	bm.Push(blk, partial.source)
+}
+
+//expirePartialBlock requests the full block from the source of a compact block whose missing transactions have not
+//arrived in time
+func (bm *BlockchainManager) expirePartialBlock(partial *partialBlock) {
+	relay := bm.compactRelay
+	relay.mutex.Lock()
+	key := string(partial.header.GetHash())
+	if relay.partialBlocks[key] != partial {
+		relay.mutex.Unlock()
+		return
+	}
+	delete(relay.partialBlocks, key)
+	relay.mutex.Unlock()
+
+	logger.WithFields(logger.Fields{
+		"hash": partial.header.GetHash(),
+	}).Info("BlockchainManager: missing transactions of a compact block have not arrived in time; requests the full block.")
+	bm.RequestBlock(partial.header.GetHash(), partial.source)
+}
@@ -0,0 +1,330 @@
+package lblockchain
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"testing"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/common/pubsub"
+	"github.com/dappley/go-dappley/consensus"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/core/blockchain"
+	"github.com/dappley/go-dappley/core/blockproducerinfo"
+	"github.com/dappley/go-dappley/core/transaction"
+	transactionpb "github.com/dappley/go-dappley/core/transaction/pb"
+	"github.com/dappley/go-dappley/core/transactionbase"
+	"github.com/dappley/go-dappley/logic/lblock"
+	blockchainMock "github.com/dappley/go-dappley/logic/lblockchain/mocks"
+	lblockchainpb "github.com/dappley/go-dappley/logic/lblockchain/pb"
+	"github.com/dappley/go-dappley/logic/transactionpool"
+	"github.com/dappley/go-dappley/network/networkmodel"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/dappley/go-dappley/util"
+	"github.com/golang/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const compactBlockSignKey = "bb23d2ff19f5b16955e8a24dca34dd520980fe3bddca2b3e1b56663f0ec1aa71"
+
+type sentCommand struct {
+	name    string
+	message proto.Message
+}
+
+type fakeNetService struct {
+	peers        []networkmodel.PeerInfo
+	capabilities map[peer.ID]networkmodel.Capability
+	sent         map[peer.ID][]sentCommand
+	reported     map[peer.ID][]networkmodel.Violation
+	gossipTopics map[string]bool
+	mutex        sync.Mutex
+}
+
+func newFakeNetService(peers map[peer.ID]networkmodel.Capability) *fakeNetService {
+	ns := &fakeNetService{
+		capabilities: peers,
+		sent:         make(map[peer.ID][]sentCommand),
+		reported:     make(map[peer.ID][]networkmodel.Violation),
+	}
+	for peerId := range peers {
+		ns.peers = append(ns.peers, networkmodel.PeerInfo{PeerId: peerId})
+	}
+	return ns
+}
+
+func (ns *fakeNetService) GetPeers() []networkmodel.PeerInfo   { return ns.peers }
+func (ns *fakeNetService) Listen(subscriber pubsub.Subscriber) {}
+
+func (ns *fakeNetService) IsGossipTopic(commandName string) bool { return ns.gossipTopics[commandName] }
+
+func (ns *fakeNetService) GetPeerCapabilities(peerId peer.ID) networkmodel.Capability {
+	return ns.capabilities[peerId]
+}
+
+func (ns *fakeNetService) UnicastNormalPriorityCommand(commandName string, message proto.Message, destination networkmodel.PeerInfo) {
+	ns.mutex.Lock()
+	defer ns.mutex.Unlock()
+	ns.sent[destination.PeerId] = append(ns.sent[destination.PeerId], sentCommand{commandName, message})
+}
+
+func (ns *fakeNetService) UnicastHighProrityCommand(commandName string, message proto.Message, destination networkmodel.PeerInfo) {
+	ns.UnicastNormalPriorityCommand(commandName, message, destination)
+}
+
+func (ns *fakeNetService) BroadcastNormalPriorityCommand(commandName string, message proto.Message) {
+	for _, peerInfo := range ns.peers {
+		ns.UnicastNormalPriorityCommand(commandName, message, peerInfo)
+	}
+}
+
+func (ns *fakeNetService) BroadcastHighProrityCommand(commandName string, message proto.Message) {
+	ns.BroadcastNormalPriorityCommand(commandName, message)
+}
+
+func (ns *fakeNetService) Relay(dappCmd *networkmodel.DappCmd, destination networkmodel.PeerInfo, priority networkmodel.DappCmdPriority) {
+}
+
+func (ns *fakeNetService) ReportPeer(peerInfo networkmodel.PeerInfo, violation networkmodel.Violation) {
+	ns.mutex.Lock()
+	defer ns.mutex.Unlock()
+	ns.reported[peerInfo.PeerId] = append(ns.reported[peerInfo.PeerId], violation)
+}
+
+//getSent returns the commands sent to a peer and forgets them
+func (ns *fakeNetService) getSent(peerId peer.ID) []sentCommand {
+	ns.mutex.Lock()
+	defer ns.mutex.Unlock()
+	sent := ns.sent[peerId]
+	delete(ns.sent, peerId)
+	return sent
+}
+
+func newCompactBlockTestManager(t *testing.T, ns *fakeNetService) *BlockchainManager {
+	libPolicy := &blockchainMock.LIBPolicy{}
+	libPolicy.On("GetMinConfirmationNum").Return(6)
+	libPolicy.On("IsBypassingLibCheck").Return(true)
+	bc := CreateBlockchain(account.NewAddress(""), storage.NewRamStorage(), libPolicy, transactionpool.NewTransactionPool(nil, 128000), 100000)
+	bc.SetState(blockchain.BlockchainReady)
+	producerInfo := blockproducerinfo.NewBlockProducerInfo(account.NewAccount().GetAddress().String())
+	conss := consensus.NewDPOS(producerInfo)
+	conss.SetDynasty(consensus.NewDynasty([]string{"dc6YApYeNS2MLyrKKvFVDYMGTy7RGQmRzm"}, 1, 99))
+	conss.SetKey(compactBlockSignKey)
+	return NewBlockchainManager(bc, blockchain.NewBlockPool(nil), ns, conss)
+}
+
+func newCompactBlockTestTx(txType transaction.TxType) *transaction.Transaction {
+	tx := &transaction.Transaction{
+		Vin: []transactionbase.TXInput{
+			{Txid: util.GenerateRandomAoB(32), Vout: 0, Signature: util.GenerateRandomAoB(64), PubKey: util.GenerateRandomAoB(64)},
+		},
+		Vout: []transactionbase.TXOutput{
+			{Value: common.NewAmount(10), PubKeyHash: account.PubKeyHash(util.GenerateRandomAoB(21)), Contract: ""},
+		},
+		Tip:      common.NewAmount(1),
+		GasLimit: common.NewAmount(0),
+		GasPrice: common.NewAmount(0),
+		Type:     txType,
+	}
+	if txType == transaction.TxTypeCoinbase {
+		//the producer of the test blocks is the beneficiary
+		tx.Vin[0].PubKey = nil
+		tx.Vout[0].PubKeyHash = account.PubKeyHash{0x5a, 0xf8, 0xbf, 0x23, 0x39, 0x70, 0xf0, 0x9b, 0x65, 0x31, 0x98, 0xca, 0xed, 0x6c, 0xb6, 0x13, 0xb, 0x77, 0xd, 0x6f, 0x5}
+	}
+	tx.ID = tx.Hash()
+	return tx
+}
+
+func newCompactBlockTestBlock(t *testing.T, bm *BlockchainManager, txs []*transaction.Transaction) *block.Block {
+	genesis, err := bm.Getblockchain().GetTailBlock()
+	require.Nil(t, err)
+	blk := block.NewBlockWithRawInfo([]byte{}, genesis.GetHash(), 1, 0, 1, txs)
+	require.Nil(t, consensus.GenerateVrfProof(blk, compactBlockSignKey, rand.Reader))
+	blk.SetHash(lblock.CalculateHash(blk))
+	require.True(t, lblock.SignBlock(blk, compactBlockSignKey))
+	return blk
+}
+
+func newCompactBlockTestContext(name string, message proto.Message, source peer.ID) *networkmodel.DappRcvdCmdContext {
+	data, _ := proto.Marshal(message)
+	return networkmodel.NewDappRcvdCmdContext(networkmodel.NewDappCmd(name, data, false), networkmodel.PeerInfo{PeerId: source})
+}
+
+func TestNewCompactBlock(t *testing.T) {
+	coinbaseTx := newCompactBlockTestTx(transaction.TxTypeCoinbase)
+	txs := []*transaction.Transaction{coinbaseTx}
+	for i := 0; i < 10; i++ {
+		txs = append(txs, newCompactBlockTestTx(transaction.TxTypeNormal))
+	}
+	blk := block.NewBlockWithRawInfo([]byte("hash"), []byte("prevHash"), 1, 0, 1, txs)
+
+	compactBlock := NewCompactBlock(blk)
+	assert.Equal(t, []byte(blk.GetHash()), compactBlock.GetHeader().GetHash())
+	assert.Equal(t, 1, len(compactBlock.GetPrefilledTxs()))
+	assert.Equal(t, uint32(0), compactBlock.GetPrefilledTxs()[0].GetIndex())
+	assert.Equal(t, []byte(coinbaseTx.ID), compactBlock.GetPrefilledTxs()[0].GetTx().GetId())
+	assert.Equal(t, 10, len(compactBlock.GetShortIds()))
+	for i, shortId := range compactBlock.GetShortIds() {
+		assert.Equal(t, []byte(txs[i+1].ID[:shortTxIdLength]), shortId)
+	}
+
+	compactBlockBytes, err := proto.Marshal(compactBlock)
+	assert.Nil(t, err)
+	blockBytes, err := proto.Marshal(blk.ToProto())
+	assert.Nil(t, err)
+	assert.True(t, len(compactBlockBytes)*4 < len(blockBytes))
+}
+
+func TestBlockchainManager_CompactBlockRelay(t *testing.T) {
+	senderId, receiverId, legacyId, thirdId := peer.ID("sender"), peer.ID("receiver"), peer.ID("legacy"), peer.ID("third")
+	senderNet := newFakeNetService(map[peer.ID]networkmodel.Capability{
+		receiverId: networkmodel.CapabilityCompactBlocks,
+		legacyId:   0,
+	})
+	receiverNet := newFakeNetService(map[peer.ID]networkmodel.Capability{
+		senderId: networkmodel.CapabilityCompactBlocks,
+		thirdId:  networkmodel.CapabilityCompactBlocks,
+	})
+	sender := newCompactBlockTestManager(t, senderNet)
+	receiver := newCompactBlockTestManager(t, receiverNet)
+
+	txs := []*transaction.Transaction{newCompactBlockTestTx(transaction.TxTypeCoinbase)}
+	for i := 0; i < 3; i++ {
+		txs = append(txs, newCompactBlockTestTx(transaction.TxTypeNormal))
+	}
+	//the receiver misses the last transaction
+	receiver.Getblockchain().GetTxPool().Push(*txs[1])
+	receiver.Getblockchain().GetTxPool().Push(*txs[2])
+	blk := newCompactBlockTestBlock(t, sender, txs)
+
+	sender.BroadcastBlock(blk)
+	sent := senderNet.getSent(legacyId)
+	assert.Equal(t, 1, len(sent))
+	assert.Equal(t, SendBlock, sent[0].name)
+	sent = senderNet.getSent(receiverId)
+	assert.Equal(t, 1, len(sent))
+	assert.Equal(t, SendCompactBlock, sent[0].name)
+
+	//the receiver requests the missing transaction
+	receiver.SendCompactBlockHandler(newCompactBlockTestContext(SendCompactBlock, sent[0].message, senderId))
+	sent = receiverNet.getSent(senderId)
+	assert.Equal(t, 1, len(sent))
+	assert.Equal(t, GetBlockTxs, sent[0].name)
+	assert.Equal(t, []uint32{3}, sent[0].message.(*lblockchainpb.GetBlockTransactions).GetIndexes())
+
+	//a compact block being rebuilt is not handled twice
+	receiver.SendCompactBlockHandler(newCompactBlockTestContext(SendCompactBlock, NewCompactBlock(blk), thirdId))
+	assert.Equal(t, 0, len(receiverNet.getSent(thirdId)))
+
+	sender.GetBlockTxsHandler(newCompactBlockTestContext(GetBlockTxs, sent[0].message, receiverId))
+	sent = senderNet.getSent(receiverId)
+	assert.Equal(t, 1, len(sent))
+	assert.Equal(t, SendBlockTxs, sent[0].name)
+
+	//the rebuilt block is relayed to the other peers
+	receiver.SendBlockTxsHandler(newCompactBlockTestContext(SendBlockTxs, sent[0].message, senderId))
+	assert.Equal(t, 0, len(receiverNet.getSent(senderId)))
+	sent = receiverNet.getSent(thirdId)
+	assert.Equal(t, 1, len(sent))
+	assert.Equal(t, SendCompactBlock, sent[0].name)
+	relayed, ok := receiver.compactRelay.recentBlocks.Get(string(blk.GetHash()))
+	assert.True(t, ok)
+	assert.Equal(t, blk.GetHash(), relayed.(*block.Block).GetHash())
+	assert.Equal(t, len(txs), len(relayed.(*block.Block).GetTransactions()))
+}
+
+func TestBlockchainManager_CompactBlockFallback(t *testing.T) {
+	senderId := peer.ID("sender")
+	receiverNet := newFakeNetService(map[peer.ID]networkmodel.Capability{
+		senderId: networkmodel.CapabilityCompactBlocks,
+	})
+	receiver := newCompactBlockTestManager(t, receiverNet)
+
+	txs := []*transaction.Transaction{newCompactBlockTestTx(transaction.TxTypeCoinbase), newCompactBlockTestTx(transaction.TxTypeNormal)}
+	blk := newCompactBlockTestBlock(t, receiver, txs)
+
+	receiver.SendCompactBlockHandler(newCompactBlockTestContext(SendCompactBlock, NewCompactBlock(blk), senderId))
+	sent := receiverNet.getSent(senderId)
+	assert.Equal(t, 1, len(sent))
+	assert.Equal(t, GetBlockTxs, sent[0].name)
+
+	//a transaction that does not match the block is replaced by the full block
+	wrongTx := newCompactBlockTestTx(transaction.TxTypeNormal)
+	receiver.SendBlockTxsHandler(newCompactBlockTestContext(SendBlockTxs, &lblockchainpb.BlockTransactions{
+		Hash:         blk.GetHash(),
+		Transactions: []*transactionpb.Transaction{wrongTx.ToProto().(*transactionpb.Transaction)},
+	}, senderId))
+	sent = receiverNet.getSent(senderId)
+	assert.Equal(t, 1, len(sent))
+	assert.Equal(t, RequestBlock, sent[0].name)
+	assert.Equal(t, []byte(blk.GetHash()), sent[0].message.(*lblockchainpb.RequestBlock).GetHash())
+}
+
+func TestBlockchainManager_CompactBlockExpiry(t *testing.T) {
+	senderId := peer.ID("sender")
+	receiverNet := newFakeNetService(map[peer.ID]networkmodel.Capability{
+		senderId: networkmodel.CapabilityCompactBlocks,
+	})
+	receiver := newCompactBlockTestManager(t, receiverNet)
+
+	txs := []*transaction.Transaction{newCompactBlockTestTx(transaction.TxTypeCoinbase), newCompactBlockTestTx(transaction.TxTypeNormal)}
+	blk := newCompactBlockTestBlock(t, receiver, txs)
+	receiver.SendCompactBlockHandler(newCompactBlockTestContext(SendCompactBlock, NewCompactBlock(blk), senderId))
+	receiverNet.getSent(senderId)
+	partial, ok := receiver.compactRelay.partialBlocks[string(blk.GetHash())]
+	require.True(t, ok)
+
+	//the full block is requested when the missing transactions do not arrive in time
+	receiver.expirePartialBlock(partial)
+	assert.Empty(t, receiver.compactRelay.partialBlocks)
+	sent := receiverNet.getSent(senderId)
+	assert.Equal(t, 1, len(sent))
+	assert.Equal(t, RequestBlock, sent[0].name)
+	assert.Equal(t, []byte(blk.GetHash()), sent[0].message.(*lblockchainpb.RequestBlock).GetHash())
+
+	//a partial block that has been completed is not requested again
+	receiver.expirePartialBlock(partial)
+	assert.Empty(t, receiverNet.getSent(senderId))
+}
+
+func TestBlockchainManager_BroadcastBlockByGossip(t *testing.T) {
+	peerId := peer.ID("peer")
+	ns := newFakeNetService(map[peer.ID]networkmodel.Capability{peerId: networkmodel.CapabilityCompactBlocks})
+	ns.gossipTopics = map[string]bool{SendBlock: true}
+	bm := newCompactBlockTestManager(t, ns)
+	blk := newCompactBlockTestBlock(t, bm, []*transaction.Transaction{newCompactBlockTestTx(transaction.TxTypeCoinbase)})
+
+	//the gossip mesh only carries full blocks
+	bm.BroadcastBlock(blk)
+	sent := ns.getSent(peerId)
+	assert.Equal(t, 1, len(sent))
+	assert.Equal(t, SendBlock, sent[0].name)
+}
+
+func TestBlockchainManager_ReportMalformedBlock(t *testing.T) {
+	senderId := peer.ID("sender")
+	ns := newFakeNetService(map[peer.ID]networkmodel.Capability{senderId: 0})
+	bm := newCompactBlockTestManager(t, ns)
+	txs := []*transaction.Transaction{newCompactBlockTestTx(transaction.TxTypeCoinbase)}
+
+	//a block signed by another producer may be valid on the chain of the sender
+	otherKey := hex.EncodeToString(account.NewAccount().GetKeyPair().GetPrivateKeyBytes())
+	blk := newCompactBlockTestBlock(t, bm, txs)
+	require.True(t, lblock.SignBlock(blk, otherKey))
+	blkpb, _ := proto.Marshal(blk.ToProto())
+	bm.Push(blk, networkmodel.PeerInfo{PeerId: senderId})
+	assert.Empty(t, ns.reported[senderId])
+	assert.Equal(t, networkmodel.ValidationIgnore, bm.ValidateMessage(SendBlock, blkpb))
+
+	//a block that does not match its hash is invalid on any chain
+	blk = newCompactBlockTestBlock(t, bm, txs)
+	blk.SetHash(util.GenerateRandomAoB(32))
+	blkpb, _ = proto.Marshal(blk.ToProto())
+	bm.Push(blk, networkmodel.PeerInfo{PeerId: senderId})
+	assert.Equal(t, []networkmodel.Violation{networkmodel.ViolationInvalidBlock}, ns.reported[senderId])
+	assert.Equal(t, networkmodel.ValidationReject, bm.ValidateMessage(SendBlock, blkpb))
+}
@@ -0,0 +1,130 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package lblockchain
+
+import (
+	"encoding/json"
+	"strconv"
+
+	errval "github.com/dappley/go-dappley/errors"
+)
+
+const dynastyKeyPrefix = "dynasty_"
+
+var dynastyHeightsKey = []byte("dynastyHeights")
+
+// SaveDynasty stores the producers that are in charge from the block at height on
+func (bc *Blockchain) SaveDynasty(height uint64, producers []string) error {
+	return bc.saveSnapshot(dynastyKeyPrefix, dynastyHeightsKey, height, producers)
+}
+
+// GetDynasty returns the producers that are in charge at height and the height from which they are in charge
+func (bc *Blockchain) GetDynasty(height uint64) ([]string, uint64, error) {
+	var producers []string
+	from, found, err := bc.getSnapshot(dynastyKeyPrefix, dynastyHeightsKey, height, &producers)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !found {
+		return nil, 0, errval.DynastyNotFound
+	}
+	return producers, from, nil
+}
+
+// DeleteDynastiesAbove removes the producers stored for the blocks higher than height
+func (bc *Blockchain) DeleteDynastiesAbove(height uint64) error {
+	return bc.deleteSnapshotsAbove(dynastyKeyPrefix, dynastyHeightsKey, height)
+}
+
+// saveSnapshot stores v as the value that holds from the block at height on. Values stored for higher blocks are
+// dropped from the index of heightsKey
+func (bc *Blockchain) saveSnapshot(prefix string, heightsKey []byte, height uint64, v interface{}) error {
+	bytes, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if err := bc.db.Put(getSnapshotKey(prefix, height), bytes); err != nil {
+		return err
+	}
+
+	var heights []uint64
+	for _, h := range bc.getSnapshotHeights(heightsKey) {
+		if h < height {
+			heights = append(heights, h)
+		}
+	}
+	return bc.putSnapshotHeights(heightsKey, append(heights, height))
+}
+
+// getSnapshot loads the value that holds at height into v and returns the height from which it holds; false if no
+// value was stored for height or a lower block
+func (bc *Blockchain) getSnapshot(prefix string, heightsKey []byte, height uint64, v interface{}) (uint64, bool, error) {
+	heights := bc.getSnapshotHeights(heightsKey)
+	for i := len(heights) - 1; i >= 0; i-- {
+		if heights[i] > height {
+			continue
+		}
+		bytes, err := bc.db.Get(getSnapshotKey(prefix, heights[i]))
+		if err != nil {
+			return 0, false, err
+		}
+		if err := json.Unmarshal(bytes, v); err != nil {
+			return 0, false, err
+		}
+		return heights[i], true, nil
+	}
+	return 0, false, nil
+}
+
+// deleteSnapshotsAbove removes the values stored for the blocks higher than height
+func (bc *Blockchain) deleteSnapshotsAbove(prefix string, heightsKey []byte, height uint64) error {
+	var heights []uint64
+	for _, h := range bc.getSnapshotHeights(heightsKey) {
+		if h <= height {
+			heights = append(heights, h)
+			continue
+		}
+		if err := bc.db.Del(getSnapshotKey(prefix, h)); err != nil {
+			return err
+		}
+	}
+	return bc.putSnapshotHeights(heightsKey, heights)
+}
+
+func (bc *Blockchain) getSnapshotHeights(heightsKey []byte) []uint64 {
+	var heights []uint64
+	bytes, err := bc.db.Get(heightsKey)
+	if err != nil {
+		return nil
+	}
+	json.Unmarshal(bytes, &heights)
+	return heights
+}
+
+func (bc *Blockchain) putSnapshotHeights(heightsKey []byte, heights []uint64) error {
+	bytes, err := json.Marshal(heights)
+	if err != nil {
+		return err
+	}
+	return bc.db.Put(heightsKey, bytes)
+}
+
+func getSnapshotKey(prefix string, height uint64) []byte {
+	return []byte(prefix + strconv.FormatUint(height, 10))
+}
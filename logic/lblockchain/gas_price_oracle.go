@@ -0,0 +1,152 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+package lblockchain
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/core/transaction"
+)
+
+const (
+	// GasPriceOracleBlocks is the number of recent blocks sampled by the gas price oracle
+	GasPriceOracleBlocks = 20
+
+	gasPriceLowPercentile    = 25
+	gasPriceMedianPercentile = 50
+	gasPriceHighPercentile   = 75
+)
+
+// GasPriceSuggestion contains the suggested gas prices and tips at the low, median and high percentiles
+type GasPriceSuggestion struct {
+	LowGasPrice    uint64
+	MedianGasPrice uint64
+	HighGasPrice   uint64
+	LowTip         uint64
+	MedianTip      uint64
+	HighTip        uint64
+}
+
+// GasPriceOracle samples the gas prices and tips paid in recent blocks and in the transaction pool
+type GasPriceOracle struct {
+	numOfBlocks int
+	// the samples of the blocks are only collected again when the tail changes
+	sampledTail hash.Hash
+	gasPrices   []uint64
+	tips        []uint64
+	mutex       sync.Mutex
+}
+
+// NewGasPriceOracle returns a gas price oracle sampling the last numOfBlocks blocks
+func NewGasPriceOracle(numOfBlocks int) *GasPriceOracle {
+	return &GasPriceOracle{numOfBlocks: numOfBlocks}
+}
+
+// Suggest returns the gas price and tip suggestions of the blockchain
+func (oracle *GasPriceOracle) Suggest(bc *Blockchain) *GasPriceSuggestion {
+	oracle.mutex.Lock()
+	defer oracle.mutex.Unlock()
+
+	tailHash := bc.GetTailBlockHash()
+	if oracle.sampledTail == nil || !bytes.Equal(oracle.sampledTail, tailHash) {
+		oracle.sampleBlocks(bc, tailHash)
+	}
+
+	gasPrices := append([]uint64{}, oracle.gasPrices...)
+	tips := append([]uint64{}, oracle.tips...)
+	if txPool := bc.GetTxPool(); txPool != nil {
+		gasPrices, tips = collectGasSamples(txPool.GetAllTransactions(), gasPrices, tips)
+	}
+	return newGasPriceSuggestion(gasPrices, tips)
+}
+
+// sampleBlocks collects the gas prices and tips of the last blocks ending at tailHash
+func (oracle *GasPriceOracle) sampleBlocks(bc *Blockchain, tailHash hash.Hash) {
+	oracle.gasPrices = nil
+	oracle.tips = nil
+	blkHash := tailHash
+	for i := 0; i < oracle.numOfBlocks; i++ {
+		blk, err := bc.GetBlockByHash(blkHash)
+		if err != nil {
+			break
+		}
+		oracle.gasPrices, oracle.tips = collectGasSamples(blk.GetTransactions(), oracle.gasPrices, oracle.tips)
+		if blk.GetHeight() == 0 {
+			break
+		}
+		blkHash = blk.GetPrevHash()
+	}
+	oracle.sampledTail = tailHash
+}
+
+// collectGasSamples appends the gas prices and tips paid by user transactions in txs
+func collectGasSamples(txs []*transaction.Transaction, gasPrices []uint64, tips []uint64) ([]uint64, []uint64) {
+	for _, tx := range txs {
+		adaptedTx := transaction.NewTxAdapter(tx)
+		if !adaptedTx.IsNormal() && !adaptedTx.IsContract() {
+			continue
+		}
+		if tx.Tip != nil {
+			tips = append(tips, tx.Tip.Uint64())
+		}
+		if adaptedTx.IsContract() && tx.GasPrice != nil && !tx.GasPrice.IsZero() {
+			gasPrices = append(gasPrices, tx.GasPrice.Uint64())
+		}
+	}
+	return gasPrices, tips
+}
+
+func newGasPriceSuggestion(gasPrices []uint64, tips []uint64) *GasPriceSuggestion {
+	suggestion := &GasPriceSuggestion{
+		LowGasPrice:    DefaultGasPrice,
+		MedianGasPrice: DefaultGasPrice,
+		HighGasPrice:   DefaultGasPrice,
+	}
+	if len(gasPrices) > 0 {
+		sortUint64s(gasPrices)
+		suggestion.LowGasPrice = maxUint64(percentile(gasPrices, gasPriceLowPercentile), DefaultGasPrice)
+		suggestion.MedianGasPrice = maxUint64(percentile(gasPrices, gasPriceMedianPercentile), DefaultGasPrice)
+		suggestion.HighGasPrice = maxUint64(percentile(gasPrices, gasPriceHighPercentile), DefaultGasPrice)
+	}
+	if len(tips) > 0 {
+		sortUint64s(tips)
+		suggestion.LowTip = percentile(tips, gasPriceLowPercentile)
+		suggestion.MedianTip = percentile(tips, gasPriceMedianPercentile)
+		suggestion.HighTip = percentile(tips, gasPriceHighPercentile)
+	}
+	return suggestion
+}
+
+// percentile returns the value at the p-th percentile of the sorted values
+func percentile(sorted []uint64, p int) uint64 {
+	return sorted[(len(sorted)-1)*p/100]
+}
+
+func sortUint64s(values []uint64) {
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+}
+
+func maxUint64(a, b uint64) uint64 {
+	if a > b {
+		return a
+	}
+	return b
+}
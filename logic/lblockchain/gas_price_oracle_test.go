@@ -0,0 +1,106 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+package lblockchain
+
+import (
+	"testing"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/core"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/core/transactionbase"
+	"github.com/dappley/go-dappley/logic/ltransaction"
+	"github.com/dappley/go-dappley/logic/transactionpool"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockContractTransaction(gasPrice uint64, tip uint64) *transaction.Transaction {
+	tx := core.MockTransaction()
+	contractAccount := account.NewContractTransactionAccount()
+	tx.Vout = []transactionbase.TXOutput{{Value: common.NewAmount(0), PubKeyHash: contractAccount.GetPubKeyHash(), Contract: "contract"}}
+	tx.Tip = common.NewAmount(tip)
+	tx.GasLimit = common.NewAmount(100)
+	tx.GasPrice = common.NewAmount(gasPrice)
+	tx.ID = tx.Hash()
+	return tx
+}
+
+func TestNewGasPriceSuggestion(t *testing.T) {
+	tests := []struct {
+		name      string
+		gasPrices []uint64
+		tips      []uint64
+		expected  *GasPriceSuggestion
+	}{
+		{
+			name:     "NoSamples",
+			expected: &GasPriceSuggestion{DefaultGasPrice, DefaultGasPrice, DefaultGasPrice, 0, 0, 0},
+		},
+		{
+			name:      "SingleSample",
+			gasPrices: []uint64{7},
+			tips:      []uint64{3},
+			expected:  &GasPriceSuggestion{7, 7, 7, 3, 3, 3},
+		},
+		{
+			name:      "UnsortedSamples",
+			gasPrices: []uint64{9, 1, 5, 3, 7},
+			tips:      []uint64{40, 0, 10, 30, 20},
+			expected:  &GasPriceSuggestion{3, 5, 7, 10, 20, 30},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, newGasPriceSuggestion(tt.gasPrices, tt.tips))
+		})
+	}
+}
+
+func TestCollectGasSamples(t *testing.T) {
+	normalTx := core.MockTransaction()
+	contractTx := mockContractTransaction(4, 2)
+	coinbaseTx := ltransaction.NewCoinbaseTX(account.NewAddress("dGDrVKjCG3sdXtDUgWZ7Fp3Q97tLhqWivf"), "", 1, common.NewAmount(0))
+
+	gasPrices, tips := collectGasSamples([]*transaction.Transaction{normalTx, contractTx, &coinbaseTx}, nil, nil)
+	assert.Equal(t, []uint64{4}, gasPrices)
+	assert.Equal(t, []uint64{5, 2}, tips)
+}
+
+func TestGasPriceOracle_Suggest(t *testing.T) {
+	db := storage.NewRamStorage()
+	defer db.Close()
+
+	addr := account.NewAddress("16PencPNnF8CiSx2EBGEd1axhf7vuHCouj")
+	txPool := transactionpool.NewTransactionPool(nil, 128000)
+	bc := CreateBlockchain(addr, db, nil, txPool, 1000000)
+
+	// Only the genesis block exists, so the oracle falls back to the default gas price
+	assert.Equal(t, DefaultGasPrice, bc.GasPrice())
+	assert.Equal(t, uint64(0), bc.SuggestGasPrice().HighTip)
+
+	for _, gasPrice := range []uint64{2, 6, 10} {
+		txPool.Push(*mockContractTransaction(gasPrice, gasPrice))
+	}
+
+	suggestion := bc.SuggestGasPrice()
+	assert.Equal(t, &GasPriceSuggestion{2, 6, 6, 2, 6, 6}, suggestion)
+	assert.Equal(t, uint64(6), bc.GasPrice())
+}
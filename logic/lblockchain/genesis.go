@@ -32,7 +32,7 @@ const genesisCoinbaseData = "Hello world"
 
 func NewGenesisBlock(address account.Address, subsidy *common.Amount) *block.Block {
 	acc := account.NewTransactionAccountByAddress(address)
-	txin := transactionbase.TXInput{nil, -1, nil, []byte(genesisCoinbaseData)}
+	txin := transactionbase.TXInput{nil, -1, nil, []byte(genesisCoinbaseData), 0}
 	txout := transactionbase.NewTXOutput(subsidy, acc)
 	txs := []*transaction.Transaction{}
 	tx := transaction.Transaction{nil, []transactionbase.TXInput{txin}, []transactionbase.TXOutput{*txout}, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), time.Now().UnixNano() / 1e6, transaction.TxTypeCoinbase}
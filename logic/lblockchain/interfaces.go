@@ -6,6 +6,7 @@ import (
 	"github.com/dappley/go-dappley/core/block"
 	"github.com/dappley/go-dappley/network/networkmodel"
 	"github.com/golang/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/peer"
 )
 
 type Storage interface {
@@ -16,8 +17,28 @@ type Storage interface {
 type Consensus interface {
 	Validate(*block.Block) bool
 	SetDynasty(dynasty *consensus.Dynasty)
+	GetDynasty() *consensus.Dynasty
 	ChangeDynasty(height uint64)
-	AddReplacement(original, new string, height uint64, kind int)
+}
+
+// ElectionPolicy is implemented by the consensus engines that can turn off the election of producers from votes
+type ElectionPolicy interface {
+	IsElectionEnabled() bool
+}
+
+// SlotPolicy is implemented by the consensus engines that assign time slots to producers
+type SlotPolicy interface {
+	ProducerAtATime(time int64) string
+}
+
+// ProducerVerifier is implemented by the consensus engines whose time slots depend on the chain that leads to a block
+type ProducerVerifier interface {
+	VerifyProducer(blk *block.Block) bool
+}
+
+// HeaderVerifier is implemented by the consensus engines that can verify the signature of a block from its header only
+type HeaderVerifier interface {
+	VerifyHeader(blk *block.Block) bool
 }
 
 type LIBPolicy interface {
@@ -26,6 +47,22 @@ type LIBPolicy interface {
 	GetTotalProducersNum() int
 }
 
+// PeerReporter is implemented by the network services that keep a score of the peers that violate the protocol
+type PeerReporter interface {
+	ReportPeer(peerInfo networkmodel.PeerInfo, violation networkmodel.Violation)
+}
+
+// CompactBlockNetService is implemented by the network services that negotiate optional protocols with their peers
+type CompactBlockNetService interface {
+	GetPeers() []networkmodel.PeerInfo
+	GetPeerCapabilities(peerId peer.ID) networkmodel.Capability
+}
+
+// GossipNetService is implemented by the network services that relay the broadcasts of some commands by gossip
+type GossipNetService interface {
+	IsGossipTopic(commandName string) bool
+}
+
 type NetService interface {
 	UnicastNormalPriorityCommand(commandName string, message proto.Message, destination networkmodel.PeerInfo)
 	UnicastHighProrityCommand(commandName string, message proto.Message, destination networkmodel.PeerInfo)
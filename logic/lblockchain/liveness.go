@@ -0,0 +1,239 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package lblockchain
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/dappley/go-dappley/consensus"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/storage"
+	logger "github.com/sirupsen/logrus"
+)
+
+const livenessRecordKeyPrefix = "liveness_"
+
+var livenessStateKey = []byte("livenessState")
+
+// ProducerStats are the block production counters of a producer
+type ProducerStats struct {
+	Address           string `json:"address"`
+	Produced          uint64 `json:"produced"`
+	Missed            uint64 `json:"missed"`
+	Orphaned          uint64 `json:"orphaned"`
+	TotalLatency      uint64 `json:"totalLatency"`
+	ConsecutiveMissed uint64 `json:"consecutiveMissed"`
+}
+
+// MeanLatency returns the mean delay in seconds between the start of a slot and the timestamp of the block minted in it
+func (stats *ProducerStats) MeanLatency() float64 {
+	if stats.Produced == 0 {
+		return 0
+	}
+	return float64(stats.TotalLatency) / float64(stats.Produced)
+}
+
+// livenessRecord is what a block changed in the counters. It is kept until the block is irreversible to revert it on rollback
+type livenessRecord struct {
+	Producer          string            `json:"producer"`
+	Latency           uint64            `json:"latency"`
+	Missed            []string          `json:"missed"`
+	ConsecutiveMissed map[string]uint64 `json:"consecutiveMissed"`
+}
+
+type livenessState struct {
+	Height    uint64                    `json:"height"`
+	Pruned    uint64                    `json:"pruned"`
+	Producers map[string]*ProducerStats `json:"producers"`
+}
+
+// LivenessTracker counts the blocks minted, missed and orphaned by each producer from the blocks added to the chain.
+// Each producer owns one slot per dynasty round, so consecutive missed slots are consecutive missed rounds. The counters
+// start at the height the node began tracking and only serve for reporting; they never change the dynasty
+type LivenessTracker struct {
+	db    storage.Storage
+	state livenessState
+	mutex *sync.Mutex
+}
+
+// NewLivenessTracker loads the counters from db. A chain without counters is tracked from height on
+func NewLivenessTracker(db storage.Storage, height uint64) *LivenessTracker {
+	tracker := &LivenessTracker{
+		db:    db,
+		mutex: &sync.Mutex{},
+	}
+	bytes, err := db.Get(livenessStateKey)
+	if err != nil || json.Unmarshal(bytes, &tracker.state) != nil {
+		tracker.state = livenessState{Height: height, Pruned: height}
+	}
+	if tracker.state.Producers == nil {
+		tracker.state.Producers = make(map[string]*ProducerStats)
+	}
+	return tracker
+}
+
+// GetProducerStats returns the counters of all producers ordered by address
+func (tracker *LivenessTracker) GetProducerStats() []*ProducerStats {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	var stats []*ProducerStats
+	for _, producerStats := range tracker.state.Producers {
+		copied := *producerStats
+		stats = append(stats, &copied)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].Address < stats[j].Address
+	})
+	return stats
+}
+
+// Apply counts the block and the slots that were missed since its parent. slots returns the producer of a time slot and
+// may be nil if the consensus has no time slots. Slots are not counted if the whole chain stalled for more than a round
+func (tracker *LivenessTracker) Apply(blk, parent *block.Block, slots SlotPolicy, dynasty *consensus.Dynasty, libHeight uint64) {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	record := &livenessRecord{
+		Producer:          blk.GetProducer(),
+		ConsecutiveMissed: make(map[string]uint64),
+	}
+	timeBetweenBlk := int64(dynasty.GetTimeBetweenBlk())
+	if slots != nil && timeBetweenBlk > 0 {
+		record.Latency = uint64(blk.GetTimestamp() % timeBetweenBlk)
+		slotStart := blk.GetTimestamp() - int64(record.Latency)
+		if parent != nil && parent.GetHeight() > 0 && slotStart-parent.GetTimestamp() <= int64(dynasty.GetDynastyTime()) {
+			for t := parent.GetTimestamp() - parent.GetTimestamp()%timeBetweenBlk + timeBetweenBlk; t < slotStart; t += timeBetweenBlk {
+				if producer := slots.ProducerAtATime(t); producer != "" {
+					record.Missed = append(record.Missed, producer)
+				}
+			}
+		}
+	}
+
+	for _, producer := range record.Missed {
+		stats := tracker.getStats(producer)
+		tracker.keepConsecutiveMissed(record, stats)
+		stats.Missed++
+		stats.ConsecutiveMissed++
+	}
+	stats := tracker.getStats(record.Producer)
+	tracker.keepConsecutiveMissed(record, stats)
+	stats.Produced++
+	stats.TotalLatency += record.Latency
+	stats.ConsecutiveMissed = 0
+
+	tracker.state.Height = blk.GetHeight()
+	tracker.putRecord(blk.GetHeight(), record)
+	tracker.prune(libHeight)
+	tracker.save()
+}
+
+// Revert reverts the blocks above height. Their producers are counted as orphaned
+func (tracker *LivenessTracker) Revert(height uint64) {
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+
+	for h := tracker.state.Height; h > height; h-- {
+		record := tracker.getRecord(h)
+		if record == nil {
+			continue
+		}
+		stats := tracker.getStats(record.Producer)
+		stats.Produced--
+		stats.Orphaned++
+		stats.TotalLatency -= record.Latency
+		for _, producer := range record.Missed {
+			tracker.getStats(producer).Missed--
+		}
+		for producer, consecutiveMissed := range record.ConsecutiveMissed {
+			tracker.getStats(producer).ConsecutiveMissed = consecutiveMissed
+		}
+		tracker.db.Del(getLivenessRecordKey(h))
+	}
+	if height < tracker.state.Height {
+		tracker.state.Height = height
+	}
+	tracker.save()
+}
+
+// keepConsecutiveMissed keeps the counter of consecutive missed rounds before the block in record
+func (tracker *LivenessTracker) keepConsecutiveMissed(record *livenessRecord, stats *ProducerStats) {
+	if _, ok := record.ConsecutiveMissed[stats.Address]; !ok {
+		record.ConsecutiveMissed[stats.Address] = stats.ConsecutiveMissed
+	}
+}
+
+func (tracker *LivenessTracker) getStats(producer string) *ProducerStats {
+	stats, ok := tracker.state.Producers[producer]
+	if !ok {
+		stats = &ProducerStats{Address: producer}
+		tracker.state.Producers[producer] = stats
+	}
+	return stats
+}
+
+// prune deletes the records of the blocks that are irreversible
+func (tracker *LivenessTracker) prune(libHeight uint64) {
+	for h := tracker.state.Pruned + 1; h <= libHeight; h++ {
+		tracker.db.Del(getLivenessRecordKey(h))
+	}
+	if libHeight > tracker.state.Pruned {
+		tracker.state.Pruned = libHeight
+	}
+}
+
+func (tracker *LivenessTracker) getRecord(height uint64) *livenessRecord {
+	bytes, err := tracker.db.Get(getLivenessRecordKey(height))
+	if err != nil {
+		return nil
+	}
+	record := &livenessRecord{}
+	if err := json.Unmarshal(bytes, record); err != nil {
+		return nil
+	}
+	return record
+}
+
+func (tracker *LivenessTracker) putRecord(height uint64, record *livenessRecord) {
+	bytes, err := json.Marshal(record)
+	if err != nil {
+		return
+	}
+	if err := tracker.db.Put(getLivenessRecordKey(height), bytes); err != nil {
+		logger.WithError(err).Warn("LivenessTracker: failed to save the record of a block.")
+	}
+}
+
+func (tracker *LivenessTracker) save() {
+	bytes, err := json.Marshal(tracker.state)
+	if err != nil {
+		return
+	}
+	if err := tracker.db.Put(livenessStateKey, bytes); err != nil {
+		logger.WithError(err).Warn("LivenessTracker: failed to save the producer stats.")
+	}
+}
+
+func getLivenessRecordKey(height uint64) []byte {
+	return []byte(livenessRecordKeyPrefix + strconv.FormatUint(height, 10))
+}
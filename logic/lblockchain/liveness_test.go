@@ -0,0 +1,80 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package lblockchain
+
+import (
+	"testing"
+
+	"github.com/dappley/go-dappley/consensus"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func getProducerStats(tracker *LivenessTracker, producer string) *ProducerStats {
+	for _, stats := range tracker.GetProducerStats() {
+		if stats.Address == producer {
+			return stats
+		}
+	}
+	return &ProducerStats{Address: producer}
+}
+
+func TestLivenessTracker(t *testing.T) {
+	db := storage.NewRamStorage()
+	defer db.Close()
+	producers := []string{"producer0", "producer1", "producer2"}
+	dynasty := consensus.NewDynasty(append([]string{}, producers...), 3, 5)
+	tracker := NewLivenessTracker(db, 0)
+
+	genesis := block.NewBlockWithTimestamp(nil, nil, 0, "")
+	genesis.SetHeight(0)
+	blk1 := block.NewBlockWithTimestamp(nil, genesis, 15, producers[0])
+	blk2 := block.NewBlockWithTimestamp(nil, blk1, 26, producers[2])
+	tracker.Apply(blk1, genesis, dynasty, dynasty, 0)
+	tracker.Apply(blk2, blk1, dynasty, dynasty, 0)
+
+	assert.Equal(t, &ProducerStats{Address: producers[0], Produced: 1}, getProducerStats(tracker, producers[0]))
+	assert.Equal(t, &ProducerStats{Address: producers[1], Missed: 1, ConsecutiveMissed: 1}, getProducerStats(tracker, producers[1]))
+	assert.Equal(t, &ProducerStats{Address: producers[2], Produced: 1, TotalLatency: 1}, getProducerStats(tracker, producers[2]))
+
+	// the counters are kept across restarts
+	assert.Equal(t, tracker.GetProducerStats(), NewLivenessTracker(db, 0).GetProducerStats())
+
+	// a reverted block is orphaned and the slots missed before it are not counted any more
+	tracker.Revert(1)
+	assert.Equal(t, &ProducerStats{Address: producers[1]}, getProducerStats(tracker, producers[1]))
+	assert.Equal(t, &ProducerStats{Address: producers[2], Orphaned: 1}, getProducerStats(tracker, producers[2]))
+
+	// a producer that misses two rounds in a row is reported but stays in the dynasty
+	blk3 := block.NewBlockWithTimestamp(nil, blk2, 30, producers[0])
+	blk4 := block.NewBlockWithTimestamp(nil, blk3, 40, producers[2])
+	tracker.Apply(blk2, blk1, dynasty, dynasty, 0)
+	tracker.Apply(blk3, blk2, dynasty, dynasty, 1)
+	tracker.Apply(blk4, blk3, dynasty, dynasty, 2)
+	assert.Equal(t, producers, dynasty.GetProducers())
+	assert.Equal(t, uint64(2), getProducerStats(tracker, producers[1]).Missed)
+	assert.Equal(t, uint64(2), getProducerStats(tracker, producers[1]).ConsecutiveMissed)
+
+	// slots are not counted while the whole chain stalls
+	blk5 := block.NewBlockWithTimestamp(nil, blk4, 100, producers[0])
+	tracker.Apply(blk5, blk4, dynasty, dynasty, 3)
+	assert.Equal(t, uint64(0), getProducerStats(tracker, producers[2]).Missed)
+	assert.Equal(t, 0.5, getProducerStats(tracker, producers[2]).MeanLatency())
+}
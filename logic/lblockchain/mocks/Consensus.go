@@ -14,16 +14,27 @@ type Consensus struct {
 	mock.Mock
 }
 
-// AddReplacement provides a mock function with given fields: original, new, height, kind
-func (_m *Consensus) AddReplacement(original string, new string, height uint64, kind int) {
-	_m.Called(original, new, height, kind)
-}
-
 // ChangeDynasty provides a mock function with given fields: height
 func (_m *Consensus) ChangeDynasty(height uint64) {
 	_m.Called(height)
 }
 
+// GetDynasty provides a mock function with given fields:
+func (_m *Consensus) GetDynasty() *consensus.Dynasty {
+	ret := _m.Called()
+
+	var r0 *consensus.Dynasty
+	if rf, ok := ret.Get(0).(func() *consensus.Dynasty); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*consensus.Dynasty)
+		}
+	}
+
+	return r0
+}
+
 // SetDynasty provides a mock function with given fields: dynasty
 func (_m *Consensus) SetDynasty(dynasty *consensus.Dynasty) {
 	_m.Called(dynasty)
@@ -0,0 +1,410 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.23.0
+// 	protoc        v3.13.0
+// source: github.com/dappley/go-dappley/logic/lblockchain/pb/compact_block.proto
+
+package lblockchainpb
+
+import (
+	pb "github.com/dappley/go-dappley/core/block/pb"
+	pb1 "github.com/dappley/go-dappley/core/transaction/pb"
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type CompactBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header       *pb.BlockHeader         `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	ShortIds     [][]byte                `protobuf:"bytes,2,rep,name=short_ids,json=shortIds,proto3" json:"short_ids,omitempty"`
+	PrefilledTxs []*PrefilledTransaction `protobuf:"bytes,3,rep,name=prefilled_txs,json=prefilledTxs,proto3" json:"prefilled_txs,omitempty"`
+}
+
+func (x *CompactBlock) Reset() {
+	*x = CompactBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompactBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompactBlock) ProtoMessage() {}
+
+func (x *CompactBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompactBlock.ProtoReflect.Descriptor instead.
+func (*CompactBlock) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CompactBlock) GetHeader() *pb.BlockHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *CompactBlock) GetShortIds() [][]byte {
+	if x != nil {
+		return x.ShortIds
+	}
+	return nil
+}
+
+func (x *CompactBlock) GetPrefilledTxs() []*PrefilledTransaction {
+	if x != nil {
+		return x.PrefilledTxs
+	}
+	return nil
+}
+
+type PrefilledTransaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index uint32           `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Tx    *pb1.Transaction `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx,omitempty"`
+}
+
+func (x *PrefilledTransaction) Reset() {
+	*x = PrefilledTransaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrefilledTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrefilledTransaction) ProtoMessage() {}
+
+func (x *PrefilledTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrefilledTransaction.ProtoReflect.Descriptor instead.
+func (*PrefilledTransaction) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PrefilledTransaction) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *PrefilledTransaction) GetTx() *pb1.Transaction {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+type GetBlockTransactions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash    []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Indexes []uint32 `protobuf:"varint,2,rep,packed,name=indexes,proto3" json:"indexes,omitempty"`
+}
+
+func (x *GetBlockTransactions) Reset() {
+	*x = GetBlockTransactions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlockTransactions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockTransactions) ProtoMessage() {}
+
+func (x *GetBlockTransactions) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockTransactions.ProtoReflect.Descriptor instead.
+func (*GetBlockTransactions) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetBlockTransactions) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *GetBlockTransactions) GetIndexes() []uint32 {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+type BlockTransactions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash         []byte             `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Transactions []*pb1.Transaction `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
+}
+
+func (x *BlockTransactions) Reset() {
+	*x = BlockTransactions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockTransactions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockTransactions) ProtoMessage() {}
+
+func (x *BlockTransactions) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockTransactions.ProtoReflect.Descriptor instead.
+func (*BlockTransactions) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BlockTransactions) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *BlockTransactions) GetTransactions() []*pb1.Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+var File_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto protoreflect.FileDescriptor
+
+var file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_rawDesc = []byte{
+	0x0a, 0x46, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70,
+	0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f,
+	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2f, 0x6c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x70, 0x62, 0x1a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64,
+	0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x2f, 0x70, 0x62, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70,
+	0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f,
+	0x63, 0x6f, 0x72, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x70, 0x62, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa3, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x2c, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x62,
+	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x64,
+	0x73, 0x12, 0x48, 0x0a, 0x0d, 0x70, 0x72, 0x65, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x74,
+	0x78, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x6c, 0x6c,
+	0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x54, 0x78, 0x73, 0x22, 0x58, 0x0a, 0x14, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x02, 0x74, 0x78, 0x22, 0x44, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0d, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x22, 0x67, 0x0a, 0x11, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x12, 0x3e, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_rawDescOnce sync.Once
+	file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_rawDescData = file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_rawDesc
+)
+
+func file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_rawDescGZIP() []byte {
+	file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_rawDescOnce.Do(func() {
+		file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_rawDescData)
+	})
+	return file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_rawDescData
+}
+
+var file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_goTypes = []interface{}{
+	(*CompactBlock)(nil),         // 0: lblockchainpb.CompactBlock
+	(*PrefilledTransaction)(nil), // 1: lblockchainpb.PrefilledTransaction
+	(*GetBlockTransactions)(nil), // 2: lblockchainpb.GetBlockTransactions
+	(*BlockTransactions)(nil),    // 3: lblockchainpb.BlockTransactions
+	(*pb.BlockHeader)(nil),       // 4: blockpb.BlockHeader
+	(*pb1.Transaction)(nil),      // 5: transactionpb.Transaction
+}
+var file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_depIdxs = []int32{
+	4, // 0: lblockchainpb.CompactBlock.header:type_name -> blockpb.BlockHeader
+	1, // 1: lblockchainpb.CompactBlock.prefilled_txs:type_name -> lblockchainpb.PrefilledTransaction
+	5, // 2: lblockchainpb.PrefilledTransaction.tx:type_name -> transactionpb.Transaction
+	5, // 3: lblockchainpb.BlockTransactions.transactions:type_name -> transactionpb.Transaction
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_init() }
+func file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_init() {
+	if File_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompactBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrefilledTransaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockTransactions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockTransactions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_goTypes,
+		DependencyIndexes: file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_depIdxs,
+		MessageInfos:      file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_msgTypes,
+	}.Build()
+	File_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto = out.File
+	file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_rawDesc = nil
+	file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_goTypes = nil
+	file_github_com_dappley_go_dappley_logic_lblockchain_pb_compact_block_proto_depIdxs = nil
+}
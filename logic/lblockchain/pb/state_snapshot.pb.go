@@ -0,0 +1,666 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.23.0
+// 	protoc        v3.13.0
+// source: github.com/dappley/go-dappley/logic/lblockchain/pb/state_snapshot.proto
+
+package lblockchainpb
+
+import (
+	pb1 "github.com/dappley/go-dappley/core/block/pb"
+	pb "github.com/dappley/go-dappley/core/utxo/pb"
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type StateSnapshotManifest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height          uint64   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	BlockHash       []byte   `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	ChunkHashes     [][]byte `protobuf:"bytes,3,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"`
+	Producers       []string `protobuf:"bytes,4,rep,name=producers,proto3" json:"producers,omitempty"`
+	ChainParameters []byte   `protobuf:"bytes,5,opt,name=chain_parameters,json=chainParameters,proto3" json:"chain_parameters,omitempty"`
+	Root            []byte   `protobuf:"bytes,6,opt,name=root,proto3" json:"root,omitempty"`
+}
+
+func (x *StateSnapshotManifest) Reset() {
+	*x = StateSnapshotManifest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateSnapshotManifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateSnapshotManifest) ProtoMessage() {}
+
+func (x *StateSnapshotManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateSnapshotManifest.ProtoReflect.Descriptor instead.
+func (*StateSnapshotManifest) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StateSnapshotManifest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *StateSnapshotManifest) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *StateSnapshotManifest) GetChunkHashes() [][]byte {
+	if x != nil {
+		return x.ChunkHashes
+	}
+	return nil
+}
+
+func (x *StateSnapshotManifest) GetProducers() []string {
+	if x != nil {
+		return x.Producers
+	}
+	return nil
+}
+
+func (x *StateSnapshotManifest) GetChainParameters() []byte {
+	if x != nil {
+		return x.ChainParameters
+	}
+	return nil
+}
+
+func (x *StateSnapshotManifest) GetRoot() []byte {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+type StateSnapshotChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Utxos  []*pb.Utxo            `protobuf:"bytes,1,rep,name=utxos,proto3" json:"utxos,omitempty"`
+	States []*StateSnapshotValue `protobuf:"bytes,2,rep,name=states,proto3" json:"states,omitempty"`
+}
+
+func (x *StateSnapshotChunk) Reset() {
+	*x = StateSnapshotChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateSnapshotChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateSnapshotChunk) ProtoMessage() {}
+
+func (x *StateSnapshotChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateSnapshotChunk.ProtoReflect.Descriptor instead.
+func (*StateSnapshotChunk) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StateSnapshotChunk) GetUtxos() []*pb.Utxo {
+	if x != nil {
+		return x.Utxos
+	}
+	return nil
+}
+
+func (x *StateSnapshotChunk) GetStates() []*StateSnapshotValue {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+type StateSnapshotValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Key     string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value   string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StateSnapshotValue) Reset() {
+	*x = StateSnapshotValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateSnapshotValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateSnapshotValue) ProtoMessage() {}
+
+func (x *StateSnapshotValue) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateSnapshotValue.ProtoReflect.Descriptor instead.
+func (*StateSnapshotValue) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StateSnapshotValue) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *StateSnapshotValue) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StateSnapshotValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type GetStateSnapshotManifest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"` // The latest snapshot is returned if it is 0
+}
+
+func (x *GetStateSnapshotManifest) Reset() {
+	*x = GetStateSnapshotManifest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStateSnapshotManifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStateSnapshotManifest) ProtoMessage() {}
+
+func (x *GetStateSnapshotManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStateSnapshotManifest.ProtoReflect.Descriptor instead.
+func (*GetStateSnapshotManifest) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetStateSnapshotManifest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type ReturnStateSnapshotManifest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Manifest *StateSnapshotManifest `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	Block    *pb1.Block             `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+}
+
+func (x *ReturnStateSnapshotManifest) Reset() {
+	*x = ReturnStateSnapshotManifest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReturnStateSnapshotManifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnStateSnapshotManifest) ProtoMessage() {}
+
+func (x *ReturnStateSnapshotManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnStateSnapshotManifest.ProtoReflect.Descriptor instead.
+func (*ReturnStateSnapshotManifest) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReturnStateSnapshotManifest) GetManifest() *StateSnapshotManifest {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+func (x *ReturnStateSnapshotManifest) GetBlock() *pb1.Block {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+type GetStateSnapshotChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Root  []byte `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (x *GetStateSnapshotChunk) Reset() {
+	*x = GetStateSnapshotChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStateSnapshotChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStateSnapshotChunk) ProtoMessage() {}
+
+func (x *GetStateSnapshotChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStateSnapshotChunk.ProtoReflect.Descriptor instead.
+func (*GetStateSnapshotChunk) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetStateSnapshotChunk) GetRoot() []byte {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *GetStateSnapshotChunk) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type ReturnStateSnapshotChunk struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Root  []byte `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Data  []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ReturnStateSnapshotChunk) Reset() {
+	*x = ReturnStateSnapshotChunk{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReturnStateSnapshotChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnStateSnapshotChunk) ProtoMessage() {}
+
+func (x *ReturnStateSnapshotChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnStateSnapshotChunk.ProtoReflect.Descriptor instead.
+func (*ReturnStateSnapshotChunk) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReturnStateSnapshotChunk) GetRoot() []byte {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *ReturnStateSnapshotChunk) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ReturnStateSnapshotChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto protoreflect.FileDescriptor
+
+var file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_rawDesc = []byte{
+	0x0a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70,
+	0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f,
+	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2f, 0x6c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x70, 0x62, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6c, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x70, 0x62, 0x1a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d,
+	0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x2f, 0x70, 0x62, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61,
+	0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79,
+	0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x75, 0x74, 0x78, 0x6f, 0x2f, 0x70, 0x62, 0x2f, 0x75, 0x74,
+	0x78, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xce, 0x01, 0x0a, 0x15, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x75,
+	0x6e, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x0b, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x22, 0x73, 0x0a, 0x12, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12,
+	0x22, 0x0a, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x75, 0x74, 0x78, 0x6f, 0x70, 0x62, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x52, 0x05, 0x75, 0x74,
+	0x78, 0x6f, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x22, 0x56,
+	0x0a, 0x12, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x32, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x1b, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x08, 0x6d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x70, 0x62, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x05,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x22, 0x41, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x72,
+	0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x58, 0x0a, 0x18, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_rawDescOnce sync.Once
+	file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_rawDescData = file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_rawDesc
+)
+
+func file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_rawDescGZIP() []byte {
+	file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_rawDescOnce.Do(func() {
+		file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_rawDescData)
+	})
+	return file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_rawDescData
+}
+
+var file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_goTypes = []interface{}{
+	(*StateSnapshotManifest)(nil),       // 0: lblockchainpb.StateSnapshotManifest
+	(*StateSnapshotChunk)(nil),          // 1: lblockchainpb.StateSnapshotChunk
+	(*StateSnapshotValue)(nil),          // 2: lblockchainpb.StateSnapshotValue
+	(*GetStateSnapshotManifest)(nil),    // 3: lblockchainpb.GetStateSnapshotManifest
+	(*ReturnStateSnapshotManifest)(nil), // 4: lblockchainpb.ReturnStateSnapshotManifest
+	(*GetStateSnapshotChunk)(nil),       // 5: lblockchainpb.GetStateSnapshotChunk
+	(*ReturnStateSnapshotChunk)(nil),    // 6: lblockchainpb.ReturnStateSnapshotChunk
+	(*pb.Utxo)(nil),                     // 7: utxopb.Utxo
+	(*pb1.Block)(nil),                   // 8: blockpb.Block
+}
+var file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_depIdxs = []int32{
+	7, // 0: lblockchainpb.StateSnapshotChunk.utxos:type_name -> utxopb.Utxo
+	2, // 1: lblockchainpb.StateSnapshotChunk.states:type_name -> lblockchainpb.StateSnapshotValue
+	0, // 2: lblockchainpb.ReturnStateSnapshotManifest.manifest:type_name -> lblockchainpb.StateSnapshotManifest
+	8, // 3: lblockchainpb.ReturnStateSnapshotManifest.block:type_name -> blockpb.Block
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_init() }
+func file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_init() {
+	if File_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateSnapshotManifest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateSnapshotChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateSnapshotValue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStateSnapshotManifest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReturnStateSnapshotManifest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStateSnapshotChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReturnStateSnapshotChunk); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_goTypes,
+		DependencyIndexes: file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_depIdxs,
+		MessageInfos:      file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_msgTypes,
+	}.Build()
+	File_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto = out.File
+	file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_rawDesc = nil
+	file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_goTypes = nil
+	file_github_com_dappley_go_dappley_logic_lblockchain_pb_state_snapshot_proto_depIdxs = nil
+}
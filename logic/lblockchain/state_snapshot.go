@@ -0,0 +1,346 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package lblockchain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"sort"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/core/transactionbase"
+	"github.com/dappley/go-dappley/core/utxo"
+	utxopb "github.com/dappley/go-dappley/core/utxo/pb"
+	errval "github.com/dappley/go-dappley/errors"
+	lblockchainpb "github.com/dappley/go-dappley/logic/lblockchain/pb"
+	"github.com/dappley/go-dappley/logic/lutxo"
+	"github.com/dappley/go-dappley/util"
+	"github.com/golang/protobuf/proto"
+	logger "github.com/sirupsen/logrus"
+)
+
+const stateSnapshotChunkSize = 256
+
+//StateSnapshot is the utxo set and the contract states at a block, split into chunks that are committed to by the
+//root of the manifest
+type StateSnapshot struct {
+	Manifest *lblockchainpb.StateSnapshotManifest
+	Chunks   [][]byte
+}
+
+//CreateStateSnapshot exports the utxos and the contract states at the block of the height, which must not be higher
+//than the LIB. The blocks up to the LIB do not change, so the owners and the state keys are collected from them
+//without holding the lock of the blockchain, which is only held while the state is reverted to the block and read
+func (bc *Blockchain) CreateStateSnapshot(height uint64) (*StateSnapshot, error) {
+	if height > bc.GetLIBHeight() {
+		return nil, errval.StateSnapshotAboveLIB
+	}
+	blk, err := bc.GetBlockByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKeyHashes, stateKeys, err := bc.getStateSnapshotKeys(height)
+	if err != nil {
+		return nil, err
+	}
+	utxos, states, err := bc.getStateSnapshotEntries(blk, pubKeyHashes, stateKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	chunk := &lblockchainpb.StateSnapshotChunk{}
+	snapshot := &StateSnapshot{Manifest: &lblockchainpb.StateSnapshotManifest{Height: height, BlockHash: blk.GetHash()}}
+	addEntry := func() {
+		if len(chunk.Utxos)+len(chunk.States) >= stateSnapshotChunkSize {
+			snapshot.addChunk(chunk)
+			chunk = &lblockchainpb.StateSnapshotChunk{}
+		}
+	}
+	for _, u := range utxos {
+		chunk.Utxos = append(chunk.Utxos, u)
+		addEntry()
+	}
+	for _, value := range states {
+		chunk.States = append(chunk.States, value)
+		addEntry()
+	}
+	if len(chunk.Utxos)+len(chunk.States) > 0 {
+		snapshot.addChunk(chunk)
+	}
+
+	if producers, _, err := bc.GetDynasty(height); err == nil {
+		snapshot.Manifest.Producers = producers
+	}
+	if params, _, err := bc.GetChainParameters(height); err == nil {
+		snapshot.Manifest.ChainParameters, _ = json.Marshal(params)
+	}
+	snapshot.Manifest.Root = GetStateSnapshotRoot(snapshot.Manifest)
+	return snapshot, nil
+}
+
+//getStateSnapshotEntries reverts the utxos and the contract states to the block and returns the utxos of the owners
+//and the values of the state keys in a deterministic order. The reverted state is derived from the tail, so the lock
+//of the blockchain is held until it has been read
+func (bc *Blockchain) getStateSnapshotEntries(blk *block.Block, pubKeyHashes []account.PubKeyHash, stateKeys map[string][]string) ([]*utxopb.Utxo, []*lblockchainpb.StateSnapshotValue, error) {
+	bc.mutex.Lock()
+	defer bc.mutex.Unlock()
+
+	utxoIndex, contractStates, err := revertUtxoAndScState(bc.db, bc, blk.GetHash(), true)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var utxos []*utxopb.Utxo
+	for _, pubKeyHash := range pubKeyHashes {
+		utxoTx := utxoIndex.GetAllUTXOsByPubKeyHash(pubKeyHash)
+		utxoKeys := make([]string, 0, len(utxoTx.Indices))
+		for utxoKey := range utxoTx.Indices {
+			utxoKeys = append(utxoKeys, utxoKey)
+		}
+		sort.Strings(utxoKeys)
+
+		for _, utxoKey := range utxoKeys {
+			//the links between the utxos of an account depend on the order in which a node added them
+			u := *utxoTx.Indices[utxoKey]
+			u.PrevUtxoKey = nil
+			u.NextUtxoKey = nil
+			utxos = append(utxos, u.ToProto().(*utxopb.Utxo))
+		}
+	}
+
+	addresses := make([]string, 0, len(stateKeys))
+	for address := range stateKeys {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+	var states []*lblockchainpb.StateSnapshotValue
+	for _, address := range addresses {
+		for _, key := range stateKeys[address] {
+			if value, ok := contractStates.GetStateValue(address, key); ok {
+				states = append(states, &lblockchainpb.StateSnapshotValue{Address: address, Key: key, Value: value})
+			}
+		}
+	}
+	return utxos, states, nil
+}
+
+//addChunk appends a chunk and its hash to the snapshot
+func (snapshot *StateSnapshot) addChunk(chunk *lblockchainpb.StateSnapshotChunk) {
+	data, err := proto.Marshal(chunk)
+	if err != nil {
+		logger.WithError(err).Panic("StateSnapshot: failed to serialize the chunk.")
+	}
+	chunkHash := sha256.Sum256(data)
+	snapshot.Chunks = append(snapshot.Chunks, data)
+	snapshot.Manifest.ChunkHashes = append(snapshot.Manifest.ChunkHashes, chunkHash[:])
+}
+
+//getStateSnapshotKeys returns the owners of the outputs and the contract state keys that were written up to the height
+func (bc *Blockchain) getStateSnapshotKeys(height uint64) ([]account.PubKeyHash, map[string][]string, error) {
+	pubKeyHashSet := make(map[string]account.PubKeyHash)
+	stateKeySet := make(map[string]map[string]bool)
+
+	for h := uint64(0); h <= height; h++ {
+		blk, err := bc.GetBlockByHeight(h)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, tx := range blk.GetTransactions() {
+			for _, vout := range tx.Vout {
+				pubKeyHashSet[vout.PubKeyHash.String()] = vout.PubKeyHash
+			}
+		}
+
+		stLog, err := bc.utxoCache.GetStateLog(utxo.GetscStateLogKey(blk.GetHash()))
+		if err != nil {
+			continue
+		}
+		for address, log := range stLog.Log {
+			if _, ok := stateKeySet[address]; !ok {
+				stateKeySet[address] = make(map[string]bool)
+			}
+			for key := range log {
+				stateKeySet[address][key] = true
+			}
+		}
+	}
+
+	pubKeyHashes := make([]account.PubKeyHash, 0, len(pubKeyHashSet))
+	for _, pubKeyHash := range pubKeyHashSet {
+		pubKeyHashes = append(pubKeyHashes, pubKeyHash)
+	}
+	sort.Slice(pubKeyHashes, func(i, j int) bool {
+		return bytes.Compare(pubKeyHashes[i], pubKeyHashes[j]) < 0
+	})
+
+	stateKeys := make(map[string][]string)
+	for address, keySet := range stateKeySet {
+		for key := range keySet {
+			stateKeys[address] = append(stateKeys[address], key)
+		}
+		sort.Strings(stateKeys[address])
+	}
+	return pubKeyHashes, stateKeys, nil
+}
+
+//GetStateSnapshotRoot returns the hash that commits to the block, the chunks and the consensus state of a snapshot
+func GetStateSnapshotRoot(manifest *lblockchainpb.StateSnapshotManifest) hash.Hash {
+	hasher := sha256.New()
+	writeField := func(field []byte) {
+		hasher.Write(util.UintToHex(uint64(len(field))))
+		hasher.Write(field)
+	}
+
+	hasher.Write(util.UintToHex(manifest.GetHeight()))
+	writeField(manifest.GetBlockHash())
+	for _, chunkHash := range manifest.GetChunkHashes() {
+		writeField(chunkHash)
+	}
+	for _, producer := range manifest.GetProducers() {
+		writeField([]byte(producer))
+	}
+	writeField(manifest.GetChainParameters())
+	return hasher.Sum(nil)
+}
+
+//VerifyStateSnapshotChunk returns if the data is the chunk at the index of the snapshot
+func VerifyStateSnapshotChunk(manifest *lblockchainpb.StateSnapshotManifest, index int, data []byte) bool {
+	if index < 0 || index >= len(manifest.GetChunkHashes()) {
+		return false
+	}
+	chunkHash := sha256.Sum256(data)
+	return bytes.Equal(chunkHash[:], manifest.GetChunkHashes()[index])
+}
+
+//ImportStateSnapshot replaces the state of a blockchain that only holds the genesis block with the snapshot and makes
+//the block of the snapshot the tail and the LIB
+func (bc *Blockchain) ImportStateSnapshot(snapshot *StateSnapshot, blk *block.Block) error {
+	bc.mutex.Lock()
+	defer bc.mutex.Unlock()
+
+	manifest := snapshot.Manifest
+	if bc.GetMaxHeight() != 0 {
+		return errval.BlockchainNotEmpty
+	}
+	if !GetStateSnapshotRoot(manifest).Equals(manifest.GetRoot()) ||
+		blk.GetHeight() != manifest.GetHeight() ||
+		!blk.GetHash().Equals(manifest.GetBlockHash()) ||
+		len(snapshot.Chunks) != len(manifest.GetChunkHashes()) {
+		return errval.InvalidStateSnapshot
+	}
+
+	var chunks []*lblockchainpb.StateSnapshotChunk
+	for index, data := range snapshot.Chunks {
+		chunk := &lblockchainpb.StateSnapshotChunk{}
+		if !VerifyStateSnapshotChunk(manifest, index, data) || proto.Unmarshal(data, chunk) != nil {
+			return errval.InvalidStateSnapshot
+		}
+		chunks = append(chunks, chunk)
+	}
+
+	genesis, err := bc.GetBlockByHeight(0)
+	if err != nil {
+		return err
+	}
+	genesisIndex := lutxo.NewUTXOIndex(bc.utxoCache)
+	if err := genesisIndex.UndoTxsInBlock(genesis, bc.db); err != nil {
+		return err
+	}
+	if err := genesisIndex.Save(); err != nil {
+		return err
+	}
+
+	utxoTxs := make(map[string]*utxo.UTXOTx)
+	journals := make(map[string][]transactionbase.TXOutput)
+	for _, chunk := range chunks {
+		for _, utxoPb := range chunk.GetUtxos() {
+			u := &utxo.UTXO{}
+			u.FromProto(utxoPb)
+			if _, ok := utxoTxs[u.PubKeyHash.String()]; !ok {
+				utxoTx := utxo.NewUTXOTx()
+				utxoTxs[u.PubKeyHash.String()] = &utxoTx
+			}
+			utxoTxs[u.PubKeyHash.String()].PutUtxo(u)
+
+			//the journals only keep the unspent outputs, which are the only ones that a later fork can spend again
+			vouts := journals[string(u.Txid)]
+			for len(vouts) <= u.TxIndex {
+				vouts = append(vouts, transactionbase.TXOutput{Value: common.NewAmount(0)})
+			}
+			vouts[u.TxIndex] = u.TXOutput
+			journals[string(u.Txid)] = vouts
+		}
+	}
+
+	for pubKeyHash, utxoTx := range utxoTxs {
+		if err := bc.utxoCache.AddUtxos(utxoTx, pubKeyHash); err != nil {
+			return err
+		}
+	}
+	for txid, vouts := range journals {
+		if err := transaction.NewTxJournal([]byte(txid), vouts).Save(bc.db); err != nil {
+			return err
+		}
+	}
+	for _, chunk := range chunks {
+		for _, state := range chunk.GetStates() {
+			if err := bc.utxoCache.AddScStates(utxo.GetscStateKey(state.GetAddress(), state.GetKey()), state.GetValue()); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(manifest.GetProducers()) > 0 {
+		if err := bc.SaveDynasty(manifest.GetHeight(), manifest.GetProducers()); err != nil {
+			return err
+		}
+	}
+	if len(manifest.GetChainParameters()) > 0 {
+		params := &ChainParameters{}
+		if err := json.Unmarshal(manifest.GetChainParameters(), params); err != nil {
+			return errval.InvalidStateSnapshot
+		}
+		if err := bc.SaveChainParameters(manifest.GetHeight(), params); err != nil {
+			return err
+		}
+	}
+
+	if err := bc.AddBlockToDb(blk); err != nil {
+		return err
+	}
+	if err := bc.setTailBlockHash(blk.GetHash()); err != nil {
+		return err
+	}
+	//with finality enabled the LIB only moves with a verified justification, which the snapshot does not carry
+	if !bc.finality {
+		bc.SetLIBHash(blk.GetHash())
+	}
+
+	logger.WithFields(logger.Fields{
+		"height": blk.GetHeight(),
+		"hash":   blk.GetHash().String(),
+	}).Info("Blockchain: imported the state snapshot.")
+	return nil
+}
@@ -0,0 +1,157 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package lblockchain
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/core/scState"
+	"github.com/dappley/go-dappley/core/transaction"
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/logic/lblock"
+	"github.com/dappley/go-dappley/logic/ltransaction"
+	"github.com/dappley/go-dappley/logic/lutxo"
+	"github.com/stretchr/testify/assert"
+)
+
+func addStateSnapshotTestBlock(bc *Blockchain, states map[string]string) *block.Block {
+	addr := account.NewAddress("16PencPNnF8CiSx2EBGEd1axhf7vuHCouj")
+	tailBlk, _ := bc.GetTailBlock()
+	cbtx := ltransaction.NewCoinbaseTX(addr, "", bc.GetMaxHeight()+1, common.NewAmount(0))
+	b := block.NewBlock([]*transaction.Transaction{&cbtx}, tailBlk, "16PencPNnF8CiSx2EBGEd1axhf7vuHCouj")
+	b.SetHash(lblock.CalculateHash(b))
+	ctx := PrepareBlockContext(bc, b)
+	for key, value := range states {
+		ctx.State.SetStateValue("contract", key, value)
+	}
+	bc.AddBlockContextToTail(ctx)
+	return b
+}
+
+func TestBlockchain_StateSnapshot(t *testing.T) {
+	bc := GenerateMockBlockchainWithCoinbaseTxOnly(0)
+	for height := 1; height <= 8; height++ {
+		var states map[string]string
+		switch height {
+		case 3:
+			states = map[string]string{"a": "a1", "b": "b1"}
+		case 5:
+			states = map[string]string{"b": "b5"}
+		case 7:
+			states = map[string]string{"a": "a7"}
+		}
+		addStateSnapshotTestBlock(bc, states)
+	}
+	assert.Nil(t, bc.SaveDynasty(2, []string{"producer1", "producer2"}))
+	lib, _ := bc.GetBlockByHeight(6)
+	bc.SetLIBHash(lib.GetHash())
+
+	_, err := bc.CreateStateSnapshot(7)
+	assert.Equal(t, errval.StateSnapshotAboveLIB, err)
+
+	snapshot, err := bc.CreateStateSnapshot(6)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(6), snapshot.Manifest.GetHeight())
+	assert.Equal(t, []string{"producer1", "producer2"}, snapshot.Manifest.GetProducers())
+	assert.Equal(t, snapshot.Manifest.GetRoot(), []byte(GetStateSnapshotRoot(snapshot.Manifest)))
+	assert.True(t, VerifyStateSnapshotChunk(snapshot.Manifest, 0, snapshot.Chunks[0]))
+	assert.False(t, VerifyStateSnapshotChunk(snapshot.Manifest, 0, []byte("forged")))
+
+	//the snapshot does not change with the blocks after it
+	again, err := bc.CreateStateSnapshot(6)
+	assert.Nil(t, err)
+	assert.Equal(t, snapshot.Manifest.GetRoot(), again.Manifest.GetRoot())
+	other, err := bc.CreateStateSnapshot(5)
+	assert.Nil(t, err)
+	assert.NotEqual(t, snapshot.Manifest.GetRoot(), other.Manifest.GetRoot())
+
+	//a forged chunk is rejected
+	imported := CopyMockBlockchain(bc, 0)
+	forged := &StateSnapshot{Manifest: snapshot.Manifest, Chunks: [][]byte{[]byte("forged")}}
+	assert.Equal(t, errval.InvalidStateSnapshot, imported.ImportStateSnapshot(forged, lib))
+
+	assert.Nil(t, imported.ImportStateSnapshot(snapshot, lib))
+	assert.Equal(t, lib.GetHash(), imported.GetTailBlockHash())
+	assert.Equal(t, lib.GetHash(), imported.GetLIBHash())
+	_, err = imported.GetBlockByHeight(3)
+	assert.NotNil(t, err)
+
+	//the utxos of the genesis block and of the 6 blocks are imported
+	pkh := account.NewTransactionAccountByAddress(account.NewAddress("16PencPNnF8CiSx2EBGEd1axhf7vuHCouj")).GetPubKeyHash()
+	utxoIndex := lutxo.NewUTXOIndex(imported.GetUtxoCache())
+	assert.Equal(t, 7, utxoIndex.GetAllUTXOsByPubKeyHash(pkh).Size())
+
+	state := scState.NewScState(imported.GetUtxoCache())
+	value, _ := state.GetStateValue("contract", "a")
+	assert.Equal(t, "a1", value)
+	value, _ = state.GetStateValue("contract", "b")
+	assert.Equal(t, "b5", value)
+	producers, _, err := imported.GetDynasty(6)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"producer1", "producer2"}, producers)
+
+	//the blocks after the snapshot are added on top of it
+	for height := uint64(7); height <= 8; height++ {
+		blk, _ := bc.GetBlockByHeight(height)
+		assert.Nil(t, imported.AddBlockContextToTail(PrepareBlockContext(imported, blk)))
+	}
+	assert.Equal(t, bc.GetTailBlockHash(), imported.GetTailBlockHash())
+	utxoIndex = lutxo.NewUTXOIndex(imported.GetUtxoCache())
+	assert.Equal(t, 9, utxoIndex.GetAllUTXOsByPubKeyHash(pkh).Size())
+
+	assert.Equal(t, errval.BlockchainNotEmpty, imported.ImportStateSnapshot(snapshot, lib))
+
+	//with finality enabled the LIB only moves with a justification
+	finalized := CopyMockBlockchain(bc, 0)
+	finalized.EnableFinality()
+	genesisHash := finalized.GetLIBHash()
+	assert.Nil(t, finalized.ImportStateSnapshot(snapshot, lib))
+	assert.Equal(t, lib.GetHash(), finalized.GetTailBlockHash())
+	assert.Equal(t, genesisHash, finalized.GetLIBHash())
+}
+
+func TestBlockchain_StateSnapshotWhileAddingBlocks(t *testing.T) {
+	bc := GenerateMockBlockchainWithCoinbaseTxOnly(0)
+	for height := 1; height <= 6; height++ {
+		addStateSnapshotTestBlock(bc, map[string]string{"a": strconv.Itoa(height)})
+	}
+	lib, _ := bc.GetBlockByHeight(4)
+	bc.SetLIBHash(lib.GetHash())
+	snapshot, err := bc.CreateStateSnapshot(4)
+	assert.Nil(t, err)
+
+	//the blocks added while the snapshot is created do not change it
+	done := make(chan bool)
+	go func() {
+		for i := 0; i < 20; i++ {
+			addStateSnapshotTestBlock(bc, map[string]string{"a": "later"})
+		}
+		done <- true
+	}()
+	for i := 0; i < 5; i++ {
+		again, err := bc.CreateStateSnapshot(4)
+		assert.Nil(t, err)
+		assert.Equal(t, snapshot.Manifest.GetRoot(), again.Manifest.GetRoot())
+	}
+	<-done
+}
@@ -41,6 +41,24 @@ func GenerateMockBlockchainWithCoinbaseTxOnly(size int) *Blockchain {
 	return bc
 }
 
+//CopyMockBlockchain creates a blockchain in a new storage that holds the blocks of bc up to the height
+func CopyMockBlockchain(bc *Blockchain, height uint64) *Blockchain {
+	libPolicy := &mocks.LIBPolicy{}
+	libPolicy.On("GetMinConfirmationNum").Return(6)
+	libPolicy.On("IsBypassingLibCheck").Return(true)
+	genesis, _ := bc.GetBlockByHeight(0)
+	bcCopy := createBlockchainWithGenesis(genesis, storage.NewRamStorage(), libPolicy, transactionpool.NewTransactionPool(nil, 128000), 100000)
+
+	for i := uint64(1); i <= height; i++ {
+		b, err := bc.GetBlockByHeight(i)
+		if err != nil {
+			break
+		}
+		bcCopy.AddBlockContextToTail(PrepareBlockContext(bcCopy, b))
+	}
+	return bcCopy
+}
+
 func AddBlockToGeneratedBlockchain(bc *Blockchain, numOfBlks int) {
 	for i := 0; i < numOfBlks; i++ {
 		addr := account.NewAddress("16PencPNnF8CiSx2EBGEd1axhf7vuHCouj")
@@ -0,0 +1,61 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package lblockchain
+
+import (
+	"bytes"
+
+	"github.com/dappley/go-dappley/core/block"
+	errval "github.com/dappley/go-dappley/errors"
+)
+
+const transactionIndexKeyPrefix = "txBlock_"
+
+// indexTransactions stores the hash of the block under the id of each of its transactions
+func (bc *Blockchain) indexTransactions(blk *block.Block) error {
+	for _, tx := range blk.GetTransactions() {
+		if err := bc.db.Put(getTransactionIndexKey(tx.ID), blk.GetHash()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetTransactionBlock returns the block of the main chain that contains the transaction
+func (bc *Blockchain) GetTransactionBlock(txid []byte) (*block.Block, error) {
+	blkHash, err := bc.db.Get(getTransactionIndexKey(txid))
+	if err != nil {
+		return nil, errval.TransactionNotFound
+	}
+	blk, err := bc.GetBlockByHash(blkHash)
+	if err != nil {
+		return nil, errval.TransactionNotFound
+	}
+
+	//the index keeps the last block that packed the transaction, which may have been reverted since
+	mainBlk, err := bc.GetBlockByHeight(blk.GetHeight())
+	if err != nil || !bytes.Equal(mainBlk.GetHash(), blk.GetHash()) {
+		return nil, errval.TransactionNotFound
+	}
+	return blk, nil
+}
+
+func getTransactionIndexKey(txid []byte) []byte {
+	return append([]byte(transactionIndexKeyPrefix), txid...)
+}
@@ -0,0 +1,311 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package lelection
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/core/scState"
+	"github.com/dappley/go-dappley/core/transaction"
+	errval "github.com/dappley/go-dappley/errors"
+)
+
+const (
+	// ElectionStateAddress is the address under which the votes are kept in the contract state
+	ElectionStateAddress = "dappley_election"
+	// ElectionInterval is the number of blocks between two elections of the dynasty
+	ElectionInterval uint64 = 210
+
+	candidatesKey  = "candidates"
+	votesKeyPrefix = "votes_"
+	voteKeyPrefix  = "vote_"
+	voterKeyPrefix = "voter_"
+	slashedKey     = "slashed"
+)
+
+// Candidate is an address that received votes and its total vote weight
+type Candidate struct {
+	Address string
+	Votes   *common.Amount
+}
+
+// VoteRecord is a vote that is currently locking coins of a voter
+type VoteRecord struct {
+	TxID       string   `json:"txid"`
+	Voter      string   `json:"voter"`
+	Amount     string   `json:"amount"`
+	Candidates []string `json:"candidates"`
+	Height     uint64   `json:"height"`
+}
+
+// SlashRecord is a producer removed from the dynasty for minting two blocks in one time slot
+type SlashRecord struct {
+	Address string `json:"address"`
+	TxID    string `json:"txid"`
+	Height  uint64 `json:"height"`
+}
+
+// IsElectionHeight returns true if a new dynasty is elected after the block at height
+func IsElectionHeight(height uint64) bool {
+	return height > 0 && height%ElectionInterval == 0
+}
+
+// ApplyTransaction updates the votes in state with a vote, an unvote or a slash transaction and schedules the dynasty
+// change of a producer change transaction. Other transactions are ignored. A vote adds its locked amount to the weight
+// of every candidate it supports; an unvote removes it again. A slashed producer can not be elected any more
+func ApplyTransaction(state *scState.ScState, tx *transaction.Transaction, height uint64) error {
+	switch {
+	case tx.IsVote():
+		return applyVote(state, tx, height)
+	case tx.IsUnvote():
+		return applyUnvote(state, tx)
+	case tx.IsSlash():
+		return applySlash(state, tx, height)
+	case tx.IsChangeProducter():
+		return applyProducerChange(state, tx, height)
+	}
+	return nil
+}
+
+func applyVote(state *scState.ScState, tx *transaction.Transaction, height uint64) error {
+	if len(tx.Vout) == 0 {
+		return errval.InvalidVotePayload
+	}
+	payload, isVote := tx.Vout[0].GetVotePayload()
+	if !isVote {
+		return errval.InvalidVotePayload
+	}
+
+	record := &VoteRecord{
+		TxID:       hex.EncodeToString(tx.ID),
+		Voter:      tx.Vout[0].PubKeyHash.GenerateAddress().String(),
+		Amount:     tx.Vout[0].Value.String(),
+		Candidates: payload.Candidates,
+		Height:     height,
+	}
+	for _, candidate := range record.Candidates {
+		addVotes(state, candidate, tx.Vout[0].Value)
+	}
+	setJSON(state, voteKeyPrefix+record.TxID, record)
+	setJSON(state, voterKeyPrefix+record.Voter, append(getVoterTxIDs(state, record.Voter), record.TxID))
+	return nil
+}
+
+func applyUnvote(state *scState.ScState, tx *transaction.Transaction) error {
+	if len(tx.Vin) == 0 {
+		return errval.VoteNotFound
+	}
+	record := getVoteRecord(state, hex.EncodeToString(tx.Vin[0].Txid))
+	if record == nil {
+		return errval.VoteNotFound
+	}
+	amount, err := common.NewAmountFromString(record.Amount)
+	if err != nil {
+		return err
+	}
+
+	for _, candidate := range record.Candidates {
+		if err := subVotes(state, candidate, amount); err != nil {
+			return err
+		}
+	}
+	state.SetStateValue(ElectionStateAddress, voteKeyPrefix+record.TxID, scState.ScStateValueIsNotExist)
+
+	var txIDs []string
+	for _, txID := range getVoterTxIDs(state, record.Voter) {
+		if txID != record.TxID {
+			txIDs = append(txIDs, txID)
+		}
+	}
+	if len(txIDs) == 0 {
+		state.SetStateValue(ElectionStateAddress, voterKeyPrefix+record.Voter, scState.ScStateValueIsNotExist)
+	} else {
+		setJSON(state, voterKeyPrefix+record.Voter, txIDs)
+	}
+	return nil
+}
+
+func applySlash(state *scState.ScState, tx *transaction.Transaction, height uint64) error {
+	if len(tx.Vout) == 0 {
+		return errval.InvalidSlashEvidence
+	}
+	record := &SlashRecord{
+		Address: tx.Vout[0].PubKeyHash.GenerateAddress().String(),
+		TxID:    hex.EncodeToString(tx.ID),
+		Height:  height,
+	}
+	records := GetSlashedProducers(state)
+	for _, slashed := range records {
+		if slashed.Address == record.Address {
+			return errval.InvalidSlashEvidence
+		}
+	}
+	setJSON(state, slashedKey, append(records, record))
+	return nil
+}
+
+// GetCandidates returns all candidates with votes, ordered by vote weight
+func GetCandidates(state *scState.ScState) []*Candidate {
+	var candidates []*Candidate
+	for _, address := range getCandidateAddresses(state) {
+		candidates = append(candidates, &Candidate{address, getVotes(state, address)})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if cmp := candidates[i].Votes.Cmp(candidates[j].Votes); cmp != 0 {
+			return cmp > 0
+		}
+		return candidates[i].Address < candidates[j].Address
+	})
+	return candidates
+}
+
+// GetVotes returns the votes currently cast by voter
+func GetVotes(state *scState.ScState, voter string) []*VoteRecord {
+	var records []*VoteRecord
+	for _, txID := range getVoterTxIDs(state, voter) {
+		if record := getVoteRecord(state, txID); record != nil {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+// GetSlashedProducers returns the producers that were slashed for double-minting
+func GetSlashedProducers(state *scState.ScState) []*SlashRecord {
+	var records []*SlashRecord
+	getJSON(state, slashedKey, &records)
+	return records
+}
+
+// ElectProducers returns the next producers: the maxProducers candidates with the most votes. If there are not
+// enough candidates, the remaining seats are kept by the current producers. Slashed producers are never elected.
+// The current producers are returned unchanged if nobody has voted
+func ElectProducers(state *scState.ScState, current []string, maxProducers int) []string {
+	candidates := GetCandidates(state)
+	if len(candidates) == 0 {
+		return current
+	}
+
+	var producers []string
+	excluded := make(map[string]bool)
+	for _, slashed := range GetSlashedProducers(state) {
+		excluded[slashed.Address] = true
+	}
+	for _, candidate := range candidates {
+		if len(producers) >= maxProducers {
+			break
+		}
+		if !excluded[candidate.Address] {
+			producers = append(producers, candidate.Address)
+			excluded[candidate.Address] = true
+		}
+	}
+	for _, producer := range current {
+		if len(producers) >= maxProducers {
+			break
+		}
+		if !excluded[producer] {
+			producers = append(producers, producer)
+		}
+	}
+	return producers
+}
+
+func addVotes(state *scState.ScState, candidate string, amount *common.Amount) {
+	votes := getVotes(state, candidate)
+	if votes.IsZero() {
+		addresses := append(getCandidateAddresses(state), candidate)
+		sort.Strings(addresses)
+		setJSON(state, candidatesKey, addresses)
+	}
+	state.SetStateValue(ElectionStateAddress, votesKeyPrefix+candidate, votes.Add(amount).String())
+}
+
+func subVotes(state *scState.ScState, candidate string, amount *common.Amount) error {
+	votes, err := getVotes(state, candidate).Sub(amount)
+	if err != nil {
+		return err
+	}
+	if !votes.IsZero() {
+		state.SetStateValue(ElectionStateAddress, votesKeyPrefix+candidate, votes.String())
+		return nil
+	}
+
+	state.SetStateValue(ElectionStateAddress, votesKeyPrefix+candidate, scState.ScStateValueIsNotExist)
+	var addresses []string
+	for _, address := range getCandidateAddresses(state) {
+		if address != candidate {
+			addresses = append(addresses, address)
+		}
+	}
+	if len(addresses) == 0 {
+		state.SetStateValue(ElectionStateAddress, candidatesKey, scState.ScStateValueIsNotExist)
+	} else {
+		setJSON(state, candidatesKey, addresses)
+	}
+	return nil
+}
+
+func getVotes(state *scState.ScState, candidate string) *common.Amount {
+	value, ok := state.GetStateValue(ElectionStateAddress, votesKeyPrefix+candidate)
+	if !ok {
+		return common.NewAmount(0)
+	}
+	votes, err := common.NewAmountFromString(value)
+	if err != nil {
+		return common.NewAmount(0)
+	}
+	return votes
+}
+
+func getCandidateAddresses(state *scState.ScState) []string {
+	var addresses []string
+	getJSON(state, candidatesKey, &addresses)
+	return addresses
+}
+
+func getVoterTxIDs(state *scState.ScState, voter string) []string {
+	var txIDs []string
+	getJSON(state, voterKeyPrefix+voter, &txIDs)
+	return txIDs
+}
+
+func getVoteRecord(state *scState.ScState, txID string) *VoteRecord {
+	record := &VoteRecord{}
+	if !getJSON(state, voteKeyPrefix+txID, record) {
+		return nil
+	}
+	return record
+}
+
+func getJSON(state *scState.ScState, key string, v interface{}) bool {
+	value, ok := state.GetStateValue(ElectionStateAddress, key)
+	if !ok {
+		return false
+	}
+	return json.Unmarshal([]byte(value), v) == nil
+}
+
+func setJSON(state *scState.ScState, key string, v interface{}) {
+	bytes, _ := json.Marshal(v)
+	state.SetStateValue(ElectionStateAddress, key, string(bytes))
+}
@@ -0,0 +1,172 @@
+package lelection
+
+import (
+	"testing"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/scState"
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/core/transactionbase"
+	"github.com/dappley/go-dappley/core/utxo"
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/dappley/go-dappley/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func newVoteTx(voter *account.Account, amount uint64, candidates ...string) *transaction.Transaction {
+	voterAccount := account.NewTransactionAccountByAddress(voter.GetAddress())
+	return &transaction.Transaction{
+		ID:   util.GenerateRandomAoB(32),
+		Vin:  []transactionbase.TXInput{{Txid: util.GenerateRandomAoB(32), Vout: 0, PubKey: voter.GetKeyPair().GetPublicKey()}},
+		Vout: []transactionbase.TXOutput{*transactionbase.NewTxOut(common.NewAmount(amount), voterAccount, transactionbase.NewVotePayload(candidates))},
+		Tip:  common.NewAmount(0),
+		Type: transaction.TxTypeVote,
+	}
+}
+
+func newUnvoteTx(voteTx *transaction.Transaction) *transaction.Transaction {
+	return &transaction.Transaction{
+		ID:   util.GenerateRandomAoB(32),
+		Vin:  []transactionbase.TXInput{{Txid: voteTx.ID, Vout: 0}},
+		Vout: []transactionbase.TXOutput{{Value: voteTx.Vout[0].Value, PubKeyHash: voteTx.Vout[0].PubKeyHash, Contract: transactionbase.NewUnbondPayload(1000)}},
+		Tip:  common.NewAmount(0),
+		Type: transaction.TxTypeUnvote,
+	}
+}
+
+func TestApplyTransaction(t *testing.T) {
+	db := storage.NewRamStorage()
+	defer db.Close()
+	state := scState.NewScState(utxo.NewUTXOCache(db))
+
+	voter1 := account.NewAccount()
+	voter2 := account.NewAccount()
+	candidate1 := account.NewAccount().GetAddress().String()
+	candidate2 := account.NewAccount().GetAddress().String()
+
+	vote1 := newVoteTx(voter1, 100, candidate1, candidate2)
+	vote2 := newVoteTx(voter2, 300, candidate2)
+	assert.Nil(t, ApplyTransaction(state, vote1, 1))
+	assert.Nil(t, ApplyTransaction(state, vote2, 2))
+
+	candidates := GetCandidates(state)
+	assert.Equal(t, 2, len(candidates))
+	assert.Equal(t, candidate2, candidates[0].Address)
+	assert.Equal(t, common.NewAmount(400), candidates[0].Votes)
+	assert.Equal(t, candidate1, candidates[1].Address)
+	assert.Equal(t, common.NewAmount(100), candidates[1].Votes)
+
+	votes := GetVotes(state, voter1.GetAddress().String())
+	assert.Equal(t, 1, len(votes))
+	assert.Equal(t, "100", votes[0].Amount)
+	assert.Equal(t, uint64(1), votes[0].Height)
+
+	// the votes survive a save and are rolled back with the block
+	assert.Nil(t, state.Save(util.Str2bytes("blk1")))
+	state = scState.NewScState(utxo.NewUTXOCache(db))
+	assert.Nil(t, ApplyTransaction(state, newUnvoteTx(vote1), 3))
+	assert.Equal(t, errval.VoteNotFound, ApplyTransaction(state, newUnvoteTx(vote1), 3))
+	candidates = GetCandidates(state)
+	assert.Equal(t, 1, len(candidates))
+	assert.Equal(t, common.NewAmount(300), candidates[0].Votes)
+	assert.Empty(t, GetVotes(state, voter1.GetAddress().String()))
+	assert.Nil(t, state.Save(util.Str2bytes("blk2")))
+
+	state = scState.NewScState(utxo.NewUTXOCache(db))
+	state.RevertState(util.Str2bytes("blk2"))
+	assert.Equal(t, 2, len(GetCandidates(state)))
+	assert.Equal(t, 1, len(GetVotes(state, voter1.GetAddress().String())))
+}
+
+func TestElectProducers(t *testing.T) {
+	db := storage.NewRamStorage()
+	defer db.Close()
+	state := scState.NewScState(utxo.NewUTXOCache(db))
+
+	current := []string{"producer1", "producer2", "producer3"}
+	assert.Equal(t, current, ElectProducers(state, current, 3))
+
+	candidate1 := account.NewAccount().GetAddress().String()
+	candidate2 := account.NewAccount().GetAddress().String()
+	assert.Nil(t, ApplyTransaction(state, newVoteTx(account.NewAccount(), 100, candidate1), 1))
+	assert.Nil(t, ApplyTransaction(state, newVoteTx(account.NewAccount(), 200, candidate2, "producer2"), 1))
+
+	assert.Equal(t, []string{candidate2, "producer2", candidate1}, ElectProducers(state, current, 3))
+	assert.Equal(t, []string{candidate2, "producer2"}, ElectProducers(state, current, 2))
+
+	assert.Equal(t, errval.VoteNotFound, ApplyTransaction(state, newUnvoteTx(newVoteTx(account.NewAccount(), 1, candidate1)), 1))
+}
+
+func TestIsElectionHeight(t *testing.T) {
+	assert.False(t, IsElectionHeight(0))
+	assert.False(t, IsElectionHeight(ElectionInterval-1))
+	assert.True(t, IsElectionHeight(ElectionInterval))
+	assert.True(t, IsElectionHeight(2*ElectionInterval))
+}
+
+func TestApplyTransaction_Slash(t *testing.T) {
+	db := storage.NewRamStorage()
+	defer db.Close()
+	state := scState.NewScState(utxo.NewUTXOCache(db))
+
+	offender := account.NewAccount()
+	candidate := account.NewAccount().GetAddress().String()
+	slashTx := &transaction.Transaction{
+		ID:   util.GenerateRandomAoB(32),
+		Vout: []transactionbase.TXOutput{*transactionbase.NewTxOut(common.NewAmount(0), account.NewTransactionAccountByAddress(offender.GetAddress()), "{}")},
+		Tip:  common.NewAmount(0),
+		Type: transaction.TxTypeSlash,
+	}
+	assert.Nil(t, ApplyTransaction(state, slashTx, 5))
+	assert.Equal(t, errval.InvalidSlashEvidence, ApplyTransaction(state, slashTx, 6))
+
+	slashed := GetSlashedProducers(state)
+	assert.Equal(t, 1, len(slashed))
+	assert.Equal(t, offender.GetAddress().String(), slashed[0].Address)
+	assert.Equal(t, uint64(5), slashed[0].Height)
+
+	// a slashed producer is neither elected nor kept in the dynasty
+	assert.Nil(t, ApplyTransaction(state, newVoteTx(account.NewAccount(), 100, offender.GetAddress().String(), candidate), 7))
+	assert.Equal(t, []string{candidate, "producer1"}, ElectProducers(state, []string{offender.GetAddress().String(), "producer1"}, 3))
+}
+
+func newProducerChangeTx(original *account.Account, contract string) *transaction.Transaction {
+	return &transaction.Transaction{
+		ID:   util.GenerateRandomAoB(32),
+		Vout: []transactionbase.TXOutput{*transactionbase.NewTxOut(common.NewAmount(1), account.NewTransactionAccountByAddress(original.GetAddress()), contract)},
+		Tip:  common.NewAmount(0),
+		Type: transaction.TxTypeProducerChange,
+	}
+}
+
+func TestApplyTransaction_ProducerChange(t *testing.T) {
+	db := storage.NewRamStorage()
+	defer db.Close()
+	state := scState.NewScState(utxo.NewUTXOCache(db))
+
+	producer1 := account.NewAccount()
+	producer2 := account.NewAccount()
+
+	assert.Equal(t, errval.InvalidDynastyChange, ApplyTransaction(state, newProducerChangeTx(producer1, "invalid"), 1))
+	assert.Equal(t, errval.InvalidDynastyChange, ApplyTransaction(state, newProducerChangeTx(producer1, `{"height":1,"addresses":"new1","kind":1}`), 1))
+	assert.Equal(t, errval.InvalidDynastyChange, ApplyTransaction(state, newProducerChangeTx(producer1, `{"height":5,"addresses":"new1","kind":4}`), 1))
+
+	assert.Nil(t, ApplyTransaction(state, newProducerChangeTx(producer1, `{"height":5,"addresses":"new1","kind":1}`), 1))
+	assert.Nil(t, ApplyTransaction(state, newProducerChangeTx(producer2, `{"height":3,"addresses":"new2","kind":2}`), 1))
+	assert.Equal(t, 2, len(GetScheduledChanges(state)))
+	changes := GetDynastyChanges(state, 3)
+	assert.Equal(t, 1, len(changes))
+	assert.Equal(t, producer2.GetAddress().String(), changes[0].Original)
+	assert.Equal(t, "new2", changes[0].New)
+	assert.Equal(t, DynastyChangeAdd, changes[0].Kind)
+
+	// a later change of the same producer overrides its pending change and applied changes are dropped
+	assert.Nil(t, ApplyTransaction(state, newProducerChangeTx(producer1, `{"height":6,"addresses":"","kind":3}`), 4))
+	changes = GetScheduledChanges(state)
+	assert.Equal(t, 1, len(changes))
+	assert.Equal(t, uint64(6), changes[0].Height)
+	assert.Equal(t, DynastyChangeRemove, changes[0].Kind)
+	assert.Empty(t, GetDynastyChanges(state, 5))
+}
@@ -0,0 +1,113 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package lelection
+
+import (
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/dappley/go-dappley/core/scState"
+	"github.com/dappley/go-dappley/core/transaction"
+	errval "github.com/dappley/go-dappley/errors"
+)
+
+const (
+	// DynastyChangeReplace replaces the original producer with the new one
+	DynastyChangeReplace = 1
+	// DynastyChangeAdd appends the new producer to the dynasty
+	DynastyChangeAdd = 2
+	// DynastyChangeRemove removes the original producer from the dynasty
+	DynastyChangeRemove = 3
+
+	scheduleKey = "schedule"
+)
+
+// DynastyChange is a change of the producers scheduled by a producer change transaction
+type DynastyChange struct {
+	Height   uint64 `json:"height"`
+	Original string `json:"original"`
+	New      string `json:"new"`
+	Kind     int    `json:"kind"`
+	TxID     string `json:"txid"`
+}
+
+// producerChangePayload is the contract field of a producer change transaction
+type producerChangePayload struct {
+	Height   uint64 `json:"height"`
+	Producer string `json:"addresses"`
+	Kind     int    `json:"kind"`
+}
+
+// ParseProducerChange returns the dynasty change carried by a producer change transaction in a block at height. The
+// original producer is the owner of the first output, which the transaction verification requires to be the signer
+func ParseProducerChange(tx *transaction.Transaction, height uint64) (*DynastyChange, error) {
+	if len(tx.Vout) == 0 {
+		return nil, errval.InvalidDynastyChange
+	}
+	var payload producerChangePayload
+	if err := json.Unmarshal([]byte(tx.Vout[0].Contract), &payload); err != nil {
+		return nil, errval.InvalidDynastyChange
+	}
+	if payload.Height <= height || payload.Kind < DynastyChangeReplace || payload.Kind > DynastyChangeRemove {
+		return nil, errval.InvalidDynastyChange
+	}
+
+	return &DynastyChange{
+		Height:   payload.Height,
+		Original: tx.Vout[0].PubKeyHash.GenerateAddress().String(),
+		New:      payload.Producer,
+		Kind:     payload.Kind,
+		TxID:     hex.EncodeToString(tx.ID),
+	}, nil
+}
+
+// applyProducerChange schedules the dynasty change of a producer change transaction. A later change of the same
+// original producer overrides its pending change. Changes that already took effect are dropped from the schedule
+func applyProducerChange(state *scState.ScState, tx *transaction.Transaction, height uint64) error {
+	change, err := ParseProducerChange(tx, height)
+	if err != nil {
+		return err
+	}
+	changes := []*DynastyChange{}
+	for _, scheduled := range GetScheduledChanges(state) {
+		if scheduled.Height >= height && scheduled.Original != change.Original {
+			changes = append(changes, scheduled)
+		}
+	}
+	setJSON(state, scheduleKey, append(changes, change))
+	return nil
+}
+
+// GetScheduledChanges returns the dynasty changes that have been scheduled and not been dropped yet
+func GetScheduledChanges(state *scState.ScState) []*DynastyChange {
+	var changes []*DynastyChange
+	getJSON(state, scheduleKey, &changes)
+	return changes
+}
+
+// GetDynastyChanges returns the dynasty changes that take effect at height
+func GetDynastyChanges(state *scState.ScState, height uint64) []*DynastyChange {
+	var changes []*DynastyChange
+	for _, change := range GetScheduledChanges(state) {
+		if change.Height == height {
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
@@ -0,0 +1,343 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package lfinality
+
+import (
+	"sync"
+	"time"
+
+	"github.com/dappley/go-dappley/common/clock"
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/common/log"
+	"github.com/dappley/go-dappley/common/pubsub"
+	"github.com/dappley/go-dappley/core/finality"
+	finalitypb "github.com/dappley/go-dappley/core/finality/pb"
+	"github.com/dappley/go-dappley/logic/lblockchain"
+	"github.com/dappley/go-dappley/network/networkmodel"
+	"github.com/golang/protobuf/proto"
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	SendVote          = "SendFinalityVote"
+	voteCheckInterval = time.Second
+	maxVoteHeightLead = 64
+)
+
+var (
+	fmSubscribedTopics = []string{
+		SendVote,
+	}
+)
+
+// Consensus provides the producers of the current dynasty
+type Consensus interface {
+	GetProducers() []string
+}
+
+// FinalityManager collects the prevotes and precommits of the producers and finalizes the blocks that have
+// precommits from more than 2/3 of the dynasty. If the local node is a producer, it also casts its own votes. Once the
+// local producer precommits a block it is locked on it: it only prevotes for the block and its descendants until more
+// than 2/3 of the dynasty prevote for a higher block. The votes are kept by height, with at most one vote of each
+// producer at a height, and only for the heights between the LIB and shortly above the tail
+type FinalityManager struct {
+	bm          *lblockchain.BlockchainManager
+	netService  lblockchain.NetService
+	consensus   Consensus
+	producer    string
+	producerKey string
+	votes       map[finality.VoteType]map[uint64]map[string]*finality.Vote
+	state       *finality.VoterState
+	clock       clock.Clock
+	mutex       *sync.Mutex
+	stopCh      chan bool
+}
+
+// NewFinalityManager returns a new finality manager. An empty producer key makes it a non-voting observer. The last
+// votes of the producer and its lock are restored from the database of the blockchain
+func NewFinalityManager(bm *lblockchain.BlockchainManager, netService lblockchain.NetService, consensus Consensus, producer, producerKey string) *FinalityManager {
+	state, err := finality.GetVoterState(producer, bm.Getblockchain().GetDb())
+	if err != nil {
+		logger.WithError(err).Warn("FinalityManager: cannot read the voter state.")
+		state = finality.NewVoterState()
+	}
+	fm := &FinalityManager{
+		bm:          bm,
+		netService:  netService,
+		consensus:   consensus,
+		producer:    producer,
+		producerKey: producerKey,
+		votes: map[finality.VoteType]map[uint64]map[string]*finality.Vote{
+			finality.Prevote:   make(map[uint64]map[string]*finality.Vote),
+			finality.Precommit: make(map[uint64]map[string]*finality.Vote),
+		},
+		state:  state,
+		clock:  clock.NewRealClock(),
+		mutex:  &sync.Mutex{},
+		stopCh: make(chan bool, 1),
+	}
+	fm.ListenToNetService()
+	return fm
+}
+
+// SetClock sets the clock that drives the prevotes for new tail blocks
+func (fm *FinalityManager) SetClock(c clock.Clock) {
+	fm.clock = c
+}
+
+// Start starts casting prevotes for new tail blocks
+func (fm *FinalityManager) Start() {
+	go func() {
+		defer log.CrashHandler()
+
+		ticker := fm.clock.NewTicker(voteCheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.Chan():
+				fm.checkTail()
+			case <-fm.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the finality manager
+func (fm *FinalityManager) Stop() {
+	fm.stopCh <- true
+}
+
+func (fm *FinalityManager) ListenToNetService() {
+	if fm.netService == nil {
+		return
+	}
+
+	fm.netService.Listen(fm)
+}
+
+func (fm *FinalityManager) GetSubscribedTopics() []string {
+	return fmSubscribedTopics
+}
+
+func (fm *FinalityManager) GetTopicHandler(topic string) pubsub.TopicHandler {
+
+	switch topic {
+	case SendVote:
+		return fm.SendVoteHandler
+	}
+	return nil
+}
+
+// SendVoteHandler handles a vote received from the peers
+func (fm *FinalityManager) SendVoteHandler(input interface{}) {
+
+	var command *networkmodel.DappRcvdCmdContext
+	command = input.(*networkmodel.DappRcvdCmdContext)
+
+	votePb := &finalitypb.Vote{}
+	if err := proto.Unmarshal(command.GetData(), votePb); err != nil {
+		logger.WithError(err).Warn("FinalityManager: parse data failed.")
+		return
+	}
+
+	vote := &finality.Vote{}
+	vote.FromProto(votePb)
+	if !fm.AddVote(vote) {
+		return
+	}
+
+	if command.IsBroadcast() {
+		//relay the original command
+		fm.netService.Relay(command.GetCommand(), networkmodel.PeerInfo{}, networkmodel.HighPriorityCommand)
+	}
+}
+
+// AddVote adds a vote from a producer of the dynasty and finalizes its block once enough votes are collected.
+// It returns false if the vote is invalid, already known, or if the producer has voted for another block at its height.
+// Votes at or below the LIB or more than maxVoteHeightLead blocks above the tail are ignored
+func (fm *FinalityManager) AddVote(vote *finality.Vote) bool {
+	if !fm.isProducer(vote.Producer) {
+		return false
+	}
+	bc := fm.bm.Getblockchain()
+	if vote.Height <= bc.GetLIBHeight() || vote.Height > bc.GetMaxHeight()+maxVoteHeightLead {
+		return false
+	}
+	if err := vote.Verify(); err != nil {
+		logger.WithError(err).Warn("FinalityManager: received an invalid vote.")
+		return false
+	}
+
+	fm.mutex.Lock()
+	heightVotes, ok := fm.votes[vote.Type][vote.Height]
+	if !ok {
+		heightVotes = make(map[string]*finality.Vote)
+		fm.votes[vote.Type][vote.Height] = heightVotes
+	}
+	if _, ok := heightVotes[vote.Producer]; ok {
+		fm.mutex.Unlock()
+		return false
+	}
+	heightVotes[vote.Producer] = vote
+	fm.mutex.Unlock()
+
+	fm.processVotes(vote)
+	return true
+}
+
+// checkTail casts a prevote for the tail block if the local producer has not voted at its height
+func (fm *FinalityManager) checkTail() {
+	tailBlk, err := fm.bm.Getblockchain().GetTailBlock()
+	if err != nil {
+		return
+	}
+	fm.castVote(finality.Prevote, tailBlk.GetHash(), tailBlk.GetHeight())
+}
+
+// processVotes casts a precommit once the block of the vote has enough prevotes and finalizes the block once it
+// has enough precommits
+func (fm *FinalityManager) processVotes(vote *finality.Vote) {
+	switch vote.Type {
+	case finality.Prevote:
+		if len(fm.getVotes(finality.Prevote, vote)) >= finality.Quorum(len(fm.consensus.GetProducers())) {
+			fm.castVote(finality.Precommit, vote.BlockHash, vote.Height)
+		}
+	case finality.Precommit:
+		precommits := fm.getVotes(finality.Precommit, vote)
+		if len(precommits) < finality.Quorum(len(fm.consensus.GetProducers())) {
+			return
+		}
+		justification := finality.NewJustification(vote.BlockHash, vote.Height, precommits)
+		if err := fm.bm.Getblockchain().Finalize(justification, fm.consensus.GetProducers()); err != nil {
+			logger.WithError(err).Debug("FinalityManager: cannot finalize the block.")
+			return
+		}
+		fm.prune(vote.Height)
+	}
+}
+
+// getVotes returns the votes of the current producers for the block of the vote
+func (fm *FinalityManager) getVotes(voteType finality.VoteType, vote *finality.Vote) []*finality.Vote {
+	fm.mutex.Lock()
+	defer fm.mutex.Unlock()
+
+	var votes []*finality.Vote
+	heightVotes := fm.votes[voteType][vote.Height]
+	for _, producer := range fm.consensus.GetProducers() {
+		if v, ok := heightVotes[producer]; ok && v.BlockHash.Equals(vote.BlockHash) {
+			votes = append(votes, v)
+		}
+	}
+	return votes
+}
+
+// castVote signs a vote of the local producer and broadcasts it. A producer votes at most once at each height
+// for each vote type, and never for a block at or below its last vote of the type. A prevote is only cast for the
+// locked block or its descendants, while a precommit, which is only cast for a block with enough prevotes, moves the
+// lock to its block. The vote is not sent if the voter state cannot be stored
+func (fm *FinalityManager) castVote(voteType finality.VoteType, blockHash []byte, height uint64) {
+	if fm.producerKey == "" || !fm.isProducer(fm.producer) {
+		return
+	}
+	if voteType == finality.Prevote && !fm.extendsLockedBlock(blockHash, height) {
+		logger.WithFields(logger.Fields{
+			"height": height,
+			"locked": fm.state.LockedHeight,
+		}).Debug("FinalityManager: does not prevote for a block on a fork of the locked block.")
+		return
+	}
+
+	fm.mutex.Lock()
+	if height <= fm.state.LastVoted[voteType] {
+		fm.mutex.Unlock()
+		return
+	}
+	fm.state.LastVoted[voteType] = height
+	if voteType == finality.Precommit {
+		fm.state.LockedHash = blockHash
+		fm.state.LockedHeight = height
+	}
+	err := fm.state.Save(fm.producer, fm.bm.Getblockchain().GetDb())
+	fm.mutex.Unlock()
+	if err != nil {
+		logger.WithError(err).Warn("FinalityManager: failed to save the voter state.")
+		return
+	}
+
+	vote := finality.NewVote(voteType, blockHash, height, fm.producer)
+	if err := vote.Sign(fm.producerKey); err != nil {
+		logger.WithError(err).Warn("FinalityManager: failed to sign the vote.")
+		return
+	}
+
+	if fm.netService != nil {
+		fm.netService.BroadcastHighProrityCommand(SendVote, vote.ToProto())
+	}
+	fm.AddVote(vote)
+}
+
+// extendsLockedBlock returns if the block is the locked block or one of its descendants on the local chain
+func (fm *FinalityManager) extendsLockedBlock(blockHash hash.Hash, height uint64) bool {
+	fm.mutex.Lock()
+	lockedHash, lockedHeight := fm.state.LockedHash, fm.state.LockedHeight
+	fm.mutex.Unlock()
+
+	if lockedHash == nil {
+		return true
+	}
+	if height < lockedHeight {
+		return false
+	}
+
+	bc := fm.bm.Getblockchain()
+	for height > lockedHeight {
+		blk, err := bc.GetBlockByHash(blockHash)
+		if err != nil {
+			return false
+		}
+		blockHash = blk.GetPrevHash()
+		height--
+	}
+	return blockHash.Equals(lockedHash)
+}
+
+// prune removes the votes at or below the finalized height
+func (fm *FinalityManager) prune(height uint64) {
+	fm.mutex.Lock()
+	defer fm.mutex.Unlock()
+
+	for _, votesByHeight := range fm.votes {
+		for voteHeight := range votesByHeight {
+			if voteHeight <= height {
+				delete(votesByHeight, voteHeight)
+			}
+		}
+	}
+}
+
+// isProducer returns if the address is a producer of the current dynasty
+func (fm *FinalityManager) isProducer(address string) bool {
+	for _, producer := range fm.consensus.GetProducers() {
+		if producer == address {
+			return true
+		}
+	}
+	return false
+}
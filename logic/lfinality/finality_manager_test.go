@@ -0,0 +1,245 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package lfinality
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/dappley/go-dappley/common/clock"
+	"github.com/dappley/go-dappley/common/pubsub"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/core/blockchain"
+	"github.com/dappley/go-dappley/core/finality"
+	finalitypb "github.com/dappley/go-dappley/core/finality/pb"
+	"github.com/dappley/go-dappley/logic/lblock"
+	"github.com/dappley/go-dappley/logic/lblockchain"
+	"github.com/dappley/go-dappley/network/networkmodel"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockConsensus struct {
+	producers []string
+}
+
+func (con *mockConsensus) GetProducers() []string {
+	return con.producers
+}
+
+// mockNetwork delivers the broadcast votes to the other finality managers
+type mockNetwork struct {
+	managers []*FinalityManager
+}
+
+func (net *mockNetwork) UnicastNormalPriorityCommand(commandName string, message proto.Message, destination networkmodel.PeerInfo) {
+}
+func (net *mockNetwork) UnicastHighProrityCommand(commandName string, message proto.Message, destination networkmodel.PeerInfo) {
+}
+func (net *mockNetwork) BroadcastNormalPriorityCommand(commandName string, message proto.Message) {}
+func (net *mockNetwork) BroadcastHighProrityCommand(commandName string, message proto.Message) {
+	for _, fm := range net.managers {
+		vote := &finality.Vote{}
+		vote.FromProto(message.(*finalitypb.Vote))
+		fm.AddVote(vote)
+	}
+}
+func (net *mockNetwork) Listen(subscriber pubsub.Subscriber) {}
+func (net *mockNetwork) Relay(dappCmd *networkmodel.DappCmd, destination networkmodel.PeerInfo, priority networkmodel.DappCmdPriority) {
+}
+
+func TestFinalityManager_Finalize(t *testing.T) {
+	bc := lblockchain.GenerateMockBlockchainWithCoinbaseTxOnly(3)
+	bc.EnableFinality()
+	bm := lblockchain.NewBlockchainManager(bc, blockchain.NewBlockPool(nil), nil, nil)
+	tailBlk, err := bc.GetTailBlock()
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), bc.GetLIBHeight())
+
+	producers := []*account.Account{account.NewAccount(), account.NewAccount(), account.NewAccount()}
+	con := &mockConsensus{}
+	for _, producer := range producers {
+		con.producers = append(con.producers, producer.GetAddress().String())
+	}
+
+	net := &mockNetwork{}
+	for _, producer := range producers {
+		key := hex.EncodeToString(producer.GetKeyPair().GetPrivateKeyBytes())
+		net.managers = append(net.managers, NewFinalityManager(bm, net, con, producer.GetAddress().String(), key))
+	}
+
+	// votes from outside the dynasty are ignored
+	outsider := account.NewAccount()
+	vote := finality.NewVote(finality.Prevote, tailBlk.GetHash(), tailBlk.GetHeight(), outsider.GetAddress().String())
+	vote.Sign(hex.EncodeToString(outsider.GetKeyPair().GetPrivateKeyBytes()))
+	assert.False(t, net.managers[0].AddVote(vote))
+
+	// two of three producers are not enough
+	net.managers[0].checkTail()
+	net.managers[1].checkTail()
+	assert.Equal(t, uint64(0), bc.GetLIBHeight())
+
+	net.managers[2].checkTail()
+	assert.Equal(t, tailBlk.GetHeight(), bc.GetLIBHeight())
+
+	justification, err := bc.GetJustification(tailBlk.GetHash())
+	assert.Nil(t, err)
+	assert.Nil(t, justification.Verify(con.GetProducers()))
+
+	// votes at or below the LIB are ignored
+	vote = finality.NewVote(finality.Prevote, tailBlk.GetHash(), tailBlk.GetHeight(), producers[0].GetAddress().String())
+	vote.Sign(hex.EncodeToString(producers[0].GetKeyPair().GetPrivateKeyBytes()))
+	assert.False(t, net.managers[0].AddVote(vote))
+}
+
+func TestFinalityManager_ForkLock(t *testing.T) {
+	bc := lblockchain.GenerateMockBlockchainWithCoinbaseTxOnly(3)
+	bc.EnableFinality()
+	bm := lblockchain.NewBlockchainManager(bc, blockchain.NewBlockPool(nil), nil, nil)
+	lockedBlk, err := bc.GetBlockByHeight(2)
+	assert.Nil(t, err)
+	tailBlk, err := bc.GetTailBlock()
+	assert.Nil(t, err)
+
+	producers := []*account.Account{account.NewAccount(), account.NewAccount(), account.NewAccount(), account.NewAccount()}
+	con := &mockConsensus{}
+	for _, producer := range producers {
+		con.producers = append(con.producers, producer.GetAddress().String())
+	}
+	fm := NewFinalityManager(bm, &mockNetwork{}, con, producers[0].GetAddress().String(), hex.EncodeToString(producers[0].GetKeyPair().GetPrivateKeyBytes()))
+
+	// a fork that leaves the canonical chain below the locked block
+	forkBlk, err := bc.GetBlockByHeight(1)
+	assert.Nil(t, err)
+	for height := uint64(2); height <= tailBlk.GetHeight()+1; height++ {
+		forkBlk = block.NewBlockWithRawInfo(nil, forkBlk.GetHash(), 0, int64(height), height, nil)
+		forkBlk.SetHash(lblock.CalculateHash(forkBlk))
+		assert.Nil(t, bc.GetDb().Put(forkBlk.GetHash(), forkBlk.Serialize()))
+	}
+
+	fm.castVote(finality.Precommit, lockedBlk.GetHash(), lockedBlk.GetHeight())
+	assert.Equal(t, lockedBlk.GetHeight(), fm.state.LastVoted[finality.Precommit])
+
+	// the descendants of the locked block get prevotes, the fork does not
+	fm.checkTail()
+	assert.Equal(t, tailBlk.GetHeight(), fm.state.LastVoted[finality.Prevote])
+	fm.castVote(finality.Prevote, forkBlk.GetHash(), forkBlk.GetHeight())
+	assert.Equal(t, tailBlk.GetHeight(), fm.state.LastVoted[finality.Prevote])
+
+	// enough prevotes of the other producers for a higher block on the fork move the lock
+	for _, producer := range producers[1:] {
+		vote := finality.NewVote(finality.Prevote, forkBlk.GetHash(), forkBlk.GetHeight(), producer.GetAddress().String())
+		vote.Sign(hex.EncodeToString(producer.GetKeyPair().GetPrivateKeyBytes()))
+		assert.True(t, fm.AddVote(vote))
+	}
+	assert.Equal(t, forkBlk.GetHeight(), fm.state.LastVoted[finality.Precommit])
+	assert.True(t, fm.extendsLockedBlock(forkBlk.GetHash(), forkBlk.GetHeight()))
+	assert.False(t, fm.extendsLockedBlock(tailBlk.GetHash(), tailBlk.GetHeight()))
+}
+
+func TestFinalityManager_RestoreVoterState(t *testing.T) {
+	bc := lblockchain.GenerateMockBlockchainWithCoinbaseTxOnly(3)
+	bc.EnableFinality()
+	bm := lblockchain.NewBlockchainManager(bc, blockchain.NewBlockPool(nil), nil, nil)
+	lockedBlk, err := bc.GetBlockByHeight(2)
+	assert.Nil(t, err)
+	tailBlk, err := bc.GetTailBlock()
+	assert.Nil(t, err)
+
+	producers := []*account.Account{account.NewAccount(), account.NewAccount(), account.NewAccount(), account.NewAccount()}
+	con := &mockConsensus{}
+	for _, producer := range producers {
+		con.producers = append(con.producers, producer.GetAddress().String())
+	}
+	address, key := producers[0].GetAddress().String(), hex.EncodeToString(producers[0].GetKeyPair().GetPrivateKeyBytes())
+	fm := NewFinalityManager(bm, &mockNetwork{}, con, address, key)
+	fm.castVote(finality.Precommit, lockedBlk.GetHash(), lockedBlk.GetHeight())
+	fm.checkTail()
+
+	// a restarted producer keeps its lock and does not vote again at the heights it voted at
+	restarted := NewFinalityManager(bm, &mockNetwork{}, con, address, key)
+	assert.Equal(t, tailBlk.GetHeight(), restarted.state.LastVoted[finality.Prevote])
+	assert.Equal(t, lockedBlk.GetHeight(), restarted.state.LastVoted[finality.Precommit])
+	assert.Equal(t, lockedBlk.GetHash(), restarted.state.LockedHash)
+	assert.Equal(t, lockedBlk.GetHeight(), restarted.state.LockedHeight)
+	restarted.castVote(finality.Precommit, lockedBlk.GetHash(), lockedBlk.GetHeight())
+	assert.Empty(t, restarted.votes[finality.Precommit])
+
+	// another producer has not voted yet
+	other := NewFinalityManager(bm, &mockNetwork{}, con, producers[1].GetAddress().String(), "")
+	assert.Equal(t, uint64(0), other.state.LastVoted[finality.Prevote])
+	assert.Nil(t, other.state.LockedHash)
+}
+
+func TestFinalityManager_AddVoteBounds(t *testing.T) {
+	bc := lblockchain.GenerateMockBlockchainWithCoinbaseTxOnly(3)
+	bc.EnableFinality()
+	bm := lblockchain.NewBlockchainManager(bc, blockchain.NewBlockPool(nil), nil, nil)
+	tailBlk, err := bc.GetTailBlock()
+	assert.Nil(t, err)
+
+	producer := account.NewAccount()
+	key := hex.EncodeToString(producer.GetKeyPair().GetPrivateKeyBytes())
+	con := &mockConsensus{producers: []string{producer.GetAddress().String(), account.NewAccount().GetAddress().String()}}
+	fm := NewFinalityManager(bm, &mockNetwork{}, con, "", "")
+	newVote := func(blockHash []byte, height uint64) *finality.Vote {
+		vote := finality.NewVote(finality.Prevote, blockHash, height, producer.GetAddress().String())
+		vote.Sign(key)
+		return vote
+	}
+
+	// votes too far above the tail are ignored
+	assert.False(t, fm.AddVote(newVote([]byte("far"), tailBlk.GetHeight()+maxVoteHeightLead+1)))
+	assert.True(t, fm.AddVote(newVote([]byte("near"), tailBlk.GetHeight()+maxVoteHeightLead)))
+
+	// a producer has at most one vote at a height
+	assert.True(t, fm.AddVote(newVote(tailBlk.GetHash(), tailBlk.GetHeight())))
+	assert.False(t, fm.AddVote(newVote([]byte("other"), tailBlk.GetHeight())))
+	assert.Equal(t, 2, len(fm.votes[finality.Prevote]))
+
+	// the votes at or below a finalized height are pruned
+	fm.prune(tailBlk.GetHeight())
+	assert.Equal(t, 1, len(fm.votes[finality.Prevote]))
+}
+
+func TestFinalityManager_Start(t *testing.T) {
+	bc := lblockchain.GenerateMockBlockchainWithCoinbaseTxOnly(3)
+	bc.EnableFinality()
+	bm := lblockchain.NewBlockchainManager(bc, blockchain.NewBlockPool(nil), nil, nil)
+	tailBlk, err := bc.GetTailBlock()
+	assert.Nil(t, err)
+
+	producers := []*account.Account{account.NewAccount(), account.NewAccount()}
+	con := &mockConsensus{producers: []string{producers[0].GetAddress().String(), producers[1].GetAddress().String()}}
+	fm := NewFinalityManager(bm, &mockNetwork{}, con, producers[0].GetAddress().String(), hex.EncodeToString(producers[0].GetKeyPair().GetPrivateKeyBytes()))
+	virtualClock := clock.NewVirtualClock(time.Unix(0, 0))
+	fm.SetClock(virtualClock)
+	fm.Start()
+	defer fm.Stop()
+
+	// the tail is prevoted when the clock ticks
+	assert.Eventually(t, func() bool {
+		virtualClock.Advance(voteCheckInterval)
+		fm.mutex.Lock()
+		defer fm.mutex.Unlock()
+		return fm.state.LastVoted[finality.Prevote] == tailBlk.GetHeight()
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,197 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package lgovernance
+
+import (
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/dappley/go-dappley/core/scState"
+	"github.com/dappley/go-dappley/core/transaction"
+	errval "github.com/dappley/go-dappley/errors"
+)
+
+const (
+	// GovernanceStateAddress is the address under which the pending proposals are kept in the contract state
+	GovernanceStateAddress = "dappley_governance"
+
+	// ParamBlockSizeLimit is the maximum size of the transactions in a block in bytes
+	ParamBlockSizeLimit = "blockSizeLimit"
+	// ParamGasPriceFloor is the minimum gas price of a contract transaction
+	ParamGasPriceFloor = "gasPriceFloor"
+	// ParamMaxProducers is the maximum number of producers in the dynasty
+	ParamMaxProducers = "maxProducers"
+	// ParamTimeBetweenBlk is the time between two blocks in seconds
+	ParamTimeBetweenBlk = "timeBetweenBlk"
+
+	// MaxPendingProposals is the maximum number of proposals that are open for votes at the same time
+	MaxPendingProposals = 16
+	// MaxActivationDelay is the maximum number of blocks between a proposal and its activation height
+	MaxActivationDelay uint64 = 100000
+
+	proposalsKey  = "proposals"
+	votePrefix    = "vote_"
+	voteSeparator = "_"
+	approveValue  = "1"
+	rejectValue   = "0"
+)
+
+// Proposal is a pending change of a chain parameter. The votes on it are kept under their own keys in the state, one
+// per voter, so that the size of the stored proposals does not grow with the number of voters
+type Proposal struct {
+	ID               string `json:"id"`
+	Proposer         string `json:"proposer"`
+	Parameter        string `json:"parameter"`
+	Value            uint64 `json:"value"`
+	Height           uint64 `json:"height"`
+	ActivationHeight uint64 `json:"activation_height"`
+}
+
+// IsValidParameter returns true if value is an acceptable value of the chain parameter
+func IsValidParameter(parameter string, value uint64) bool {
+	switch parameter {
+	case ParamGasPriceFloor:
+		return true
+	case ParamBlockSizeLimit, ParamMaxProducers, ParamTimeBetweenBlk:
+		return value > 0 && value <= uint64(^uint32(0)>>1)
+	}
+	return false
+}
+
+// Quorum returns the number of approvals a proposal needs to pass in a dynasty of numOfProducers producers
+func Quorum(numOfProducers int) int {
+	return numOfProducers*2/3 + 1
+}
+
+// GetApprovals returns the producers that approve the proposal. Only the votes of the producers are read
+func (proposal *Proposal) GetApprovals(state *scState.ScState, producers []string) []string {
+	var approvals []string
+	for _, producer := range producers {
+		if value, ok := state.GetStateValue(GovernanceStateAddress, voteKey(proposal.ID, producer)); ok && value == approveValue {
+			approvals = append(approvals, producer)
+		}
+	}
+	return approvals
+}
+
+// IsApproved returns true if a quorum of the producers approves the proposal
+func (proposal *Proposal) IsApproved(state *scState.ScState, producers []string) bool {
+	return len(producers) > 0 && len(proposal.GetApprovals(state, producers)) >= Quorum(len(producers))
+}
+
+// ApplyTransaction records the proposal or the vote of a governance transaction in state. Other transactions are
+// ignored. A proposal counts as an approval of its proposer; a later vote of the same voter overrides the earlier one.
+// Votes are accepted up to the activation height. Proposals whose activation height has passed are dropped. At most
+// MaxPendingProposals proposals are open at a time and a proposal activates within MaxActivationDelay blocks
+func ApplyTransaction(state *scState.ScState, tx *transaction.Transaction, height uint64) error {
+	if !tx.IsGovernance() {
+		return nil
+	}
+	if len(tx.Vout) == 0 {
+		return errval.InvalidGovernancePayload
+	}
+	payload, ok := tx.Vout[0].GetGovernancePayload()
+	if !ok {
+		return errval.InvalidGovernancePayload
+	}
+	voter := tx.Vout[0].PubKeyHash.GenerateAddress().String()
+
+	stored := GetProposals(state)
+	proposals := []*Proposal{}
+	for _, proposal := range stored {
+		if proposal.ActivationHeight >= height {
+			proposals = append(proposals, proposal)
+		}
+	}
+
+	if payload.IsVote() {
+		for _, proposal := range proposals {
+			if proposal.ID == payload.Proposal {
+				if len(proposals) != len(stored) {
+					setJSON(state, proposalsKey, proposals)
+				}
+				setVote(state, proposal.ID, voter, payload.Approve)
+				return nil
+			}
+		}
+		return errval.ProposalNotFound
+	}
+
+	if payload.ActivationHeight <= height || payload.ActivationHeight-height > MaxActivationDelay ||
+		!IsValidParameter(payload.Parameter, payload.Value) {
+		return errval.InvalidGovernancePayload
+	}
+	if len(proposals) >= MaxPendingProposals {
+		return errval.TooManyProposals
+	}
+	proposal := &Proposal{
+		ID:               hex.EncodeToString(tx.ID),
+		Proposer:         voter,
+		Parameter:        payload.Parameter,
+		Value:            payload.Value,
+		Height:           height,
+		ActivationHeight: payload.ActivationHeight,
+	}
+	proposals = append(proposals, proposal)
+	setJSON(state, proposalsKey, proposals)
+	setVote(state, proposal.ID, voter, true)
+	return nil
+}
+
+// GetProposals returns the proposals in the order they were made. Proposals are kept at least until the block at
+// their activation height
+func GetProposals(state *scState.ScState) []*Proposal {
+	var proposals []*Proposal
+	value, ok := state.GetStateValue(GovernanceStateAddress, proposalsKey)
+	if !ok {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(value), &proposals); err != nil {
+		return nil
+	}
+	return proposals
+}
+
+// GetActivatedProposals returns the proposals that are tallied after the block at height
+func GetActivatedProposals(state *scState.ScState, height uint64) []*Proposal {
+	var proposals []*Proposal
+	for _, proposal := range GetProposals(state) {
+		if proposal.ActivationHeight == height {
+			proposals = append(proposals, proposal)
+		}
+	}
+	return proposals
+}
+
+func voteKey(proposal string, voter string) string {
+	return votePrefix + proposal + voteSeparator + voter
+}
+
+func setVote(state *scState.ScState, proposal string, voter string, approve bool) {
+	value := rejectValue
+	if approve {
+		value = approveValue
+	}
+	state.SetStateValue(GovernanceStateAddress, voteKey(proposal, voter), value)
+}
+
+func setJSON(state *scState.ScState, key string, v interface{}) {
+	bytes, _ := json.Marshal(v)
+	state.SetStateValue(GovernanceStateAddress, key, string(bytes))
+}
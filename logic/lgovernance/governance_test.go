@@ -0,0 +1,96 @@
+package lgovernance
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/scState"
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/core/transactionbase"
+	"github.com/dappley/go-dappley/core/utxo"
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/dappley/go-dappley/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func newGovernanceTx(sender *account.Account, payload string) *transaction.Transaction {
+	senderAccount := account.NewTransactionAccountByAddress(sender.GetAddress())
+	return &transaction.Transaction{
+		ID:   util.GenerateRandomAoB(32),
+		Vout: []transactionbase.TXOutput{*transactionbase.NewTxOut(common.NewAmount(0), senderAccount, payload)},
+		Tip:  common.NewAmount(0),
+		Type: transaction.TxTypeGovernance,
+	}
+}
+
+func TestApplyTransaction(t *testing.T) {
+	db := storage.NewRamStorage()
+	defer db.Close()
+	state := scState.NewScState(utxo.NewUTXOCache(db))
+
+	producers := []*account.Account{account.NewAccount(), account.NewAccount(), account.NewAccount()}
+
+	assert.Equal(t, errval.InvalidGovernancePayload, ApplyTransaction(state, newGovernanceTx(producers[0], "invalid"), 1))
+	assert.Equal(t, errval.InvalidGovernancePayload, ApplyTransaction(state, newGovernanceTx(producers[0], transactionbase.NewProposalPayload("unknown", 1, 10)), 1))
+	assert.Equal(t, errval.InvalidGovernancePayload, ApplyTransaction(state, newGovernanceTx(producers[0], transactionbase.NewProposalPayload(ParamMaxProducers, 0, 10)), 1))
+	assert.Equal(t, errval.InvalidGovernancePayload, ApplyTransaction(state, newGovernanceTx(producers[0], transactionbase.NewProposalPayload(ParamMaxProducers, 5, 1)), 1))
+	assert.Equal(t, errval.InvalidGovernancePayload, ApplyTransaction(state, newGovernanceTx(producers[0], transactionbase.NewProposalPayload(ParamMaxProducers, 5, MaxActivationDelay+2)), 1))
+	assert.Equal(t, errval.ProposalNotFound, ApplyTransaction(state, newGovernanceTx(producers[0], transactionbase.NewProposalVotePayload("00", true)), 1))
+
+	proposalTx := newGovernanceTx(producers[0], transactionbase.NewProposalPayload(ParamMaxProducers, 5, 10))
+	assert.Nil(t, ApplyTransaction(state, proposalTx, 1))
+	assert.Nil(t, ApplyTransaction(state, newGovernanceTx(producers[1], transactionbase.NewProposalPayload(ParamGasPriceFloor, 0, 3)), 1))
+	assert.Equal(t, 2, len(GetProposals(state)))
+
+	proposalID := hex.EncodeToString(proposalTx.ID)
+	assert.Nil(t, ApplyTransaction(state, newGovernanceTx(producers[1], transactionbase.NewProposalVotePayload(proposalID, true)), 4))
+	assert.Nil(t, ApplyTransaction(state, newGovernanceTx(producers[2], transactionbase.NewProposalVotePayload(proposalID, true)), 5))
+	// a later vote of the same voter overrides the earlier one
+	assert.Nil(t, ApplyTransaction(state, newGovernanceTx(producers[2], transactionbase.NewProposalVotePayload(proposalID, false)), 6))
+	// votes are closed after the activation height
+	assert.Equal(t, errval.ProposalNotFound, ApplyTransaction(state, newGovernanceTx(producers[2], transactionbase.NewProposalVotePayload(proposalID, true)), 11))
+
+	// the proposal activated at height 3 was dropped
+	proposals := GetProposals(state)
+	assert.Equal(t, 1, len(proposals))
+	assert.Empty(t, GetActivatedProposals(state, 3))
+	activated := GetActivatedProposals(state, 10)
+	assert.Equal(t, 1, len(activated))
+	assert.Equal(t, producers[0].GetAddress().String(), activated[0].Proposer)
+	assert.Equal(t, ParamMaxProducers, activated[0].Parameter)
+	assert.Equal(t, uint64(5), activated[0].Value)
+	assert.Equal(t, uint64(1), activated[0].Height)
+
+	addresses := []string{producers[0].GetAddress().String(), producers[1].GetAddress().String(), producers[2].GetAddress().String()}
+	assert.Equal(t, addresses[:2], activated[0].GetApprovals(state, addresses))
+	assert.False(t, activated[0].IsApproved(state, addresses))
+	assert.True(t, activated[0].IsApproved(state, addresses[:2]))
+	assert.False(t, activated[0].IsApproved(state, nil))
+}
+
+func TestApplyTransaction_MaxPendingProposals(t *testing.T) {
+	db := storage.NewRamStorage()
+	defer db.Close()
+	state := scState.NewScState(utxo.NewUTXOCache(db))
+	proposer := account.NewAccount()
+
+	for i := 0; i < MaxPendingProposals; i++ {
+		assert.Nil(t, ApplyTransaction(state, newGovernanceTx(proposer, transactionbase.NewProposalPayload(ParamMaxProducers, 5, 10)), 1))
+	}
+	assert.Equal(t, errval.TooManyProposals, ApplyTransaction(state, newGovernanceTx(proposer, transactionbase.NewProposalPayload(ParamMaxProducers, 5, 10)), 1))
+
+	// the proposals whose activation height has passed make room for new ones
+	assert.Nil(t, ApplyTransaction(state, newGovernanceTx(proposer, transactionbase.NewProposalPayload(ParamMaxProducers, 5, 20)), 11))
+	assert.Equal(t, 1, len(GetProposals(state)))
+}
+
+func TestQuorum(t *testing.T) {
+	assert.Equal(t, 1, Quorum(1))
+	assert.Equal(t, 2, Quorum(2))
+	assert.Equal(t, 3, Quorum(3))
+	assert.Equal(t, 3, Quorum(4))
+	assert.Equal(t, 15, Quorum(21))
+}
@@ -0,0 +1,379 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package lightclient
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"reflect"
+	"strconv"
+	"sync"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/consensus"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/block"
+	blockpb "github.com/dappley/go-dappley/core/block/pb"
+	"github.com/dappley/go-dappley/core/transaction"
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/logic/lblock"
+	lightclientpb "github.com/dappley/go-dappley/logic/lightclient/pb"
+	logger "github.com/sirupsen/logrus"
+)
+
+//Source is a full node that serves block headers and proofs to a light client
+type Source interface {
+	GetLightBlockHeaders(startHeight uint64, count uint32) ([]*lightclientpb.LightBlockHeader, error)
+	GetUtxoProofs(pubKeyHash account.PubKeyHash) (*lightclientpb.ReturnUtxoProofs, error)
+}
+
+//lightHeader is a verified block header, the hash of the transactions of its block and the producers in charge of the
+//blocks after it
+type lightHeader struct {
+	blk       *block.Block
+	txsHash   []byte
+	producers []string
+	pending   *dynastyChange
+}
+
+//dynastyChange is a change of the producers that the client does not trust yet. The headers after the change are only
+//added to the best chain once more than a third of the previous producers signed some of them, so at least one honest
+//producer of the previous dynasty built on the change
+type dynastyChange struct {
+	previous []string
+	signers  map[string]bool
+}
+
+//Client keeps the best chain of block headers signed by the producers of the dynasty and verifies the proofs of
+//transactions and outputs against it. The producers follow the dynasty changes that the source announces in the
+//headers once the previous producers confirmed them
+type Client struct {
+	dynasty   *consensus.Dynasty
+	headers   map[string]*lightHeader
+	mainChain []*lightHeader
+	mutex     sync.RWMutex
+}
+
+//headerReader reads the headers that lead to a parent header, which is what the seed of an epoch is computed from
+type headerReader struct {
+	client *Client
+	tail   *lightHeader
+}
+
+func (reader *headerReader) GetTailBlock() (*block.Block, error) {
+	return reader.tail.blk, nil
+}
+
+func (reader *headerReader) GetBlockByHash(hash hash.Hash) (*block.Block, error) {
+	header, ok := reader.client.headers[string(hash)]
+	if !ok {
+		return nil, errval.BlockDoesNotExist
+	}
+	return header.blk, nil
+}
+
+//NewClient returns a light client that trusts the genesis header and the producers of the dynasty
+func NewClient(genesis *lightclientpb.LightBlockHeader, dynasty *consensus.Dynasty) (*Client, error) {
+	header, ok := newLightHeader(genesis)
+	if !ok || header.blk.GetHeight() != 0 {
+		return nil, errval.InvalidLightBlockHeader
+	}
+
+	header.producers = dynasty.GetProducers()
+	return &Client{
+		dynasty:   dynasty,
+		headers:   map[string]*lightHeader{string(header.blk.GetHash()): header},
+		mainChain: []*lightHeader{header},
+	}, nil
+}
+
+//newLightHeader returns the header if the block hash matches its fields and the hash of its transactions
+func newLightHeader(headerPb *lightclientpb.LightBlockHeader) (*lightHeader, bool) {
+	if headerPb.GetHeader() == nil {
+		return nil, false
+	}
+	blk := &block.Block{}
+	blk.FromProto(&blockpb.Block{Header: headerPb.GetHeader()})
+	if !bytes.Equal(blk.GetHash(), lblock.CalculateHashWithTxsHash(blk, headerPb.GetTxsHash())) {
+		return nil, false
+	}
+	return &lightHeader{blk: blk, txsHash: headerPb.GetTxsHash()}, true
+}
+
+//SetDynasty trusts the producers of the dynasty for the headers after the best chain. A change that replaces more than
+//two thirds of the producers can not be confirmed by the previous producers, so the client has to be given the new
+//dynasty instead
+func (client *Client) SetDynasty(dynasty *consensus.Dynasty) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+	client.dynasty = dynasty
+	tail := client.mainChain[len(client.mainChain)-1]
+	tail.producers = dynasty.GetProducers()
+	tail.pending = nil
+}
+
+//GetTailHeader returns the header at the top of the best chain as a block without transactions
+func (client *Client) GetTailHeader() *block.Block {
+	client.mutex.RLock()
+	defer client.mutex.RUnlock()
+	return client.mainChain[len(client.mainChain)-1].blk
+}
+
+//GetMaxHeight returns the height of the best chain
+func (client *Client) GetMaxHeight() uint64 {
+	client.mutex.RLock()
+	defer client.mutex.RUnlock()
+	return uint64(len(client.mainChain) - 1)
+}
+
+//GetHeaderByHeight returns the header of the best chain at the height as a block without transactions
+func (client *Client) GetHeaderByHeight(height uint64) (*block.Block, error) {
+	client.mutex.RLock()
+	defer client.mutex.RUnlock()
+
+	if height >= uint64(len(client.mainChain)) {
+		return nil, errval.BlockDoesNotExist
+	}
+	return client.mainChain[height].blk, nil
+}
+
+//AddHeaders verifies the headers and adds them to the known chains. The best chain is the highest one. Headers that
+//are already known are skipped and the first invalid header stops the import
+func (client *Client) AddHeaders(headerPbs []*lightclientpb.LightBlockHeader) error {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	for _, headerPb := range headerPbs {
+		header, ok := newLightHeader(headerPb)
+		if !ok {
+			return errval.InvalidLightBlockHeader
+		}
+		if _, ok := client.headers[string(header.blk.GetHash())]; ok {
+			continue
+		}
+		parent, ok := client.headers[string(header.blk.GetPrevHash())]
+		if !ok || !client.verifyHeader(header, parent, headerPb.GetProducers()) {
+			logger.WithFields(logger.Fields{
+				"height": header.blk.GetHeight(),
+				"hash":   header.blk.GetHash(),
+			}).Warn("LightClient: the block header is invalid.")
+			return errval.InvalidLightBlockHeader
+		}
+
+		client.headers[string(header.blk.GetHash())] = header
+		if header.pending == nil && header.blk.GetHeight() >= uint64(len(client.mainChain)) {
+			client.setTail(header)
+		}
+	}
+	return nil
+}
+
+//verifyHeader returns if the header follows its parent and is signed by the producer of its time slot in the dynasty
+//of its parent. It also sets the producers in charge after the header
+func (client *Client) verifyHeader(header *lightHeader, parent *lightHeader, announced []string) bool {
+	blk := header.blk
+	if blk.GetHeight() != parent.blk.GetHeight()+1 || blk.GetTimestamp() <= parent.blk.GetTimestamp() {
+		return false
+	}
+
+	dynasty := client.getDynasty(parent)
+	seed, _ := consensus.GetEpochSeed(&headerReader{client, parent}, dynasty, dynasty.GetEpoch(blk.GetTimestamp()))
+	producer := dynasty.ProducerAtATimeWithSeed(blk.GetTimestamp(), seed)
+	if !consensus.VerifyBlockSignature(blk, producer, dynasty.GetVrfActivationHeight()) {
+		return false
+	}
+	return trackDynasty(header, parent, producer, announced)
+}
+
+//getDynasty returns the dynasty of the client with the producers in charge of the blocks after the header
+func (client *Client) getDynasty(header *lightHeader) *consensus.Dynasty {
+	dynasty := consensus.NewDynasty(header.producers, client.dynasty.GetMaxProducers(), client.dynasty.GetTimeBetweenBlk())
+	dynasty.SetVrfActivationHeight(client.dynasty.GetVrfActivationHeight())
+	return dynasty
+}
+
+//trackDynasty sets the producers in charge after a header signed by the producer. A pending change is confirmed once
+//more than a third of the previous producers signed headers after it. A header can not announce another change while
+//a change is pending
+func trackDynasty(header *lightHeader, parent *lightHeader, producer string, announced []string) bool {
+	header.producers = parent.producers
+	if pending := parent.pending; pending != nil {
+		signers := make(map[string]bool, len(pending.signers)+1)
+		for signer := range pending.signers {
+			signers[signer] = true
+		}
+		if isProducer(pending.previous, producer) {
+			signers[producer] = true
+		}
+		if len(signers) <= len(pending.previous)/3 {
+			header.pending = &dynastyChange{previous: pending.previous, signers: signers}
+		}
+	}
+
+	if len(announced) == 0 || reflect.DeepEqual(announced, header.producers) {
+		return true
+	}
+	if header.pending != nil {
+		return false
+	}
+	header.pending = &dynastyChange{previous: header.producers, signers: make(map[string]bool)}
+	header.producers = announced
+	return true
+}
+
+func isProducer(producers []string, producer string) bool {
+	for _, p := range producers {
+		if p == producer {
+			return true
+		}
+	}
+	return false
+}
+
+//setTail makes a header higher than the best chain its top and replaces the headers of the previous best chain up to
+//the fork point
+func (client *Client) setTail(header *lightHeader) {
+	height := header.blk.GetHeight()
+	client.mainChain = append(client.mainChain, make([]*lightHeader, height+1-uint64(len(client.mainChain)))...)
+
+	for current := header; client.mainChain[current.blk.GetHeight()] != current; {
+		client.mainChain[current.blk.GetHeight()] = current
+		if current.blk.GetHeight() == 0 {
+			break
+		}
+		current = client.headers[string(current.blk.GetPrevHash())]
+	}
+}
+
+//Sync downloads the headers after the best chain from the source. The download starts further back if the chain of
+//the source forked below the tail
+func (client *Client) Sync(source Source) error {
+	startHeight := client.GetMaxHeight() + 1
+	for {
+		headers, err := source.GetLightBlockHeaders(startHeight, MaxLightBlockHeadersNum)
+		if err != nil {
+			return err
+		}
+		if len(headers) == 0 {
+			return nil
+		}
+
+		if !client.isKnownHeader(headers[0].GetHeader().GetPreviousHash()) {
+			if startHeight <= 1 {
+				return errval.InvalidLightBlockHeader
+			}
+			if startHeight > MaxLightBlockHeadersNum {
+				startHeight -= MaxLightBlockHeadersNum
+			} else {
+				startHeight = 1
+			}
+			continue
+		}
+
+		if err := client.AddHeaders(headers); err != nil {
+			return err
+		}
+		if len(headers) < MaxLightBlockHeadersNum {
+			return nil
+		}
+		startHeight += uint64(len(headers))
+	}
+}
+
+func (client *Client) isKnownHeader(hash hash.Hash) bool {
+	client.mutex.RLock()
+	defer client.mutex.RUnlock()
+	_, ok := client.headers[string(hash)]
+	return ok
+}
+
+//VerifyTransactionProof returns the transaction of the proof if it is packed in a block of the best chain
+func (client *Client) VerifyTransactionProof(proof *lightclientpb.TransactionProof) (*transaction.Transaction, error) {
+	client.mutex.RLock()
+	defer client.mutex.RUnlock()
+
+	if proof.GetHeight() >= uint64(len(client.mainChain)) || proof.GetTransaction() == nil {
+		return nil, errval.InvalidTransactionProof
+	}
+	header := client.mainChain[proof.GetHeight()]
+	if !bytes.Equal(header.blk.GetHash(), proof.GetBlockHash()) || int(proof.GetIndex()) >= len(proof.GetTxHashes()) {
+		return nil, errval.InvalidTransactionProof
+	}
+
+	txsHash := sha256.Sum256(bytes.Join(proof.GetTxHashes(), []byte{}))
+	if !bytes.Equal(txsHash[:], header.txsHash) {
+		return nil, errval.InvalidTransactionProof
+	}
+
+	tx := &transaction.Transaction{}
+	tx.FromProto(proof.GetTransaction())
+	txHash := tx.Hash()
+	if !bytes.Equal(txHash, proof.GetTxHashes()[proof.GetIndex()]) || !bytes.Equal(txHash, tx.ID) {
+		return nil, errval.InvalidTransactionProof
+	}
+	return tx, nil
+}
+
+//VerifyOutputProofs returns the total value of the outputs that the proofs show to be paid to the public key hash in the
+//best chain. The block headers do not commit to the utxos, so the proofs can not show that the outputs are unspent: the
+//total includes spent outputs that the source passes off as unspent and misses the outputs that it leaves out
+func (client *Client) VerifyOutputProofs(pubKeyHash account.PubKeyHash, proofs []*lightclientpb.UtxoProof) (*common.Amount, error) {
+	balance := common.NewAmount(0)
+	seen := make(map[string]bool)
+	for _, proof := range proofs {
+		tx, err := client.VerifyTransactionProof(proof.GetTxProof())
+		if err != nil {
+			return nil, err
+		}
+		if int(proof.GetVout()) >= len(tx.Vout) {
+			return nil, errval.InvalidTransactionProof
+		}
+		vout := tx.Vout[proof.GetVout()]
+		if !bytes.Equal(vout.PubKeyHash, pubKeyHash) {
+			return nil, errval.InvalidTransactionProof
+		}
+
+		key := string(tx.ID) + "_" + strconv.Itoa(int(proof.GetVout()))
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		balance = balance.Add(vout.Value)
+	}
+	return balance, nil
+}
+
+//GetUnverifiedBalance syncs the headers from the source and returns the total value of the outputs that the source
+//proves to be paid to the address. The client trusts the source that the outputs are unspent and that none is missing
+func (client *Client) GetUnverifiedBalance(source Source, address account.Address) (*common.Amount, error) {
+	ta := account.NewTransactionAccountByAddress(address)
+	if !ta.IsValid() {
+		return nil, errval.InvalidAddress
+	}
+	if err := client.Sync(source); err != nil {
+		return nil, err
+	}
+
+	result, err := source.GetUtxoProofs(ta.GetPubKeyHash())
+	if err != nil {
+		return nil, err
+	}
+	return client.VerifyOutputProofs(ta.GetPubKeyHash(), result.GetProofs())
+}
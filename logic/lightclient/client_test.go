@@ -0,0 +1,281 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package lightclient
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/consensus"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/core/transaction"
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/logic/lblock"
+	"github.com/dappley/go-dappley/logic/lblockchain"
+	"github.com/dappley/go-dappley/logic/lblockchain/mocks"
+	lightclientpb "github.com/dappley/go-dappley/logic/lightclient/pb"
+	"github.com/dappley/go-dappley/logic/ltransaction"
+	"github.com/dappley/go-dappley/logic/lutxo"
+	"github.com/dappley/go-dappley/logic/transactionpool"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+const testTimeBetweenBlk = 15
+
+//bcSource serves the headers and the proofs of a blockchain in the same process
+type bcSource struct {
+	bc *lblockchain.Blockchain
+}
+
+func (source *bcSource) GetLightBlockHeaders(startHeight uint64, count uint32) ([]*lightclientpb.LightBlockHeader, error) {
+	return GetLightBlockHeaders(source.bc, startHeight, count), nil
+}
+
+func (source *bcSource) GetUtxoProofs(pubKeyHash account.PubKeyHash) (*lightclientpb.ReturnUtxoProofs, error) {
+	return GetUtxoProofs(source.bc, pubKeyHash), nil
+}
+
+func newSignedBlock(producer *account.Account, parent *block.Block, offset int64) *block.Block {
+	cbtx := ltransaction.NewCoinbaseTX(producer.GetAddress(), "", parent.GetHeight()+1, common.NewAmount(0))
+	timestamp := parent.GetTimestamp() + offset*testTimeBetweenBlk
+	blk := block.NewBlockWithTimestamp([]*transaction.Transaction{&cbtx}, parent, timestamp, producer.GetAddress().String())
+	key := hex.EncodeToString(producer.GetKeyPair().GetPrivateKeyBytes())
+	consensus.GenerateVrfProof(blk, key, rand.Reader)
+	blk.SetHash(lblock.CalculateHash(blk))
+	lblock.SignBlock(blk, key)
+	return blk
+}
+
+//newSignedBlockchain returns a blockchain whose blocks after the genesis block are signed by the producer
+func newSignedBlockchain(producer *account.Account, size int) *lblockchain.Blockchain {
+	libPolicy := &mocks.LIBPolicy{}
+	libPolicy.On("GetMinConfirmationNum").Return(6)
+	libPolicy.On("IsBypassingLibCheck").Return(true)
+	bc := lblockchain.CreateBlockchain(producer.GetAddress(), storage.NewRamStorage(), libPolicy, transactionpool.NewTransactionPool(nil, 128000), 100000)
+
+	for i := 0; i < size; i++ {
+		tailBlk, _ := bc.GetTailBlock()
+		bc.AddBlockContextToTail(lblockchain.PrepareBlockContext(bc, newSignedBlock(producer, tailBlk, 1)))
+	}
+	return bc
+}
+
+func newTestClient(t *testing.T, bc *lblockchain.Blockchain, producer *account.Account) *Client {
+	genesis, err := bc.GetBlockByHeight(0)
+	assert.Nil(t, err)
+	client, err := NewClient(NewLightBlockHeader(genesis), consensus.NewDynasty([]string{producer.GetAddress().String()}, 1, testTimeBetweenBlk))
+	assert.Nil(t, err)
+	return client
+}
+
+func TestClient_Sync(t *testing.T) {
+	producer := account.NewAccount()
+	bc := newSignedBlockchain(producer, MaxLightBlockHeadersNum+10)
+	client := newTestClient(t, bc, producer)
+
+	assert.Nil(t, client.Sync(&bcSource{bc}))
+	assert.Equal(t, bc.GetMaxHeight(), client.GetMaxHeight())
+	assert.Equal(t, bc.GetTailBlockHash(), client.GetTailHeader().GetHash())
+}
+
+func TestClient_AddHeadersRejectsOtherProducers(t *testing.T) {
+	producer := account.NewAccount()
+	bc := newSignedBlockchain(producer, 0)
+	client := newTestClient(t, bc, producer)
+	genesis, _ := bc.GetBlockByHeight(0)
+
+	blk := newSignedBlock(account.NewAccount(), genesis, 1)
+	assert.Equal(t, errval.InvalidLightBlockHeader, client.AddHeaders([]*lightclientpb.LightBlockHeader{NewLightBlockHeader(blk)}))
+
+	//a header whose hash does not match its transactions is rejected
+	header := NewLightBlockHeader(newSignedBlock(producer, genesis, 1))
+	header.TxsHash = []byte("forged")
+	assert.Equal(t, errval.InvalidLightBlockHeader, client.AddHeaders([]*lightclientpb.LightBlockHeader{header}))
+	assert.Equal(t, uint64(0), client.GetMaxHeight())
+}
+
+func TestClient_AddHeadersFork(t *testing.T) {
+	producer := account.NewAccount()
+	bc := newSignedBlockchain(producer, 0)
+	client := newTestClient(t, bc, producer)
+	genesis, _ := bc.GetBlockByHeight(0)
+
+	var shortFork, longFork []*lightclientpb.LightBlockHeader
+	parent := genesis
+	for i := 0; i < 2; i++ {
+		parent = newSignedBlock(producer, parent, 1)
+		shortFork = append(shortFork, NewLightBlockHeader(parent))
+	}
+	parent = genesis
+	for i := 0; i < 3; i++ {
+		parent = newSignedBlock(producer, parent, 2)
+		longFork = append(longFork, NewLightBlockHeader(parent))
+	}
+
+	assert.Nil(t, client.AddHeaders(shortFork))
+	assert.Equal(t, uint64(2), client.GetMaxHeight())
+
+	//the higher fork replaces the best chain down to the fork point
+	assert.Nil(t, client.AddHeaders(longFork))
+	assert.Equal(t, uint64(3), client.GetMaxHeight())
+	header, err := client.GetHeaderByHeight(1)
+	assert.Nil(t, err)
+	assert.Equal(t, longFork[0].GetHeader().GetHash(), []byte(header.GetHash()))
+
+	//a lower fork does not
+	assert.Nil(t, client.AddHeaders(shortFork))
+	assert.Equal(t, longFork[2].GetHeader().GetHash(), []byte(client.GetTailHeader().GetHash()))
+}
+
+//newSlotBlock returns the block after the parent signed by the producer in its next time slot of the dynasty that the
+//client follows after the parent
+func newSlotBlock(t *testing.T, client *Client, parent *block.Block, producer *account.Account) *block.Block {
+	parentHeader := client.headers[string(parent.GetHash())]
+	dynasty := client.getDynasty(parentHeader)
+	for offset := int64(1); offset <= 100; offset++ {
+		timestamp := parent.GetTimestamp() + offset*testTimeBetweenBlk
+		seed, _ := consensus.GetEpochSeed(&headerReader{client, parentHeader}, dynasty, dynasty.GetEpoch(timestamp))
+		if dynasty.ProducerAtATimeWithSeed(timestamp, seed) == producer.GetAddress().String() {
+			return newSignedBlock(producer, parent, offset)
+		}
+	}
+	t.Fatal("the producer has no time slot")
+	return nil
+}
+
+func TestClient_DynastyChange(t *testing.T) {
+	accounts := []*account.Account{account.NewAccount(), account.NewAccount(), account.NewAccount(), account.NewAccount()}
+	a, b, c, d := accounts[0], accounts[1], accounts[2], accounts[3]
+	bc := newSignedBlockchain(a, 0)
+	genesis, _ := bc.GetBlockByHeight(0)
+	newClient := func() *Client {
+		producers := []string{a.GetAddress().String(), b.GetAddress().String(), c.GetAddress().String()}
+		client, err := NewClient(NewLightBlockHeader(genesis), consensus.NewDynasty(producers, 3, testTimeBetweenBlk))
+		assert.Nil(t, err)
+		return client
+	}
+	addBlock := func(client *Client, blk *block.Block, producers []string) error {
+		header := NewLightBlockHeader(blk)
+		header.Producers = producers
+		return client.AddHeaders([]*lightclientpb.LightBlockHeader{header})
+	}
+
+	//the headers after a change are verified against the new producers but are only trusted once more than a third of
+	//the previous producers signed some of them
+	client := newClient()
+	blk1 := newSlotBlock(t, client, genesis, a)
+	assert.Nil(t, addBlock(client, blk1, []string{a.GetAddress().String(), b.GetAddress().String(), d.GetAddress().String()}))
+	blk2 := newSlotBlock(t, client, blk1, d)
+	assert.Nil(t, addBlock(client, blk2, nil))
+	blk3 := newSlotBlock(t, client, blk2, b)
+	assert.Nil(t, addBlock(client, blk3, nil))
+	assert.Equal(t, uint64(0), client.GetMaxHeight())
+
+	//no other change is accepted while a change is pending
+	assert.Equal(t, errval.InvalidLightBlockHeader, addBlock(client, newSlotBlock(t, client, blk3, d), []string{a.GetAddress().String()}))
+
+	blk4 := newSlotBlock(t, client, blk3, a)
+	assert.Nil(t, addBlock(client, blk4, nil))
+	assert.Equal(t, uint64(4), client.GetMaxHeight())
+	assert.Equal(t, blk4.GetHash(), client.GetTailHeader().GetHash())
+
+	//the removed producer is no longer trusted
+	assert.Equal(t, errval.InvalidLightBlockHeader, addBlock(client, newSignedBlock(c, blk4, 1), nil))
+
+	//a change that puts other producers in charge is never confirmed by the previous producers
+	client = newClient()
+	forged := []*account.Account{account.NewAccount(), account.NewAccount(), account.NewAccount()}
+	var forgedProducers []string
+	for _, producer := range forged {
+		forgedProducers = append(forgedProducers, producer.GetAddress().String())
+	}
+	parent := newSlotBlock(t, client, genesis, a)
+	assert.Nil(t, addBlock(client, parent, forgedProducers))
+	for i := 0; i < 6; i++ {
+		parent = newSlotBlock(t, client, parent, forged[i%len(forged)])
+		assert.Nil(t, addBlock(client, parent, nil))
+	}
+	assert.Equal(t, uint64(0), client.GetMaxHeight())
+}
+
+func TestGetLightBlockHeaders(t *testing.T) {
+	producer := account.NewAccount()
+	bc := newSignedBlockchain(producer, 2)
+	producers := []string{producer.GetAddress().String(), account.NewAccount().GetAddress().String()}
+	assert.Nil(t, bc.SaveDynasty(1, producers))
+
+	//only the header of the block after which the dynasty changed carries the producers
+	headers := GetLightBlockHeaders(bc, 0, 3)
+	assert.Equal(t, 3, len(headers))
+	assert.Nil(t, headers[0].GetProducers())
+	assert.Equal(t, producers, headers[1].GetProducers())
+	assert.Nil(t, headers[2].GetProducers())
+}
+
+func TestClient_GetUnverifiedBalance(t *testing.T) {
+	producer := account.NewAccount()
+	bc := newSignedBlockchain(producer, 5)
+	client := newTestClient(t, bc, producer)
+
+	expected := common.NewAmount(0)
+	utxoTx := lutxo.NewUTXOIndex(bc.GetUtxoCache()).GetAllUTXOsByPubKeyHash(producer.GetPubKeyHash())
+	for _, u := range utxoTx.Indices {
+		expected = expected.Add(u.Value)
+	}
+
+	balance, err := client.GetUnverifiedBalance(&bcSource{bc}, producer.GetAddress())
+	assert.Nil(t, err)
+	assert.Equal(t, expected, balance)
+	assert.Equal(t, bc.GetMaxHeight(), client.GetMaxHeight())
+
+	_, err = client.GetUnverifiedBalance(&bcSource{bc}, account.NewAddress("invalid"))
+	assert.Equal(t, errval.InvalidAddress, err)
+}
+
+func TestClient_VerifyOutputProofs(t *testing.T) {
+	producer := account.NewAccount()
+	bc := newSignedBlockchain(producer, 3)
+	client := newTestClient(t, bc, producer)
+	assert.Nil(t, client.Sync(&bcSource{bc}))
+
+	proofs := GetUtxoProofs(bc, producer.GetPubKeyHash()).GetProofs()
+	assert.Equal(t, 4, len(proofs))
+	_, err := client.VerifyOutputProofs(producer.GetPubKeyHash(), proofs)
+	assert.Nil(t, err)
+
+	//the outputs must be paid to the public key hash
+	_, err = client.VerifyOutputProofs(account.NewAccount().GetPubKeyHash(), proofs)
+	assert.Equal(t, errval.InvalidTransactionProof, err)
+
+	//a transaction whose output was changed does not match the hashes committed by the block
+	forged := GetUtxoProofs(bc, producer.GetPubKeyHash()).GetProofs()
+	forged[0].GetTxProof().GetTransaction().GetVout()[0].Value = common.NewAmount(1000000).Bytes()
+	_, err = client.VerifyOutputProofs(producer.GetPubKeyHash(), forged)
+	assert.Equal(t, errval.InvalidTransactionProof, err)
+
+	//a transaction of a block that is not in the best chain is rejected
+	genesis, _ := bc.GetBlockByHeight(0)
+	proof := NewTransactionProof(newSignedBlock(producer, genesis, 2), 0)
+	_, err = client.VerifyTransactionProof(proof)
+	assert.Equal(t, errval.InvalidTransactionProof, err)
+}
@@ -0,0 +1,240 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package lightclient
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/dappley/go-dappley/common/pubsub"
+	"github.com/dappley/go-dappley/core/account"
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/logic/lblockchain"
+	lightclientpb "github.com/dappley/go-dappley/logic/lightclient/pb"
+	"github.com/dappley/go-dappley/network/networkmodel"
+	"github.com/golang/protobuf/proto"
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	GetLightBlockHeadersRequest  = "GetLightBlockHeadersRequest"
+	GetLightBlockHeadersResponse = "GetLightBlockHeadersResponse"
+	GetUtxoProofsRequest         = "GetUtxoProofsRequest"
+	GetUtxoProofsResponse        = "GetUtxoProofsResponse"
+
+	RequestMaxWaitTime time.Duration = 10
+)
+
+var (
+	serverSubscribedTopics = []string{
+		GetLightBlockHeadersRequest,
+		GetUtxoProofsRequest,
+	}
+
+	sourceSubscribedTopics = []string{
+		GetLightBlockHeadersResponse,
+		GetUtxoProofsResponse,
+	}
+)
+
+type NetService interface {
+	UnicastNormalPriorityCommand(commandName string, message proto.Message, destination networkmodel.PeerInfo)
+	Listen(subscriber pubsub.Subscriber)
+}
+
+//Server serves block headers and utxo proofs of a full node to the light clients of the network
+type Server struct {
+	bc   *lblockchain.Blockchain
+	node NetService
+}
+
+//NewServer returns a server that answers the light client requests received by the node
+func NewServer(bc *lblockchain.Blockchain, node NetService) *Server {
+	server := &Server{bc: bc, node: node}
+	node.Listen(server)
+	return server
+}
+
+func (server *Server) GetSubscribedTopics() []string {
+	return serverSubscribedTopics
+}
+
+func (server *Server) GetTopicHandler(topic string) pubsub.TopicHandler {
+	switch topic {
+	case GetLightBlockHeadersRequest:
+		return server.GetLightBlockHeadersRequestHandler
+	case GetUtxoProofsRequest:
+		return server.GetUtxoProofsRequestHandler
+	}
+	return nil
+}
+
+func (server *Server) GetLightBlockHeadersRequestHandler(input interface{}) {
+	command := input.(*networkmodel.DappRcvdCmdContext)
+
+	param := &lightclientpb.GetLightBlockHeaders{}
+	if err := proto.Unmarshal(command.GetData(), param); err != nil {
+		logger.WithFields(logger.Fields{
+			"name": GetLightBlockHeadersRequest,
+		}).Info("LightClient: parse data failed.")
+		return
+	}
+
+	result := &lightclientpb.ReturnLightBlockHeaders{
+		StartHeight: param.GetStartHeight(),
+		Headers:     GetLightBlockHeaders(server.bc, param.GetStartHeight(), param.GetCount()),
+	}
+	server.node.UnicastNormalPriorityCommand(GetLightBlockHeadersResponse, result, command.GetSource())
+}
+
+func (server *Server) GetUtxoProofsRequestHandler(input interface{}) {
+	command := input.(*networkmodel.DappRcvdCmdContext)
+
+	param := &lightclientpb.GetUtxoProofs{}
+	if err := proto.Unmarshal(command.GetData(), param); err != nil {
+		logger.WithFields(logger.Fields{
+			"name": GetUtxoProofsRequest,
+		}).Info("LightClient: parse data failed.")
+		return
+	}
+
+	result := GetUtxoProofs(server.bc, param.GetPubKeyHash())
+	server.node.UnicastNormalPriorityCommand(GetUtxoProofsResponse, result, command.GetSource())
+}
+
+//NetworkSource requests block headers and utxo proofs from a peer. A node holds at most one source, which sends one
+//request at a time
+type NetworkSource struct {
+	node       NetService
+	peer       networkmodel.PeerInfo
+	headersCh  chan *lightclientpb.ReturnLightBlockHeaders
+	proofsCh   chan *lightclientpb.ReturnUtxoProofs
+	requestMux sync.Mutex
+}
+
+//NewNetworkSource returns a source that requests the headers and the proofs from the peer
+func NewNetworkSource(node NetService, peer networkmodel.PeerInfo) *NetworkSource {
+	source := &NetworkSource{
+		node:      node,
+		peer:      peer,
+		headersCh: make(chan *lightclientpb.ReturnLightBlockHeaders, 1),
+		proofsCh:  make(chan *lightclientpb.ReturnUtxoProofs, 1),
+	}
+	node.Listen(source)
+	return source
+}
+
+func (source *NetworkSource) GetSubscribedTopics() []string {
+	return sourceSubscribedTopics
+}
+
+func (source *NetworkSource) GetTopicHandler(topic string) pubsub.TopicHandler {
+	switch topic {
+	case GetLightBlockHeadersResponse:
+		return source.GetLightBlockHeadersResponseHandler
+	case GetUtxoProofsResponse:
+		return source.GetUtxoProofsResponseHandler
+	}
+	return nil
+}
+
+//GetLightBlockHeaders requests the headers from the start height and waits for the response of the peer
+func (source *NetworkSource) GetLightBlockHeaders(startHeight uint64, count uint32) ([]*lightclientpb.LightBlockHeader, error) {
+	source.requestMux.Lock()
+	defer source.requestMux.Unlock()
+
+	request := &lightclientpb.GetLightBlockHeaders{StartHeight: startHeight, Count: count}
+	source.node.UnicastNormalPriorityCommand(GetLightBlockHeadersRequest, request, source.peer)
+
+	timer := time.NewTimer(RequestMaxWaitTime * time.Second)
+	defer timer.Stop()
+	for {
+		select {
+		case result := <-source.headersCh:
+			if result.GetStartHeight() == startHeight {
+				return result.GetHeaders(), nil
+			}
+		case <-timer.C:
+			return nil, errval.LightClientRequestTimeout
+		}
+	}
+}
+
+//GetUtxoProofs requests the utxo proofs of the public key hash and waits for the response of the peer
+func (source *NetworkSource) GetUtxoProofs(pubKeyHash account.PubKeyHash) (*lightclientpb.ReturnUtxoProofs, error) {
+	source.requestMux.Lock()
+	defer source.requestMux.Unlock()
+
+	request := &lightclientpb.GetUtxoProofs{PubKeyHash: pubKeyHash}
+	source.node.UnicastNormalPriorityCommand(GetUtxoProofsRequest, request, source.peer)
+
+	timer := time.NewTimer(RequestMaxWaitTime * time.Second)
+	defer timer.Stop()
+	for {
+		select {
+		case result := <-source.proofsCh:
+			if bytes.Equal(result.GetPubKeyHash(), pubKeyHash) {
+				return result, nil
+			}
+		case <-timer.C:
+			return nil, errval.LightClientRequestTimeout
+		}
+	}
+}
+
+func (source *NetworkSource) GetLightBlockHeadersResponseHandler(input interface{}) {
+	command := input.(*networkmodel.DappRcvdCmdContext)
+	if command.GetSource().PeerId != source.peer.PeerId {
+		return
+	}
+
+	param := &lightclientpb.ReturnLightBlockHeaders{}
+	if err := proto.Unmarshal(command.GetData(), param); err != nil {
+		logger.WithFields(logger.Fields{
+			"name": GetLightBlockHeadersResponse,
+		}).Info("LightClient: parse data failed.")
+		return
+	}
+
+	select {
+	case source.headersCh <- param:
+	default:
+	}
+}
+
+func (source *NetworkSource) GetUtxoProofsResponseHandler(input interface{}) {
+	command := input.(*networkmodel.DappRcvdCmdContext)
+	if command.GetSource().PeerId != source.peer.PeerId {
+		return
+	}
+
+	param := &lightclientpb.ReturnUtxoProofs{}
+	if err := proto.Unmarshal(command.GetData(), param); err != nil {
+		logger.WithFields(logger.Fields{
+			"name": GetUtxoProofsResponse,
+		}).Info("LightClient: parse data failed.")
+		return
+	}
+
+	select {
+	case source.proofsCh <- param:
+	default:
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package lightclient
+
+import (
+	"testing"
+
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/network"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testPort int = 10420
+	confDir      = "../../storage/fakeFileLoaders/"
+)
+
+func TestNetworkSource_GetUnverifiedBalance(t *testing.T) {
+	producer := account.NewAccount()
+	bc := newSignedBlockchain(producer, 5)
+
+	rfl := storage.NewRamFileLoader(confDir, "lightclient.conf")
+	defer rfl.DeleteFolder()
+
+	serverNode := network.NewNode(rfl.File, nil)
+	serverNode.Start(testPort, "")
+	defer serverNode.Stop()
+	NewServer(bc, serverNode)
+
+	clientNode := network.NewNode(rfl.File, nil)
+	clientNode.Start(testPort+1, "")
+	defer clientNode.Stop()
+	clientNode.GetNetwork().ConnectToSeed(serverNode.GetHostPeerInfo())
+
+	client := newTestClient(t, bc, producer)
+	source := NewNetworkSource(clientNode, serverNode.GetHostPeerInfo())
+	balance, err := client.GetUnverifiedBalance(source, producer.GetAddress())
+	assert.Nil(t, err)
+	assert.Equal(t, bc.GetMaxHeight(), client.GetMaxHeight())
+
+	expected, err := client.VerifyOutputProofs(producer.GetPubKeyHash(), GetUtxoProofs(bc, producer.GetPubKeyHash()).GetProofs())
+	assert.Nil(t, err)
+	assert.Equal(t, expected, balance)
+}
@@ -0,0 +1,661 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.23.0
+// 	protoc        v3.13.0
+// source: github.com/dappley/go-dappley/logic/lightclient/pb/lightclient.proto
+
+package lightclientpb
+
+import (
+	pb "github.com/dappley/go-dappley/core/block/pb"
+	pb1 "github.com/dappley/go-dappley/core/transaction/pb"
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type LightBlockHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header    *pb.BlockHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	TxsHash   []byte          `protobuf:"bytes,2,opt,name=txs_hash,json=txsHash,proto3" json:"txs_hash,omitempty"` // the hash of the transactions that the block hash commits to
+	Producers []string        `protobuf:"bytes,3,rep,name=producers,proto3" json:"producers,omitempty"`            // the producers in charge after the block if the dynasty changed at the block
+}
+
+func (x *LightBlockHeader) Reset() {
+	*x = LightBlockHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LightBlockHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LightBlockHeader) ProtoMessage() {}
+
+func (x *LightBlockHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LightBlockHeader.ProtoReflect.Descriptor instead.
+func (*LightBlockHeader) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LightBlockHeader) GetHeader() *pb.BlockHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+func (x *LightBlockHeader) GetTxsHash() []byte {
+	if x != nil {
+		return x.TxsHash
+	}
+	return nil
+}
+
+func (x *LightBlockHeader) GetProducers() []string {
+	if x != nil {
+		return x.Producers
+	}
+	return nil
+}
+
+type GetLightBlockHeaders struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartHeight uint64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	Count       uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetLightBlockHeaders) Reset() {
+	*x = GetLightBlockHeaders{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLightBlockHeaders) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLightBlockHeaders) ProtoMessage() {}
+
+func (x *GetLightBlockHeaders) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLightBlockHeaders.ProtoReflect.Descriptor instead.
+func (*GetLightBlockHeaders) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetLightBlockHeaders) GetStartHeight() uint64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *GetLightBlockHeaders) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ReturnLightBlockHeaders struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartHeight uint64              `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	Headers     []*LightBlockHeader `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty"`
+}
+
+func (x *ReturnLightBlockHeaders) Reset() {
+	*x = ReturnLightBlockHeaders{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReturnLightBlockHeaders) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnLightBlockHeaders) ProtoMessage() {}
+
+func (x *ReturnLightBlockHeaders) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnLightBlockHeaders.ProtoReflect.Descriptor instead.
+func (*ReturnLightBlockHeaders) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReturnLightBlockHeaders) GetStartHeight() uint64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *ReturnLightBlockHeaders) GetHeaders() []*LightBlockHeader {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+type TransactionProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transaction *pb1.Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Height      uint64           `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	BlockHash   []byte           `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	TxHashes    [][]byte         `protobuf:"bytes,4,rep,name=tx_hashes,json=txHashes,proto3" json:"tx_hashes,omitempty"` // the hashes of all transactions in the block
+	Index       uint32           `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`                      // the position of the transaction in the block
+}
+
+func (x *TransactionProof) Reset() {
+	*x = TransactionProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransactionProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactionProof) ProtoMessage() {}
+
+func (x *TransactionProof) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactionProof.ProtoReflect.Descriptor instead.
+func (*TransactionProof) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TransactionProof) GetTransaction() *pb1.Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *TransactionProof) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *TransactionProof) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *TransactionProof) GetTxHashes() [][]byte {
+	if x != nil {
+		return x.TxHashes
+	}
+	return nil
+}
+
+func (x *TransactionProof) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+type UtxoProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxProof *TransactionProof `protobuf:"bytes,1,opt,name=tx_proof,json=txProof,proto3" json:"tx_proof,omitempty"`
+	Vout    uint32            `protobuf:"varint,2,opt,name=vout,proto3" json:"vout,omitempty"`
+}
+
+func (x *UtxoProof) Reset() {
+	*x = UtxoProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UtxoProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UtxoProof) ProtoMessage() {}
+
+func (x *UtxoProof) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UtxoProof.ProtoReflect.Descriptor instead.
+func (*UtxoProof) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UtxoProof) GetTxProof() *TransactionProof {
+	if x != nil {
+		return x.TxProof
+	}
+	return nil
+}
+
+func (x *UtxoProof) GetVout() uint32 {
+	if x != nil {
+		return x.Vout
+	}
+	return 0
+}
+
+type GetUtxoProofs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PubKeyHash []byte `protobuf:"bytes,1,opt,name=pub_key_hash,json=pubKeyHash,proto3" json:"pub_key_hash,omitempty"`
+}
+
+func (x *GetUtxoProofs) Reset() {
+	*x = GetUtxoProofs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUtxoProofs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUtxoProofs) ProtoMessage() {}
+
+func (x *GetUtxoProofs) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUtxoProofs.ProtoReflect.Descriptor instead.
+func (*GetUtxoProofs) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetUtxoProofs) GetPubKeyHash() []byte {
+	if x != nil {
+		return x.PubKeyHash
+	}
+	return nil
+}
+
+type ReturnUtxoProofs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PubKeyHash []byte       `protobuf:"bytes,1,opt,name=pub_key_hash,json=pubKeyHash,proto3" json:"pub_key_hash,omitempty"`
+	Proofs     []*UtxoProof `protobuf:"bytes,2,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	Height     uint64       `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"` // the height of the tail block when the utxos were read
+}
+
+func (x *ReturnUtxoProofs) Reset() {
+	*x = ReturnUtxoProofs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReturnUtxoProofs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnUtxoProofs) ProtoMessage() {}
+
+func (x *ReturnUtxoProofs) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnUtxoProofs.ProtoReflect.Descriptor instead.
+func (*ReturnUtxoProofs) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReturnUtxoProofs) GetPubKeyHash() []byte {
+	if x != nil {
+		return x.PubKeyHash
+	}
+	return nil
+}
+
+func (x *ReturnUtxoProofs) GetProofs() []*UtxoProof {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+func (x *ReturnUtxoProofs) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+var File_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto protoreflect.FileDescriptor
+
+var file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_rawDesc = []byte{
+	0x0a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70,
+	0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f,
+	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x2f, 0x70, 0x62, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x70, 0x62, 0x1a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70,
+	0x70, 0x6c, 0x65, 0x79, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f,
+	0x70, 0x62, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x43,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x70, 0x6c,
+	0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x63, 0x6f,
+	0x72, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x70,
+	0x62, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x79, 0x0a, 0x10, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70,
+	0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x73, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x78, 0x73, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x22, 0x4f,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x77, 0x0a, 0x17, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x39, 0x0a,
+	0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c,
+	0x69, 0x67, 0x68, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x10, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x3c, 0x0a,
+	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x5b, 0x0a, 0x09, 0x55, 0x74, 0x78, 0x6f, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x12, 0x3a, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x07, 0x74, 0x78, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x12,
+	0x0a, 0x04, 0x76, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x76, 0x6f,
+	0x75, 0x74, 0x22, 0x31, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x50, 0x72, 0x6f,
+	0x6f, 0x66, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x75, 0x62, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x4b, 0x65,
+	0x79, 0x48, 0x61, 0x73, 0x68, 0x22, 0x7e, 0x0a, 0x10, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x55,
+	0x74, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x75, 0x62,
+	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0a, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x48, 0x61, 0x73, 0x68, 0x12, 0x30, 0x0a, 0x06, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55, 0x74, 0x78, 0x6f,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_rawDescOnce sync.Once
+	file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_rawDescData = file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_rawDesc
+)
+
+func file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_rawDescGZIP() []byte {
+	file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_rawDescOnce.Do(func() {
+		file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_rawDescData)
+	})
+	return file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_rawDescData
+}
+
+var file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_goTypes = []interface{}{
+	(*LightBlockHeader)(nil),        // 0: lightclientpb.LightBlockHeader
+	(*GetLightBlockHeaders)(nil),    // 1: lightclientpb.GetLightBlockHeaders
+	(*ReturnLightBlockHeaders)(nil), // 2: lightclientpb.ReturnLightBlockHeaders
+	(*TransactionProof)(nil),        // 3: lightclientpb.TransactionProof
+	(*UtxoProof)(nil),               // 4: lightclientpb.UtxoProof
+	(*GetUtxoProofs)(nil),           // 5: lightclientpb.GetUtxoProofs
+	(*ReturnUtxoProofs)(nil),        // 6: lightclientpb.ReturnUtxoProofs
+	(*pb.BlockHeader)(nil),          // 7: blockpb.BlockHeader
+	(*pb1.Transaction)(nil),         // 8: transactionpb.Transaction
+}
+var file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_depIdxs = []int32{
+	7, // 0: lightclientpb.LightBlockHeader.header:type_name -> blockpb.BlockHeader
+	0, // 1: lightclientpb.ReturnLightBlockHeaders.headers:type_name -> lightclientpb.LightBlockHeader
+	8, // 2: lightclientpb.TransactionProof.transaction:type_name -> transactionpb.Transaction
+	3, // 3: lightclientpb.UtxoProof.tx_proof:type_name -> lightclientpb.TransactionProof
+	4, // 4: lightclientpb.ReturnUtxoProofs.proofs:type_name -> lightclientpb.UtxoProof
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_init() }
+func file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_init() {
+	if File_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LightBlockHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLightBlockHeaders); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReturnLightBlockHeaders); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UtxoProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUtxoProofs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReturnUtxoProofs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_goTypes,
+		DependencyIndexes: file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_depIdxs,
+		MessageInfos:      file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_msgTypes,
+	}.Build()
+	File_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto = out.File
+	file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_rawDesc = nil
+	file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_goTypes = nil
+	file_github_com_dappley_go_dappley_logic_lightclient_pb_lightclient_proto_depIdxs = nil
+}
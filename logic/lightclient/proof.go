@@ -0,0 +1,122 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package lightclient
+
+import (
+	"sort"
+
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/block"
+	blockpb "github.com/dappley/go-dappley/core/block/pb"
+	transactionpb "github.com/dappley/go-dappley/core/transaction/pb"
+	"github.com/dappley/go-dappley/logic/lblock"
+	"github.com/dappley/go-dappley/logic/lblockchain"
+	lightclientpb "github.com/dappley/go-dappley/logic/lightclient/pb"
+	"github.com/dappley/go-dappley/logic/lutxo"
+	logger "github.com/sirupsen/logrus"
+)
+
+const MaxLightBlockHeadersNum = 500
+
+//NewLightBlockHeader returns the header of a block together with the hash of its transactions, which is all that is
+//needed to recompute the block hash
+func NewLightBlockHeader(blk *block.Block) *lightclientpb.LightBlockHeader {
+	return &lightclientpb.LightBlockHeader{
+		Header:  blk.GetHeader().ToProto().(*blockpb.BlockHeader),
+		TxsHash: lblock.HashTransactions(blk),
+	}
+}
+
+//GetLightBlockHeaders returns the headers of the main chain from the start height. The headers of the blocks after which
+//the dynasty changed carry the new producers
+func GetLightBlockHeaders(bc *lblockchain.Blockchain, startHeight uint64, count uint32) []*lightclientpb.LightBlockHeader {
+	if count > MaxLightBlockHeadersNum {
+		count = MaxLightBlockHeadersNum
+	}
+
+	var headers []*lightclientpb.LightBlockHeader
+	for height := startHeight; height < startHeight+uint64(count); height++ {
+		blk, err := bc.GetBlockByHeight(height)
+		if err != nil {
+			break
+		}
+		header := NewLightBlockHeader(blk)
+		if producers, from, err := bc.GetDynasty(height); err == nil && from == height && height > 0 {
+			header.Producers = producers
+		}
+		headers = append(headers, header)
+	}
+	return headers
+}
+
+//NewTransactionProof returns the proof that the transaction at the index is packed in the block. The block hash
+//commits to the concatenated hashes of its transactions, so the proof carries all of them
+func NewTransactionProof(blk *block.Block, index int) *lightclientpb.TransactionProof {
+	proof := &lightclientpb.TransactionProof{
+		Transaction: blk.GetTransactions()[index].ToProto().(*transactionpb.Transaction),
+		Height:      blk.GetHeight(),
+		BlockHash:   blk.GetHash(),
+		Index:       uint32(index),
+	}
+	for _, tx := range blk.GetTransactions() {
+		proof.TxHashes = append(proof.TxHashes, tx.Hash())
+	}
+	return proof
+}
+
+//GetUtxoProofs returns the proofs of the transactions that created the utxos of the public key hash. Utxos whose
+//transaction is not indexed, such as the ones imported from a state snapshot, are left out
+func GetUtxoProofs(bc *lblockchain.Blockchain, pubKeyHash account.PubKeyHash) *lightclientpb.ReturnUtxoProofs {
+	bc.GetBlockMutex().Lock()
+	height := bc.GetMaxHeight()
+	utxoTx := lutxo.NewUTXOIndex(bc.GetUtxoCache()).GetAllUTXOsByPubKeyHash(pubKeyHash)
+	bc.GetBlockMutex().Unlock()
+
+	utxoKeys := make([]string, 0, len(utxoTx.Indices))
+	for utxoKey := range utxoTx.Indices {
+		utxoKeys = append(utxoKeys, utxoKey)
+	}
+	sort.Strings(utxoKeys)
+
+	result := &lightclientpb.ReturnUtxoProofs{PubKeyHash: pubKeyHash, Height: height}
+	blocks := make(map[string]*block.Block)
+	for _, utxoKey := range utxoKeys {
+		u := utxoTx.Indices[utxoKey]
+		blk, ok := blocks[string(u.Txid)]
+		if !ok {
+			var err error
+			if blk, err = bc.GetTransactionBlock(u.Txid); err != nil {
+				logger.WithError(err).Debug("LightClient: the transaction of the utxo is not indexed.")
+				continue
+			}
+			blocks[string(u.Txid)] = blk
+		}
+
+		for index, tx := range blk.GetTransactions() {
+			if string(tx.ID) == string(u.Txid) {
+				result.Proofs = append(result.Proofs, &lightclientpb.UtxoProof{
+					TxProof: NewTransactionProof(blk, index),
+					Vout:    uint32(u.TxIndex),
+				})
+				break
+			}
+		}
+	}
+	return result
+}
@@ -34,6 +34,8 @@ import (
 
 	"github.com/dappley/go-dappley/common"
 	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/crypto"
+	"github.com/dappley/go-dappley/crypto/keystore"
 	"github.com/dappley/go-dappley/storage"
 
 	"github.com/dappley/go-dappley/wallet"
@@ -95,6 +97,14 @@ func SaveAccount(optionalAccountFilePath ...string) error {
 
 //create a account with passphrase
 func CreateAccountWithPassphrase(password string, optionalAccountFilePath ...string) (*account.Account, error) {
+	return CreateAccountWithAlgorithmAndPassphrase(keystore.SECP256K1, password, optionalAccountFilePath...)
+}
+
+//create a account of the signature algorithm with passphrase
+func CreateAccountWithAlgorithmAndPassphrase(alg keystore.Algorithm, password string, optionalAccountFilePath ...string) (*account.Account, error) {
+	if err := crypto.CheckAlgorithm(alg); err != nil {
+		return nil, err
+	}
 	am, err := GetAccountManager(getAccountFilePath(optionalAccountFilePath))
 	if err != nil {
 		logger.Error(err)
@@ -106,7 +116,7 @@ func CreateAccountWithPassphrase(password string, optionalAccountFilePath ...str
 		if err != nil {
 			return nil, errval.PasswordIncorrect
 		}
-		account := account.NewAccount()
+		account := account.NewAccountWithAlgorithm(alg)
 		am.AddAccount(account)
 		am.SaveAccountToFile()
 		return account, err
@@ -117,7 +127,7 @@ func CreateAccountWithPassphrase(password string, optionalAccountFilePath ...str
 	}
 	am.PassPhrase = passBytes
 	logger.Info("Account password is set!")
-	account := account.NewAccount()
+	account := account.NewAccountWithAlgorithm(alg)
 	am.AddAccount(account)
 	am.SaveAccountToFile()
 	return account, err
@@ -173,11 +183,6 @@ func SendFromMiner(address account.Address, amount *common.Amount, bc *lblockcha
 	return sendTo(sendTxParam, bc)
 }
 
-func ChangeProducers(address string, height uint64, bm *lblockchain.BlockchainManager, kind int) {
-	minerAccount := account.NewAccountByPrivateKey(minerPrivateKey)
-	bm.SetNewDynasty(minerAccount.GetAddress().String(), address, height, kind)
-}
-
 func SendProducerModifyTX(addresses string, height uint64, bc *lblockchain.Blockchain, kind uint64) ([]byte, error) {
 	minerAccount := account.NewAccountByPrivateKey(minerPrivateKey)
 	sendTxParam := transaction.NewSendTxParam(minerAccount.GetAddress(), minerAccount.GetKeyPair(), minerAccount.GetAddress(), common.NewAmount(1), common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), "{ \"height\":"+strconv.FormatUint(height, 10)+",\"addresses\":\""+addresses+"\",\"kind\":"+strconv.FormatUint(kind, 10)+"}")
@@ -209,7 +214,7 @@ func sendProducerChange(sendTxParam transaction.SendTxParam, bc *lblockchain.Blo
 		logger.Warn("sendTo error")
 	}
 
-	utxos, err := utxoIndex.GetUTXOsAccordingToAmount([]byte(acc.GetPubKeyHash()), sendTxParam.TotalCost())
+	utxos, err := utxoIndex.GetUTXOsAccordingToAmount([]byte(acc.GetPubKeyHash()), sendTxParam.TotalCost(), bc.GetMaxHeight()+1)
 	if err != nil {
 		return nil, err
 	}
@@ -248,7 +253,7 @@ func sendTo(sendTxParam transaction.SendTxParam, bc *lblockchain.Blockchain) ([]
 		logger.Warn("sendTo error")
 	}
 
-	utxos, err := utxoIndex.GetUTXOsAccordingToAmount([]byte(acc.GetPubKeyHash()), sendTxParam.TotalCost())
+	utxos, err := utxoIndex.GetUTXOsAccordingToAmount([]byte(acc.GetPubKeyHash()), sendTxParam.TotalCost(), bc.GetMaxHeight()+1)
 	if err != nil {
 		return nil, "", err
 	}
@@ -0,0 +1,54 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package ltransaction
+
+import (
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/core/scState"
+	"github.com/dappley/go-dappley/core/transaction"
+)
+
+const (
+	// MonetaryStateAddress is the address under which the burnt gas fees are kept in the contract state
+	MonetaryStateAddress = "dappley_monetary"
+
+	burntFeesKey = "burnt"
+)
+
+// BurnGasFee adds the part of a gas fee that is burnt by the monetary policy to the burnt fees in state
+func BurnGasFee(state *scState.ScState, fee *common.Amount) {
+	_, burnt := transaction.GetMonetaryPolicy().SplitFee(fee)
+	if burnt.IsZero() {
+		return
+	}
+	state.SetStateValue(MonetaryStateAddress, burntFeesKey, GetBurntFees(state).Add(burnt).String())
+}
+
+// GetBurntFees returns the gas fees burnt so far
+func GetBurntFees(state *scState.ScState) *common.Amount {
+	value, ok := state.GetStateValue(MonetaryStateAddress, burntFeesKey)
+	if !ok {
+		return common.NewAmount(0)
+	}
+	burnt, err := common.NewAmountFromString(value)
+	if err != nil {
+		return common.NewAmount(0)
+	}
+	return burnt
+}
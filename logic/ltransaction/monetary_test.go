@@ -0,0 +1,63 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package ltransaction
+
+import (
+	"testing"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/scState"
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/core/utxo"
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBurnGasFee(t *testing.T) {
+	defaultPolicy := transaction.GetMonetaryPolicy()
+	defer transaction.SetMonetaryPolicy(defaultPolicy)
+	policy, err := transaction.NewMonetaryPolicy(common.NewAmount(100), transaction.RewardCurveHalving, 10, 0, nil, 40)
+	assert.Nil(t, err)
+	transaction.SetMonetaryPolicy(policy)
+
+	db := storage.NewRamStorage()
+	defer db.Close()
+	state := scState.NewScState(utxo.NewUTXOCache(db))
+	assert.Equal(t, uint64(0), GetBurntFees(state).Uint64())
+	BurnGasFee(state, common.NewAmount(1000))
+	BurnGasFee(state, common.NewAmount(500))
+	assert.Equal(t, uint64(600), GetBurntFees(state).Uint64())
+
+	// the producer is rewarded the part of the fee that is not burnt
+	miner := account.NewTransactionAccountByAddress(account.NewAccount().GetAddress())
+	gasRewardTx, ok := NewGasRewardTx(miner, 1, common.NewAmount(100), common.NewAmount(10), 0)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(600), gasRewardTx.Vout[0].Value.Uint64())
+
+	// the coinbase follows the reward schedule
+	coinbaseTx := NewCoinbaseTX(account.NewAccount().GetAddress(), "", 10, common.NewAmount(5))
+	assert.Equal(t, uint64(55), coinbaseTx.Vout[0].Value.Uint64())
+	adaptedTx := &TxCoinbase{&coinbaseTx}
+	assert.Nil(t, adaptedTx.Verify(nil, 10))
+	coinbaseTx = NewCoinbaseTX(account.NewAccount().GetAddress(), "", 10, common.NewAmount(0))
+	coinbaseTx.Vout[0].Value = common.NewAmount(49)
+	assert.Equal(t, errval.SubsidyCheckFail, (&TxCoinbase{&coinbaseTx}).Verify(nil, 10))
+}
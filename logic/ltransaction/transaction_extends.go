@@ -32,6 +32,7 @@ import (
 	"github.com/dappley/go-dappley/core/transaction"
 	"github.com/dappley/go-dappley/core/transactionbase"
 	"github.com/dappley/go-dappley/core/utxo"
+	"github.com/dappley/go-dappley/crypto/keystore"
 	errval "github.com/dappley/go-dappley/errors"
 	"github.com/dappley/go-dappley/logic/lutxo"
 	"github.com/dappley/go-dappley/util"
@@ -94,6 +95,16 @@ func NewTxDecorator(tx *transaction.Transaction) TxDecorator {
 		return &TxReward{tx}
 	case transaction.TxTypeContractSend:
 		return &TxContractSend{tx}
+	case transaction.TxTypeVote:
+		return &TxVote{tx}
+	case transaction.TxTypeUnvote:
+		return &TxUnvote{tx}
+	case transaction.TxTypeSlash:
+		return &TxSlash{tx}
+	case transaction.TxTypeGovernance:
+		return &TxGovernance{tx}
+	case transaction.TxTypeProducerChange:
+		return &TxProducerChange{tx}
 	}
 	return nil
 }
@@ -111,6 +122,9 @@ func (tx *TxNormal) Verify(utxoIndex *lutxo.UTXOIndex, blockHeight uint64) error
 		}).Warn("Verify: cannot find vin while verifying normal tx")
 		return err
 	}
+	if err := verifyStakeSpending(tx.Transaction, prevUtxos, blockHeight); err != nil {
+		return err
+	}
 	return tx.Transaction.Verify(prevUtxos)
 }
 
@@ -127,6 +141,9 @@ func (tx *TxContract) Verify(utxoIndex *lutxo.UTXOIndex, blockHeight uint64) err
 		}).Warn("Verify: cannot find vin while verifying contract tx")
 		return err
 	}
+	if err := verifyStakeSpending(tx.Transaction, prevUtxos, blockHeight); err != nil {
+		return err
+	}
 	err = tx.Transaction.Verify(prevUtxos)
 	if err != nil {
 		logger.WithError(err).WithFields(logger.Fields{
@@ -148,7 +165,7 @@ func (tx *TxCoinbase) Sign(privKey ecdsa.PrivateKey, prevUtxos []*utxo.UTXO) err
 
 func (tx *TxCoinbase) Verify(utxoIndex *lutxo.UTXOIndex, blockHeight uint64) error {
 	//TODO coinbase vout check need add tip
-	if tx.Vout[0].Value.Cmp(transaction.Subsidy) < 0 {
+	if tx.Vout[0].Value.Cmp(transaction.GetMonetaryPolicy().GetReward(blockHeight)) < 0 {
 		return errval.SubsidyCheckFail
 	}
 	bh := binary.BigEndian.Uint64(tx.Vin[0].Signature)
@@ -335,7 +352,7 @@ func NewRewardTx(blockHeight uint64, rewards map[string]string) transaction.Tran
 	bh := make([]byte, 8)
 	binary.BigEndian.PutUint64(bh, uint64(blockHeight))
 
-	txin := transactionbase.TXInput{nil, -1, bh, transaction.RewardTxData}
+	txin := transactionbase.TXInput{nil, -1, bh, transaction.RewardTxData, 0}
 	txOutputs := []transactionbase.TXOutput{}
 	for address, amount := range rewards {
 		amt, err := common.NewAmountFromString(amount)
@@ -371,16 +388,17 @@ func (tx *TxGasReward) GetRewardValue() *common.Amount {
 	return tx.Vout[0].Value
 }
 
-// NewGasRewardTx returns a reward to miner, earned for contract execution gas fee
+// NewGasRewardTx returns a reward to miner, earned for contract execution gas fee. The part of the fee burnt by the
+// monetary policy is not rewarded
 func NewGasRewardTx(to *account.TransactionAccount, blockHeight uint64, actualGasCount *common.Amount, gasPrice *common.Amount, uniqueNum int) (transaction.Transaction, bool) {
 	if actualGasCount.Cmp(common.NewAmount(0)) <= 0 {
 		return transaction.Transaction{}, false
 	}
-		fee := actualGasCount.Mul(gasPrice)
+	fee, _ := transaction.GetMonetaryPolicy().SplitFee(actualGasCount.Mul(gasPrice))
 	if fee.IsZero() {
 		return transaction.Transaction{}, false
 	}
-	txin := transactionbase.TXInput{nil, -1, getUniqueByte(blockHeight, uniqueNum), transaction.GasRewardData}
+	txin := transactionbase.TXInput{nil, -1, getUniqueByte(blockHeight, uniqueNum), transaction.GasRewardData, 0}
 	txout := transactionbase.NewTXOutput(fee, to)
 	tx := transaction.Transaction{nil, []transactionbase.TXInput{txin}, []transactionbase.TXOutput{*txout}, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), time.Now().UnixNano() / 1e6, transaction.TxTypeGasReward}
 	tx.ID = tx.Hash()
@@ -402,7 +420,7 @@ func NewGasChangeTx(to *account.TransactionAccount, blockHeight uint64, actualGa
 	if changeValue.IsZero() {
 		return transaction.Transaction{}, false
 	}
-	txin := transactionbase.TXInput{nil, -1, getUniqueByte(blockHeight, uniqueNum), transaction.GasChangeData}
+	txin := transactionbase.TXInput{nil, -1, getUniqueByte(blockHeight, uniqueNum), transaction.GasChangeData, 0}
 	txout := transactionbase.NewTXOutput(changeValue, to)
 	tx := transaction.Transaction{nil, []transactionbase.TXInput{txin}, []transactionbase.TXOutput{*txout}, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), time.Now().UnixNano() / 1e6, transaction.TxTypeGasChange}
 
@@ -418,8 +436,8 @@ func NewCoinbaseTX(to account.Address, data string, blockHeight uint64, tip *com
 	bh := make([]byte, 8)
 	binary.BigEndian.PutUint64(bh, uint64(blockHeight))
 	toAccount := account.NewTransactionAccountByAddress(to)
-	txin := transactionbase.TXInput{nil, -1, bh, []byte(data)}
-	txout := transactionbase.NewTXOutput(transaction.Subsidy.Add(tip), toAccount)
+	txin := transactionbase.TXInput{nil, -1, bh, []byte(data), 0}
+	txout := transactionbase.NewTXOutput(transaction.GetMonetaryPolicy().GetReward(blockHeight).Add(tip), toAccount)
 	tx := transaction.Transaction{nil, []transactionbase.TXInput{txin}, []transactionbase.TXOutput{*txout}, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), time.Now().UnixNano() / 1e6, transaction.TxTypeCoinbase}
 	tx.ID = tx.Hash()
 
@@ -453,7 +471,7 @@ func NewUTXOTransaction(txType transaction.TxType, utxos []*utxo.UTXO, sendTxPar
 
 	tx := transaction.Transaction{
 		nil,
-		prepareInputLists(utxos, sendTxParam.SenderKeyPair.GetPublicKey(), nil),
+		prepareInputLists(utxos, sendTxParam.SenderKeyPair.GetPublicKey(), nil, sendTxParam.SenderKeyPair.GetAlgorithm()),
 		prepareOutputLists(fromAccount, toAccount, sendTxParam.Amount, change, sendTxParam.Contract),
 		sendTxParam.Tip,
 		sendTxParam.GasLimit,
@@ -463,7 +481,7 @@ func NewUTXOTransaction(txType transaction.TxType, utxos []*utxo.UTXO, sendTxPar
 	}
 	tx.ID = tx.Hash()
 
-	err = tx.Sign(sendTxParam.SenderKeyPair.GetPrivateKey(), utxos)
+	err = tx.SignWithKeyPair(sendTxParam.SenderKeyPair, utxos)
 	if err != nil {
 		return transaction.Transaction{}, err
 	}
@@ -482,7 +500,7 @@ func NewHardCodeTransaction(txType transaction.TxType, utxos []*utxo.UTXO, sendT
 
 	tx := transaction.Transaction{
 		nil,
-		prepareInputLists(utxos, sendTxParam.SenderKeyPair.GetPublicKey(), nil),
+		prepareInputLists(utxos, sendTxParam.SenderKeyPair.GetPublicKey(), nil, sendTxParam.SenderKeyPair.GetAlgorithm()),
 		prepareOutputListsForHardcode(fromAccount, toAccount, sendTxParam.Amount, change, sendTxParam.Contract),
 		sendTxParam.Tip,
 		sendTxParam.GasLimit,
@@ -492,7 +510,7 @@ func NewHardCodeTransaction(txType transaction.TxType, utxos []*utxo.UTXO, sendT
 	}
 	tx.ID = tx.Hash()
 
-	err = tx.Sign(sendTxParam.SenderKeyPair.GetPrivateKey(), utxos)
+	err = tx.SignWithKeyPair(sendTxParam.SenderKeyPair, utxos)
 	if err != nil {
 		return transaction.Transaction{}, err
 	}
@@ -529,7 +547,7 @@ func NewContractTransferTX(utxos []*utxo.UTXO, contractAddr, toAddr account.Addr
 	// Intentionally set PubKeyHash as PubKey (to recognize it is from contract) and sourceTXID as signature in Vin
 	tx := transaction.Transaction{
 		nil,
-		prepareInputLists(utxos, contractAccount.GetPubKeyHash(), sourceTXID),
+		prepareInputLists(utxos, contractAccount.GetPubKeyHash(), sourceTXID, keystore.SECP256K1),
 		prepareOutputLists(contractAccount, toAccount, amount, change, ""),
 		tip,
 		gasLimit,
@@ -543,12 +561,17 @@ func NewContractTransferTX(utxos []*utxo.UTXO, contractAddr, toAddr account.Addr
 }
 
 //prepareInputLists prepares a list of txinputs for a new transaction
-func prepareInputLists(utxos []*utxo.UTXO, publicKey []byte, signature []byte) []transactionbase.TXInput {
+func prepareInputLists(utxos []*utxo.UTXO, publicKey []byte, signature []byte, alg keystore.Algorithm) []transactionbase.TXInput {
 	var inputs []transactionbase.TXInput
 
+	// secp256k1 inputs leave the algorithm unset to keep their encoding unchanged
+	if alg == keystore.SECP256K1 {
+		alg = 0
+	}
+
 	// Build a list of inputs
 	for _, utxo := range utxos {
-		input := transactionbase.TXInput{utxo.Txid, utxo.TxIndex, signature, publicKey}
+		input := transactionbase.TXInput{utxo.Txid, utxo.TxIndex, signature, publicKey, alg}
 		inputs = append(inputs, input)
 	}
 
@@ -0,0 +1,109 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+package ltransaction
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/hex"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/core/transactionbase"
+	"github.com/dappley/go-dappley/core/utxo"
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/logic/lutxo"
+	logger "github.com/sirupsen/logrus"
+)
+
+// TxGovernance transaction, proposes a new value of a chain parameter or votes on a pending proposal. The payload is
+// carried by an output to the sender, so that the proposer or the voter is authenticated by the signature. The output
+// of a proposal locks a deposit of at least transactionbase.MinProposalDeposit until the activation height; the
+// output of a vote has no value
+type TxGovernance struct {
+	*transaction.Transaction
+}
+
+// NewGovernanceTransaction returns a transaction of the sender of sendTxParam that carries payload. The amount of
+// sendTxParam is the deposit of a proposal and zero for a vote. The utxos pay the deposit and the tip; the rest is
+// returned as change
+func NewGovernanceTransaction(utxos []*utxo.UTXO, sendTxParam transaction.SendTxParam, payload string) (transaction.Transaction, error) {
+	fromAccount := account.NewTransactionAccountByAddress(sendTxParam.From)
+	sum := transaction.CalculateUtxoSum(utxos)
+	change, err := transaction.CalculateChange(sum, sendTxParam.Amount, sendTxParam.Tip, common.NewAmount(0), common.NewAmount(0))
+	if err != nil {
+		return transaction.Transaction{}, err
+	}
+
+	outputs := []transactionbase.TXOutput{*transactionbase.NewTxOut(sendTxParam.Amount, fromAccount, payload)}
+	if !change.IsZero() {
+		outputs = append(outputs, *transactionbase.NewTXOutput(change, fromAccount))
+	}
+	return newSignedStakeTransaction(transaction.TxTypeGovernance, utxos, outputs, sendTxParam.SenderKeyPair, sendTxParam.Tip)
+}
+
+func (tx *TxGovernance) Sign(privKey ecdsa.PrivateKey, prevUtxos []*utxo.UTXO) error {
+	return tx.Transaction.Sign(privKey, prevUtxos)
+}
+
+// Verify checks the signatures and the shape of the payload; whether the proposal is acceptable is checked when the
+// transaction is applied to the governance state
+func (tx *TxGovernance) Verify(utxoIndex *lutxo.UTXOIndex, blockHeight uint64) error {
+	prevUtxos, err := lutxo.FindVinUtxosInUtxoPool(utxoIndex, tx.Transaction)
+	if err != nil {
+		logger.WithError(err).WithFields(logger.Fields{
+			"txid":        hex.EncodeToString(tx.ID),
+			"blockHeight": blockHeight,
+		}).Warn("Verify: cannot find vin while verifying governance tx")
+		return err
+	}
+	if err := tx.Transaction.Verify(prevUtxos); err != nil {
+		return err
+	}
+	if err := verifyStakeSpending(tx.Transaction, prevUtxos, blockHeight); err != nil {
+		return err
+	}
+
+	if len(tx.Vout) == 0 {
+		return errval.VoutInvalid
+	}
+	payloadOutput := tx.Vout[0]
+	if !bytes.Equal(payloadOutput.PubKeyHash, prevUtxos[0].PubKeyHash) || payloadOutput.Value == nil {
+		return errval.VoutInvalid
+	}
+	for _, out := range tx.Vout[1:] {
+		if out.IsStake() {
+			return errval.VoutInvalid
+		}
+	}
+	payload, ok := payloadOutput.GetGovernancePayload()
+	if !ok {
+		return errval.InvalidGovernancePayload
+	}
+	if payload.IsVote() {
+		if !payloadOutput.Value.IsZero() {
+			return errval.VoutInvalid
+		}
+		return nil
+	}
+	if payloadOutput.Value.Cmp(transactionbase.MinProposalDeposit) < 0 {
+		return errval.InsufficientDeposit
+	}
+	return nil
+}
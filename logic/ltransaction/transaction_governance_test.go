@@ -0,0 +1,65 @@
+package ltransaction
+
+import (
+	"testing"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/core/transactionbase"
+	"github.com/dappley/go-dappley/core/utxo"
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/logic/lutxo"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxGovernance_Verify(t *testing.T) {
+	producer := account.NewAccount()
+	utxoIndex := lutxo.NewUTXOIndex(utxo.NewUTXOCache(storage.NewRamStorage()))
+
+	fund := &utxo.UTXO{
+		TXOutput: *transactionbase.NewTXOutput(transactionbase.MinProposalDeposit.Add(common.NewAmount(100)), account.NewTransactionAccountByAddress(producer.GetAddress())),
+		Txid:     []byte{0x01},
+		TxIndex:  0,
+	}
+	utxoIndex.AddUTXO(fund.TXOutput, fund.Txid, fund.TxIndex)
+
+	proposalParam := transaction.NewSendTxParam(producer.GetAddress(), producer.GetKeyPair(), producer.GetAddress(), transactionbase.MinProposalDeposit, common.NewAmount(1), common.NewAmount(0), common.NewAmount(0), "")
+	proposalTx, err := NewGovernanceTransaction([]*utxo.UTXO{fund}, proposalParam, transactionbase.NewProposalPayload("maxProducers", 5, 10))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(proposalTx.Vout))
+	assert.Equal(t, transactionbase.MinProposalDeposit, proposalTx.Vout[0].Value)
+	assert.Equal(t, uint64(11), proposalTx.Vout[0].GetUnlockHeight())
+	assert.True(t, proposalTx.Vout[0].IsStake())
+	assert.Equal(t, common.NewAmount(99), proposalTx.Vout[1].Value)
+	assert.Nil(t, NewTxDecorator(&proposalTx).Verify(utxoIndex, 1))
+
+	// a proposal must lock the minimum deposit
+	sendTxParam := transaction.NewSendTxParam(producer.GetAddress(), producer.GetKeyPair(), producer.GetAddress(), common.NewAmount(0), common.NewAmount(1), common.NewAmount(0), common.NewAmount(0), "")
+	noDepositTx, err := NewGovernanceTransaction([]*utxo.UTXO{fund}, sendTxParam, transactionbase.NewProposalPayload("maxProducers", 5, 10))
+	assert.Nil(t, err)
+	assert.Equal(t, errval.InsufficientDeposit, NewTxDecorator(&noDepositTx).Verify(utxoIndex, 1))
+
+	voteTx, err := NewGovernanceTransaction([]*utxo.UTXO{fund}, sendTxParam, transactionbase.NewProposalVotePayload("0a", true))
+	assert.Nil(t, err)
+	assert.Nil(t, NewTxDecorator(&voteTx).Verify(utxoIndex, 1))
+
+	// the payload must be either a proposal or a vote
+	badTx, err := NewGovernanceTransaction([]*utxo.UTXO{fund}, sendTxParam, `{"parameter":"maxProducers","proposal":"0a"}`)
+	assert.Nil(t, err)
+	assert.Equal(t, errval.InvalidGovernancePayload, NewTxDecorator(&badTx).Verify(utxoIndex, 1))
+
+	// a vote does not lock any coins
+	paidVoteTx, err := NewGovernanceTransaction([]*utxo.UTXO{fund}, proposalParam, transactionbase.NewProposalVotePayload("0a", true))
+	assert.Nil(t, err)
+	assert.Equal(t, errval.VoutInvalid, NewTxDecorator(&paidVoteTx).Verify(utxoIndex, 1))
+
+	// the deposit is locked up to the activation height
+	deposit := &utxo.UTXO{TXOutput: proposalTx.Vout[0], Txid: proposalTx.ID, TxIndex: 0}
+	utxoIndex.AddUTXO(deposit.TXOutput, deposit.Txid, deposit.TxIndex)
+	spendTx, err := NewGovernanceTransaction([]*utxo.UTXO{deposit}, sendTxParam, transactionbase.NewProposalVotePayload("0a", true))
+	assert.Nil(t, err)
+	assert.Equal(t, errval.StakeUnbonding, NewTxDecorator(&spendTx).Verify(utxoIndex, 10))
+	assert.Nil(t, NewTxDecorator(&spendTx).Verify(utxoIndex, 11))
+}
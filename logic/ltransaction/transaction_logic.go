@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"runtime"
+	"sync"
 
 	"github.com/dappley/go-dappley/common"
 	"github.com/dappley/go-dappley/core/account"
@@ -29,6 +31,38 @@ func SetGasConsumption(isOn bool) {
 	}
 }
 
+// VerifySignaturesInParallel checks the signatures of the normal and contract transactions across all CPU cores
+// and stores the results in the shared signature cache. Transactions spending outputs that are not in utxoIndex yet,
+// e.g. outputs created earlier in the same block, are skipped and verified serially afterwards.
+func VerifySignaturesInParallel(utxoIndex *lutxo.UTXOIndex, txs []*transaction.Transaction) {
+	workers := runtime.NumCPU()
+	if workers > len(txs) {
+		workers = len(txs)
+	}
+	txCh := make(chan *transaction.Transaction)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for tx := range txCh {
+				prevUtxos, err := lutxo.FindVinUtxosInUtxoPool(utxoIndex, tx)
+				if err != nil || len(prevUtxos) != len(tx.Vin) {
+					continue
+				}
+				tx.VerifySignaturesWithCache(prevUtxos)
+			}
+		}()
+	}
+	for _, tx := range txs {
+		if tx.IsNormal() || tx.IsContract() {
+			txCh <- tx
+		}
+	}
+	close(txCh)
+	wg.Wait()
+}
+
 // VerifyTransaction ensures signature of transactions is correct or verifies against blockHeight if it's a coinbase transactions
 func VerifyTransaction(utxoIndex *lutxo.UTXOIndex, tx *transaction.Transaction, blockHeight uint64) error {
 	err := tx.CheckVinNum()
@@ -42,16 +76,17 @@ func VerifyTransaction(utxoIndex *lutxo.UTXOIndex, tx *transaction.Transaction,
 	return nil
 }
 
-// VerifyAndCollectContractOutput ensures the generated transactions from smart contract are the same with those in block
-func VerifyAndCollectContractOutput(utxoIndex *lutxo.UTXOIndex, tx *TxContract, ctState *scState.ScState, scEngine ScEngine, currBlkHeight uint64, parentBlk *block.Block, rewards map[string]string, db storage.Storage) (gasCount uint64, generatedTxs []*transaction.Transaction, err error) {
+// VerifyAndCollectContractOutput ensures the generated transactions from smart contract are the same with those in block.
+// The returned receipt records the outcome of the contract execution.
+func VerifyAndCollectContractOutput(utxoIndex *lutxo.UTXOIndex, tx *TxContract, ctState *scState.ScState, scEngine ScEngine, currBlkHeight uint64, parentBlk *block.Block, rewards map[string]string, db storage.Storage) (gasCount uint64, generatedTxs []*transaction.Transaction, receipt *transaction.TxReceipt, err error) {
 	// Run the contract and collect generated transactions
 	if scEngine == nil {
-		return 0, nil, errval.MissingEngineManager
+		return 0, nil, nil, errval.MissingEngineManager
 	}
 	if tx.GasPrice.Cmp(common.NewAmount(0)) < 0 || tx.GasPrice.Cmp(common.NewAmount(0)) == GasConsumption {
 		err := errval.NegativeGasPrice
 		logger.WithError(err).Error("CollectContractOutput: executeSmartContract error")
-		return 0, nil, err
+		return 0, nil, nil, err
 	}
 
 	prevUtxos, err := lutxo.FindVinUtxosInUtxoPool(utxoIndex, tx.Transaction)
@@ -59,22 +94,28 @@ func VerifyAndCollectContractOutput(utxoIndex *lutxo.UTXOIndex, tx *TxContract,
 		logger.WithError(err).WithFields(logger.Fields{
 			"txid": hex.EncodeToString(tx.ID),
 		}).Warn("VerifyAndCollectContractOutput: cannot find vin while executing smart contract")
-		return 0, nil, err
+		return 0, nil, nil, err
 	}
 	isContractDeployed := tx.IsContractDeployed(utxoIndex)
 	if !utxoIndex.UpdateUtxo(tx.Transaction) {
 		logger.Warn("VerifyAndCollectContractOutput warn")
 	}
 	if err := scEngine.SetExecutionLimits(1000, 0); err != nil {
-		return 0, nil, err
+		return 0, nil, nil, err
 	}
-	gasCount, generatedTxs, err = tx.Execute(prevUtxos, isContractDeployed, utxoIndex, ctState, rewards, scEngine, currBlkHeight, parentBlk)
-	if err != nil {
-		logger.Warn(err)
+	numOfEventsBeforeExe := len(ctState.GetEvents())
+	gasCount, generatedTxs, execErr := tx.Execute(prevUtxos, isContractDeployed, utxoIndex, ctState, rewards, scEngine, currBlkHeight, parentBlk)
+	if execErr != nil {
+		logger.Warn(execErr)
 		//invoke smart contracts before they are completed deploy, will cause an ErrLoadError.
 		//for example: deploy and invoke contracts in the same block
 	}
-	return gasCount, generatedTxs, nil
+	var events []*transaction.ReceiptEvent
+	for _, event := range ctState.GetEvents()[numOfEventsBeforeExe:] {
+		events = append(events, &transaction.ReceiptEvent{Topic: event.GetTopic(), Data: event.GetData()})
+	}
+	receipt = transaction.NewTxReceipt(tx.ID, gasCount, generatedTxs, events, execErr)
+	return gasCount, generatedTxs, receipt, nil
 }
 
 // DescribeTransaction reverse-engineers the high-level description of a transaction
@@ -101,7 +142,7 @@ func DescribeTransaction(utxoIndex *lutxo.UTXOIndex, tx *transaction.Transaction
 					return nil, nil, nil, nil, err
 				}
 
-				ta = account.NewTransactionAccountByPubKey(vin.PubKey)
+				ta = account.NewTransactionAccountByPubKeyWithAlgorithm(vin.PubKey, vin.GetAlgorithm())
 
 			}
 			usedUTXO, err := utxoIndex.GetUpdatedUtxo(ta.GetPubKeyHash(), vin.Txid, vin.Vout)
@@ -0,0 +1,71 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+package ltransaction
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/hex"
+
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/core/utxo"
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/logic/lelection"
+	"github.com/dappley/go-dappley/logic/lutxo"
+	logger "github.com/sirupsen/logrus"
+)
+
+// TxProducerChange transaction, schedules a change of the dynasty. The first output carries the payload and names the
+// producer being changed, who must sign all the inputs. The change is only applied if that address is a producer of
+// the dynasty when the change takes effect
+type TxProducerChange struct {
+	*transaction.Transaction
+}
+
+func (tx *TxProducerChange) Sign(privKey ecdsa.PrivateKey, prevUtxos []*utxo.UTXO) error {
+	return tx.Transaction.Sign(privKey, prevUtxos)
+}
+
+// Verify checks the signatures, that the signer is the producer named by the first output and the shape of the payload
+func (tx *TxProducerChange) Verify(utxoIndex *lutxo.UTXOIndex, blockHeight uint64) error {
+	prevUtxos, err := lutxo.FindVinUtxosInUtxoPool(utxoIndex, tx.Transaction)
+	if err != nil {
+		logger.WithError(err).WithFields(logger.Fields{
+			"txid":        hex.EncodeToString(tx.ID),
+			"blockHeight": blockHeight,
+		}).Warn("Verify: cannot find vin while verifying producer change tx")
+		return err
+	}
+	if err := tx.Transaction.Verify(prevUtxos); err != nil {
+		return err
+	}
+	if err := verifyStakeSpending(tx.Transaction, prevUtxos, blockHeight); err != nil {
+		return err
+	}
+
+	if len(tx.Vout) == 0 || len(prevUtxos) == 0 {
+		return errval.VoutInvalid
+	}
+	for _, prevUtxo := range prevUtxos {
+		if !bytes.Equal(tx.Vout[0].PubKeyHash, prevUtxo.PubKeyHash) {
+			return errval.UnauthorizedDynastyChange
+		}
+	}
+	_, err = lelection.ParseProducerChange(tx.Transaction, blockHeight)
+	return err
+}
@@ -0,0 +1,51 @@
+package ltransaction
+
+import (
+	"testing"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/core/transactionbase"
+	"github.com/dappley/go-dappley/core/utxo"
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/logic/lutxo"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxProducerChange_Verify(t *testing.T) {
+	producer := account.NewAccount()
+	attacker := account.NewAccount()
+	utxoIndex := lutxo.NewUTXOIndex(utxo.NewUTXOCache(storage.NewRamStorage()))
+
+	newFund := func(owner *account.Account, txid byte) *utxo.UTXO {
+		fund := &utxo.UTXO{
+			TXOutput: *transactionbase.NewTXOutput(common.NewAmount(10), account.NewTransactionAccountByAddress(owner.GetAddress())),
+			Txid:     []byte{txid},
+			TxIndex:  0,
+		}
+		utxoIndex.AddUTXO(fund.TXOutput, fund.Txid, fund.TxIndex)
+		return fund
+	}
+	producerFund := newFund(producer, 0x01)
+	attackerFund := newFund(attacker, 0x02)
+
+	newChangeTx := func(signer *account.Account, fund *utxo.UTXO, payload string) *transaction.Transaction {
+		sendTxParam := transaction.NewSendTxParam(signer.GetAddress(), signer.GetKeyPair(), producer.GetAddress(), common.NewAmount(1), common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), payload)
+		tx, err := NewProducerChangeUTXOTransaction([]*utxo.UTXO{fund}, sendTxParam)
+		assert.Nil(t, err)
+		return &tx
+	}
+
+	assert.Nil(t, VerifyTransaction(utxoIndex, newChangeTx(producer, producerFund, `{"height":5,"addresses":"new","kind":1}`), 1))
+
+	// only the producer being changed may sign the change
+	assert.Equal(t, errval.UnauthorizedDynastyChange, VerifyTransaction(utxoIndex, newChangeTx(attacker, attackerFund, `{"height":5,"addresses":"new","kind":1}`), 1))
+	assert.Equal(t, errval.UnauthorizedDynastyChange, VerifyTransaction(utxoIndex, newChangeTx(attacker, attackerFund, `{"height":5,"addresses":"","kind":3}`), 1))
+
+	// the change must take effect after the block and be of a known kind
+	assert.Equal(t, errval.InvalidDynastyChange, VerifyTransaction(utxoIndex, newChangeTx(producer, producerFund, `{"height":1,"addresses":"new","kind":1}`), 1))
+	assert.Equal(t, errval.InvalidDynastyChange, VerifyTransaction(utxoIndex, newChangeTx(producer, producerFund, `{"height":5,"addresses":"new","kind":4}`), 1))
+	assert.Equal(t, errval.InvalidDynastyChange, VerifyTransaction(utxoIndex, newChangeTx(producer, producerFund, "invalid"), 1))
+}
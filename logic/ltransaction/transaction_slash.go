@@ -0,0 +1,82 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+package ltransaction
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"time"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/core/transactionbase"
+	"github.com/dappley/go-dappley/core/utxo"
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/logic/lutxo"
+)
+
+// TxSlash transaction, reports a producer that minted two blocks in one time slot. The evidence is verified by
+// the consensus; the transaction itself has no input and pays nothing
+type TxSlash struct {
+	*transaction.Transaction
+}
+
+// NewSlashTransaction returns a transaction that reports offender with the evidence
+func NewSlashTransaction(offender account.Address, evidence string) transaction.Transaction {
+	offenderAccount := account.NewTransactionAccountByAddress(offender)
+	tx := transaction.Transaction{
+		Vout:       []transactionbase.TXOutput{*transactionbase.NewTxOut(common.NewAmount(0), offenderAccount, evidence)},
+		Tip:        common.NewAmount(0),
+		GasLimit:   common.NewAmount(0),
+		GasPrice:   common.NewAmount(0),
+		CreateTime: time.Now().UnixNano() / 1e6,
+		Type:       transaction.TxTypeSlash,
+	}
+	tx.ID = tx.Hash()
+	return tx
+}
+
+// GetOffender returns the address of the reported producer
+func (tx *TxSlash) GetOffender() account.Address {
+	return tx.Vout[0].PubKeyHash.GenerateAddress()
+}
+
+// GetEvidence returns the evidence carried by the transaction
+func (tx *TxSlash) GetEvidence() string {
+	return tx.Vout[0].Contract
+}
+
+func (tx *TxSlash) Sign(privKey ecdsa.PrivateKey, prevUtxos []*utxo.UTXO) error {
+	return nil
+}
+
+// Verify checks the shape of the transaction; the evidence is checked by the consensus when the block is validated
+func (tx *TxSlash) Verify(utxoIndex *lutxo.UTXOIndex, blockHeight uint64) error {
+	if len(tx.Vin) != 0 || len(tx.Vout) != 1 || tx.Vout[0].Contract == "" {
+		return errval.InvalidSlashEvidence
+	}
+	if tx.Vout[0].Value == nil || !tx.Vout[0].Value.IsZero() || (tx.Tip != nil && !tx.Tip.IsZero()) {
+		return errval.InvalidSlashEvidence
+	}
+	txCopy := tx.TrimmedCopy(true)
+	if !bytes.Equal(tx.ID, (&txCopy).Hash()) {
+		return errval.TransactionIDInvalid
+	}
+	return nil
+}
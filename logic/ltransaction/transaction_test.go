@@ -13,6 +13,7 @@ import (
 	"github.com/dappley/go-dappley/core/transaction"
 	"github.com/dappley/go-dappley/core/transactionbase"
 	"github.com/dappley/go-dappley/core/utxo"
+	"github.com/dappley/go-dappley/crypto/keystore"
 	"github.com/dappley/go-dappley/crypto/keystore/secp256k1"
 	errval "github.com/dappley/go-dappley/errors"
 	"github.com/dappley/go-dappley/logic/lutxo"
@@ -47,9 +48,9 @@ func TestSign(t *testing.T) {
 
 	// New transaction to be signed (paid from the fake account)
 	txin := []transactionbase.TXInput{
-		{[]byte{1}, 0, nil, pubKey},
-		{[]byte{3}, 0, nil, pubKey},
-		{[]byte{3}, 2, nil, pubKey},
+		{[]byte{1}, 0, nil, pubKey, 0},
+		{[]byte{3}, 0, nil, pubKey, 0},
+		{[]byte{3}, 2, nil, pubKey, 0},
 	}
 	txout := []transactionbase.TXOutput{
 		{common.NewAmount(19), ta.GetPubKeyHash(), ""},
@@ -152,7 +153,7 @@ func TestVerifyCoinbaseTransaction(t *testing.T) {
 	var t5 = NewCoinbaseTX(account.NewAddress("13ZRUc4Ho3oK3Cw56PhE5rmaum9VBeAn5F"), "", 5, common.NewAmount(0))
 	bh1 := make([]byte, 8)
 	binary.BigEndian.PutUint64(bh1, 5)
-	txin1 := transactionbase.TXInput{nil, -1, bh1, []byte("Reward to test")}
+	txin1 := transactionbase.TXInput{nil, -1, bh1, []byte("Reward to test"), 0}
 	txout1 := transactionbase.NewTXOutput(transaction.Subsidy, account.NewTransactionAccountByAddress(account.NewAddress("13ZRUc4Ho3oK3Cw56PhE5rmaum9VBeAn5F")))
 	var t6 = transaction.Transaction{nil, []transactionbase.TXInput{txin1}, []transactionbase.TXOutput{*txout1}, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), 0, transaction.TxTypeCoinbase}
 
@@ -169,7 +170,7 @@ func TestVerifyCoinbaseTransaction(t *testing.T) {
 	// test coinbase transaction with incorrect Subsidy
 	bh2 := make([]byte, 8)
 	binary.BigEndian.PutUint64(bh2, 5)
-	txin2 := transactionbase.TXInput{nil, -1, bh2, []byte(nil)}
+	txin2 := transactionbase.TXInput{nil, -1, bh2, []byte(nil), 0}
 	txout2 := transactionbase.NewTXOutput(common.NewAmount(9), account.NewTransactionAccountByAddress(account.NewAddress("13ZRUc4Ho3oK3Cw56PhE5rmaum9VBeAn5F")))
 	var t7 = transaction.Transaction{nil, []transactionbase.TXInput{txin2}, []transactionbase.TXOutput{*txout2}, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), 0, transaction.TxTypeCoinbase}
 	err7 := VerifyTransaction(&lutxo.UTXOIndex{}, &t7, 5)
@@ -199,11 +200,11 @@ func TestVerifyNoCoinbaseTransaction(t *testing.T) {
 	})
 
 	// Prepare a transaction to be verified
-	txin := []transactionbase.TXInput{{[]byte{1}, 0, nil, pubKey}}
-	txin1 := append(txin, transactionbase.TXInput{[]byte{2}, 1, nil, pubKey})      // Normal test
-	txin2 := append(txin, transactionbase.TXInput{[]byte{2}, 1, nil, wrongPubKey}) // previous not found with wrong pubkey
-	txin3 := append(txin, transactionbase.TXInput{[]byte{3}, 1, nil, pubKey})      // previous not found with wrong Txid
-	txin4 := append(txin, transactionbase.TXInput{[]byte{2}, 2, nil, pubKey})      // previous not found with wrong TxIndex
+	txin := []transactionbase.TXInput{{[]byte{1}, 0, nil, pubKey, 0}}
+	txin1 := append(txin, transactionbase.TXInput{[]byte{2}, 1, nil, pubKey, 0})      // Normal test
+	txin2 := append(txin, transactionbase.TXInput{[]byte{2}, 1, nil, wrongPubKey, 0}) // previous not found with wrong pubkey
+	txin3 := append(txin, transactionbase.TXInput{[]byte{3}, 1, nil, pubKey, 0})      // previous not found with wrong Txid
+	txin4 := append(txin, transactionbase.TXInput{[]byte{2}, 2, nil, pubKey, 0})      // previous not found with wrong TxIndex
 	txout := []transactionbase.TXOutput{{common.NewAmount(7), ta.GetPubKeyHash(), ""}}
 	txout2 := []transactionbase.TXOutput{{common.NewAmount(8), ta.GetPubKeyHash(), ""}} //Vout amount > Vin amount
 
@@ -246,7 +247,7 @@ func TestInvalidExecutionTx(t *testing.T) {
 	var deploymentTx = transaction.Transaction{
 		ID: nil,
 		Vin: []transactionbase.TXInput{
-			{tx1.ID, 1, nil, pubkey1},
+			{tx1.ID, 1, nil, pubkey1, 0},
 		},
 		Vout: []transactionbase.TXOutput{
 			{common.NewAmount(5), ta1.GetPubKeyHash(), "dapp_schedule"},
@@ -267,7 +268,7 @@ func TestInvalidExecutionTx(t *testing.T) {
 	var executionTx = &transaction.Transaction{
 		ID: nil,
 		Vin: []transactionbase.TXInput{
-			{deploymentTx.ID, 0, nil, pubkey1},
+			{deploymentTx.ID, 0, nil, pubkey1, 0},
 		},
 		Vout: []transactionbase.TXOutput{
 			{common.NewAmount(3), contractPubkeyHash, "execution"},
@@ -292,7 +293,7 @@ func TestInvalidTipTx(t *testing.T) {
 	var deploymentTx = transaction.Transaction{
 		ID: nil,
 		Vin: []transactionbase.TXInput{
-			{tx1.ID, 1, nil, pubkey1},
+			{tx1.ID, 1, nil, pubkey1, 0},
 		},
 		Vout: []transactionbase.TXOutput{
 			{common.NewAmount(50000), ta1.GetPubKeyHash(), "dapp_schedule"},
@@ -313,7 +314,7 @@ func TestInvalidTipTx(t *testing.T) {
 	var executionTx = &transaction.Transaction{
 		ID: nil,
 		Vin: []transactionbase.TXInput{
-			{deploymentTx.ID, 0, nil, pubkey1},
+			{deploymentTx.ID, 0, nil, pubkey1, 0},
 		},
 		Vout: []transactionbase.TXOutput{
 			{common.NewAmount(19998), contractPubkeyHash, "execution"},
@@ -708,7 +709,7 @@ func TestNewGasRewardTx(t *testing.T) {
 func TestNewCoinbaseTX(t *testing.T) {
 	t1 := NewCoinbaseTX(account.NewAddress("dXnq2R6SzRNUt7ZANAqyZc2P9ziF6vYekB"), "", 0, common.NewAmount(0))
 	t2 := NewCoinbaseTX(account.NewAddress("dXnq2R6SzRNUt7ZANAqyZc2P9ziF6vYekB"), "", 0, common.NewAmount(0))
-	expectVin := transactionbase.TXInput{nil, -1, []byte{0, 0, 0, 0, 0, 0, 0, 0}, []byte("Reward to 'dXnq2R6SzRNUt7ZANAqyZc2P9ziF6vYekB'")}
+	expectVin := transactionbase.TXInput{nil, -1, []byte{0, 0, 0, 0, 0, 0, 0, 0}, []byte("Reward to 'dXnq2R6SzRNUt7ZANAqyZc2P9ziF6vYekB'"), 0}
 	expectVout := transactionbase.TXOutput{transaction.Subsidy, account.PubKeyHash([]byte{0x5a, 0xc9, 0x85, 0x37, 0x92, 0x37, 0x76, 0x80, 0xb1, 0x31, 0xa1, 0xab, 0xb, 0x5b, 0xa6, 0x49, 0xe5, 0x27, 0xf0, 0x42, 0x5d}), ""}
 	assert.Equal(t, 1, len(t1.Vin))
 	assert.Equal(t, expectVin, t1.Vin[0])
@@ -818,7 +819,7 @@ func TestPrepareInputLists(t *testing.T) {
 		{Txid: []byte{0x89}, Vout: 1, Signature: []byte("signature"), PubKey: []byte("pubkey")},
 	}
 
-	result := prepareInputLists(utxos, []byte("pubkey"), []byte("signature"))
+	result := prepareInputLists(utxos, []byte("pubkey"), []byte("signature"), keystore.SECP256K1)
 	assert.Equal(t, expected, result)
 }
 
@@ -922,3 +923,109 @@ func TestDescribeTransaction(t *testing.T) {
 	assert.Equal(t, common.NewAmount(2), tip)
 	assert.Nil(t, err)
 }
+
+// prepareSignedTxs returns n independent signed transactions and the utxo index holding the utxos they spend
+func prepareSignedTxs(tb testing.TB, n int) (*lutxo.UTXOIndex, []*transaction.Transaction) {
+	utxoIndex := lutxo.NewUTXOIndex(utxo.NewUTXOCache(storage.NewRamStorage()))
+	indexAdd := make(map[string]*utxo.UTXOTx)
+	var txs []*transaction.Transaction
+	for i := 0; i < n; i++ {
+		acc := account.NewAccount()
+		// a public key starting with the contract version byte is taken for a contract public key hash
+		if isContract, _ := account.PubKeyHash(acc.GetKeyPair().GetPublicKey()).IsContract(); isContract {
+			i--
+			continue
+		}
+		prevTxid := util.GenerateRandomAoB(32)
+		prevUtxo := utxo.NewUTXO(transactionbase.TXOutput{Value: common.NewAmount(10), PubKeyHash: acc.GetPubKeyHash()}, prevTxid, 0, utxo.UtxoNormal)
+		utxoTx := utxo.NewUTXOTx()
+		utxoTx.PutUtxo(prevUtxo)
+		indexAdd[acc.GetPubKeyHash().String()] = &utxoTx
+
+		tx := &transaction.Transaction{
+			Vin:      []transactionbase.TXInput{{Txid: prevTxid, Vout: 0, PubKey: acc.GetKeyPair().GetPublicKey()}},
+			Vout:     []transactionbase.TXOutput{{Value: common.NewAmount(9), PubKeyHash: acc.GetPubKeyHash()}},
+			Tip:      common.NewAmount(1),
+			GasLimit: common.NewAmount(0),
+			GasPrice: common.NewAmount(0),
+			Type:     transaction.TxTypeNormal,
+		}
+		tx.ID = tx.Hash()
+		if err := tx.SignWithKeyPair(acc.GetKeyPair(), []*utxo.UTXO{prevUtxo}); err != nil {
+			tb.Fatal(err)
+		}
+		txs = append(txs, tx)
+	}
+	utxoIndex.SetIndexAdd(indexAdd)
+	return utxoIndex, txs
+}
+
+func TestVerifySignaturesInParallel(t *testing.T) {
+	utxoIndex, txs := prepareSignedTxs(t, 20)
+	// an invalid signature is cached as invalid and still rejected by the serial verification
+	txs[3].Vin[0].Signature[0] ^= 0xff
+	sigCache := transaction.GetSignatureCache()
+	sigCache.Purge()
+
+	VerifySignaturesInParallel(utxoIndex, txs)
+	assert.Equal(t, len(txs), sigCache.Len())
+
+	for i, tx := range txs {
+		err := VerifyTransaction(utxoIndex, tx, 0)
+		if i == 3 {
+			assert.Equal(t, errval.SignaturesInvalid, err)
+		} else {
+			assert.Nil(t, err)
+		}
+	}
+	assert.Equal(t, len(txs), sigCache.Len())
+}
+
+const benchmarkBlockTxCount = 200
+
+// BenchmarkVerifyBlockTransactions_Serial verifies the transactions of a block one by one without cached results
+func BenchmarkVerifyBlockTransactions_Serial(b *testing.B) {
+	utxoIndex, txs := prepareSignedTxs(b, benchmarkBlockTxCount)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		transaction.GetSignatureCache().Purge()
+		for _, tx := range txs {
+			if err := VerifyTransaction(utxoIndex, tx, 0); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+// BenchmarkVerifyBlockTransactions_Parallel checks the signatures in parallel before the serial verification
+func BenchmarkVerifyBlockTransactions_Parallel(b *testing.B) {
+	utxoIndex, txs := prepareSignedTxs(b, benchmarkBlockTxCount)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		transaction.GetSignatureCache().Purge()
+		VerifySignaturesInParallel(utxoIndex, txs)
+		for _, tx := range txs {
+			if err := VerifyTransaction(utxoIndex, tx, 0); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+// BenchmarkVerifyBlockTransactions_Cached verifies a block whose transactions were already checked by the transaction pool
+func BenchmarkVerifyBlockTransactions_Cached(b *testing.B) {
+	utxoIndex, txs := prepareSignedTxs(b, benchmarkBlockTxCount)
+	transaction.GetSignatureCache().Purge()
+	for _, tx := range txs {
+		VerifyTransaction(utxoIndex, tx, 0)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		VerifySignaturesInParallel(utxoIndex, txs)
+		for _, tx := range txs {
+			if err := VerifyTransaction(utxoIndex, tx, 0); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+package ltransaction
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"time"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/core/transactionbase"
+	"github.com/dappley/go-dappley/core/utxo"
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/logic/lutxo"
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	// UnbondingDelay is the number of blocks the coins of a withdrawn vote stay locked
+	UnbondingDelay uint64 = 1000
+	// MaxVoteCandidates is the maximum number of candidates a single vote can support
+	MaxVoteCandidates = 21
+)
+
+// TxVote transaction, locks coins of the sender to vote for producer candidates
+type TxVote struct {
+	*transaction.Transaction
+}
+
+// TxUnvote transaction, withdraws a vote and starts unbonding its coins
+type TxUnvote struct {
+	*transaction.Transaction
+}
+
+// NewVoteTransaction returns a transaction that locks the amount of sendTxParam to vote for the candidates
+func NewVoteTransaction(utxos []*utxo.UTXO, sendTxParam transaction.SendTxParam, candidates []string) (transaction.Transaction, error) {
+	fromAccount := account.NewTransactionAccountByAddress(sendTxParam.From)
+	sum := transaction.CalculateUtxoSum(utxos)
+	change, err := transaction.CalculateChange(sum, sendTxParam.Amount, sendTxParam.Tip, common.NewAmount(0), common.NewAmount(0))
+	if err != nil {
+		return transaction.Transaction{}, err
+	}
+
+	outputs := []transactionbase.TXOutput{*transactionbase.NewTxOut(sendTxParam.Amount, fromAccount, transactionbase.NewVotePayload(candidates))}
+	if !change.IsZero() {
+		outputs = append(outputs, *transactionbase.NewTXOutput(change, fromAccount))
+	}
+	return newSignedStakeTransaction(transaction.TxTypeVote, utxos, outputs, sendTxParam.SenderKeyPair, sendTxParam.Tip)
+}
+
+// NewUnvoteTransaction returns a transaction that withdraws the vote locked in stake. The coins, minus the tip,
+// can be spent again from unlockHeight on
+func NewUnvoteTransaction(stake *utxo.UTXO, keyPair *account.KeyPair, tip *common.Amount, unlockHeight uint64) (transaction.Transaction, error) {
+	value, err := stake.Value.Sub(tip)
+	if err != nil {
+		return transaction.Transaction{}, errval.InsufficientFund
+	}
+	unbondOutput := transactionbase.TXOutput{Value: value, PubKeyHash: stake.PubKeyHash, Contract: transactionbase.NewUnbondPayload(unlockHeight)}
+	return newSignedStakeTransaction(transaction.TxTypeUnvote, []*utxo.UTXO{stake}, []transactionbase.TXOutput{unbondOutput}, keyPair, tip)
+}
+
+func newSignedStakeTransaction(txType transaction.TxType, utxos []*utxo.UTXO, outputs []transactionbase.TXOutput, keyPair *account.KeyPair, tip *common.Amount) (transaction.Transaction, error) {
+	tx := transaction.Transaction{
+		Vin:        prepareInputLists(utxos, keyPair.GetPublicKey(), nil, keyPair.GetAlgorithm()),
+		Vout:       outputs,
+		Tip:        tip,
+		GasLimit:   common.NewAmount(0),
+		GasPrice:   common.NewAmount(0),
+		CreateTime: time.Now().UnixNano() / 1e6,
+		Type:       txType,
+	}
+	tx.ID = tx.Hash()
+
+	if err := tx.SignWithKeyPair(keyPair, utxos); err != nil {
+		return transaction.Transaction{}, err
+	}
+	return tx, nil
+}
+
+func (tx *TxVote) Sign(privKey ecdsa.PrivateKey, prevUtxos []*utxo.UTXO) error {
+	return tx.Transaction.Sign(privKey, prevUtxos)
+}
+
+func (tx *TxVote) Verify(utxoIndex *lutxo.UTXOIndex, blockHeight uint64) error {
+	prevUtxos, err := lutxo.FindVinUtxosInUtxoPool(utxoIndex, tx.Transaction)
+	if err != nil {
+		logger.WithError(err).WithFields(logger.Fields{
+			"txid":        hex.EncodeToString(tx.ID),
+			"blockHeight": blockHeight,
+		}).Warn("Verify: cannot find vin while verifying vote tx")
+		return err
+	}
+	if err := tx.Transaction.Verify(prevUtxos); err != nil {
+		return err
+	}
+	if err := verifyStakeSpending(tx.Transaction, prevUtxos, blockHeight); err != nil {
+		return err
+	}
+	return tx.verifyVoteOutput(prevUtxos[0].PubKeyHash)
+}
+
+// verifyVoteOutput checks that the first output locks a positive amount of the voter for a list of valid candidates
+func (tx *TxVote) verifyVoteOutput(voter account.PubKeyHash) error {
+	if len(tx.Vout) == 0 {
+		return errval.VoutInvalid
+	}
+	voteOutput := tx.Vout[0]
+	if !bytes.Equal(voteOutput.PubKeyHash, voter) || voteOutput.Value == nil || voteOutput.Value.IsZero() {
+		return errval.VoutInvalid
+	}
+	for _, out := range tx.Vout[1:] {
+		if out.IsStake() {
+			return errval.VoutInvalid
+		}
+	}
+
+	payload, isVote := voteOutput.GetVotePayload()
+	if !isVote || len(payload.Candidates) > MaxVoteCandidates {
+		return errval.InvalidVotePayload
+	}
+	seen := make(map[string]bool)
+	for _, candidate := range payload.Candidates {
+		if seen[candidate] || !account.NewTransactionAccountByAddress(account.NewAddress(candidate)).IsValid() {
+			return errval.InvalidVotePayload
+		}
+		seen[candidate] = true
+	}
+	return nil
+}
+
+func (tx *TxUnvote) Sign(privKey ecdsa.PrivateKey, prevUtxos []*utxo.UTXO) error {
+	return tx.Transaction.Sign(privKey, prevUtxos)
+}
+
+func (tx *TxUnvote) Verify(utxoIndex *lutxo.UTXOIndex, blockHeight uint64) error {
+	prevUtxos, err := lutxo.FindVinUtxosInUtxoPool(utxoIndex, tx.Transaction)
+	if err != nil {
+		logger.WithError(err).WithFields(logger.Fields{
+			"txid":        hex.EncodeToString(tx.ID),
+			"blockHeight": blockHeight,
+		}).Warn("Verify: cannot find vin while verifying unvote tx")
+		return err
+	}
+	if err := tx.Transaction.Verify(prevUtxos); err != nil {
+		return err
+	}
+	if len(prevUtxos) != 1 || len(tx.Vout) != 1 {
+		return errval.VoutInvalid
+	}
+	if _, isVote := prevUtxos[0].GetVotePayload(); !isVote {
+		return errval.VoteNotFound
+	}
+	unbondOutput := tx.Vout[0]
+	if !bytes.Equal(unbondOutput.PubKeyHash, prevUtxos[0].PubKeyHash) {
+		return errval.VoutInvalid
+	}
+	if unbondOutput.GetUnlockHeight() < blockHeight+UnbondingDelay {
+		return errval.InvalidUnvotePayload
+	}
+	return nil
+}
+
+// verifyStakeSpending ensures that coins locked by a vote are only spent by an unvote transaction and that
+// unbonding coins are only spent once their unlock height is reached
+func verifyStakeSpending(tx *transaction.Transaction, prevUtxos []*utxo.UTXO, blockHeight uint64) error {
+	for _, prevUtxo := range prevUtxos {
+		if _, isVote := prevUtxo.GetVotePayload(); isVote && !tx.IsUnvote() {
+			return errval.StakeLocked
+		}
+		if prevUtxo.GetUnlockHeight() > blockHeight {
+			return errval.StakeUnbonding
+		}
+	}
+	return nil
+}
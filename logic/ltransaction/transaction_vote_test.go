@@ -0,0 +1,110 @@
+package ltransaction
+
+import (
+	"testing"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/core/transactionbase"
+	"github.com/dappley/go-dappley/core/utxo"
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/logic/lutxo"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxVote_Verify(t *testing.T) {
+	voter := account.NewAccount()
+	candidate := account.NewAccount()
+	utxoIndex := lutxo.NewUTXOIndex(utxo.NewUTXOCache(storage.NewRamStorage()))
+
+	fund := &utxo.UTXO{
+		TXOutput: *transactionbase.NewTXOutput(common.NewAmount(100), account.NewTransactionAccountByAddress(voter.GetAddress())),
+		Txid:     []byte{0x01},
+		TxIndex:  0,
+	}
+	utxoIndex.AddUTXO(fund.TXOutput, fund.Txid, fund.TxIndex)
+
+	sendTxParam := transaction.NewSendTxParam(voter.GetAddress(), voter.GetKeyPair(), voter.GetAddress(), common.NewAmount(60), common.NewAmount(1), common.NewAmount(0), common.NewAmount(0), "")
+	voteTx, err := NewVoteTransaction([]*utxo.UTXO{fund}, sendTxParam, []string{candidate.GetAddress().String()})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(voteTx.Vout))
+	assert.Nil(t, NewTxDecorator(&voteTx).Verify(utxoIndex, 1))
+
+	// a vote for an invalid candidate is rejected
+	badVoteTx, err := NewVoteTransaction([]*utxo.UTXO{fund}, sendTxParam, []string{"invalid"})
+	assert.Nil(t, err)
+	assert.Equal(t, errval.InvalidVotePayload, NewTxDecorator(&badVoteTx).Verify(utxoIndex, 1))
+
+	assert.True(t, utxoIndex.UpdateUtxo(&voteTx))
+	stake := utxoIndex.GetAllUTXOsByPubKeyHash(voter.GetPubKeyHash()).GetAllUtxos()
+	var voteUtxo *utxo.UTXO
+	for _, u := range stake {
+		if u.IsStake() {
+			voteUtxo = u
+		}
+	}
+	assert.NotNil(t, voteUtxo)
+
+	// a locked vote can not be spent by a normal transaction
+	normalTx, err := NewUTXOTransaction(transaction.TxTypeNormal, []*utxo.UTXO{voteUtxo}, transaction.NewSendTxParam(voter.GetAddress(), voter.GetKeyPair(), candidate.GetAddress(), common.NewAmount(10), common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), ""))
+	assert.Nil(t, err)
+	assert.Equal(t, errval.StakeLocked, NewTxDecorator(&normalTx).Verify(utxoIndex, 2))
+
+	// the unbonding delay must be respected
+	unvoteTx, err := NewUnvoteTransaction(voteUtxo, voter.GetKeyPair(), common.NewAmount(0), 2)
+	assert.Nil(t, err)
+	assert.Equal(t, errval.InvalidUnvotePayload, NewTxDecorator(&unvoteTx).Verify(utxoIndex, 2))
+
+	unvoteTx, err = NewUnvoteTransaction(voteUtxo, voter.GetKeyPair(), common.NewAmount(0), 2+UnbondingDelay)
+	assert.Nil(t, err)
+	assert.Nil(t, NewTxDecorator(&unvoteTx).Verify(utxoIndex, 2))
+	assert.True(t, utxoIndex.UpdateUtxo(&unvoteTx))
+
+	unbonding := &utxo.UTXO{TXOutput: unvoteTx.Vout[0], Txid: unvoteTx.ID, TxIndex: 0}
+	spendTx, err := NewUTXOTransaction(transaction.TxTypeNormal, []*utxo.UTXO{unbonding}, transaction.NewSendTxParam(voter.GetAddress(), voter.GetKeyPair(), candidate.GetAddress(), common.NewAmount(10), common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), ""))
+	assert.Nil(t, err)
+	assert.Equal(t, errval.StakeUnbonding, NewTxDecorator(&spendTx).Verify(utxoIndex, 3))
+	assert.Nil(t, NewTxDecorator(&spendTx).Verify(utxoIndex, 2+UnbondingDelay))
+}
+
+func TestTxUnvote_SpendAfterUnbonding(t *testing.T) {
+	voter := account.NewAccount()
+	candidate := account.NewAccount()
+	fund := &utxo.UTXO{
+		TXOutput: *transactionbase.NewTXOutput(common.NewAmount(100), account.NewTransactionAccountByAddress(voter.GetAddress())),
+		Txid:     []byte{0x01},
+		TxIndex:  0,
+	}
+
+	sendTxParam := transaction.NewSendTxParam(voter.GetAddress(), voter.GetKeyPair(), voter.GetAddress(), common.NewAmount(60), common.NewAmount(1), common.NewAmount(0), common.NewAmount(0), "")
+	voteTx, err := NewVoteTransaction([]*utxo.UTXO{fund}, sendTxParam, []string{candidate.GetAddress().String()})
+	assert.Nil(t, err)
+	unlockHeight := 2 + UnbondingDelay
+	unvoteTx, err := NewUnvoteTransaction(&utxo.UTXO{TXOutput: voteTx.Vout[0], Txid: voteTx.ID, TxIndex: 0}, voter.GetKeyPair(), common.NewAmount(0), unlockHeight)
+	assert.Nil(t, err)
+
+	newIndex := func(cache *utxo.UTXOCache) *lutxo.UTXOIndex {
+		utxoIndex := lutxo.NewUTXOIndex(cache)
+		utxoIndex.AddUTXO(fund.TXOutput, fund.Txid, fund.TxIndex)
+		assert.True(t, utxoIndex.UpdateUtxo(&voteTx))
+		assert.True(t, utxoIndex.UpdateUtxo(&unvoteTx))
+		return utxoIndex
+	}
+	cache := utxo.NewUTXOCache(storage.NewRamStorage())
+	assert.Nil(t, newIndex(cache).Save())
+
+	// the unbonding stake is selected from the index and from the cache once its unlock height is reached
+	for _, utxoIndex := range []*lutxo.UTXOIndex{newIndex(utxo.NewUTXOCache(storage.NewRamStorage())), lutxo.NewUTXOIndex(cache)} {
+		_, err = utxoIndex.GetUTXOsAccordingToAmount(voter.GetPubKeyHash(), common.NewAmount(50), unlockHeight-1)
+		assert.Equal(t, errval.InsufficientFund, err)
+
+		utxos, err := utxoIndex.GetUTXOsAccordingToAmount(voter.GetPubKeyHash(), common.NewAmount(50), unlockHeight)
+		assert.Nil(t, err)
+		spendParam := transaction.NewSendTxParam(voter.GetAddress(), voter.GetKeyPair(), candidate.GetAddress(), common.NewAmount(50), common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), "")
+		spendTx, err := NewNormalUTXOTransaction(utxos, spendParam)
+		assert.Nil(t, err)
+		assert.Nil(t, VerifyTransaction(utxoIndex, &spendTx, unlockHeight))
+	}
+}
@@ -195,12 +195,13 @@ func (utxos *UTXOIndex) GetContractInvokeUTXOsByPubKeyHash(pubkeyHash account.Pu
 	return invokeUTXOs
 }
 
-// GetUTXOsAccordingToAmount returns a number of UTXOs that has a sum more than or equal to the amount
-func (utxos *UTXOIndex) GetUTXOsAccordingToAmount(pubkeyHash account.PubKeyHash, amount *common.Amount) ([]*utxo.UTXO, error) {
+// GetUTXOsAccordingToAmount returns a number of UTXOs that has a sum more than or equal to the amount. The UTXOs are
+// spent by a transaction in the block at blockHeight, so the stakes locked at that height are skipped
+func (utxos *UTXOIndex) GetUTXOsAccordingToAmount(pubkeyHash account.PubKeyHash, amount *common.Amount, blockHeight uint64) ([]*utxo.UTXO, error) {
 	utxos.mutex.RLock()
 	defer utxos.mutex.RUnlock()
 
-	utxoTxRemove, utxoCache, cacheUTXOAmount, err := utxos.getUTXOsFromCacheUTXO(pubkeyHash, amount)
+	utxoTxRemove, utxoCache, cacheUTXOAmount, err := utxos.getUTXOsFromCacheUTXO(pubkeyHash, amount, blockHeight)
 	if err != nil {
 		return nil, err
 	}
@@ -213,7 +214,7 @@ func (utxos *UTXOIndex) GetUTXOsAccordingToAmount(pubkeyHash account.PubKeyHash,
 		return nil, err
 	}
 
-	utxoFromdb, err := utxos.cache.GetUTXOsByAmountWithOutRemovedUTXOs(pubkeyHash, leftAmount, utxoTxRemove)
+	utxoFromdb, err := utxos.cache.GetUTXOsByAmountWithOutRemovedUTXOs(pubkeyHash, leftAmount, utxoTxRemove, blockHeight)
 	if err != nil {
 		return nil, err
 	}
@@ -221,7 +222,7 @@ func (utxos *UTXOIndex) GetUTXOsAccordingToAmount(pubkeyHash account.PubKeyHash,
 }
 
 //get UTXOS from UTXOIndex, if the utxo already exist in remove list, the utxo will not be included.
-func (utxos *UTXOIndex) getUTXOsFromCacheUTXO(pubkeyHash account.PubKeyHash, amount *common.Amount) (*utxo.UTXOTx, []*utxo.UTXO, *common.Amount, error) {
+func (utxos *UTXOIndex) getUTXOsFromCacheUTXO(pubkeyHash account.PubKeyHash, amount *common.Amount, blockHeight uint64) (*utxo.UTXOTx, []*utxo.UTXO, *common.Amount, error) {
 	utxoTxAdd := utxos.indexAdd[pubkeyHash.String()]
 	utxoTxRemove := utxos.indexRemove[pubkeyHash.String()]
 
@@ -230,7 +231,8 @@ func (utxos *UTXOIndex) getUTXOsFromCacheUTXO(pubkeyHash account.PubKeyHash, amo
 
 	if utxoTxAdd != nil {
 		for _, u := range utxoTxAdd.Indices {
-			if u.UtxoType == utxo.UtxoCreateContract {
+			// coins locked by votes or still unbonding are not selected to pay for a transaction
+			if u.UtxoType == utxo.UtxoCreateContract || u.IsLockedAt(blockHeight) {
 				continue
 			}
 			if utxoTxRemove != nil {
@@ -250,14 +252,14 @@ func (utxos *UTXOIndex) getUTXOsFromCacheUTXO(pubkeyHash account.PubKeyHash, amo
 
 func (utxos *UTXOIndex) UpdateUtxo(tx *transaction.Transaction) bool {
 	adaptedTx := transaction.NewTxAdapter(tx)
-	if adaptedTx.IsNormal() || adaptedTx.IsContract() || adaptedTx.IsContractSend() {
+	if adaptedTx.IsNormal() || adaptedTx.IsContract() || adaptedTx.IsContractSend() || adaptedTx.IsVote() || adaptedTx.IsUnvote() {
 		for _, txin := range tx.Vin {
 			isContract, _ := account.PubKeyHash(txin.PubKey).IsContract()
 			// spent contract utxo
 			pubKeyHash := txin.PubKey
 			if !isContract {
 				// spent normal utxo
-				ta := account.NewTransactionAccountByPubKey(txin.PubKey)
+				ta := account.NewTransactionAccountByPubKeyWithAlgorithm(txin.PubKey, txin.GetAlgorithm())
 				_, err := account.IsValidPubKey(txin.PubKey)
 				if err != nil {
 					logger.WithError(err).Warn("UTXOIndex: txin.pubKey error, discard update in utxo.")
@@ -24,7 +24,7 @@ func FindVinUtxosInUtxoPool(utxoIndex *UTXOIndex, tx *transaction.Transaction) (
 			if ok, _ := account.IsValidPubKey(vin.PubKey); !ok {
 				return nil, errval.NewUserPubKeyHash
 			}
-			ta := account.NewTransactionAccountByPubKey(vin.PubKey)
+			ta := account.NewTransactionAccountByPubKeyWithAlgorithm(vin.PubKey, vin.GetAlgorithm())
 			pubKeyHash = ta.GetPubKeyHash()
 		}
 		utxo, err := utxoIndex.GetUpdatedUtxo(pubKeyHash, vin.Txid, vin.Vout)
@@ -344,7 +344,7 @@ func TestUTXOIndex_GetUTXOsAccordingToAmount(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			utxos, err := index.GetUTXOsAccordingToAmount(tt.pubKey, tt.amount)
+			utxos, err := index.GetUTXOsAccordingToAmount(tt.pubKey, tt.amount, 0)
 			assert.Equal(t, tt.err, err)
 			if err != nil {
 				return
@@ -384,7 +384,7 @@ func TestUTXOIndex_getUTXOsFromCacheUTXO(t *testing.T) {
 	index.indexRemove[acc.GetPubKeyHash().String()] = &indexRemoveUtxoTx
 
 	// amount only requires one utxo
-	remove, utxos, amount, err := index.getUTXOsFromCacheUTXO(acc.GetPubKeyHash(), common.NewAmount(1))
+	remove, utxos, amount, err := index.getUTXOsFromCacheUTXO(acc.GetPubKeyHash(), common.NewAmount(1), 0)
 	assert.Equal(t, &indexRemoveUtxoTx, remove)
 	assert.Equal(t, 1, len(utxos))
 	// map access is random so either utxo2 or utxo3 is valid
@@ -397,7 +397,7 @@ func TestUTXOIndex_getUTXOsFromCacheUTXO(t *testing.T) {
 	assert.Nil(t, err)
 
 	// amount requires all utxos
-	remove, utxos, amount, err = index.getUTXOsFromCacheUTXO(acc.GetPubKeyHash(), common.NewAmount(200))
+	remove, utxos, amount, err = index.getUTXOsFromCacheUTXO(acc.GetPubKeyHash(), common.NewAmount(200), 0)
 	assert.Equal(t, &indexRemoveUtxoTx, remove)
 	assert.ElementsMatch(t, []*utxo.UTXO{utxo2, utxo3}, utxos)
 	assert.Equal(t, common.NewAmount(15), amount)
@@ -507,7 +507,7 @@ func TestUTXOIndex_Save(t *testing.T) {
 	var dependentTx1 = &transaction.Transaction{
 		ID: nil,
 		Vin: []transactionbase.TXInput{
-			{util.GenerateRandomAoB(1), 1, nil, ta1.GetKeyPair().GetPublicKey()},
+			{util.GenerateRandomAoB(1), 1, nil, ta1.GetKeyPair().GetPublicKey(), 0},
 		},
 		Vout: []transactionbase.TXOutput{
 			{common.NewAmount(5), ta1.GetPubKeyHash(), ""},
@@ -523,7 +523,7 @@ func TestUTXOIndex_Save(t *testing.T) {
 	var dependentTx2 = &transaction.Transaction{
 		ID: nil,
 		Vin: []transactionbase.TXInput{
-			{dependentTx1.ID, 1, nil, ta2.GetKeyPair().GetPublicKey()},
+			{dependentTx1.ID, 1, nil, ta2.GetKeyPair().GetPublicKey(), 0},
 		},
 		Vout: []transactionbase.TXOutput{
 			{common.NewAmount(5), ta3.GetPubKeyHash(), ""},
@@ -539,7 +539,7 @@ func TestUTXOIndex_Save(t *testing.T) {
 	var dependentTx3 = &transaction.Transaction{
 		ID: nil,
 		Vin: []transactionbase.TXInput{
-			{dependentTx2.ID, 0, nil, ta3.GetKeyPair().GetPublicKey()},
+			{dependentTx2.ID, 0, nil, ta3.GetKeyPair().GetPublicKey(), 0},
 		},
 		Vout: []transactionbase.TXOutput{
 			{common.NewAmount(1), ta4.GetPubKeyHash(), ""},
@@ -553,8 +553,8 @@ func TestUTXOIndex_Save(t *testing.T) {
 	var dependentTx4 = &transaction.Transaction{
 		ID: nil,
 		Vin: []transactionbase.TXInput{
-			{dependentTx2.ID, 1, nil, ta4.GetKeyPair().GetPublicKey()},
-			{dependentTx3.ID, 0, nil, ta4.GetKeyPair().GetPublicKey()},
+			{dependentTx2.ID, 1, nil, ta4.GetKeyPair().GetPublicKey(), 0},
+			{dependentTx3.ID, 0, nil, ta4.GetKeyPair().GetPublicKey(), 0},
 		},
 		Vout: []transactionbase.TXOutput{
 			{common.NewAmount(3), ta1.GetPubKeyHash(), ""},
@@ -568,8 +568,8 @@ func TestUTXOIndex_Save(t *testing.T) {
 	var dependentTx5 = &transaction.Transaction{
 		ID: nil,
 		Vin: []transactionbase.TXInput{
-			{dependentTx1.ID, 0, nil, ta1.GetKeyPair().GetPublicKey()},
-			{dependentTx4.ID, 0, nil, ta1.GetKeyPair().GetPublicKey()},
+			{dependentTx1.ID, 0, nil, ta1.GetKeyPair().GetPublicKey(), 0},
+			{dependentTx4.ID, 0, nil, ta1.GetKeyPair().GetPublicKey(), 0},
 		},
 		Vout: []transactionbase.TXOutput{
 			{common.NewAmount(4), ta5.GetPubKeyHash(), ""},
@@ -701,7 +701,7 @@ func TestUTXOIndex_AddAndRmoveUTXO(t *testing.T) {
 	var dependentTx1 = &transaction.Transaction{
 		ID: nil,
 		Vin: []transactionbase.TXInput{
-			{util.GenerateRandomAoB(1), 1, nil, ta1.GetKeyPair().GetPublicKey()},
+			{util.GenerateRandomAoB(1), 1, nil, ta1.GetKeyPair().GetPublicKey(), 0},
 		},
 		Vout: []transactionbase.TXOutput{
 			{common.NewAmount(5), ta1.GetPubKeyHash(), ""},
@@ -754,7 +754,7 @@ func TestUTXOIndex_AddAndRmoveUTXO(t *testing.T) {
 	var dependentTx2 = &transaction.Transaction{
 		ID: nil,
 		Vin: []transactionbase.TXInput{
-			{util.GenerateRandomAoB(1), 1, nil, ta1.GetKeyPair().GetPublicKey()},
+			{util.GenerateRandomAoB(1), 1, nil, ta1.GetKeyPair().GetPublicKey(), 0},
 		},
 		Vout: []transactionbase.TXOutput{
 			{common.NewAmount(4), ta1.GetPubKeyHash(), ""},
@@ -821,7 +821,7 @@ func TestUTXOIndex_AddAndRmoveUTXO(t *testing.T) {
 	var dependentTx3 = &transaction.Transaction{
 		ID: nil,
 		Vin: []transactionbase.TXInput{
-			{util.GenerateRandomAoB(1), 1, nil, ta1.GetKeyPair().GetPublicKey()},
+			{util.GenerateRandomAoB(1), 1, nil, ta1.GetKeyPair().GetPublicKey(), 0},
 		},
 		Vout: []transactionbase.TXOutput{
 			{common.NewAmount(8), ta1.GetPubKeyHash(), ""},
@@ -4,9 +4,16 @@ import (
 	"github.com/dappley/go-dappley/common/pubsub"
 	"github.com/dappley/go-dappley/network/networkmodel"
 	"github.com/golang/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/peer"
 )
 
+// CapabilityProvider is implemented by the network services that negotiate optional protocols with their peers
+type CapabilityProvider interface {
+	GetPeerCapabilities(peerId peer.ID) networkmodel.Capability
+}
+
 type NetService interface {
+	GetPeers() []networkmodel.PeerInfo
 	GetHostPeerInfo() networkmodel.PeerInfo
 	UnicastNormalPriorityCommand(commandName string, message proto.Message, destination networkmodel.PeerInfo)
 	UnicastHighProrityCommand(commandName string, message proto.Message, destination networkmodel.PeerInfo)
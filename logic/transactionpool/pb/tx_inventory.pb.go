@@ -0,0 +1,150 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.23.0
+// 	protoc        v3.13.0
+// source: github.com/dappley/go-dappley/logic/transactionpool/pb/tx_inventory.proto
+
+package transactionpoolpb
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type TxInventory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxIds [][]byte `protobuf:"bytes,1,rep,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
+}
+
+func (x *TxInventory) Reset() {
+	*x = TxInventory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_logic_transactionpool_pb_tx_inventory_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxInventory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxInventory) ProtoMessage() {}
+
+func (x *TxInventory) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_logic_transactionpool_pb_tx_inventory_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxInventory.ProtoReflect.Descriptor instead.
+func (*TxInventory) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_logic_transactionpool_pb_tx_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TxInventory) GetTxIds() [][]byte {
+	if x != nil {
+		return x.TxIds
+	}
+	return nil
+}
+
+var File_github_com_dappley_go_dappley_logic_transactionpool_pb_tx_inventory_proto protoreflect.FileDescriptor
+
+var file_github_com_dappley_go_dappley_logic_transactionpool_pb_tx_inventory_proto_rawDesc = []byte{
+	0x0a, 0x49, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70,
+	0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f,
+	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x62, 0x2f, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x76, 0x65,
+	0x6e, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x6f, 0x6f, 0x6c, 0x70, 0x62, 0x22, 0x24,
+	0x0a, 0x0b, 0x54, 0x78, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x15, 0x0a,
+	0x06, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x74,
+	0x78, 0x49, 0x64, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_dappley_go_dappley_logic_transactionpool_pb_tx_inventory_proto_rawDescOnce sync.Once
+	file_github_com_dappley_go_dappley_logic_transactionpool_pb_tx_inventory_proto_rawDescData = file_github_com_dappley_go_dappley_logic_transactionpool_pb_tx_inventory_proto_rawDesc
+)
+
+func file_github_com_dappley_go_dappley_logic_transactionpool_pb_tx_inventory_proto_rawDescGZIP() []byte {
+	file_github_com_dappley_go_dappley_logic_transactionpool_pb_tx_inventory_proto_rawDescOnce.Do(func() {
+		file_github_com_dappley_go_dappley_logic_transactionpool_pb_tx_inventory_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_dappley_go_dappley_logic_transactionpool_pb_tx_inventory_proto_rawDescData)
+	})
+	return file_github_com_dappley_go_dappley_logic_transactionpool_pb_tx_inventory_proto_rawDescData
+}
+
+var file_github_com_dappley_go_dappley_logic_transactionpool_pb_tx_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_github_com_dappley_go_dappley_logic_transactionpool_pb_tx_inventory_proto_goTypes = []interface{}{
+	(*TxInventory)(nil), // 0: transactionpoolpb.TxInventory
+}
+var file_github_com_dappley_go_dappley_logic_transactionpool_pb_tx_inventory_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_github_com_dappley_go_dappley_logic_transactionpool_pb_tx_inventory_proto_init() }
+func file_github_com_dappley_go_dappley_logic_transactionpool_pb_tx_inventory_proto_init() {
+	if File_github_com_dappley_go_dappley_logic_transactionpool_pb_tx_inventory_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_dappley_go_dappley_logic_transactionpool_pb_tx_inventory_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxInventory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_dappley_go_dappley_logic_transactionpool_pb_tx_inventory_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_dappley_go_dappley_logic_transactionpool_pb_tx_inventory_proto_goTypes,
+		DependencyIndexes: file_github_com_dappley_go_dappley_logic_transactionpool_pb_tx_inventory_proto_depIdxs,
+		MessageInfos:      file_github_com_dappley_go_dappley_logic_transactionpool_pb_tx_inventory_proto_msgTypes,
+	}.Build()
+	File_github_com_dappley_go_dappley_logic_transactionpool_pb_tx_inventory_proto = out.File
+	file_github_com_dappley_go_dappley_logic_transactionpool_pb_tx_inventory_proto_rawDesc = nil
+	file_github_com_dappley_go_dappley_logic_transactionpool_pb_tx_inventory_proto_goTypes = nil
+	file_github_com_dappley_go_dappley_logic_transactionpool_pb_tx_inventory_proto_depIdxs = nil
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/asaskevich/EventBus"
 	"github.com/dappley/go-dappley/common/pubsub"
 
+	"github.com/dappley/go-dappley/network"
 	"github.com/dappley/go-dappley/network/networkmodel"
 	"github.com/golang-collections/collections/stack"
 	"github.com/golang/protobuf/proto"
@@ -52,6 +53,10 @@ var (
 	txPoolSubscribedTopics = []string{
 		BroadcastTx,
 		BroadcastBatchTxs,
+		TxInv,
+		GetTxs,
+		SendTxs,
+		network.TopicOnStreamStop,
 	}
 )
 
@@ -64,6 +69,7 @@ type TransactionPool struct {
 	EventBus   EventBus.Bus
 	mutex      sync.RWMutex
 	netService NetService
+	inventory  *txInventory
 }
 
 func NewTransactionPool(netService NetService, limit uint32) *TransactionPool {
@@ -76,6 +82,7 @@ func NewTransactionPool(netService NetService, limit uint32) *TransactionPool {
 		EventBus:   EventBus.New(),
 		mutex:      sync.RWMutex{},
 		netService: netService,
+		inventory:  newTxInventory(),
 	}
 	txPool.ListenToNetService()
 	return txPool
@@ -102,6 +109,14 @@ func (txPool *TransactionPool) GetTopicHandler(topic string) pubsub.TopicHandler
 		return txPool.BroadcastTxHandler
 	case BroadcastBatchTxs:
 		return txPool.BroadcastBatchTxsHandler
+	case TxInv:
+		return txPool.TxInvHandler
+	case GetTxs:
+		return txPool.GetTxsHandler
+	case SendTxs:
+		return txPool.SendTxsHandler
+	case network.TopicOnStreamStop:
+		return txPool.StreamStopHandler
 	}
 	return nil
 }
@@ -175,8 +190,8 @@ func (txPool *TransactionPool) GetAllTransactions() []*transaction.Transaction {
 	return txs
 }
 
-//PopTransactionWithMostTips pops the transactions with the most tips
-func (txPool *TransactionPool) PopTransactionWithMostTips(utxoIndex *lutxo.UTXOIndex) (*transaction.TransactionNode, error) {
+//PopTransactionWithMostTips pops the transactions with the most tips that is valid in a block at blockHeight
+func (txPool *TransactionPool) PopTransactionWithMostTips(utxoIndex *lutxo.UTXOIndex, blockHeight uint64) (*transaction.TransactionNode, error) {
 	txPool.mutex.Lock()
 	defer txPool.mutex.Unlock()
 
@@ -187,7 +202,7 @@ func (txPool *TransactionPool) PopTransactionWithMostTips(utxoIndex *lutxo.UTXOI
 	//remove the transaction from tip order
 	txPool.tipOrder = txPool.tipOrder[1:]
 
-	if err := ltransaction.VerifyTransaction(utxoIndex, txNode.Value, 0); err == nil {
+	if err := ltransaction.VerifyTransaction(utxoIndex, txNode.Value, blockHeight); err == nil {
 		txPool.insertChildrenIntoSortedWaitlist(txNode)
 		txPool.removeTransaction(txNode)
 	} else if err == errval.TXInputNotFound {
@@ -247,6 +262,13 @@ func (txPool *TransactionPool) Push(tx transaction.Transaction) {
 		logger.Warn("TransactionPool: transaction is not pushed to pool because sizeLimit is set to 0.")
 		return
 	}
+	//slash transactions are generated by the producer from verified evidence and never travel through the pool
+	if tx.IsSlash() {
+		logger.WithFields(logger.Fields{
+			"txid": hex.EncodeToString(tx.ID),
+		}).Warn("TransactionPool: slash transaction is not accepted by the pool.")
+		return
+	}
 
 	txNode := transaction.NewTransactionNode(&tx)
 
@@ -534,8 +556,9 @@ func (txPool *TransactionPool) getMinTipTxid() string {
 	return txPool.tipOrder[len(txPool.tipOrder)-1]
 }
 
+//BroadcastTx announces a transaction to the peers
 func (txPool *TransactionPool) BroadcastTx(tx *transaction.Transaction) {
-	txPool.netService.BroadcastNormalPriorityCommand(BroadcastTx, tx.ToProto())
+	txPool.announceTxs([]*transaction.Transaction{tx})
 }
 
 func (txPool *TransactionPool) BroadcastTxHandler(input interface{}) {
@@ -566,15 +589,54 @@ func (txPool *TransactionPool) BroadcastTxHandler(input interface{}) {
 	}
 }
 
+//ValidateMessage checks gossiped transactions before they are relayed to other peers
+func (txPool *TransactionPool) ValidateMessage(topic string, data []byte) networkmodel.ValidationResult {
+	var txs []transaction.Transaction
+
+	switch topic {
+	case BroadcastTx:
+		txpb := &transactionpb.Transaction{}
+		if err := proto.Unmarshal(data, txpb); err != nil {
+			return networkmodel.ValidationReject
+		}
+		tx := transaction.Transaction{}
+		tx.FromProto(txpb)
+		txs = append(txs, tx)
+	case BroadcastBatchTxs:
+		txspb := &transactionpb.Transactions{}
+		if err := proto.Unmarshal(data, txspb); err != nil {
+			return networkmodel.ValidationReject
+		}
+		transactions := &transaction.Transactions{}
+		transactions.FromProto(txspb)
+		txs = transactions.GetTransactions()
+	default:
+		return networkmodel.ValidationAccept
+	}
+
+	for _, tx := range txs {
+		if err := tx.PreVerify(); err != nil {
+			logger.WithError(err).WithFields(logger.Fields{
+				"tx_id": hex.EncodeToString(tx.ID),
+			}).Warn("TransactionPool: gossiped transaction is invalid.")
+			return networkmodel.ValidationReject
+		}
+	}
+	return networkmodel.ValidationAccept
+}
+
+//BroadcastBatchTxs announces transactions to the peers
 func (txPool *TransactionPool) BroadcastBatchTxs(txs []transaction.Transaction) {
 
 	if len(txs) == 0 {
 		return
 	}
 
-	transactions := transaction.NewTransactions(txs)
-
-	txPool.netService.BroadcastNormalPriorityCommand(BroadcastBatchTxs, transactions.ToProto())
+	var announcedTxs []*transaction.Transaction
+	for i := range txs {
+		announcedTxs = append(announcedTxs, &txs[i])
+	}
+	txPool.announceTxs(announcedTxs)
 }
 
 func (txPool *TransactionPool) BroadcastBatchTxsHandler(input interface{}) {
@@ -48,7 +48,7 @@ func TestTransactionPool_VerifyDependentTransactions(t *testing.T) {
 	var dependentTx1 = &transaction.Transaction{
 		ID: nil,
 		Vin: []transactionbase.TXInput{
-			{tx1.ID, 1, nil, ta1.GetKeyPair().GetPublicKey()},
+			{tx1.ID, 1, nil, ta1.GetKeyPair().GetPublicKey(), 0},
 		},
 		Vout: []transactionbase.TXOutput{
 			{common.NewAmount(5), ta1.GetPubKeyHash(), ""},
@@ -62,7 +62,7 @@ func TestTransactionPool_VerifyDependentTransactions(t *testing.T) {
 	var dependentTx2 = &transaction.Transaction{
 		ID: nil,
 		Vin: []transactionbase.TXInput{
-			{dependentTx1.ID, 1, nil, ta2.GetKeyPair().GetPublicKey()},
+			{dependentTx1.ID, 1, nil, ta2.GetKeyPair().GetPublicKey(), 0},
 		},
 		Vout: []transactionbase.TXOutput{
 			{common.NewAmount(5), ta3.GetPubKeyHash(), ""},
@@ -76,7 +76,7 @@ func TestTransactionPool_VerifyDependentTransactions(t *testing.T) {
 	var dependentTx3 = &transaction.Transaction{
 		ID: nil,
 		Vin: []transactionbase.TXInput{
-			{dependentTx2.ID, 0, nil, ta3.GetKeyPair().GetPublicKey()},
+			{dependentTx2.ID, 0, nil, ta3.GetKeyPair().GetPublicKey(), 0},
 		},
 		Vout: []transactionbase.TXOutput{
 			{common.NewAmount(1), ta4.GetPubKeyHash(), ""},
@@ -89,8 +89,8 @@ func TestTransactionPool_VerifyDependentTransactions(t *testing.T) {
 	var dependentTx4 = &transaction.Transaction{
 		ID: nil,
 		Vin: []transactionbase.TXInput{
-			{dependentTx2.ID, 1, nil, ta4.GetKeyPair().GetPublicKey()},
-			{dependentTx3.ID, 0, nil, ta4.GetKeyPair().GetPublicKey()},
+			{dependentTx2.ID, 1, nil, ta4.GetKeyPair().GetPublicKey(), 0},
+			{dependentTx3.ID, 0, nil, ta4.GetKeyPair().GetPublicKey(), 0},
 		},
 		Vout: []transactionbase.TXOutput{
 			{common.NewAmount(3), ta1.GetPubKeyHash(), ""},
@@ -103,8 +103,8 @@ func TestTransactionPool_VerifyDependentTransactions(t *testing.T) {
 	var dependentTx5 = &transaction.Transaction{
 		ID: nil,
 		Vin: []transactionbase.TXInput{
-			{dependentTx1.ID, 0, nil, ta1.GetKeyPair().GetPublicKey()},
-			{dependentTx4.ID, 0, nil, ta1.GetKeyPair().GetPublicKey()},
+			{dependentTx1.ID, 0, nil, ta1.GetKeyPair().GetPublicKey(), 0},
+			{dependentTx4.ID, 0, nil, ta1.GetKeyPair().GetPublicKey(), 0},
 		},
 		Vout: []transactionbase.TXOutput{
 			{common.NewAmount(4), ta5.GetPubKeyHash(), ""},
@@ -209,7 +209,7 @@ func TestTransactionPool_PopTransactionsWithMostTipsNoDependency(t *testing.T) {
 	}
 
 	//pop out the transactions with most tips
-	poppedTx, err := txPool.PopTransactionWithMostTips(utxoIndex)
+	poppedTx, err := txPool.PopTransactionWithMostTips(utxoIndex, 0)
 	assert.Nil(t, err)
 	assert.Equal(t, txs[3], poppedTx.Value)
 }
@@ -242,7 +242,7 @@ func TestTransactionPool_PopTransactionsWithMostTipsWithDependency(t *testing.T)
 		txs = append(txs, &tx)
 	}
 	//pop out the transactions with most tips. Each tx is about 263 bytes
-	poppedTx, err := txPool.PopTransactionWithMostTips(utxoIndex)
+	poppedTx, err := txPool.PopTransactionWithMostTips(utxoIndex, 0)
 	assert.Nil(t, err)
 
 	//tx 0 should be popped first since it is the parent of all other transactions
@@ -50,8 +50,8 @@ func findTransaction(txs []*transaction.Transaction, toFind *transaction.Transac
 
 func GenerateFakeTxInputs() []transactionbase.TXInput {
 	return []transactionbase.TXInput{
-		{getAoB(2), 10, getAoB(2), getAoB(2)},
-		{getAoB(2), 5, getAoB(2), getAoB(2)},
+		{getAoB(2), 10, getAoB(2), getAoB(2), 0},
+		{getAoB(2), 5, getAoB(2), getAoB(2), 0},
 	}
 }
 
@@ -144,6 +144,17 @@ func TestTransactionPool_Push(t *testing.T) {
 	}
 }
 
+func TestTransactionPool_PushSlash(t *testing.T) {
+	txPool := NewTransactionPool(nil, 128000)
+	slashTx := tx1.DeepCopy()
+	slashTx.Type = transaction.TxTypeSlash
+	txPool.Push(slashTx)
+	assert.Equal(t, 0, len(txPool.GetTransactions()))
+
+	txPool.Push(tx1)
+	assert.Equal(t, 1, len(txPool.GetTransactions()))
+}
+
 func TestTransactionPool_addTransaction(t *testing.T) {
 
 	txs := generateDependentTxs()
@@ -0,0 +1,369 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package transactionpool
+
+import (
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/dappley/go-dappley/core/transaction"
+	transactionpb "github.com/dappley/go-dappley/core/transaction/pb"
+	transactionpoolpb "github.com/dappley/go-dappley/logic/transactionpool/pb"
+	"github.com/dappley/go-dappley/network/networkmodel"
+	networkpb "github.com/dappley/go-dappley/network/pb"
+	"github.com/golang/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/libp2p/go-libp2p-core/peer"
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	TxInv   = "TxInv"
+	GetTxs  = "GetTxs"
+	SendTxs = "SendTxs"
+
+	maxTxInvSize       = 1000
+	knownTxsPerPeer    = 8192
+	relayTxCacheSize   = 4096
+	requestedCacheSize = 8192
+	maxTxAnnouncers    = 8
+	txInvBatchInterval = 100 * time.Millisecond
+	txRequestTimeout   = 10 * time.Second
+)
+
+//txInventory keeps the ids of the transactions that each peer knows and the transactions waiting to be announced
+type txInventory struct {
+	knownTxs  map[peer.ID]*lru.Cache
+	relayTxs  *lru.Cache // the recently announced transactions, which are served even if they have left the pool
+	requested *lru.Cache // the requests of the unknown transactions
+	pending   []*transaction.Transaction
+	scheduled bool
+	mutex     sync.Mutex
+}
+
+//txRequest is an unknown transaction that has been requested from a peer and the other peers that announced it, which
+//are asked in turn if the peer does not send it in time
+type txRequest struct {
+	peerId     peer.ID
+	announcers []peer.ID
+}
+
+func newTxInventory() *txInventory {
+	relayTxs, err := lru.New(relayTxCacheSize)
+	if err != nil {
+		logger.WithError(err).Panic("TransactionPool: Can not initialize lru cache for relayed transactions!")
+	}
+	requested, err := lru.New(requestedCacheSize)
+	if err != nil {
+		logger.WithError(err).Panic("TransactionPool: Can not initialize lru cache for requested transactions!")
+	}
+	return &txInventory{
+		knownTxs:  make(map[peer.ID]*lru.Cache),
+		relayTxs:  relayTxs,
+		requested: requested,
+	}
+}
+
+//markKnown records that a peer knows a transaction and returns if the peer knew it already
+func (inv *txInventory) markKnown(peerId peer.ID, txid string) bool {
+	known, ok := inv.knownTxs[peerId]
+	if !ok {
+		known, _ = lru.New(knownTxsPerPeer)
+		inv.knownTxs[peerId] = known
+	}
+	wasKnown, _ := known.ContainsOrAdd(txid, true)
+	return wasKnown
+}
+
+//announceTxs announces transactions to the peers that do not know them. Announcements are batched for
+//txInvBatchInterval
+func (txPool *TransactionPool) announceTxs(txs []*transaction.Transaction) {
+	if txPool.netService == nil || len(txs) == 0 {
+		return
+	}
+
+	inv := txPool.inventory
+	inv.mutex.Lock()
+	defer inv.mutex.Unlock()
+
+	inv.pending = append(inv.pending, txs...)
+	if !inv.scheduled {
+		inv.scheduled = true
+		time.AfterFunc(txInvBatchInterval, txPool.flushTxInventory)
+	}
+}
+
+//flushTxInventory sends the pending announcements. Peers that do not support transaction inventory receive the
+//transactions instead
+func (txPool *TransactionPool) flushTxInventory() {
+	inv := txPool.inventory
+	inv.mutex.Lock()
+	defer inv.mutex.Unlock()
+
+	pending := inv.pending
+	inv.pending = nil
+	inv.scheduled = false
+	if len(pending) == 0 {
+		return
+	}
+	for _, tx := range pending {
+		inv.relayTxs.Add(hex.EncodeToString(tx.ID), tx)
+	}
+
+	for _, peerInfo := range txPool.netService.GetPeers() {
+		var txids [][]byte
+		var txs []transaction.Transaction
+		for _, tx := range pending {
+			if inv.markKnown(peerInfo.PeerId, hex.EncodeToString(tx.ID)) {
+				continue
+			}
+			txids = append(txids, tx.ID)
+			txs = append(txs, *tx)
+		}
+		if len(txids) == 0 {
+			continue
+		}
+
+		if !txPool.supportsTxInventory(peerInfo.PeerId) {
+			txPool.netService.UnicastNormalPriorityCommand(BroadcastBatchTxs, transaction.NewTransactions(txs).ToProto(), peerInfo)
+			continue
+		}
+		for start := 0; start < len(txids); start += maxTxInvSize {
+			end := start + maxTxInvSize
+			if end > len(txids) {
+				end = len(txids)
+			}
+			txPool.netService.UnicastNormalPriorityCommand(TxInv, &transactionpoolpb.TxInventory{TxIds: txids[start:end]}, peerInfo)
+		}
+	}
+}
+
+//supportsTxInventory returns if a peer accepts transaction announcements
+func (txPool *TransactionPool) supportsTxInventory(peerId peer.ID) bool {
+	if provider, ok := txPool.netService.(CapabilityProvider); ok {
+		return provider.GetPeerCapabilities(peerId).Has(networkmodel.CapabilityTxInventory)
+	}
+	return false
+}
+
+//TxInvHandler requests the announced transactions that the pool does not have. A transaction is requested from its first
+//announcer; the later announcers are kept to retry the request
+func (txPool *TransactionPool) TxInvHandler(input interface{}) {
+	command := input.(*networkmodel.DappRcvdCmdContext)
+
+	txInv := &transactionpoolpb.TxInventory{}
+	if err := proto.Unmarshal(command.GetData(), txInv); err != nil {
+		logger.WithError(err).Warn("TransactionPool: parse tx inventory failed.")
+		return
+	}
+	if len(txInv.GetTxIds()) > maxTxInvSize {
+		logger.WithFields(logger.Fields{
+			"from": command.GetSource().PeerId,
+			"size": len(txInv.GetTxIds()),
+		}).Warn("TransactionPool: tx inventory is too large.")
+		return
+	}
+
+	inv := txPool.inventory
+	source := command.GetSource().PeerId
+	var unknownTxids [][]byte
+	inv.mutex.Lock()
+	for _, txid := range txInv.GetTxIds() {
+		key := hex.EncodeToString(txid)
+		inv.markKnown(source, key)
+		if txPool.GetTransactionById(txid) != nil {
+			continue
+		}
+		if value, ok := inv.requested.Get(key); ok {
+			value.(*txRequest).addAnnouncer(source)
+			continue
+		}
+		inv.requested.Add(key, &txRequest{peerId: source})
+		unknownTxids = append(unknownTxids, txid)
+	}
+	inv.mutex.Unlock()
+
+	if len(unknownTxids) == 0 {
+		return
+	}
+	txPool.requestTxs(source, unknownTxids)
+}
+
+//requestTxs requests transactions from a peer and retries the ones that the peer has not sent after txRequestTimeout
+func (txPool *TransactionPool) requestTxs(peerId peer.ID, txids [][]byte) {
+	txPool.netService.UnicastNormalPriorityCommand(GetTxs, &transactionpoolpb.TxInventory{TxIds: txids}, networkmodel.PeerInfo{PeerId: peerId})
+	time.AfterFunc(txRequestTimeout, func() {
+		txPool.retryTxRequests(peerId, txids)
+	})
+}
+
+//retryTxRequests requests the transactions that a peer has not sent from their next announcers. A transaction is
+//forgotten when no announcer is left
+func (txPool *TransactionPool) retryTxRequests(peerId peer.ID, txids [][]byte) {
+	inv := txPool.inventory
+	retries := make(map[peer.ID][][]byte)
+	inv.mutex.Lock()
+	for _, txid := range txids {
+		key := hex.EncodeToString(txid)
+		value, ok := inv.requested.Get(key)
+		if !ok || value.(*txRequest).peerId != peerId {
+			continue
+		}
+		request := value.(*txRequest)
+		if len(request.announcers) == 0 {
+			inv.requested.Remove(key)
+			continue
+		}
+		request.peerId = request.announcers[0]
+		request.announcers = request.announcers[1:]
+		retries[request.peerId] = append(retries[request.peerId], txid)
+	}
+	inv.mutex.Unlock()
+
+	for announcer, announcedTxids := range retries {
+		txPool.requestTxs(announcer, announcedTxids)
+	}
+}
+
+//addAnnouncer keeps a peer that announced the requested transaction
+func (request *txRequest) addAnnouncer(peerId peer.ID) {
+	if peerId == request.peerId || len(request.announcers) >= maxTxAnnouncers {
+		return
+	}
+	for _, announcer := range request.announcers {
+		if announcer == peerId {
+			return
+		}
+	}
+	request.announcers = append(request.announcers, peerId)
+}
+
+//GetTxsHandler sends the requested transactions to a peer
+func (txPool *TransactionPool) GetTxsHandler(input interface{}) {
+	command := input.(*networkmodel.DappRcvdCmdContext)
+
+	request := &transactionpoolpb.TxInventory{}
+	if err := proto.Unmarshal(command.GetData(), request); err != nil {
+		logger.WithError(err).Warn("TransactionPool: parse tx request failed.")
+		return
+	}
+	if len(request.GetTxIds()) > maxTxInvSize {
+		logger.WithFields(logger.Fields{
+			"from": command.GetSource().PeerId,
+			"size": len(request.GetTxIds()),
+		}).Warn("TransactionPool: tx request is too large.")
+		return
+	}
+
+	inv := txPool.inventory
+	var txs []transaction.Transaction
+	inv.mutex.Lock()
+	for _, txid := range request.GetTxIds() {
+		key := hex.EncodeToString(txid)
+		tx := txPool.GetTransactionById(txid)
+		if tx == nil {
+			relayTx, ok := inv.relayTxs.Get(key)
+			if !ok {
+				continue
+			}
+			tx = relayTx.(*transaction.Transaction)
+		}
+		inv.markKnown(command.GetSource().PeerId, key)
+		txs = append(txs, *tx)
+	}
+	inv.mutex.Unlock()
+
+	if len(txs) == 0 {
+		return
+	}
+	txPool.netService.UnicastNormalPriorityCommand(SendTxs, transaction.NewTransactions(txs).ToProto(), command.GetSource())
+}
+
+//SendTxsHandler adds the transactions requested from the peer to the pool and announces the new ones to the other
+//peers. Transactions that were not requested from the peer are dropped
+func (txPool *TransactionPool) SendTxsHandler(input interface{}) {
+	command := input.(*networkmodel.DappRcvdCmdContext)
+
+	txspb := &transactionpb.Transactions{}
+	if err := proto.Unmarshal(command.GetData(), txspb); err != nil {
+		logger.WithError(err).Warn("TransactionPool: parse transactions failed.")
+		return
+	}
+	txs := &transaction.Transactions{}
+	txs.FromProto(txspb)
+
+	inv := txPool.inventory
+	var newTxs []*transaction.Transaction
+	for _, tx := range txs.GetTransactions() {
+		tx := tx
+		key := hex.EncodeToString(tx.ID)
+
+		inv.mutex.Lock()
+		value, ok := inv.requested.Get(key)
+		if !ok || value.(*txRequest).peerId != command.GetSource().PeerId {
+			inv.mutex.Unlock()
+			logger.WithFields(logger.Fields{
+				"from":  command.GetSource().PeerId,
+				"tx_id": key,
+			}).Debug("TransactionPool: received a transaction that was not requested.")
+			continue
+		}
+		inv.requested.Remove(key)
+		inv.markKnown(command.GetSource().PeerId, key)
+		inv.mutex.Unlock()
+
+		if txPool.GetTransactionById(tx.ID) != nil {
+			continue
+		}
+		if err := tx.PreVerify(); err != nil {
+			logger.WithError(err).WithFields(logger.Fields{
+				"from":  command.GetSource().PeerId,
+				"tx_id": key,
+			}).Warn("TransactionPool: received an invalid transaction.")
+			continue
+		}
+		tx.CreateTime = -1
+		txPool.Push(tx)
+		if txPool.GetTransactionById(tx.ID) != nil {
+			newTxs = append(newTxs, &tx)
+		}
+	}
+	txPool.announceTxs(newTxs)
+}
+
+//StreamStopHandler forgets the transactions known by a disconnected peer
+func (txPool *TransactionPool) StreamStopHandler(input interface{}) {
+	command := input.(*networkmodel.DappRcvdCmdContext)
+
+	peerInfopb := &networkpb.PeerInfo{}
+	if err := proto.Unmarshal(command.GetData(), peerInfopb); err != nil {
+		logger.WithError(err).Warn("TransactionPool: parse peerInfo failed.")
+		return
+	}
+	peerInfo := networkmodel.PeerInfo{}
+	if err := peerInfo.FromProto(peerInfopb); err != nil {
+		logger.WithError(err).Warn("TransactionPool: parse peerInfo failed.")
+		return
+	}
+
+	txPool.inventory.mutex.Lock()
+	defer txPool.inventory.mutex.Unlock()
+	delete(txPool.inventory.knownTxs, peerInfo.PeerId)
+}
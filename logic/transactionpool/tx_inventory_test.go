@@ -0,0 +1,267 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either pubKeyHash 3 of the License, or
+// (at your option) any later pubKeyHash.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package transactionpool
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/common/pubsub"
+	"github.com/dappley/go-dappley/core/transaction"
+	transactionpb "github.com/dappley/go-dappley/core/transaction/pb"
+	transactionpoolpb "github.com/dappley/go-dappley/logic/transactionpool/pb"
+	"github.com/dappley/go-dappley/network/networkmodel"
+	"github.com/golang/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+type sentCommand struct {
+	name    string
+	message proto.Message
+}
+
+type fakeNetService struct {
+	peers        []networkmodel.PeerInfo
+	capabilities map[peer.ID]networkmodel.Capability
+	sent         map[peer.ID][]sentCommand
+	mutex        sync.Mutex
+}
+
+func newFakeNetService(numInvPeers, numLegacyPeers int) *fakeNetService {
+	ns := &fakeNetService{
+		capabilities: make(map[peer.ID]networkmodel.Capability),
+		sent:         make(map[peer.ID][]sentCommand),
+	}
+	for i := 0; i < numInvPeers+numLegacyPeers; i++ {
+		peerId := peer.ID(fmt.Sprintf("peer%d", i))
+		ns.peers = append(ns.peers, networkmodel.PeerInfo{PeerId: peerId})
+		if i < numInvPeers {
+			ns.capabilities[peerId] = networkmodel.CapabilityTxInventory
+		}
+	}
+	return ns
+}
+
+func (ns *fakeNetService) GetPeers() []networkmodel.PeerInfo      { return ns.peers }
+func (ns *fakeNetService) GetHostPeerInfo() networkmodel.PeerInfo { return networkmodel.PeerInfo{} }
+func (ns *fakeNetService) Listen(subscriber pubsub.Subscriber)    {}
+
+func (ns *fakeNetService) GetPeerCapabilities(peerId peer.ID) networkmodel.Capability {
+	return ns.capabilities[peerId]
+}
+
+func (ns *fakeNetService) UnicastNormalPriorityCommand(commandName string, message proto.Message, destination networkmodel.PeerInfo) {
+	ns.mutex.Lock()
+	defer ns.mutex.Unlock()
+	ns.sent[destination.PeerId] = append(ns.sent[destination.PeerId], sentCommand{commandName, message})
+}
+
+func (ns *fakeNetService) UnicastHighProrityCommand(commandName string, message proto.Message, destination networkmodel.PeerInfo) {
+	ns.UnicastNormalPriorityCommand(commandName, message, destination)
+}
+
+func (ns *fakeNetService) BroadcastNormalPriorityCommand(commandName string, message proto.Message) {
+	for _, peerInfo := range ns.peers {
+		ns.UnicastNormalPriorityCommand(commandName, message, peerInfo)
+	}
+}
+
+func (ns *fakeNetService) BroadcastHighProrityCommand(commandName string, message proto.Message) {
+	ns.BroadcastNormalPriorityCommand(commandName, message)
+}
+
+func (ns *fakeNetService) Relay(dappCmd *networkmodel.DappCmd, destination networkmodel.PeerInfo, priority networkmodel.DappCmdPriority) {
+}
+
+//getSent returns the commands sent to a peer and forgets them
+func (ns *fakeNetService) getSent(peerId peer.ID) []sentCommand {
+	ns.mutex.Lock()
+	defer ns.mutex.Unlock()
+	sent := ns.sent[peerId]
+	delete(ns.sent, peerId)
+	return sent
+}
+
+func newInventoryTestTx(tip uint64) *transaction.Transaction {
+	tx := &transaction.Transaction{
+		Vin:      GenerateFakeTxInputs(),
+		Vout:     GenerateFakeTxOutputs(),
+		Tip:      common.NewAmount(tip),
+		GasLimit: common.NewAmount(0),
+		GasPrice: common.NewAmount(0),
+		Type:     transaction.TxTypeNormal,
+	}
+	txCopy := tx.TrimmedCopy(true)
+	tx.ID = (&txCopy).Hash()
+	return tx
+}
+
+func newTestCommandContext(name string, message proto.Message, source peer.ID) *networkmodel.DappRcvdCmdContext {
+	data, _ := proto.Marshal(message)
+	cmd := networkmodel.NewDappCmd(name, data, false)
+	return networkmodel.NewDappRcvdCmdContext(cmd, networkmodel.PeerInfo{PeerId: source})
+}
+
+func TestTransactionPool_BroadcastTx(t *testing.T) {
+	ns := newFakeNetService(2, 1)
+	txPool := NewTransactionPool(ns, 128000)
+	tx1 := newInventoryTestTx(1)
+	tx2 := newInventoryTestTx(2)
+	txPool.Push(*tx1)
+	txPool.Push(*tx2)
+
+	txPool.BroadcastTx(tx1)
+	txPool.BroadcastBatchTxs([]transaction.Transaction{*tx2})
+	txPool.flushTxInventory()
+
+	//peers that support transaction inventory receive one batched announcement
+	for _, peerInfo := range ns.peers[:2] {
+		sent := ns.getSent(peerInfo.PeerId)
+		assert.Equal(t, 1, len(sent))
+		assert.Equal(t, TxInv, sent[0].name)
+		assert.Equal(t, [][]byte{tx1.ID, tx2.ID}, sent[0].message.(*transactionpoolpb.TxInventory).GetTxIds())
+	}
+	//legacy peers receive the transactions
+	sent := ns.getSent(ns.peers[2].PeerId)
+	assert.Equal(t, 1, len(sent))
+	assert.Equal(t, BroadcastBatchTxs, sent[0].name)
+	assert.Equal(t, 2, len(sent[0].message.(*transactionpb.Transactions).GetTransactions()))
+
+	//a transaction is announced to a peer only once
+	txPool.BroadcastTx(tx1)
+	txPool.flushTxInventory()
+	for _, peerInfo := range ns.peers {
+		assert.Equal(t, 0, len(ns.getSent(peerInfo.PeerId)))
+	}
+}
+
+func TestTransactionPool_TxInvHandler(t *testing.T) {
+	ns := newFakeNetService(3, 0)
+	txPool := NewTransactionPool(ns, 128000)
+	knownTx := newInventoryTestTx(1)
+	unknownTx := newInventoryTestTx(2)
+	txPool.Push(*knownTx)
+	source := ns.peers[0].PeerId
+
+	txInv := &transactionpoolpb.TxInventory{TxIds: [][]byte{knownTx.ID, unknownTx.ID}}
+	txPool.TxInvHandler(newTestCommandContext(TxInv, txInv, source))
+	sent := ns.getSent(source)
+	assert.Equal(t, 1, len(sent))
+	assert.Equal(t, GetTxs, sent[0].name)
+	assert.Equal(t, [][]byte{unknownTx.ID}, sent[0].message.(*transactionpoolpb.TxInventory).GetTxIds())
+
+	//a transaction that has been requested is not requested again from another peer
+	txPool.TxInvHandler(newTestCommandContext(TxInv, txInv, ns.peers[1].PeerId))
+	assert.Equal(t, 0, len(ns.getSent(ns.peers[1].PeerId)))
+
+	//the other announcer is asked when the request times out
+	txPool.retryTxRequests(source, [][]byte{unknownTx.ID})
+	sent = ns.getSent(ns.peers[1].PeerId)
+	assert.Equal(t, 1, len(sent))
+	assert.Equal(t, GetTxs, sent[0].name)
+	assert.Equal(t, [][]byte{unknownTx.ID}, sent[0].message.(*transactionpoolpb.TxInventory).GetTxIds())
+	txPool.retryTxRequests(source, [][]byte{unknownTx.ID})
+	assert.Equal(t, 0, len(ns.getSent(ns.peers[1].PeerId)))
+
+	//the transaction is forgotten when no announcer is left, so a new announcement requests it again
+	txPool.retryTxRequests(ns.peers[1].PeerId, [][]byte{unknownTx.ID})
+	txPool.TxInvHandler(newTestCommandContext(TxInv, txInv, ns.peers[2].PeerId))
+	sent = ns.getSent(ns.peers[2].PeerId)
+	assert.Equal(t, 1, len(sent))
+	assert.Equal(t, [][]byte{unknownTx.ID}, sent[0].message.(*transactionpoolpb.TxInventory).GetTxIds())
+
+	//the announcing peers know the transactions
+	txPool.BroadcastTx(knownTx)
+	txPool.flushTxInventory()
+	for _, peerInfo := range ns.peers {
+		assert.Equal(t, 0, len(ns.getSent(peerInfo.PeerId)))
+	}
+
+	//an inventory that is too large is dropped
+	txInv = &transactionpoolpb.TxInventory{}
+	for i := 0; i <= maxTxInvSize; i++ {
+		txInv.TxIds = append(txInv.TxIds, []byte(fmt.Sprintf("tx%d", i)))
+	}
+	txPool.TxInvHandler(newTestCommandContext(TxInv, txInv, source))
+	assert.Equal(t, 0, len(ns.getSent(source)))
+}
+
+func TestTransactionPool_GetTxsHandler(t *testing.T) {
+	ns := newFakeNetService(1, 0)
+	txPool := NewTransactionPool(ns, 128000)
+	tx := newInventoryTestTx(1)
+	txPool.Push(*tx)
+	source := ns.peers[0].PeerId
+
+	request := &transactionpoolpb.TxInventory{TxIds: [][]byte{tx.ID, []byte("unknown")}}
+	txPool.GetTxsHandler(newTestCommandContext(GetTxs, request, source))
+	sent := ns.getSent(source)
+	assert.Equal(t, 1, len(sent))
+	assert.Equal(t, SendTxs, sent[0].name)
+	txs := sent[0].message.(*transactionpb.Transactions).GetTransactions()
+	assert.Equal(t, 1, len(txs))
+	assert.Equal(t, tx.ID, txs[0].GetId())
+
+	//an announced transaction is served after it has left the pool
+	minedTx := newInventoryTestTx(2)
+	txPool.BroadcastTx(minedTx)
+	txPool.flushTxInventory()
+	ns.getSent(source)
+	txPool.GetTxsHandler(newTestCommandContext(GetTxs, &transactionpoolpb.TxInventory{TxIds: [][]byte{minedTx.ID}}, source))
+	sent = ns.getSent(source)
+	assert.Equal(t, 1, len(sent))
+	assert.Equal(t, minedTx.ID, sent[0].message.(*transactionpb.Transactions).GetTransactions()[0].GetId())
+}
+
+func TestTransactionPool_SendTxsHandler(t *testing.T) {
+	ns := newFakeNetService(3, 0)
+	txPool := NewTransactionPool(ns, 128000)
+	tx := newInventoryTestTx(1)
+	invalidTx := newInventoryTestTx(2)
+	invalidTx.ID = []byte("invalid")
+	unrequestedTx := newInventoryTestTx(3)
+	source := ns.peers[0].PeerId
+
+	txInv := &transactionpoolpb.TxInventory{TxIds: [][]byte{tx.ID, invalidTx.ID}}
+	txPool.TxInvHandler(newTestCommandContext(TxInv, txInv, source))
+	ns.getSent(source)
+
+	//a transaction is only accepted from the peer it was requested from
+	txs := transaction.NewTransactions([]transaction.Transaction{*tx})
+	txPool.SendTxsHandler(newTestCommandContext(SendTxs, txs.ToProto(), ns.peers[1].PeerId))
+	assert.Nil(t, txPool.GetTransactionById(tx.ID))
+
+	txs = transaction.NewTransactions([]transaction.Transaction{*tx, *invalidTx, *unrequestedTx})
+	txPool.SendTxsHandler(newTestCommandContext(SendTxs, txs.ToProto(), source))
+	assert.NotNil(t, txPool.GetTransactionById(tx.ID))
+	assert.Nil(t, txPool.GetTransactionById(invalidTx.ID))
+	assert.Nil(t, txPool.GetTransactionById(unrequestedTx.ID))
+
+	//the new transaction is announced to the peers except its source
+	txPool.flushTxInventory()
+	assert.Equal(t, 0, len(ns.getSent(source)))
+	for _, peerInfo := range ns.peers[1:] {
+		sent := ns.getSent(peerInfo.PeerId)
+		assert.Equal(t, 1, len(sent))
+		assert.Equal(t, [][]byte{tx.ID}, sent[0].message.(*transactionpoolpb.TxInventory).GetTxIds())
+	}
+}
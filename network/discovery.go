@@ -0,0 +1,232 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package network
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/dappley/go-dappley/common/clock"
+	"github.com/dappley/go-dappley/common/log"
+	"github.com/dappley/go-dappley/network/networkmodel"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/peer"
+	discovery "github.com/libp2p/go-libp2p-discovery"
+	dht "github.com/libp2p/go-libp2p-kad-dht"
+	mdns "github.com/libp2p/go-libp2p/p2p/discovery"
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	MdnsServiceTag      = "_dappley-discovery._udp"
+	DhtProtocolPrefix   = "/dappley"
+	discoveryNamespace  = "dappley"
+	defaultMdnsInterval = 10 * time.Second
+	defaultDhtInterval  = time.Minute
+)
+
+//DiscoveryConfig is the configurations of the peer discovery
+type DiscoveryConfig struct {
+	Mdns         bool          // find the peers of the local network by multicast DNS
+	Dht          bool          // find the peers of the chain through the Kademlia DHT
+	MdnsInterval time.Duration // the interval of the multicast DNS queries
+	DhtInterval  time.Duration // the interval of the DHT lookups of the chain
+}
+
+type onPeerDiscovered func(peerInfo networkmodel.PeerInfo)
+
+//PeerDiscovery finds peers by multicast DNS on the local network and through a Kademlia DHT shared by the nodes of
+//the chain, in addition to the seeds and the peer lists of the peers
+type PeerDiscovery struct {
+	config             DiscoveryConfig
+	host               host.Host
+	namespace          string
+	mdns               mdns.Service
+	dht                *dht.IpfsDHT
+	onPeerDiscoveredCb onPeerDiscovered
+	ctx                context.Context
+	cancel             context.CancelFunc
+	clock              clock.Clock
+}
+
+//NewDiscoveryConfig returns the default discovery configurations
+func NewDiscoveryConfig(enableMdns bool, enableDht bool) DiscoveryConfig {
+	return DiscoveryConfig{
+		Mdns:         enableMdns,
+		Dht:          enableDht,
+		MdnsInterval: defaultMdnsInterval,
+		DhtInterval:  defaultDhtInterval,
+	}
+}
+
+//NewPeerDiscovery creates a PeerDiscovery instance
+func NewPeerDiscovery(config DiscoveryConfig, onPeerDiscoveredCb onPeerDiscovered) *PeerDiscovery {
+	if config.MdnsInterval == 0 {
+		config.MdnsInterval = defaultMdnsInterval
+	}
+	if config.DhtInterval == 0 {
+		config.DhtInterval = defaultDhtInterval
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &PeerDiscovery{
+		config:             config,
+		onPeerDiscoveredCb: onPeerDiscoveredCb,
+		ctx:                ctx,
+		cancel:             cancel,
+		clock:              clock.NewRealClock(),
+	}
+}
+
+//SetClock sets the clock that schedules the DHT lookups and advertisements
+func (pd *PeerDiscovery) SetClock(c clock.Clock) {
+	pd.clock = c
+}
+
+//GetDiscoveryNamespace returns the namespace under which the nodes of the chain of the genesis hash meet in the DHT
+func GetDiscoveryNamespace(genesisHash []byte) string {
+	if len(genesisHash) == 0 {
+		return discoveryNamespace
+	}
+	return discoveryNamespace + "/" + hex.EncodeToString(genesisHash)
+}
+
+//Start starts the discovery on the host. The DHT is bootstrapped from the seeds and only meets the nodes that
+//advertise the namespace of the chain
+func (pd *PeerDiscovery) Start(h host.Host, namespace string, seeds []networkmodel.PeerInfo) error {
+	pd.host = h
+	pd.namespace = namespace
+
+	if pd.config.Mdns {
+		service, err := mdns.NewMdnsService(pd.ctx, h, pd.config.MdnsInterval, MdnsServiceTag)
+		if err != nil {
+			logger.WithError(err).Warn("PeerDiscovery: failed to start mDNS discovery.")
+			return err
+		}
+		service.RegisterNotifee(pd)
+		pd.mdns = service
+	}
+
+	if pd.config.Dht {
+		bootstrapPeers := make([]peer.AddrInfo, 0, len(seeds))
+		for _, seed := range seeds {
+			bootstrapPeers = append(bootstrapPeers, peer.AddrInfo{ID: seed.PeerId, Addrs: seed.Addrs})
+		}
+		kad, err := dht.New(pd.ctx, h,
+			dht.Mode(dht.ModeServer),
+			dht.ProtocolPrefix(DhtProtocolPrefix),
+			dht.BootstrapPeers(bootstrapPeers...),
+		)
+		if err != nil {
+			logger.WithError(err).Warn("PeerDiscovery: failed to start DHT discovery.")
+			pd.Stop()
+			return err
+		}
+		pd.dht = kad
+		pd.startDhtDiscovery(bootstrapPeers)
+	}
+
+	logger.WithFields(logger.Fields{
+		"mdns":      pd.config.Mdns,
+		"dht":       pd.config.Dht,
+		"namespace": namespace,
+	}).Info("PeerDiscovery: started.")
+	return nil
+}
+
+//Stop stops the discovery
+func (pd *PeerDiscovery) Stop() {
+	pd.cancel()
+	if pd.mdns != nil {
+		pd.mdns.Close()
+	}
+	if pd.dht != nil {
+		pd.dht.Close()
+	}
+}
+
+//HandlePeerFound is called by the mDNS service when it finds a peer on the local network
+func (pd *PeerDiscovery) HandlePeerFound(addrInfo peer.AddrInfo) {
+	pd.onPeerFound(addrInfo)
+}
+
+//startDhtDiscovery joins the DHT through the bootstrap peers, then advertises the namespace of the chain and looks up
+//the nodes that advertise it periodically
+func (pd *PeerDiscovery) startDhtDiscovery(bootstrapPeers []peer.AddrInfo) {
+	go func() {
+		defer log.CrashHandler()
+
+		for _, addrInfo := range bootstrapPeers {
+			if err := pd.host.Connect(pd.ctx, addrInfo); err != nil {
+				logger.WithError(err).WithFields(logger.Fields{
+					"peer_id": addrInfo.ID,
+				}).Debug("PeerDiscovery: failed to connect to the bootstrap peer.")
+			}
+		}
+		if err := pd.dht.Bootstrap(pd.ctx); err != nil {
+			logger.WithError(err).Warn("PeerDiscovery: failed to bootstrap the DHT.")
+		}
+
+		routingDiscovery := discovery.NewRoutingDiscovery(pd.dht)
+		var nextAdvertise time.Time
+		ticker := pd.clock.NewTicker(pd.config.DhtInterval)
+		defer ticker.Stop()
+		for {
+			if pd.clock.Now().After(nextAdvertise) {
+				if ttl, err := routingDiscovery.Advertise(pd.ctx, pd.namespace); err == nil {
+					nextAdvertise = pd.clock.Now().Add(7 * ttl / 8)
+				} else {
+					logger.WithError(err).Debug("PeerDiscovery: failed to advertise in the DHT.")
+				}
+			}
+			pd.findDhtPeers(routingDiscovery)
+
+			select {
+			case <-pd.ctx.Done():
+				return
+			case <-ticker.Chan():
+			}
+		}
+	}()
+}
+
+func (pd *PeerDiscovery) findDhtPeers(routingDiscovery *discovery.RoutingDiscovery) {
+	ctx, cancel := context.WithTimeout(pd.ctx, pd.config.DhtInterval)
+	defer cancel()
+
+	peers, err := routingDiscovery.FindPeers(ctx, pd.namespace)
+	if err != nil {
+		logger.WithError(err).Debug("PeerDiscovery: DHT lookup failed.")
+		return
+	}
+	for addrInfo := range peers {
+		pd.onPeerFound(addrInfo)
+	}
+}
+
+func (pd *PeerDiscovery) onPeerFound(addrInfo peer.AddrInfo) {
+	if addrInfo.ID == pd.host.ID() || len(addrInfo.Addrs) == 0 {
+		return
+	}
+
+	logger.WithFields(logger.Fields{
+		"peer_id": addrInfo.ID,
+	}).Debug("PeerDiscovery: found a peer.")
+	pd.onPeerDiscoveredCb(networkmodel.PeerInfo{PeerId: addrInfo.ID, Addrs: addrInfo.Addrs})
+}
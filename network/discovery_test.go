@@ -0,0 +1,131 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package network
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/dappley/go-dappley/network/networkmodel"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+const discoveryTestPort = 10530
+
+//startTestDiscovery starts a discovery on a new host and returns the channel of the peers that it finds
+func startTestDiscovery(t *testing.T, config DiscoveryConfig, port int, namespace string, seeds []networkmodel.PeerInfo) (*PeerDiscovery, *networkmodel.Host, chan peer.ID) {
+	foundCh := make(chan peer.ID, 16)
+	pd := NewPeerDiscovery(config, func(peerInfo networkmodel.PeerInfo) {
+		select {
+		case foundCh <- peerInfo.PeerId:
+		default:
+		}
+	})
+	h := networkmodel.NewHost(port, nil, nil)
+	assert.NotNil(t, h)
+	assert.Nil(t, pd.Start(h.Host, namespace, seeds))
+	return pd, h, foundCh
+}
+
+func waitForPeer(foundCh chan peer.ID, peerId peer.ID, timeout time.Duration) bool {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		select {
+		case found := <-foundCh:
+			if found == peerId {
+				return true
+			}
+		case <-timer.C:
+			return false
+		}
+	}
+}
+
+func TestPeerDiscovery_Mdns(t *testing.T) {
+	config := NewDiscoveryConfig(true, false)
+	config.MdnsInterval = time.Second
+	pd1, h1, foundCh1 := startTestDiscovery(t, config, discoveryTestPort, "", nil)
+	defer h1.Close()
+	defer pd1.Stop()
+	pd2, h2, _ := startTestDiscovery(t, config, discoveryTestPort+1, "", nil)
+	defer h2.Close()
+	defer pd2.Stop()
+
+	assert.True(t, waitForPeer(foundCh1, h2.ID(), 10*time.Second))
+}
+
+func TestPeerDiscovery_Dht(t *testing.T) {
+	config := NewDiscoveryConfig(false, true)
+	config.DhtInterval = time.Second
+	namespace := GetDiscoveryNamespace([]byte("genesis"))
+
+	pd0, h0, _ := startTestDiscovery(t, config, discoveryTestPort+2, namespace, nil)
+	defer h0.Close()
+	defer pd0.Stop()
+	seeds := []networkmodel.PeerInfo{h0.GetPeerInfo()}
+
+	//the nodes only know the seed and meet through the DHT
+	pd1, h1, foundCh1 := startTestDiscovery(t, config, discoveryTestPort+3, namespace, seeds)
+	defer h1.Close()
+	defer pd1.Stop()
+	pd2, h2, _ := startTestDiscovery(t, config, discoveryTestPort+4, namespace, seeds)
+	defer h2.Close()
+	defer pd2.Stop()
+	pd3, h3, _ := startTestDiscovery(t, config, discoveryTestPort+5, GetDiscoveryNamespace([]byte("other")), seeds)
+	defer h3.Close()
+	defer pd3.Stop()
+
+	assert.True(t, waitForPeer(foundCh1, h2.ID(), 20*time.Second))
+	assert.False(t, waitForPeer(foundCh1, h3.ID(), 3*time.Second))
+}
+
+func TestNode_EnableDiscovery(t *testing.T) {
+	config := NewDiscoveryConfig(true, false)
+	config.MdnsInterval = time.Second
+
+	var nodes []*Node
+	for i := 0; i < 2; i++ {
+		rfl := storage.NewRamFileLoader(confDir, fmt.Sprintf("discovery%d.conf", i))
+		defer rfl.DeleteFolder()
+
+		n := NewNode(rfl.File, nil)
+		n.EnableDiscovery(config)
+		assert.Nil(t, n.Start(discoveryTestPort+6+i, ""))
+		defer n.Stop()
+		nodes = append(nodes, n)
+	}
+
+	//the nodes find each other without seeds, keep each other as seeds and connect
+	deadline := time.Now().Add(10 * time.Second)
+	for (len(nodes[0].GetPeers()) == 0 || len(nodes[0].GetNetwork().GetPeerManager().GetSeeds()) == 0) && time.Now().Before(deadline) {
+		time.Sleep(100 * time.Millisecond)
+	}
+	assert.Equal(t, 1, len(nodes[0].GetPeers()))
+	assert.Equal(t, nodes[1].GetHostPeerInfo().PeerId, nodes[0].GetPeers()[0].PeerId)
+	assert.Equal(t, 1, len(nodes[0].GetNetwork().GetPeerManager().GetSeeds()))
+}
+
+func TestGetDiscoveryNamespace(t *testing.T) {
+	assert.Equal(t, "dappley", GetDiscoveryNamespace(nil))
+	assert.Equal(t, "dappley/0102", GetDiscoveryNamespace([]byte{1, 2}))
+}
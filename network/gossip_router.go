@@ -0,0 +1,349 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package network
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"sync"
+
+	"github.com/dappley/go-dappley/common/log"
+	"github.com/dappley/go-dappley/network/networkmodel"
+	networkpb "github.com/dappley/go-dappley/network/pb"
+	"github.com/golang/protobuf/proto"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/libp2p/go-libp2p-core/peer"
+	libp2ppubsub "github.com/libp2p/go-libp2p-pubsub"
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	gossipSeenCacheSize = 10240
+)
+
+//GossipConfig is the configurations of the gossip relay
+type GossipConfig struct {
+	Topics map[string]networkmodel.DappCmdPriority // the broadcast commands relayed by gossip and their priority to the peers that do not speak gossip
+}
+
+type gossipNetwork interface {
+	GetHost() *networkmodel.Host
+	GetConnectedPeers() []networkmodel.PeerInfo
+	GetPeerCapabilities(peerId peer.ID) networkmodel.Capability
+	Multicast(data []byte, pids []peer.ID, priority networkmodel.DappCmdPriority)
+	getDiscoveryNamespace() string
+}
+
+//onGossipMessage delivers a gossip message that passed validation to the local subscribers
+type onGossipMessage func(cmd *networkmodel.DappCmd, source networkmodel.PeerInfo)
+
+//gossipDelivery is a validated gossip message and the peer that forwarded it
+type gossipDelivery struct {
+	cmd    *networkmodel.DappCmd
+	source peer.ID
+}
+
+//GossipRouter relays broadcasts through libp2p gossipsub. Each topic is a gossipsub topic of the chain whose topic
+//validator runs the validator of the local subscribers, so that a message is only delivered and forwarded to the mesh
+//after it passes validation. Peers that do not speak gossip receive every broadcast over their dappley stream. The mesh
+//is maintained by the heartbeat of gossipsub, which runs on the real clock
+type GossipRouter struct {
+	config        GossipConfig
+	net           gossipNetwork
+	pubsub        *libp2ppubsub.PubSub
+	namespace     string
+	validators    map[string]MessageValidator
+	seen          *lru.Cache
+	deliveries    *lru.Cache
+	onMessageCb   onGossipMessage
+	onViolationCb onViolation
+	cancel        context.CancelFunc
+	mutex         sync.Mutex
+}
+
+//NewGossipConfig returns the gossip configurations for the topics
+func NewGossipConfig(topics map[string]networkmodel.DappCmdPriority) GossipConfig {
+	return GossipConfig{
+		Topics: topics,
+	}
+}
+
+//NewGossipRouter creates a GossipRouter instance
+func NewGossipRouter(config GossipConfig, net gossipNetwork, onMessageCb onGossipMessage, onViolationCb onViolation) *GossipRouter {
+	seen, err := lru.New(gossipSeenCacheSize)
+	if err != nil {
+		logger.WithError(err).Panic("GossipRouter: Can not initialize lru cache for seen messages!")
+	}
+	deliveries, err := lru.New(gossipSeenCacheSize)
+	if err != nil {
+		logger.WithError(err).Panic("GossipRouter: Can not initialize lru cache for deliveries!")
+	}
+
+	return &GossipRouter{
+		config:        config,
+		net:           net,
+		validators:    make(map[string]MessageValidator),
+		seen:          seen,
+		deliveries:    deliveries,
+		onMessageCb:   onMessageCb,
+		onViolationCb: onViolationCb,
+	}
+}
+
+//Start joins gossipsub on the host of the network and subscribes to the topics. The network should have started
+func (gr *GossipRouter) Start() error {
+	ctx, cancel := context.WithCancel(context.Background())
+	ps, err := libp2ppubsub.NewGossipSub(ctx, gr.net.GetHost())
+	if err != nil {
+		cancel()
+		return err
+	}
+
+	gr.mutex.Lock()
+	gr.pubsub = ps
+	gr.namespace = gr.net.getDiscoveryNamespace()
+	gr.cancel = cancel
+	gr.mutex.Unlock()
+
+	for topic := range gr.config.Topics {
+		if err := ps.RegisterTopicValidator(gr.getPubsubTopic(topic), gr.getTopicValidator(topic)); err != nil {
+			cancel()
+			return err
+		}
+		subscription, err := ps.Subscribe(gr.getPubsubTopic(topic))
+		if err != nil {
+			cancel()
+			return err
+		}
+		go gr.readLoop(ctx, subscription)
+	}
+	return nil
+}
+
+//Stop leaves gossipsub
+func (gr *GossipRouter) Stop() {
+	gr.mutex.Lock()
+	defer gr.mutex.Unlock()
+	if gr.cancel != nil {
+		gr.cancel()
+	}
+}
+
+//IsTopic returns if the command is relayed by gossip
+func (gr *GossipRouter) IsTopic(topic string) bool {
+	_, ok := gr.config.Topics[topic]
+	return ok
+}
+
+//SetValidator sets the validator of the messages of a topic
+func (gr *GossipRouter) SetValidator(topic string, validator MessageValidator) {
+	gr.mutex.Lock()
+	defer gr.mutex.Unlock()
+	gr.validators[topic] = validator
+}
+
+//GetTopicPeers returns the gossip peers that have subscribed to a topic
+func (gr *GossipRouter) GetTopicPeers(topic string) []peer.ID {
+	gr.mutex.Lock()
+	ps := gr.pubsub
+	gr.mutex.Unlock()
+
+	if ps == nil {
+		return nil
+	}
+	return ps.ListPeers(gr.getPubsubTopic(topic))
+}
+
+//Publish sends a message of the local node to the mesh of its topic and to the peers that do not speak gossip
+func (gr *GossipRouter) Publish(cmd *networkmodel.DappCmd) {
+	gr.seen.Add(getGossipMessageId(cmd), true)
+	gr.publish(cmd)
+	gr.relayToFloodPeers(cmd, "")
+}
+
+//HandleMessage validates a message received over the dappley stream of a peer and relays it to the mesh of its topic
+//and to the other peers that do not speak gossip. It returns if the message should be delivered to the local
+//subscribers
+func (gr *GossipRouter) HandleMessage(cmd *networkmodel.DappCmd, source networkmodel.PeerInfo) bool {
+	if !gr.validate(cmd, source.PeerId) {
+		return false
+	}
+	gr.publish(cmd)
+	gr.relayToFloodPeers(cmd, source.PeerId)
+	return true
+}
+
+//getTopicValidator returns the gossipsub validator of a topic. A message is forwarded to the mesh only if the
+//validator returns true
+func (gr *GossipRouter) getTopicValidator(topic string) libp2ppubsub.Validator {
+	return func(ctx context.Context, source peer.ID, msg *libp2ppubsub.Message) bool {
+		if source == gr.net.GetHost().ID() {
+			//the local node has validated its own messages
+			return true
+		}
+		if !gr.net.GetPeerCapabilities(source).Has(networkmodel.CapabilityGossip) {
+			//the peer has not passed the handshake of the chain
+			return false
+		}
+
+		cmd, err := parseGossipMessage(msg.GetData())
+		if err != nil || cmd.GetName() != topic || !cmd.IsBroadcast() {
+			logger.WithFields(logger.Fields{
+				"topic":   topic,
+				"peer_id": source,
+			}).Warn("GossipRouter: message is malformed.")
+			if gr.onViolationCb != nil {
+				gr.onViolationCb(source, networkmodel.ViolationRejectedMessage)
+			}
+			return false
+		}
+
+		if !gr.validate(cmd, source) {
+			return false
+		}
+		gr.deliveries.Add(getPubsubMessageId(msg), &gossipDelivery{cmd, source})
+		return true
+	}
+}
+
+//validate returns if a message has not been seen and passes the validator of its topic. The peer that sent a rejected
+//message is reported
+func (gr *GossipRouter) validate(cmd *networkmodel.DappCmd, source peer.ID) bool {
+	gr.mutex.Lock()
+	if ok, _ := gr.seen.ContainsOrAdd(getGossipMessageId(cmd), true); ok {
+		gr.mutex.Unlock()
+		return false
+	}
+	validator := gr.validators[cmd.GetName()]
+	gr.mutex.Unlock()
+
+	if validator == nil {
+		return true
+	}
+
+	switch validator.ValidateMessage(cmd.GetName(), cmd.GetData()) {
+	case networkmodel.ValidationReject:
+		logger.WithFields(logger.Fields{
+			"topic":   cmd.GetName(),
+			"peer_id": source,
+		}).Warn("GossipRouter: message is rejected.")
+		if gr.onViolationCb != nil {
+			gr.onViolationCb(source, networkmodel.ViolationRejectedMessage)
+		}
+		return false
+	case networkmodel.ValidationIgnore:
+		return false
+	}
+	return true
+}
+
+//readLoop delivers the validated messages of a subscription to the local subscribers and relays them to the peers
+//that do not speak gossip
+func (gr *GossipRouter) readLoop(ctx context.Context, subscription *libp2ppubsub.Subscription) {
+	defer log.CrashHandler()
+
+	for {
+		msg, err := subscription.Next(ctx)
+		if err != nil {
+			return
+		}
+		if msg.GetFrom() == gr.net.GetHost().ID() {
+			continue
+		}
+
+		messageId := getPubsubMessageId(msg)
+		value, ok := gr.deliveries.Get(messageId)
+		if !ok {
+			continue
+		}
+		gr.deliveries.Remove(messageId)
+		delivery := value.(*gossipDelivery)
+
+		gr.relayToFloodPeers(delivery.cmd, delivery.source)
+		if gr.onMessageCb != nil {
+			gr.onMessageCb(delivery.cmd, networkmodel.PeerInfo{PeerId: delivery.source})
+		}
+	}
+}
+
+//publish sends a message to the mesh of its topic
+func (gr *GossipRouter) publish(cmd *networkmodel.DappCmd) {
+	gr.mutex.Lock()
+	ps := gr.pubsub
+	gr.mutex.Unlock()
+
+	if ps == nil {
+		return
+	}
+	if err := ps.Publish(gr.getPubsubTopic(cmd.GetName()), cmd.Serialize()); err != nil {
+		logger.WithError(err).WithFields(logger.Fields{
+			"topic": cmd.GetName(),
+		}).Warn("GossipRouter: publish message failed.")
+	}
+}
+
+//relayToFloodPeers sends a message to the connected peers that do not speak gossip except its source
+func (gr *GossipRouter) relayToFloodPeers(cmd *networkmodel.DappCmd, source peer.ID) {
+	peers := []peer.ID{}
+	for _, peerInfo := range gr.net.GetConnectedPeers() {
+		if peerInfo.PeerId != source && !gr.net.GetPeerCapabilities(peerInfo.PeerId).Has(networkmodel.CapabilityGossip) {
+			peers = append(peers, peerInfo.PeerId)
+		}
+	}
+	if len(peers) == 0 {
+		return
+	}
+	gr.net.Multicast(cmd.Serialize(), peers, gr.config.Topics[cmd.GetName()])
+}
+
+//getPubsubTopic returns the gossipsub topic of a command on the chain of the network
+func (gr *GossipRouter) getPubsubTopic(topic string) string {
+	gr.mutex.Lock()
+	defer gr.mutex.Unlock()
+	return gr.namespace + "/" + topic
+}
+
+//parseGossipMessage extracts the command from the data of a gossipsub message
+func parseGossipMessage(data []byte) (*networkmodel.DappCmd, error) {
+	cmdpb := &networkpb.DappCmd{}
+	if err := proto.Unmarshal(data, cmdpb); err != nil {
+		return nil, err
+	}
+	cmd := &networkmodel.DappCmd{}
+	cmd.FromProto(cmdpb)
+	return cmd, nil
+}
+
+//getPubsubMessageId returns the id that gossipsub gives to a message
+func getPubsubMessageId(msg *libp2ppubsub.Message) string {
+	return string(msg.GetFrom()) + string(msg.GetSeqno())
+}
+
+//getGossipMessageId returns the id of the command of a gossip message
+func getGossipMessageId(cmd *networkmodel.DappCmd) string {
+	magicNum := make([]byte, 4)
+	binary.BigEndian.PutUint32(magicNum, cmd.GetMagicNumber())
+
+	hasher := sha256.New()
+	hasher.Write([]byte(cmd.GetName()))
+	hasher.Write(cmd.GetData())
+	hasher.Write(magicNum)
+	return string(hasher.Sum(nil))
+}
@@ -0,0 +1,193 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package network
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dappley/go-dappley/common/pubsub"
+	"github.com/dappley/go-dappley/network/networkmodel"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/dappley/go-dappley/util"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+const testGossipTopic = "TestGossipTopic"
+
+//testGossipSubscriber validates the gossip messages of its topic with a fixed result and records the delivered ones
+type testGossipSubscriber struct {
+	result   networkmodel.ValidationResult
+	received []*networkmodel.DappRcvdCmdContext
+	mutex    sync.Mutex
+}
+
+func (s *testGossipSubscriber) GetSubscribedTopics() []string { return []string{testGossipTopic} }
+
+func (s *testGossipSubscriber) GetTopicHandler(topic string) pubsub.TopicHandler {
+	return func(input interface{}) {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		s.received = append(s.received, input.(*networkmodel.DappRcvdCmdContext))
+	}
+}
+
+func (s *testGossipSubscriber) ValidateMessage(topic string, data []byte) networkmodel.ValidationResult {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.result
+}
+
+func (s *testGossipSubscriber) setResult(result networkmodel.ValidationResult) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.result = result
+}
+
+//getReceived returns the data and the sources of the delivered messages
+func (s *testGossipSubscriber) getReceived() map[string]peer.ID {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	received := make(map[string]peer.ID)
+	for _, command := range s.received {
+		received[string(command.GetData())] = command.GetSource().PeerId
+	}
+	return received
+}
+
+func newGossipTestNode(t *testing.T, port int, gossip bool) (*Node, *testGossipSubscriber, func()) {
+	rfl := storage.NewRamFileLoader(confDir, fmt.Sprintf("gossip%d.conf", port))
+	n := NewNode(rfl.File, nil)
+	if gossip {
+		n.EnableGossip(NewGossipConfig(map[string]networkmodel.DappCmdPriority{
+			testGossipTopic: networkmodel.NormalPriorityCommand,
+		}))
+	}
+	subscriber := &testGossipSubscriber{result: networkmodel.ValidationAccept}
+	n.Listen(subscriber)
+	assert.Nil(t, n.Start(port, ""))
+	return n, subscriber, func() {
+		n.Stop()
+		rfl.DeleteFolder()
+	}
+}
+
+//waitForTopicPeers waits until the gossip routers of two nodes have seen each other subscribe to the test topic
+func waitForTopicPeers(n1, n2 *Node) {
+	util.WaitDoneOrTimeout(func() bool {
+		return containsPeerId(n1.GetGossipRouter().GetTopicPeers(testGossipTopic), n2.GetHostPeerInfo().PeerId) &&
+			containsPeerId(n2.GetGossipRouter().GetTopicPeers(testGossipTopic), n1.GetHostPeerInfo().PeerId)
+	}, 5)
+	//let the heartbeat graft the peers into the meshes
+	time.Sleep(1500 * time.Millisecond)
+}
+
+func containsPeerId(peerIds []peer.ID, peerId peer.ID) bool {
+	for _, id := range peerIds {
+		if id == peerId {
+			return true
+		}
+	}
+	return false
+}
+
+func TestGossipRouter_Relay(t *testing.T) {
+	n1, s1, stop1 := newGossipTestNode(t, test_port16, true)
+	defer stop1()
+	n2, s2, stop2 := newGossipTestNode(t, test_port17, true)
+	defer stop2()
+	n3, s3, stop3 := newGossipTestNode(t, test_port18, false)
+	defer stop3()
+
+	assert.Nil(t, n2.GetNetwork().ConnectToSeed(n1.GetHostPeerInfo()))
+	assert.Nil(t, n3.GetNetwork().ConnectToSeed(n2.GetHostPeerInfo()))
+	waitForTopicPeers(n1, n2)
+	assert.Contains(t, n1.GetGossipRouter().GetTopicPeers(testGossipTopic), n2.GetHostPeerInfo().PeerId)
+
+	//the message reaches the gossip peer through gossipsub and the peer without gossip through its stream
+	n1.GetGossipRouter().Publish(networkmodel.NewDappCmd(testGossipTopic, []byte("message1"), true))
+	util.WaitDoneOrTimeout(func() bool {
+		return len(s2.getReceived()) > 0 && len(s3.getReceived()) > 0
+	}, 5)
+	assert.Equal(t, n1.GetHostPeerInfo().PeerId, s2.getReceived()["message1"])
+	assert.Contains(t, s3.getReceived(), "message1")
+	assert.Empty(t, s1.getReceived())
+
+	//a message from the peer without gossip is published to the mesh by the node it was sent to
+	cmd := networkmodel.NewDappCmd(testGossipTopic, []byte("message2"), true)
+	n3.GetNetwork().Unicast(cmd.Serialize(), n2.GetHostPeerInfo().PeerId, networkmodel.NormalPriorityCommand)
+	util.WaitDoneOrTimeout(func() bool {
+		_, ok := s1.getReceived()["message2"]
+		return ok
+	}, 5)
+	assert.Equal(t, n2.GetHostPeerInfo().PeerId, s1.getReceived()["message2"])
+	assert.Equal(t, n3.GetHostPeerInfo().PeerId, s2.getReceived()["message2"])
+}
+
+func TestGossipRouter_Validation(t *testing.T) {
+	n1, _, stop1 := newGossipTestNode(t, test_port19, true)
+	defer stop1()
+	n2, s2, stop2 := newGossipTestNode(t, test_port20, true)
+	defer stop2()
+
+	assert.Nil(t, n2.GetNetwork().ConnectToSeed(n1.GetHostPeerInfo()))
+	waitForTopicPeers(n1, n2)
+	peerId1 := n1.GetHostPeerInfo().PeerId
+	getScore := func() int {
+		for _, peerScore := range n2.GetNetwork().GetPeerManager().GetPeerScores() {
+			if peerScore.PeerId == peerId1 {
+				return peerScore.Score
+			}
+		}
+		return 0
+	}
+
+	//an ignored message is neither delivered nor held against its sender
+	s2.setResult(networkmodel.ValidationIgnore)
+	n1.GetGossipRouter().Publish(networkmodel.NewDappCmd(testGossipTopic, []byte("ignored"), true))
+	time.Sleep(500 * time.Millisecond)
+	assert.Empty(t, s2.getReceived())
+	assert.Equal(t, 0, getScore())
+
+	//a rejected message lowers the score of its sender
+	s2.setResult(networkmodel.ValidationReject)
+	n1.GetGossipRouter().Publish(networkmodel.NewDappCmd(testGossipTopic, []byte("rejected"), true))
+	util.WaitDoneOrTimeout(func() bool {
+		return getScore() < 0
+	}, 5)
+	assert.Empty(t, s2.getReceived())
+	assert.Equal(t, -GetViolationPenalty(networkmodel.ViolationRejectedMessage), getScore())
+
+	//an accepted message is delivered once
+	s2.setResult(networkmodel.ValidationAccept)
+	cmd := networkmodel.NewDappCmd(testGossipTopic, []byte("accepted"), true)
+	n1.GetGossipRouter().Publish(cmd)
+	n1.GetGossipRouter().Publish(cmd)
+	util.WaitDoneOrTimeout(func() bool {
+		return len(s2.getReceived()) > 0
+	}, 5)
+	time.Sleep(500 * time.Millisecond)
+	assert.Equal(t, map[string]peer.ID{"accepted": peerId1}, s2.getReceived())
+	s2.mutex.Lock()
+	assert.Equal(t, 1, len(s2.received))
+	s2.mutex.Unlock()
+}
@@ -1,6 +1,7 @@
 package network
 
 import (
+	"github.com/dappley/go-dappley/common/hash"
 	"github.com/dappley/go-dappley/common/pubsub"
 	"github.com/dappley/go-dappley/network/networkmodel"
 	"github.com/golang/protobuf/proto"
@@ -18,3 +19,16 @@ type NetService interface {
 	BroadcastHighProrityCommand(commandName string, message proto.Message)
 	Listen(subscriber pubsub.Subscriber)
 }
+
+// ChainInfo is the chain that a node serves. It is announced to peers in the handshake
+type ChainInfo interface {
+	GetGenesisHash() hash.Hash
+	GetMaxHeight() uint64
+	GetLIBHeight() uint64
+}
+
+// MessageValidator is implemented by the subscribers that check the gossip messages of their topics before they are
+// relayed
+type MessageValidator interface {
+	ValidateMessage(topic string, data []byte) networkmodel.ValidationResult
+}
@@ -3,12 +3,14 @@ package network
 import (
 	"time"
 
+	"github.com/dappley/go-dappley/common/clock"
 	"github.com/dappley/go-dappley/common/log"
 	"github.com/dappley/go-dappley/storage"
 
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/pnet"
 	logger "github.com/sirupsen/logrus"
 
 	"github.com/dappley/go-dappley/network/networkmodel"
@@ -21,6 +23,11 @@ type Network struct {
 	streamMsgDispatcherCh chan *networkmodel.DappPacketContext
 	recentlyRcvdDapMsgs   *lru.Cache
 	onStreamStopCb        OnStreamCbFunc
+	chainInfo             ChainInfo
+	capabilities          networkmodel.Capability
+	discovery             *PeerDiscovery
+	psk                   pnet.PSK
+	clock                 clock.Clock
 }
 
 type NetworkContext struct {
@@ -41,11 +48,17 @@ func NewNetwork(netContext *NetworkContext) *Network {
 		streamMsgRcvCh:        make(chan *networkmodel.DappPacketContext, dispatchChLen),
 		streamMsgDispatcherCh: netContext.streamMsgDispatcherCh,
 		onStreamStopCb:        netContext.onStreamStopCb,
+		capabilities:          networkmodel.LocalCapabilities,
+		clock:                 clock.NewRealClock(),
 	}
 
 	net.recentlyRcvdDapMsgs, err = lru.New(10240)
 	net.streamManager = NewStreamManager(netContext.config, net.streamMsgRcvCh, net.onStreamStop, net.onStreamConnected)
 	net.peerManager = NewPeerManager(netContext.netService, netContext.peerInfoConf, net.onPeerListReceived, netContext.seeds)
+	net.peerManager.SetOnPeerBannedCb(net.streamManager.Disconnect)
+	net.streamManager.SetIsPeerBannedCb(net.peerManager.IsBanned)
+	net.streamManager.SetOnViolationCb(net.peerManager.ReportViolation)
+	net.streamManager.SetGetHandshakeCb(net.getHandshake)
 
 	if err != nil {
 		logger.WithError(err).Panic("Network: Can not initialize lru cache for recentlyRcvdDapMsgs!")
@@ -77,24 +90,96 @@ func (net *Network) GetStreamManager() *StreamManager {
 	return net.streamManager
 }
 
+//SetChainInfo sets the chain that the network announces in handshakes. It should be set before the network starts
+func (net *Network) SetChainInfo(chainInfo ChainInfo) {
+	net.chainInfo = chainInfo
+}
+
+//AddCapabilities announces more optional protocols in handshakes. It should be called before the network starts
+func (net *Network) AddCapabilities(capabilities networkmodel.Capability) {
+	net.capabilities |= capabilities
+}
+
+//SetPrivateNetworkKey makes the network a private network of the hosts that share the pre-shared key. It should be
+//called before the network starts
+func (net *Network) SetPrivateNetworkKey(psk pnet.PSK) {
+	net.psk = psk
+}
+
+//SetClock sets the clock that drives the timeouts, the bans and the schedules of the network. It should be set before
+//the network starts
+func (net *Network) SetClock(c clock.Clock) {
+	net.clock = c
+	net.streamManager.SetClock(c)
+	net.peerManager.SetClock(c)
+	if net.discovery != nil {
+		net.discovery.SetClock(c)
+	}
+}
+
+//GetClock returns the clock of the network
+func (net *Network) GetClock() clock.Clock {
+	return net.clock
+}
+
+//SetPeerAllowlist accepts only the peers in the allowlist. An empty allowlist accepts all peers that are not denied
+func (net *Network) SetPeerAllowlist(peerIds []peer.ID) {
+	net.streamManager.SetPeerAllowlist(peerIds)
+}
+
+//SetPeerDenylist refuses the peers in the denylist
+func (net *Network) SetPeerDenylist(peerIds []peer.ID) {
+	net.streamManager.SetPeerDenylist(peerIds)
+}
+
+//GetPeerFilter returns the filter of the peers that the network connects to
+func (net *Network) GetPeerFilter() *PeerFilter {
+	return net.streamManager.GetPeerFilter()
+}
+
+//EnableDiscovery finds peers by multicast DNS or through the DHT of the chain. It should be called before the network
+//starts
+func (net *Network) EnableDiscovery(config DiscoveryConfig) {
+	net.discovery = NewPeerDiscovery(config, net.onPeerDiscovered)
+	net.discovery.SetClock(net.clock)
+}
+
+//GetPeerCapabilities returns the optional protocols that both the local node and the peer support
+func (net *Network) GetPeerCapabilities(peerId peer.ID) networkmodel.Capability {
+	return net.streamManager.GetCapabilities(peerId)
+}
+
+//GetPeerManager returns the peer manager of the network
+func (net *Network) GetPeerManager() *PeerManager {
+	return net.peerManager
+}
+
 func (net *Network) StartNewPingService(interval time.Duration) error {
 	return net.streamManager.StartNewPingService(interval)
 }
 
 //Start starts the network
 func (net *Network) Start(listenPort int, privKey crypto.PrivKey) error {
-	host := networkmodel.NewHost(listenPort, privKey, net.streamManager.StreamHandler)
+	host := networkmodel.NewPrivateHost(listenPort, privKey, net.psk, net.streamManager.GetPeerFilter(), net.streamManager.StreamHandler)
 	net.streamManager.Start(host)
 	net.connectToAllPeers()
 	net.peerManager.Start()
 	net.peerManager.SetHostPeerId(host.GetPeerInfo().PeerId)
 	net.startStreamMsgHandler()
 	net.startPeerConnectionSchedule()
+	if net.discovery != nil {
+		if err := net.discovery.Start(host.Host, net.getDiscoveryNamespace(), net.peerManager.GetSeeds()); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 //Stop stops the network
 func (net *Network) Stop() {
+	if net.discovery != nil {
+		net.discovery.Stop()
+	}
 	net.streamManager.Stop()
 }
 
@@ -114,6 +199,22 @@ func (net *Network) Broadcast(data []byte, priority networkmodel.DappCmdPriority
 	net.streamManager.Broadcast(packet, priority)
 }
 
+//Multicast sends a broadcast message to some of the peers
+func (net *Network) Multicast(data []byte, pids []peer.ID, priority networkmodel.DappCmdPriority) {
+	packet := networkmodel.ConstructDappPacketFromData(data, true)
+
+	net.recordMessage(packet)
+	net.streamManager.Multicast(packet, pids, priority)
+}
+
+//GetBytesSent returns the number of bytes that the network has sent to its peers
+func (net *Network) GetBytesSent() uint64 {
+	if net.GetHost() == nil {
+		return 0
+	}
+	return net.GetHost().GetBytesSent()
+}
+
 //ConnectToSeed adds a peer to its network and starts the connectionManager
 func (net *Network) ConnectToSeed(peerInfo networkmodel.PeerInfo) error {
 	err := net.streamManager.connectPeer(peerInfo, ConnectionTypeOut)
@@ -180,10 +281,10 @@ func (net *Network) startPeerConnectionSchedule() {
 	go func() {
 		defer log.CrashHandler()
 
-		ticker := time.NewTicker(PeerConnectionInterval)
+		ticker := net.clock.NewTicker(PeerConnectionInterval)
 		for {
 			select {
-			case <-ticker.C:
+			case <-ticker.Chan():
 				net.connectToAllPeers()
 				net.updatePeers()
 			}
@@ -217,6 +318,30 @@ func (net *Network) recordMessage(msg *networkmodel.DappPacket) {
 	net.recentlyRcvdDapMsgs.Add(string(msg.GetRawBytes()), true)
 }
 
+//getHandshake builds the handshake of the local node from its chain
+func (net *Network) getHandshake() *networkmodel.Handshake {
+	handshake := networkmodel.NewHandshake(nil, 0, 0)
+	if net.chainInfo != nil {
+		handshake = networkmodel.NewHandshake(net.chainInfo.GetGenesisHash(), net.chainInfo.GetMaxHeight(), net.chainInfo.GetLIBHeight())
+	}
+	handshake.Capabilities = net.capabilities
+	return handshake
+}
+
+//getDiscoveryNamespace returns the namespace of the chain of the network in the DHT
+func (net *Network) getDiscoveryNamespace() string {
+	if net.chainInfo == nil {
+		return GetDiscoveryNamespace(nil)
+	}
+	return GetDiscoveryNamespace(net.chainInfo.GetGenesisHash())
+}
+
+//onPeerDiscovered adds a discovered peer as a seed and connects to it
+func (net *Network) onPeerDiscovered(peerInfo networkmodel.PeerInfo) {
+	net.peerManager.AddSeedByPeerInfo(peerInfo)
+	net.streamManager.ConnectPeers([]networkmodel.PeerInfo{peerInfo})
+}
+
 //onStreamStop runs cb function upon any stream stops
 func (net *Network) onStreamStop(stream *Stream) {
 	net.onStreamStopCb(stream)
@@ -49,6 +49,16 @@ func (dc *DappCmd) GetData() []byte {
 	return dc.data
 }
 
+//IsBroadcast returns if the DappCmd is a broadcast
+func (dc *DappCmd) IsBroadcast() bool {
+	return dc.isBroadcast
+}
+
+//GetMagicNumber returns the number that tells apart two DappCmds with the same content
+func (dc *DappCmd) GetMagicNumber() uint32 {
+	return dc.magicNum
+}
+
 //ParseDappMsgFromDappPacket creates a new DappCmd from a DappPacket
 func ParseDappMsgFromDappPacket(packet *DappPacket) *DappCmd {
 	return DeserializeToDappCmd(packet.GetData())
@@ -5,6 +5,7 @@ import (
 	"reflect"
 
 	errval "github.com/dappley/go-dappley/errors"
+	"github.com/golang/snappy"
 )
 
 const (
@@ -20,12 +21,15 @@ const (
 	isBroadcastByteIndex    = lengthBytesIndex + lengthByteLength
 	checkSumByteIndex       = isBroadcastByteIndex + isBroadcastByteLength
 	headerCheckSumByteIndex = checkSumByteIndex + checkSumLength
+
+	maxDecompressedLength = 64 * 1024 * 1024
 )
 
 var (
-	startBytes    = []byte{0x7E, 0x7E}
-	broadcastByte = byte(1)
-	unitcastByte  = byte(0)
+	startBytes     = []byte{0x7E, 0x7E}
+	broadcastByte  = byte(1)
+	unitcastByte   = byte(0)
+	compressedByte = byte(2)
 )
 
 type DappPacket struct {
@@ -117,7 +121,49 @@ func (packet *DappPacket) GetLength() int {
 
 //IsBroadcast returns if the packet is a broadcast
 func (packet *DappPacket) IsBroadcast() bool {
-	return packet.GetBroadcastByte() == broadcastByte
+	return packet.GetBroadcastByte()&broadcastByte == broadcastByte
+}
+
+//IsCompressed returns if the data of the packet is compressed
+func (packet *DappPacket) IsCompressed() bool {
+	return packet.GetBroadcastByte()&compressedByte == compressedByte
+}
+
+//Compress returns the packet with its data compressed, or the packet itself if compression does not make it smaller
+func (packet *DappPacket) Compress() *DappPacket {
+	if packet.IsCompressed() {
+		return packet
+	}
+
+	data := snappy.Encode(nil, packet.data)
+	if len(data) >= len(packet.data) {
+		return packet
+	}
+	return &DappPacket{
+		header: constructHeaderWithFlags(data, packet.GetBroadcastByte()|compressedByte),
+		data:   data,
+	}
+}
+
+//Decompress returns the packet with its data decompressed
+func (packet *DappPacket) Decompress() (*DappPacket, error) {
+	if !packet.IsCompressed() {
+		return packet, nil
+	}
+
+	length, err := snappy.DecodedLen(packet.data)
+	if err != nil {
+		return nil, err
+	}
+	if length > maxDecompressedLength {
+		return nil, errval.DecompressedLengthTooLong
+	}
+
+	data, err := snappy.Decode(nil, packet.data)
+	if err != nil {
+		return nil, err
+	}
+	return ConstructDappPacketFromData(data, packet.IsBroadcast()), nil
 }
 
 //GetRawBytes returns the whole packet in raw bytes
@@ -165,6 +211,15 @@ func (packet *DappPacket) containStartingBytes() bool {
 
 //constructHeader constructs the header bytes from the given data bytes
 func constructHeader(data []byte, isBroadcast bool) []byte {
+	isBroadcastByte := unitcastByte
+	if isBroadcast {
+		isBroadcastByte = broadcastByte
+	}
+	return constructHeaderWithFlags(data, isBroadcastByte)
+}
+
+//constructHeaderWithFlags constructs the header bytes from the given data bytes and flag byte
+func constructHeaderWithFlags(data []byte, flags byte) []byte {
 
 	length := len(data)
 	msg := make([]byte, lengthByteLength)
@@ -175,13 +230,7 @@ func constructHeader(data []byte, isBroadcast bool) []byte {
 	}
 
 	header := append(startBytes, msg...)
-
-	isBroadcastByte := unitcastByte
-	if isBroadcast {
-		isBroadcastByte = broadcastByte
-	}
-
-	header = append(header, isBroadcastByte)
+	header = append(header, flags)
 
 	cs := checkSum(data)
 	header = append(header, cs)
@@ -1,6 +1,7 @@
 package networkmodel
 
 import (
+	"bytes"
 	"testing"
 
 	errval "github.com/dappley/go-dappley/errors"
@@ -123,3 +124,32 @@ func TestDappPacket_checkSum(t *testing.T) {
 	}
 	assert.Equal(t, byte(50), checkSum(bytes))
 }
+
+func TestDappPacket_Compress(t *testing.T) {
+	data := bytes.Repeat([]byte("dappley"), 1024)
+	packet := ConstructDappPacketFromData(data, true)
+
+	compressed := packet.Compress()
+	assert.True(t, compressed.IsCompressed())
+	assert.True(t, compressed.IsBroadcast())
+	assert.True(t, compressed.GetLength() < packet.GetLength())
+
+	deserialized, err := DeserializeIntoDappPacket(compressed.GetRawBytes())
+	assert.Nil(t, err)
+	decompressed, err := deserialized.Decompress()
+	assert.Nil(t, err)
+	assert.False(t, decompressed.IsCompressed())
+	assert.Equal(t, packet.GetRawBytes(), decompressed.GetRawBytes())
+}
+
+func TestDappPacket_CompressIncompressibleData(t *testing.T) {
+	packet := ConstructDappPacketFromData([]byte{1, 2, 3, 4, 5}, false)
+	assert.Equal(t, packet, packet.Compress())
+}
+
+func TestDappPacket_DecompressCorruptedData(t *testing.T) {
+	data := []byte{0xff, 0xff, 0xff, 0xff, 0x0f, 0x1, 0x2}
+	packet := &DappPacket{constructHeaderWithFlags(data, compressedByte), data}
+	_, err := packet.Decompress()
+	assert.NotNil(t, err)
+}
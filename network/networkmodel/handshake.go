@@ -0,0 +1,118 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package networkmodel
+
+import (
+	"bytes"
+
+	errval "github.com/dappley/go-dappley/errors"
+	networkpb "github.com/dappley/go-dappley/network/pb"
+	"github.com/golang/protobuf/proto"
+)
+
+const (
+	// HandshakeCmd is the first command that both ends of a stream send
+	HandshakeCmd = "Handshake"
+	// ProtocolVersion is the version of the protocol that this build speaks
+	ProtocolVersion uint32 = 1
+	// MinProtocolVersion is the oldest protocol version that this build accepts
+	MinProtocolVersion uint32 = 1
+)
+
+// Capability is a bitset of the optional protocols that a node supports
+type Capability uint64
+
+const (
+	// CapabilityCompression is the snappy compression of large packets
+	CapabilityCompression Capability = 1 << iota
+	// CapabilityCompactBlocks is the relay of blocks by their transaction ids
+	CapabilityCompactBlocks
+	// CapabilityGossip is the relay of broadcasts to a mesh of peers instead of all peers
+	CapabilityGossip
+	// CapabilityTxInventory is the announcement of transactions by their ids, which peers fetch on demand
+	CapabilityTxInventory
+	// CapabilityParallelSync is the download of block headers first and of block bodies in windows from many peers
+	CapabilityParallelSync
+	// CapabilitySnapshotSync is the export of the utxo set and the contract states at the lib in verifiable chunks
+	CapabilitySnapshotSync
+)
+
+// LocalCapabilities are the optional protocols that this build supports
+var LocalCapabilities = CapabilityCompression | CapabilityCompactBlocks | CapabilityTxInventory | CapabilityParallelSync | CapabilitySnapshotSync
+
+type Handshake struct {
+	ProtocolVersion uint32
+	GenesisHash     []byte
+	BestHeight      uint64
+	LIBHeight       uint64
+	Capabilities    Capability
+}
+
+//Has returns if all the capabilities in other are in the set
+func (c Capability) Has(other Capability) bool {
+	return c&other == other
+}
+
+//NewHandshake creates a handshake that announces the chain of a node and the capabilities of this build
+func NewHandshake(genesisHash []byte, bestHeight uint64, libHeight uint64) *Handshake {
+	return &Handshake{
+		ProtocolVersion: ProtocolVersion,
+		GenesisHash:     genesisHash,
+		BestHeight:      bestHeight,
+		LIBHeight:       libHeight,
+		Capabilities:    LocalCapabilities,
+	}
+}
+
+//Verify checks that the remote handshake is from a compatible build on the same chain. Once the local chain is known,
+//a remote handshake without a genesis hash is rejected as well
+func (hs *Handshake) Verify(remote *Handshake) error {
+	if remote.ProtocolVersion < MinProtocolVersion {
+		return errval.IncompatibleProtocolVersion
+	}
+	if len(hs.GenesisHash) > 0 && !bytes.Equal(hs.GenesisHash, remote.GenesisHash) {
+		return errval.GenesisHashMismatch
+	}
+	return nil
+}
+
+//Negotiate returns the capabilities that both ends support
+func (hs *Handshake) Negotiate(remote *Handshake) Capability {
+	return hs.Capabilities & remote.Capabilities
+}
+
+//ToProto converts a Handshake into proto message
+func (hs *Handshake) ToProto() proto.Message {
+	return &networkpb.Handshake{
+		ProtocolVersion: hs.ProtocolVersion,
+		GenesisHash:     hs.GenesisHash,
+		BestHeight:      hs.BestHeight,
+		LibHeight:       hs.LIBHeight,
+		Capabilities:    uint64(hs.Capabilities),
+	}
+}
+
+//FromProto extracts a Handshake from a proto message
+func (hs *Handshake) FromProto(pb proto.Message) {
+	hs.ProtocolVersion = pb.(*networkpb.Handshake).GetProtocolVersion()
+	hs.GenesisHash = pb.(*networkpb.Handshake).GetGenesisHash()
+	hs.BestHeight = pb.(*networkpb.Handshake).GetBestHeight()
+	hs.LIBHeight = pb.(*networkpb.Handshake).GetLibHeight()
+	hs.Capabilities = Capability(pb.(*networkpb.Handshake).GetCapabilities())
+}
@@ -0,0 +1,65 @@
+package networkmodel
+
+import (
+	"testing"
+
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandshake_Verify(t *testing.T) {
+	local := NewHandshake([]byte("genesis1"), 10, 5)
+
+	tests := []struct {
+		name   string
+		remote *Handshake
+		retErr error
+	}{
+		{
+			name:   "SameChain",
+			remote: NewHandshake([]byte("genesis1"), 20, 15),
+			retErr: nil,
+		},
+		{
+			name:   "UnknownChain",
+			remote: NewHandshake(nil, 0, 0),
+			retErr: errval.GenesisHashMismatch,
+		},
+		{
+			name:   "AnotherChain",
+			remote: NewHandshake([]byte("genesis2"), 10, 5),
+			retErr: errval.GenesisHashMismatch,
+		},
+		{
+			name:   "OldProtocol",
+			remote: &Handshake{ProtocolVersion: MinProtocolVersion - 1, GenesisHash: []byte("genesis1")},
+			retErr: errval.IncompatibleProtocolVersion,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.retErr, local.Verify(tt.remote))
+		})
+	}
+
+	//a node that does not know its chain yet accepts any chain
+	assert.Nil(t, NewHandshake(nil, 0, 0).Verify(NewHandshake([]byte("genesis1"), 10, 5)))
+}
+
+func TestHandshake_Negotiate(t *testing.T) {
+	local := &Handshake{Capabilities: CapabilityCompression | CapabilityCompactBlocks}
+	remote := &Handshake{Capabilities: CapabilityCompactBlocks}
+
+	capabilities := local.Negotiate(remote)
+	assert.True(t, capabilities.Has(CapabilityCompactBlocks))
+	assert.False(t, capabilities.Has(CapabilityCompression))
+}
+
+func TestHandshake_Proto(t *testing.T) {
+	handshake := NewHandshake([]byte("genesis1"), 10, 5)
+
+	newHandshake := &Handshake{}
+	newHandshake.FromProto(handshake.ToProto())
+	assert.Equal(t, handshake, newHandshake)
+}
@@ -3,10 +3,15 @@ package networkmodel
 import (
 	"context"
 	"fmt"
+	"sync/atomic"
+
 	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/connmgr"
 	"github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/metrics"
 	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/pnet"
 	ma "github.com/multiformats/go-multiaddr"
 	logger "github.com/sirupsen/logrus"
 )
@@ -17,12 +22,26 @@ const (
 
 type Host struct {
 	host.Host
-	Info PeerInfo
+	Info      PeerInfo
+	bandwidth *bandwidthCounter
+}
+
+//bandwidthCounter keeps the exact number of bytes that the host has written to all its streams
+type bandwidthCounter struct {
+	*metrics.BandwidthCounter
+	bytesSent uint64
 }
 
 //NewHost starts a p2p host with a listening port, network private key and a stream handler
 func NewHost(listenPort int, privKey crypto.PrivKey, handler network.StreamHandler) *Host {
-	h, addrs, err := createBasicHost(listenPort, privKey)
+	return NewPrivateHost(listenPort, privKey, nil, nil, handler)
+}
+
+//NewPrivateHost starts a p2p host that only connects to the hosts sharing its pre-shared key. The host joins the public
+//network if the key is nil. The gater, if not nil, refuses the connections of the peers that the node does not accept
+func NewPrivateHost(listenPort int, privKey crypto.PrivKey, psk pnet.PSK, gater connmgr.ConnectionGater, handler network.StreamHandler) *Host {
+	bandwidth := &bandwidthCounter{BandwidthCounter: metrics.NewBandwidthCounter()}
+	h, addrs, err := createBasicHost(listenPort, privKey, psk, gater, bandwidth)
 	if err != nil {
 		logger.WithError(err).Error("Network: Failed to create host.")
 		return nil
@@ -40,23 +59,47 @@ func NewHost(listenPort int, privKey crypto.PrivKey, handler network.StreamHandl
 	return &Host{
 		h,
 		info,
+		bandwidth,
 	}
 }
 
 //GetPeerInfo returns the peerInfo of the host
 func (host *Host) GetPeerInfo() PeerInfo { return host.Info }
 
+//GetBytesSent returns the number of bytes that the host has sent to its peers over all protocols
+func (host *Host) GetBytesSent() uint64 {
+	if host.bandwidth == nil {
+		return 0
+	}
+	return atomic.LoadUint64(&host.bandwidth.bytesSent)
+}
+
+//LogSentMessage counts the bytes written to a stream
+func (bc *bandwidthCounter) LogSentMessage(size int64) {
+	atomic.AddUint64(&bc.bytesSent, uint64(size))
+	bc.BandwidthCounter.LogSentMessage(size)
+}
+
 //create basic host. Returns host object, host address and error
-func createBasicHost(listenPort int, priv crypto.PrivKey) (host.Host, []ma.Multiaddr, error) {
+func createBasicHost(listenPort int, priv crypto.PrivKey, psk pnet.PSK, gater connmgr.ConnectionGater, reporter metrics.Reporter) (host.Host, []ma.Multiaddr, error) {
 
 	opts := []libp2p.Option{
 		libp2p.ListenAddrStrings(fmt.Sprintf("/ip4/0.0.0.0/tcp/%d", listenPort)),
+		libp2p.BandwidthReporter(reporter),
 	}
 
 	if priv != nil {
 		opts = append(opts, libp2p.Identity(priv))
 	}
 
+	if psk != nil {
+		opts = append(opts, libp2p.PrivateNetwork(psk))
+	}
+
+	if gater != nil {
+		opts = append(opts, libp2p.ConnectionGater(gater))
+	}
+
 	basicHost, err := libp2p.New(context.Background(), opts...)
 
 	if err != nil {
@@ -0,0 +1,13 @@
+package networkmodel
+
+// ValidationResult is the outcome of validating a gossip message before it is relayed
+type ValidationResult int
+
+const (
+	// ValidationAccept relays the message and delivers it to the subscribers
+	ValidationAccept ValidationResult = iota
+	// ValidationIgnore drops the message without penalizing its sender
+	ValidationIgnore
+	// ValidationReject drops the message and lowers the score of its sender
+	ValidationReject
+)
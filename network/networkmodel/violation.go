@@ -0,0 +1,31 @@
+package networkmodel
+
+// Violation is a kind of misbehavior of a peer that lowers its score
+type Violation int
+
+const (
+	// ViolationInvalidBlock is a block that is invalid on any chain: its hash does not match its content or its signature
+	// is malformed
+	ViolationInvalidBlock Violation = iota
+	// ViolationBadPacket is a packet with a corrupted header or a wrong checksum
+	ViolationBadPacket
+	// ViolationInvalidBlocks is a response to a block download request that is empty or carries invalid blocks
+	ViolationInvalidBlocks
+	// ViolationRejectedMessage is a gossip message that the validator of its topic rejected
+	ViolationRejectedMessage
+)
+
+//String returns the name of the violation
+func (v Violation) String() string {
+	switch v {
+	case ViolationInvalidBlock:
+		return "invalid block"
+	case ViolationBadPacket:
+		return "bad packet"
+	case ViolationInvalidBlocks:
+		return "invalid blocks"
+	case ViolationRejectedMessage:
+		return "rejected message"
+	}
+	return "unknown"
+}
@@ -20,10 +20,13 @@ package network
 
 import (
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 
+	"github.com/dappley/go-dappley/common/clock"
 	"github.com/dappley/go-dappley/common/log"
 	"github.com/dappley/go-dappley/common/pubsub"
+	errval "github.com/dappley/go-dappley/errors"
 	"github.com/dappley/go-dappley/storage"
 
 	"github.com/libp2p/go-libp2p-core/host"
@@ -31,6 +34,7 @@ import (
 	"github.com/dappley/go-dappley/network/networkmodel"
 	"github.com/golang/protobuf/proto"
 	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
 	ma "github.com/multiformats/go-multiaddr"
 	logger "github.com/sirupsen/logrus"
 )
@@ -40,6 +44,8 @@ const (
 
 	requestChLen  = 1024
 	dispatchChLen = 1024 * 4
+
+	privateNetworkKeyLength = 32
 )
 
 var (
@@ -54,6 +60,7 @@ type Node struct {
 	exitCh        chan bool
 	dispatcher    chan *networkmodel.DappPacketContext
 	commandSendCh chan *networkmodel.DappSendCmdContext
+	gossip        *GossipRouter
 }
 
 //NewNode creates a new Node instance
@@ -124,6 +131,12 @@ func (n *Node) Start(listenPort int, key string) error {
 		return err
 	}
 
+	if n.gossip != nil {
+		if err := n.gossip.Start(); err != nil {
+			n.network.Stop()
+			return err
+		}
+	}
 	n.StartRequestLoop()
 	n.StartListenLoop()
 	return nil
@@ -165,6 +178,10 @@ func (n *Node) broadcast(commandName string, message proto.Message, priority net
 
 //Relay relays a command to a peer or all peers
 func (n *Node) Relay(dappCmd *networkmodel.DappCmd, destination networkmodel.PeerInfo, priority networkmodel.DappCmdPriority) {
+	if dappCmd.IsBroadcast() && n.IsGossipTopic(dappCmd.GetName()) {
+		//the gossip router has relayed the command when it was received
+		return
+	}
 	command := networkmodel.NewDappSendCmdContextFromDappCmd(dappCmd, destination.PeerId, priority)
 	select {
 	case n.commandSendCh <- command:
@@ -187,14 +204,78 @@ func (n *Node) sendCommand(commandName string, message proto.Message, destinatio
 	}
 }
 
+//SetChainInfo sets the chain that the node announces in handshakes. It should be set before the node starts
+func (n *Node) SetChainInfo(chainInfo ChainInfo) {
+	n.network.SetChainInfo(chainInfo)
+}
+
+//GetPeerCapabilities returns the optional protocols that both the node and a peer support
+func (n *Node) GetPeerCapabilities(peerId peer.ID) networkmodel.Capability {
+	return n.network.GetPeerCapabilities(peerId)
+}
+
+//ReportPeer lowers the score of a peer that violated the protocol
+func (n *Node) ReportPeer(peerInfo networkmodel.PeerInfo, violation networkmodel.Violation) {
+	n.network.GetPeerManager().ReportViolation(peerInfo.PeerId, violation)
+}
+
+//SetClock sets the clock that drives the timeouts, the bans and the schedules of the node. It should be set before the
+//node starts
+func (n *Node) SetClock(c clock.Clock) {
+	n.network.SetClock(c)
+}
+
+//SetBannedPeersConf sets the file that keeps the banned peers
+func (n *Node) SetBannedPeersConf(bannedPeersConf *storage.FileLoader) {
+	n.network.GetPeerManager().SetBannedPeersConf(bannedPeersConf)
+}
+
+//EnableGossip relays the broadcasts of the configured topics by libp2p gossipsub instead of flooding them to all
+//peers. It should be called before the node starts
+func (n *Node) EnableGossip(config GossipConfig) {
+	n.gossip = NewGossipRouter(config, n.network, n.dispatchGossipMessage, n.network.GetPeerManager().ReportViolation)
+	n.network.AddCapabilities(networkmodel.CapabilityGossip)
+}
+
+//EnableDiscovery finds peers by multicast DNS on the local network or through the DHT of the chain. It should be
+//called before the node starts
+func (n *Node) EnableDiscovery(config DiscoveryConfig) {
+	n.network.EnableDiscovery(config)
+}
+
+//SetPrivateNetworkKey makes the node only connect to the hosts that share the pre-shared key, the hex of 32 bytes. It
+//should be called before the node starts
+func (n *Node) SetPrivateNetworkKey(key string) error {
+	psk, err := hex.DecodeString(key)
+	if err != nil || len(psk) != privateNetworkKeyLength {
+		return errval.InvalidPrivateNetworkKey
+	}
+	n.network.SetPrivateNetworkKey(psk)
+	return nil
+}
+
+//GetGossipRouter returns the gossip router of the node. It is nil if gossip is not enabled
+func (n *Node) GetGossipRouter() *GossipRouter { return n.gossip }
+
 //Listen registers a callback function for a topic
 func (n *Node) Listen(subscriber pubsub.Subscriber) {
 	n.commandBroker.AddSubscriber(subscriber)
+
+	if validator, ok := subscriber.(MessageValidator); ok && n.gossip != nil {
+		for _, topic := range subscriber.GetSubscribedTopics() {
+			if n.gossip.IsTopic(topic) {
+				n.gossip.SetValidator(topic, validator)
+			}
+		}
+	}
 }
 
 //Stop stops the node
 func (n *Node) Stop() {
 	n.exitCh <- true
+	if n.gossip != nil {
+		n.gossip.Stop()
+	}
 	n.network.Stop()
 }
 
@@ -213,6 +294,11 @@ func (n *Node) StartRequestLoop() {
 					continue
 				}
 
+				if cmdCtx.IsBroadcast() && n.IsGossipTopic(cmdCtx.GetCommand().GetName()) {
+					n.gossip.Publish(cmdCtx.GetCommand())
+					continue
+				}
+
 				rawBytes := cmdCtx.GetCommand().Serialize()
 
 				if cmdCtx.IsBroadcast() {
@@ -235,6 +321,10 @@ func (n *Node) StartListenLoop() {
 			if streamMsg, ok := <-n.dispatcher; ok {
 
 				cmdMsg := networkmodel.ParseDappMsgFromDappPacket(streamMsg.Packet)
+				if cmdMsg.IsBroadcast() && n.IsGossipTopic(cmdMsg.GetName()) &&
+					!n.gossip.HandleMessage(cmdMsg, streamMsg.Source) {
+					continue
+				}
 				dappRcvdCmd := networkmodel.NewDappRcvdCmdContext(cmdMsg, streamMsg.Source)
 				n.commandBroker.Dispatch(cmdMsg.GetName(), dappRcvdCmd)
 
@@ -244,6 +334,16 @@ func (n *Node) StartListenLoop() {
 
 }
 
+//dispatchGossipMessage delivers a message received by gossipsub to the subscribers of its command
+func (n *Node) dispatchGossipMessage(cmd *networkmodel.DappCmd, source networkmodel.PeerInfo) {
+	n.commandBroker.Dispatch(cmd.GetName(), networkmodel.NewDappRcvdCmdContext(cmd, source))
+}
+
+//IsGossipTopic returns if the broadcasts of a command are relayed by gossip
+func (n *Node) IsGossipTopic(commandName string) bool {
+	return n.gossip != nil && n.gossip.IsTopic(commandName)
+}
+
 //decodeNetworkKey decode network key
 func decodeNetworkKey(key string) crypto.PrivKey {
 	if key == "" {
@@ -265,6 +365,19 @@ func decodeNetworkKey(key string) crypto.PrivKey {
 	return privKey
 }
 
+//DecodePeerIds decodes the base58 ids of peers
+func DecodePeerIds(peerIds []string) ([]peer.ID, error) {
+	ids := make([]peer.ID, 0, len(peerIds))
+	for _, peerId := range peerIds {
+		id, err := peer.IDB58Decode(peerId)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
 //onStreamStop runs when a stream is disconnected
 func (n *Node) onStreamStop(stream *Stream) {
 
@@ -19,10 +19,22 @@
 package network
 
 import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/dappley/go-dappley/common/clock"
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/common/pubsub"
 	"github.com/dappley/go-dappley/network/networkmodel"
+	networkpb "github.com/dappley/go-dappley/network/pb"
 	"github.com/dappley/go-dappley/util"
+	"github.com/golang/protobuf/proto"
+	libp2pnetwork "github.com/libp2p/go-libp2p-core/network"
+	libp2ppubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/sirupsen/logrus"
 
 	"github.com/dappley/go-dappley/storage"
@@ -45,6 +57,14 @@ const (
 	test_port12
 	test_port13
 	test_port14
+	test_port15
+	test_port16
+	test_port17
+	test_port18
+	test_port19
+	test_port20
+
+	test_bandwidth_port = 20650
 )
 
 func initNode(port int, seedPeer networkmodel.PeerInfo, fileloader *storage.FileLoader) (*Node, error) {
@@ -201,3 +221,236 @@ func TestNode_ConnectionFull(t *testing.T) {
 	assert.Equal(t, 0, n4.GetNetwork().streamManager.connectionManager.connectionOutCount)
 
 }
+
+type testChainInfo struct {
+	genesisHash hash.Hash
+}
+
+func (ci *testChainInfo) GetGenesisHash() hash.Hash { return ci.genesisHash }
+func (ci *testChainInfo) GetMaxHeight() uint64      { return 10 }
+func (ci *testChainInfo) GetLIBHeight() uint64      { return 5 }
+
+func initNodeWithChain(port int, genesisHash hash.Hash, fileloader *storage.FileLoader) (*Node, error) {
+	n := NewNode(fileloader, nil)
+	n.SetChainInfo(&testChainInfo{genesisHash})
+	err := n.Start(port, "")
+	return n, err
+}
+
+func TestNode_Handshake(t *testing.T) {
+	rfl1 := storage.NewRamFileLoader(confDir, "test1.conf")
+	defer rfl1.DeleteFolder()
+	n1, err := initNodeWithChain(test_port3, hash.Hash("genesis1"), rfl1.File)
+	defer n1.Stop()
+	assert.Nil(t, err)
+
+	//node2 is on the same chain
+	rfl2 := storage.NewRamFileLoader(confDir, "test2.conf")
+	defer rfl2.DeleteFolder()
+	n2, err := initNodeWithChain(test_port4, hash.Hash("genesis1"), rfl2.File)
+	defer n2.Stop()
+	assert.Nil(t, err)
+
+	//node3 is on another chain
+	rfl3 := storage.NewRamFileLoader(confDir, "test3.conf")
+	defer rfl3.DeleteFolder()
+	n3, err := initNodeWithChain(test_port5, hash.Hash("genesis2"), rfl3.File)
+	defer n3.Stop()
+	assert.Nil(t, err)
+
+	//node4 does not announce its chain
+	rfl4 := storage.NewRamFileLoader(confDir, "test4.conf")
+	defer rfl4.DeleteFolder()
+	n4, err := initNodeWithChain(test_port15, nil, rfl4.File)
+	defer n4.Stop()
+	assert.Nil(t, err)
+
+	assert.Nil(t, n2.GetNetwork().ConnectToSeed(n1.GetHostPeerInfo()))
+	assert.Nil(t, n3.GetNetwork().ConnectToSeed(n1.GetHostPeerInfo()))
+	assert.Nil(t, n4.GetNetwork().ConnectToSeed(n1.GetHostPeerInfo()))
+
+	util.WaitDoneOrTimeout(func() bool {
+		return false
+	}, 1)
+
+	_, ok := n1.GetNetwork().streamManager.GetConnectedPeers()[n2.GetHostPeerInfo().PeerId]
+	assert.True(t, ok)
+	_, ok = n1.GetNetwork().streamManager.GetConnectedPeers()[n3.GetHostPeerInfo().PeerId]
+	assert.False(t, ok)
+	_, ok = n3.GetNetwork().streamManager.GetConnectedPeers()[n1.GetHostPeerInfo().PeerId]
+	assert.False(t, ok)
+	_, ok = n1.GetNetwork().streamManager.GetConnectedPeers()[n4.GetHostPeerInfo().PeerId]
+	assert.False(t, ok)
+
+	assert.Equal(t, networkmodel.LocalCapabilities, n1.GetNetwork().GetPeerCapabilities(n2.GetHostPeerInfo().PeerId))
+	assert.Equal(t, networkmodel.LocalCapabilities, n2.GetNetwork().GetPeerCapabilities(n1.GetHostPeerInfo().PeerId))
+	remoteHandshake := n1.GetNetwork().streamManager.GetStreams()[n2.GetHostPeerInfo().PeerId].stream.GetRemoteHandshake()
+	assert.Equal(t, uint64(10), remoteHandshake.BestHeight)
+	assert.Equal(t, uint64(5), remoteHandshake.LIBHeight)
+}
+
+func TestStreamManager_HandshakeTimeout(t *testing.T) {
+	rfl := storage.NewRamFileLoader(confDir, "test_handshake_timeout.conf")
+	defer rfl.DeleteFolder()
+	virtualClock := clock.NewVirtualClock(time.Unix(1600000000, 0))
+	n1 := NewNode(rfl.File, nil)
+	n1.SetClock(virtualClock)
+	assert.Nil(t, n1.Start(test_port6, ""))
+	defer n1.Stop()
+
+	//the peer accepts the stream but never sends its handshake
+	silentHost := networkmodel.NewHost(test_port14, nil, func(s libp2pnetwork.Stream) {})
+	assert.NotNil(t, silentHost)
+	defer silentHost.Close()
+
+	assert.Nil(t, n1.GetNetwork().ConnectToSeed(silentHost.GetPeerInfo()))
+	assert.Equal(t, 1, numOfStreams(n1))
+
+	//the stream stays open until the handshake times out on the clock of the node
+	virtualClock.Advance(HandshakeTimeout - time.Second)
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, 1, numOfStreams(n1))
+	virtualClock.Advance(time.Second)
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) && numOfStreams(n1) > 0 {
+		time.Sleep(50 * time.Millisecond)
+	}
+	assert.Equal(t, 0, numOfStreams(n1))
+}
+
+func numOfStreams(n *Node) int {
+	sm := n.GetNetwork().GetStreamManager()
+	sm.mutex.RLock()
+	defer sm.mutex.RUnlock()
+	return len(sm.GetStreams())
+}
+
+const testBroadcastTopic = "TestBroadcast"
+
+//testRelaySubscriber relays the broadcasts that it receives the way the blockchain manager relays blocks
+type testRelaySubscriber struct {
+	node     *Node
+	received map[string]bool
+	count    int32
+	mutex    sync.Mutex
+}
+
+func (s *testRelaySubscriber) GetSubscribedTopics() []string { return []string{testBroadcastTopic} }
+
+func (s *testRelaySubscriber) GetTopicHandler(topic string) pubsub.TopicHandler {
+	return func(input interface{}) {
+		command := input.(*networkmodel.DappRcvdCmdContext)
+		message := &networkpb.DappCmd{}
+		if err := proto.Unmarshal(command.GetData(), message); err != nil {
+			return
+		}
+		s.mutex.Lock()
+		if !s.received[message.GetCmd()] {
+			s.received[message.GetCmd()] = true
+			atomic.AddInt32(&s.count, 1)
+		}
+		s.mutex.Unlock()
+
+		if command.IsBroadcast() {
+			s.node.Relay(command.GetCommand(), networkmodel.PeerInfo{}, networkmodel.NormalPriorityCommand)
+		}
+	}
+}
+
+//measureBroadcastBandwidth starts a full mesh of nodes, broadcasts messages from them and returns the bytes that the
+//nodes have sent to deliver the messages to every node
+func measureBroadcastBandwidth(t *testing.T, basePort, numNodes, numMessages int, gossip bool) uint64 {
+	var nodes []*Node
+	var subscribers []*testRelaySubscriber
+	for i := 0; i < numNodes; i++ {
+		rfl := storage.NewRamFileLoader(confDir, fmt.Sprintf("bandwidth%d.conf", i))
+		defer rfl.DeleteFolder()
+
+		n := NewNode(rfl.File, nil)
+		if gossip {
+			n.EnableGossip(NewGossipConfig(map[string]networkmodel.DappCmdPriority{
+				testBroadcastTopic: networkmodel.NormalPriorityCommand,
+			}))
+		}
+		subscriber := &testRelaySubscriber{node: n, received: make(map[string]bool)}
+		n.Listen(subscriber)
+		assert.Nil(t, n.Start(basePort+i, ""))
+		defer n.Stop()
+
+		for _, seed := range nodes {
+			//the peers may have been connected by peer syncing already
+			n.GetNetwork().ConnectToSeed(seed.GetHostPeerInfo())
+		}
+		nodes = append(nodes, n)
+		subscribers = append(subscribers, subscriber)
+	}
+
+	util.WaitDoneOrTimeout(func() bool {
+		for _, n := range nodes {
+			if len(n.GetPeers()) < numNodes-1 {
+				return false
+			}
+		}
+		return true
+	}, 5)
+	for _, n := range nodes {
+		assert.Equal(t, numNodes-1, len(n.GetPeers()))
+	}
+	//let the heartbeats of gossipsub form the meshes
+	time.Sleep(2 * time.Second)
+
+	var bytesBefore uint64
+	for _, n := range nodes {
+		bytesBefore += n.GetNetwork().GetBytesSent()
+	}
+
+	for i := 0; i < numMessages; i++ {
+		//random payloads are not compressed
+		payload := make([]byte, 1024)
+		rand.Read(payload)
+		nodes[i%numNodes].BroadcastNormalPriorityCommand(testBroadcastTopic, &networkpb.DappCmd{
+			Cmd:  fmt.Sprintf("message%d", i),
+			Data: payload,
+		})
+	}
+
+	util.WaitDoneOrTimeout(func() bool {
+		for i, subscriber := range subscribers {
+			//the sender of a message does not receive it
+			if int(atomic.LoadInt32(&subscriber.count)) < numMessages-(numMessages+numNodes-1-i)/numNodes {
+				return false
+			}
+		}
+		return true
+	}, 10)
+	//let the last relays finish
+	time.Sleep(500 * time.Millisecond)
+
+	for i, subscriber := range subscribers {
+		assert.Equal(t, int32(numMessages-(numMessages+numNodes-1-i)/numNodes), atomic.LoadInt32(&subscriber.count))
+	}
+
+	var bytesAfter uint64
+	for _, n := range nodes {
+		bytesAfter += n.GetNetwork().GetBytesSent()
+	}
+	return bytesAfter - bytesBefore
+}
+
+func TestNode_GossipBandwidth(t *testing.T) {
+	const numNodes = 10
+	const numMessages = 20
+
+	//a mesh of 10 nodes needs a smaller overlay than the default of gossipsub to save bandwidth
+	d, dlo, dhi := libp2ppubsub.GossipSubD, libp2ppubsub.GossipSubDlo, libp2ppubsub.GossipSubDhi
+	libp2ppubsub.GossipSubD, libp2ppubsub.GossipSubDlo, libp2ppubsub.GossipSubDhi = 3, 2, 4
+	defer func() {
+		libp2ppubsub.GossipSubD, libp2ppubsub.GossipSubDlo, libp2ppubsub.GossipSubDhi = d, dlo, dhi
+	}()
+
+	floodBytes := measureBroadcastBandwidth(t, test_bandwidth_port, numNodes, numMessages, false)
+	gossipBytes := measureBroadcastBandwidth(t, test_bandwidth_port+numNodes, numNodes, numMessages, true)
+	t.Logf("bytes sent to broadcast %d messages to %d nodes: flooding %d, gossip %d", numMessages, numNodes, floodBytes, gossipBytes)
+
+	assert.True(t, gossipBytes < floodBytes)
+}
@@ -435,6 +435,226 @@ func (x *ReturnCommonBlocks) GetBlockHeaders() []*pb.BlockHeader {
 	return nil
 }
 
+type GetBlockHeaders struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartHeight uint64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	Count       uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *GetBlockHeaders) Reset() {
+	*x = GetBlockHeaders{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlockHeaders) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockHeaders) ProtoMessage() {}
+
+func (x *GetBlockHeaders) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockHeaders.ProtoReflect.Descriptor instead.
+func (*GetBlockHeaders) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetBlockHeaders) GetStartHeight() uint64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *GetBlockHeaders) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ReturnBlockHeaders struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartHeight  uint64            `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	BlockHeaders []*pb.BlockHeader `protobuf:"bytes,2,rep,name=block_headers,json=blockHeaders,proto3" json:"block_headers,omitempty"` // Sorted ascending by height.
+	TxsHashes    [][]byte          `protobuf:"bytes,3,rep,name=txs_hashes,json=txsHashes,proto3" json:"txs_hashes,omitempty"`          // The hash of the transactions of each header.
+}
+
+func (x *ReturnBlockHeaders) Reset() {
+	*x = ReturnBlockHeaders{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReturnBlockHeaders) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnBlockHeaders) ProtoMessage() {}
+
+func (x *ReturnBlockHeaders) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnBlockHeaders.ProtoReflect.Descriptor instead.
+func (*ReturnBlockHeaders) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReturnBlockHeaders) GetStartHeight() uint64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *ReturnBlockHeaders) GetBlockHeaders() []*pb.BlockHeader {
+	if x != nil {
+		return x.BlockHeaders
+	}
+	return nil
+}
+
+func (x *ReturnBlockHeaders) GetTxsHashes() [][]byte {
+	if x != nil {
+		return x.TxsHashes
+	}
+	return nil
+}
+
+type GetBlockBodies struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHashes [][]byte `protobuf:"bytes,1,rep,name=block_hashes,json=blockHashes,proto3" json:"block_hashes,omitempty"`
+}
+
+func (x *GetBlockBodies) Reset() {
+	*x = GetBlockBodies{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlockBodies) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockBodies) ProtoMessage() {}
+
+func (x *GetBlockBodies) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockBodies.ProtoReflect.Descriptor instead.
+func (*GetBlockBodies) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetBlockBodies) GetBlockHashes() [][]byte {
+	if x != nil {
+		return x.BlockHashes
+	}
+	return nil
+}
+
+type ReturnBlockBodies struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHashes [][]byte    `protobuf:"bytes,1,rep,name=block_hashes,json=blockHashes,proto3" json:"block_hashes,omitempty"`
+	Blocks      []*pb.Block `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (x *ReturnBlockBodies) Reset() {
+	*x = ReturnBlockBodies{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReturnBlockBodies) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnBlockBodies) ProtoMessage() {}
+
+func (x *ReturnBlockBodies) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnBlockBodies.ProtoReflect.Descriptor instead.
+func (*ReturnBlockBodies) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReturnBlockBodies) GetBlockHashes() [][]byte {
+	if x != nil {
+		return x.BlockHashes
+	}
+	return nil
+}
+
+func (x *ReturnBlockBodies) GetBlocks() []*pb.Block {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
 type GetPeerList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -446,7 +666,7 @@ type GetPeerList struct {
 func (x *GetPeerList) Reset() {
 	*x = GetPeerList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_msgTypes[7]
+		mi := &file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -459,7 +679,7 @@ func (x *GetPeerList) String() string {
 func (*GetPeerList) ProtoMessage() {}
 
 func (x *GetPeerList) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_msgTypes[7]
+	mi := &file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -472,7 +692,7 @@ func (x *GetPeerList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPeerList.ProtoReflect.Descriptor instead.
 func (*GetPeerList) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_rawDescGZIP(), []int{7}
+	return file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetPeerList) GetMaxNumber() int32 {
@@ -493,7 +713,7 @@ type ReturnPeerList struct {
 func (x *ReturnPeerList) Reset() {
 	*x = ReturnPeerList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_msgTypes[8]
+		mi := &file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -506,7 +726,7 @@ func (x *ReturnPeerList) String() string {
 func (*ReturnPeerList) ProtoMessage() {}
 
 func (x *ReturnPeerList) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_msgTypes[8]
+	mi := &file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -519,7 +739,7 @@ func (x *ReturnPeerList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReturnPeerList.ProtoReflect.Descriptor instead.
 func (*ReturnPeerList) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_rawDescGZIP(), []int{8}
+	return file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ReturnPeerList) GetPeerList() []*PeerInfo {
@@ -529,6 +749,85 @@ func (x *ReturnPeerList) GetPeerList() []*PeerInfo {
 	return nil
 }
 
+type Handshake struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolVersion uint32 `protobuf:"varint,1,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	GenesisHash     []byte `protobuf:"bytes,2,opt,name=genesis_hash,json=genesisHash,proto3" json:"genesis_hash,omitempty"`
+	BestHeight      uint64 `protobuf:"varint,3,opt,name=best_height,json=bestHeight,proto3" json:"best_height,omitempty"`
+	LibHeight       uint64 `protobuf:"varint,4,opt,name=lib_height,json=libHeight,proto3" json:"lib_height,omitempty"`
+	Capabilities    uint64 `protobuf:"varint,5,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+}
+
+func (x *Handshake) Reset() {
+	*x = Handshake{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Handshake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Handshake) ProtoMessage() {}
+
+func (x *Handshake) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Handshake.ProtoReflect.Descriptor instead.
+func (*Handshake) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Handshake) GetProtocolVersion() uint32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *Handshake) GetGenesisHash() []byte {
+	if x != nil {
+		return x.GenesisHash
+	}
+	return nil
+}
+
+func (x *Handshake) GetBestHeight() uint64 {
+	if x != nil {
+		return x.BestHeight
+	}
+	return 0
+}
+
+func (x *Handshake) GetLibHeight() uint64 {
+	if x != nil {
+		return x.LibHeight
+	}
+	return 0
+}
+
+func (x *Handshake) GetCapabilities() uint64 {
+	if x != nil {
+		return x.Capabilities
+	}
+	return 0
+}
+
 var File_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto protoreflect.FileDescriptor
 
 var file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_rawDesc = []byte{
@@ -588,14 +887,50 @@ var file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_rawDesc = []byt
 	0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
 	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
 	0x61, 0x64, 0x65, 0x72, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x73, 0x22, 0x2c, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x22, 0x42, 0x0a, 0x0e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x30, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x70,
-	0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72,
-	0x4c, 0x69, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x73, 0x22, 0x4a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x91,
+	0x01, 0x0a, 0x12, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x39, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x78, 0x73, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x78, 0x73, 0x48, 0x61, 0x73, 0x68,
+	0x65, 0x73, 0x22, 0x33, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x6f,
+	0x64, 0x69, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x5e, 0x0a, 0x11, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x6f, 0x64, 0x69, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12,
+	0x26, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
+	0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x2c, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x50, 0x65,
+	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x42, 0x0a, 0x0e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x50,
+	0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x08, 0x70, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xbd, 0x01, 0x0a, 0x09, 0x48, 0x61,
+	0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69,
+	0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x62, 0x65, 0x73, 0x74,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x62, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x69, 0x62, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x63, 0x61, 0x70,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -610,7 +945,7 @@ func file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_rawDescGZIP()
 	return file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_rawDescData
 }
 
-var file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_goTypes = []interface{}{
 	(*DappCmd)(nil),              // 0: networkpb.DappCmd
 	(*GetBlockchainInfo)(nil),    // 1: networkpb.GetBlockchainInfo
@@ -619,22 +954,29 @@ var file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_goTypes = []int
 	(*ReturnBlocks)(nil),         // 4: networkpb.ReturnBlocks
 	(*GetCommonBlocks)(nil),      // 5: networkpb.GetCommonBlocks
 	(*ReturnCommonBlocks)(nil),   // 6: networkpb.ReturnCommonBlocks
-	(*GetPeerList)(nil),          // 7: networkpb.GetPeerList
-	(*ReturnPeerList)(nil),       // 8: networkpb.ReturnPeerList
-	(*pb.Block)(nil),             // 9: blockpb.Block
-	(*pb.BlockHeader)(nil),       // 10: blockpb.BlockHeader
-	(*PeerInfo)(nil),             // 11: networkpb.PeerInfo
+	(*GetBlockHeaders)(nil),      // 7: networkpb.GetBlockHeaders
+	(*ReturnBlockHeaders)(nil),   // 8: networkpb.ReturnBlockHeaders
+	(*GetBlockBodies)(nil),       // 9: networkpb.GetBlockBodies
+	(*ReturnBlockBodies)(nil),    // 10: networkpb.ReturnBlockBodies
+	(*GetPeerList)(nil),          // 11: networkpb.GetPeerList
+	(*ReturnPeerList)(nil),       // 12: networkpb.ReturnPeerList
+	(*Handshake)(nil),            // 13: networkpb.Handshake
+	(*pb.Block)(nil),             // 14: blockpb.Block
+	(*pb.BlockHeader)(nil),       // 15: blockpb.BlockHeader
+	(*PeerInfo)(nil),             // 16: networkpb.PeerInfo
 }
 var file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_depIdxs = []int32{
-	9,  // 0: networkpb.ReturnBlocks.blocks:type_name -> blockpb.Block
-	10, // 1: networkpb.GetCommonBlocks.block_headers:type_name -> blockpb.BlockHeader
-	10, // 2: networkpb.ReturnCommonBlocks.block_headers:type_name -> blockpb.BlockHeader
-	11, // 3: networkpb.ReturnPeerList.peer_list:type_name -> networkpb.PeerInfo
-	4,  // [4:4] is the sub-list for method output_type
-	4,  // [4:4] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	14, // 0: networkpb.ReturnBlocks.blocks:type_name -> blockpb.Block
+	15, // 1: networkpb.GetCommonBlocks.block_headers:type_name -> blockpb.BlockHeader
+	15, // 2: networkpb.ReturnCommonBlocks.block_headers:type_name -> blockpb.BlockHeader
+	15, // 3: networkpb.ReturnBlockHeaders.block_headers:type_name -> blockpb.BlockHeader
+	14, // 4: networkpb.ReturnBlockBodies.blocks:type_name -> blockpb.Block
+	16, // 5: networkpb.ReturnPeerList.peer_list:type_name -> networkpb.PeerInfo
+	6,  // [6:6] is the sub-list for method output_type
+	6,  // [6:6] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_init() }
@@ -729,7 +1071,7 @@ func file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_init() {
 			}
 		}
 		file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPeerList); i {
+			switch v := v.(*GetBlockHeaders); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -741,6 +1083,54 @@ func file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_init() {
 			}
 		}
 		file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReturnBlockHeaders); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockBodies); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReturnBlockBodies); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeerList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReturnPeerList); i {
 			case 0:
 				return &v.state
@@ -752,6 +1142,18 @@ func file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_init() {
 				return nil
 			}
 		}
+		file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Handshake); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -759,7 +1161,7 @@ func file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_dappley_go_dappley_network_pb_dapp_cmd_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
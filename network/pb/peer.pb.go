@@ -107,6 +107,116 @@ type PeerInfo_Latency struct {
 
 func (*PeerInfo_Latency) isPeerInfo_OptionalValue() {}
 
+type BannedPeer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Until  int64  `protobuf:"varint,2,opt,name=until,proto3" json:"until,omitempty"`
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *BannedPeer) Reset() {
+	*x = BannedPeer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_network_pb_peer_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BannedPeer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BannedPeer) ProtoMessage() {}
+
+func (x *BannedPeer) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_network_pb_peer_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BannedPeer.ProtoReflect.Descriptor instead.
+func (*BannedPeer) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_network_pb_peer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BannedPeer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BannedPeer) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *BannedPeer) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type BannedPeerList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BannedPeers []*BannedPeer `protobuf:"bytes,1,rep,name=banned_peers,json=bannedPeers,proto3" json:"banned_peers,omitempty"`
+}
+
+func (x *BannedPeerList) Reset() {
+	*x = BannedPeerList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_network_pb_peer_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BannedPeerList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BannedPeerList) ProtoMessage() {}
+
+func (x *BannedPeerList) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_network_pb_peer_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BannedPeerList.ProtoReflect.Descriptor instead.
+func (*BannedPeerList) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_network_pb_peer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BannedPeerList) GetBannedPeers() []*BannedPeer {
+	if x != nil {
+		return x.BannedPeers
+	}
+	return nil
+}
+
 var File_github_com_dappley_go_dappley_network_pb_peer_proto protoreflect.FileDescriptor
 
 var file_github_com_dappley_go_dappley_network_pb_peer_proto_rawDesc = []byte{
@@ -120,7 +230,17 @@ var file_github_com_dappley_go_dappley_network_pb_peer_proto_rawDesc = []byte{
 	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63,
 	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e,
 	0x63, 0x79, 0x42, 0x10, 0x0a, 0x0e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x4a, 0x0a, 0x0a, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x50, 0x65,
+	0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0x4a, 0x0a, 0x0e, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x38, 0x0a, 0x0c, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52,
+	0x0b, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x50, 0x65, 0x65, 0x72, 0x73, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -135,16 +255,19 @@ func file_github_com_dappley_go_dappley_network_pb_peer_proto_rawDescGZIP() []by
 	return file_github_com_dappley_go_dappley_network_pb_peer_proto_rawDescData
 }
 
-var file_github_com_dappley_go_dappley_network_pb_peer_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_github_com_dappley_go_dappley_network_pb_peer_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_github_com_dappley_go_dappley_network_pb_peer_proto_goTypes = []interface{}{
-	(*PeerInfo)(nil), // 0: networkpb.PeerInfo
+	(*PeerInfo)(nil),       // 0: networkpb.PeerInfo
+	(*BannedPeer)(nil),     // 1: networkpb.BannedPeer
+	(*BannedPeerList)(nil), // 2: networkpb.BannedPeerList
 }
 var file_github_com_dappley_go_dappley_network_pb_peer_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: networkpb.BannedPeerList.banned_peers:type_name -> networkpb.BannedPeer
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_github_com_dappley_go_dappley_network_pb_peer_proto_init() }
@@ -165,6 +288,30 @@ func file_github_com_dappley_go_dappley_network_pb_peer_proto_init() {
 				return nil
 			}
 		}
+		file_github_com_dappley_go_dappley_network_pb_peer_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BannedPeer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_network_pb_peer_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BannedPeerList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_github_com_dappley_go_dappley_network_pb_peer_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*PeerInfo_Latency)(nil),
@@ -175,7 +322,7 @@ func file_github_com_dappley_go_dappley_network_pb_peer_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_dappley_go_dappley_network_pb_peer_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
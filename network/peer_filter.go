@@ -0,0 +1,127 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package network
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/libp2p/go-libp2p-core/control"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+//PeerFilter decides which peers the node connects to. A denied peer is always refused. If the allowlist is not empty,
+//only the peers in it are accepted. It is installed as the connection gater of the host so that the refused peers
+//cannot use any protocol of the node
+type PeerFilter struct {
+	allowlist map[peer.ID]bool
+	denylist  map[peer.ID]bool
+	mutex     sync.RWMutex
+}
+
+//NewPeerFilter creates a PeerFilter that accepts all peers
+func NewPeerFilter() *PeerFilter {
+	return &PeerFilter{
+		allowlist: make(map[peer.ID]bool),
+		denylist:  make(map[peer.ID]bool),
+	}
+}
+
+//IsAllowed returns if the node may connect to the peer
+func (pf *PeerFilter) IsAllowed(peerId peer.ID) bool {
+	pf.mutex.RLock()
+	defer pf.mutex.RUnlock()
+
+	if pf.denylist[peerId] {
+		return false
+	}
+	return len(pf.allowlist) == 0 || pf.allowlist[peerId]
+}
+
+//SetAllowlist replaces the allowlist. An empty allowlist accepts all peers that are not denied
+func (pf *PeerFilter) SetAllowlist(peerIds []peer.ID) {
+	pf.mutex.Lock()
+	defer pf.mutex.Unlock()
+	pf.allowlist = toPeerIdSet(peerIds)
+}
+
+//SetDenylist replaces the denylist
+func (pf *PeerFilter) SetDenylist(peerIds []peer.ID) {
+	pf.mutex.Lock()
+	defer pf.mutex.Unlock()
+	pf.denylist = toPeerIdSet(peerIds)
+}
+
+//GetAllowlist returns the peers in the allowlist
+func (pf *PeerFilter) GetAllowlist() []peer.ID {
+	pf.mutex.RLock()
+	defer pf.mutex.RUnlock()
+	return toSortedPeerIds(pf.allowlist)
+}
+
+//GetDenylist returns the peers in the denylist
+func (pf *PeerFilter) GetDenylist() []peer.ID {
+	pf.mutex.RLock()
+	defer pf.mutex.RUnlock()
+	return toSortedPeerIds(pf.denylist)
+}
+
+//InterceptPeerDial refuses to dial a peer that is not allowed
+func (pf *PeerFilter) InterceptPeerDial(peerId peer.ID) bool {
+	return pf.IsAllowed(peerId)
+}
+
+//InterceptAddrDial refuses to dial an address of a peer that is not allowed
+func (pf *PeerFilter) InterceptAddrDial(peerId peer.ID, addr ma.Multiaddr) bool {
+	return pf.IsAllowed(peerId)
+}
+
+//InterceptAccept accepts all inbound connections. The peer is only known after the connection is secured
+func (pf *PeerFilter) InterceptAccept(addrs network.ConnMultiaddrs) bool {
+	return true
+}
+
+//InterceptSecured refuses an inbound or outbound connection once it is known to be from a peer that is not allowed
+func (pf *PeerFilter) InterceptSecured(dir network.Direction, peerId peer.ID, addrs network.ConnMultiaddrs) bool {
+	return pf.IsAllowed(peerId)
+}
+
+//InterceptUpgraded accepts the connections that passed InterceptSecured
+func (pf *PeerFilter) InterceptUpgraded(conn network.Conn) (bool, control.DisconnectReason) {
+	return true, 0
+}
+
+func toPeerIdSet(peerIds []peer.ID) map[peer.ID]bool {
+	set := make(map[peer.ID]bool, len(peerIds))
+	for _, peerId := range peerIds {
+		set[peerId] = true
+	}
+	return set
+}
+
+func toSortedPeerIds(set map[peer.ID]bool) []peer.ID {
+	peerIds := make([]peer.ID, 0, len(set))
+	for peerId := range set {
+		peerIds = append(peerIds, peerId)
+	}
+	sort.Slice(peerIds, func(i, j int) bool { return peerIds[i] < peerIds[j] })
+	return peerIds
+}
@@ -0,0 +1,159 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/network/networkmodel"
+	"github.com/dappley/go-dappley/storage"
+	libp2pnetwork "github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+const peerFilterTestPort = 10540
+
+func TestPeerFilter_IsAllowed(t *testing.T) {
+	peer1, peer2, peer3 := peer.ID("peer1"), peer.ID("peer2"), peer.ID("peer3")
+	pf := NewPeerFilter()
+	assert.True(t, pf.IsAllowed(peer1))
+
+	pf.SetDenylist([]peer.ID{peer1})
+	assert.False(t, pf.IsAllowed(peer1))
+	assert.True(t, pf.IsAllowed(peer2))
+
+	//the allowlist accepts only its peers and the denylist overrides it
+	pf.SetAllowlist([]peer.ID{peer2, peer1})
+	assert.False(t, pf.IsAllowed(peer1))
+	assert.True(t, pf.IsAllowed(peer2))
+	assert.False(t, pf.IsAllowed(peer3))
+	assert.Equal(t, []peer.ID{peer1, peer2}, pf.GetAllowlist())
+	assert.Equal(t, []peer.ID{peer1}, pf.GetDenylist())
+
+	pf.SetAllowlist(nil)
+	pf.SetDenylist(nil)
+	assert.True(t, pf.IsAllowed(peer3))
+	assert.Empty(t, pf.GetAllowlist())
+}
+
+func startPeerFilterTestNodes(t *testing.T, num int, psks []string) ([]*Node, []*storage.RamFileLoader) {
+	var nodes []*Node
+	var rfls []*storage.RamFileLoader
+	for i := 0; i < num; i++ {
+		rfl := storage.NewRamFileLoader(confDir, fmt.Sprintf("peerfilter%d.conf", i))
+		n := NewNode(rfl.File, nil)
+		if len(psks) > i && psks[i] != "" {
+			assert.Nil(t, n.SetPrivateNetworkKey(psks[i]))
+		}
+		assert.Nil(t, n.Start(peerFilterTestPort+len(psks)*10+i, ""))
+		nodes = append(nodes, n)
+		rfls = append(rfls, rfl)
+	}
+	return nodes, rfls
+}
+
+func waitForPeers(n *Node, num int) bool {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if len(n.GetPeers()) == num {
+			return true
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	return false
+}
+
+func TestStreamManager_PeerFilter(t *testing.T) {
+	nodes, rfls := startPeerFilterTestNodes(t, 3, nil)
+	for i, n := range nodes {
+		defer rfls[i].DeleteFolder()
+		defer n.Stop()
+	}
+	peer1, peer2 := nodes[1].GetHostPeerInfo(), nodes[2].GetHostPeerInfo()
+
+	//the node does not connect to a denied peer
+	nodes[0].GetNetwork().SetPeerDenylist([]peer.ID{peer1.PeerId})
+	assert.Equal(t, errval.PeerNotAllowed, nodes[0].GetNetwork().ConnectToSeed(peer1))
+
+	//nor accepts a stream from a peer out of its allowlist
+	nodes[0].GetNetwork().SetPeerDenylist(nil)
+	nodes[0].GetNetwork().SetPeerAllowlist([]peer.ID{peer1.PeerId})
+	nodes[2].GetNetwork().ConnectToSeed(nodes[0].GetHostPeerInfo())
+	assert.True(t, waitForPeers(nodes[2], 0))
+	assert.Equal(t, 0, len(nodes[0].GetPeers()))
+
+	assert.Nil(t, nodes[0].GetNetwork().ConnectToSeed(peer1))
+	assert.True(t, waitForPeers(nodes[0], 1))
+	assert.Equal(t, errval.PeerNotAllowed, nodes[0].GetNetwork().ConnectToSeed(peer2))
+
+	//denying a connected peer disconnects it
+	nodes[0].GetNetwork().SetPeerDenylist([]peer.ID{peer1.PeerId})
+	assert.True(t, waitForPeers(nodes[0], 0))
+}
+
+func TestPeerFilter_ConnectionGater(t *testing.T) {
+	nodes, rfls := startPeerFilterTestNodes(t, 1, nil)
+	defer rfls[0].DeleteFolder()
+	defer nodes[0].Stop()
+	nodeInfo := nodes[0].GetHostPeerInfo()
+	nodeAddrInfo := peer.AddrInfo{ID: nodeInfo.PeerId, Addrs: nodeInfo.Addrs}
+
+	//the hosts do not open the dappley protocol, so only the connection gater can refuse them
+	refusedHost := networkmodel.NewHost(peerFilterTestPort+20, nil, func(s libp2pnetwork.Stream) {})
+	assert.NotNil(t, refusedHost)
+	defer refusedHost.Close()
+	otherHost := networkmodel.NewHost(peerFilterTestPort+21, nil, func(s libp2pnetwork.Stream) {})
+	assert.NotNil(t, otherHost)
+	defer otherHost.Close()
+
+	nodes[0].GetNetwork().SetPeerAllowlist([]peer.ID{otherHost.ID()})
+	assert.NotNil(t, refusedHost.Connect(context.Background(), nodeAddrInfo))
+	assert.Nil(t, otherHost.Connect(context.Background(), nodeAddrInfo))
+
+	//denying a connected peer closes its connection
+	nodes[0].GetNetwork().SetPeerDenylist([]peer.ID{otherHost.ID()})
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) && otherHost.Network().Connectedness(nodeInfo.PeerId) == libp2pnetwork.Connected {
+		time.Sleep(50 * time.Millisecond)
+	}
+	assert.NotEqual(t, libp2pnetwork.Connected, otherHost.Network().Connectedness(nodeInfo.PeerId))
+}
+
+func TestNode_PrivateNetwork(t *testing.T) {
+	psk := "8c2a4e3b6d2f0e1a9b7c5d3f1e0a2b4c6d8e0f1a3b5c7d9e1f2a4b6c8d0e2f4a"
+	otherPsk := "0f1e2d3c4b5a69788796a5b4c3d2e1f00f1e2d3c4b5a69788796a5b4c3d2e1f0"
+	nodes, rfls := startPeerFilterTestNodes(t, 3, []string{psk, psk, otherPsk})
+	for i, n := range nodes {
+		defer rfls[i].DeleteFolder()
+		defer n.Stop()
+	}
+
+	assert.Nil(t, nodes[0].GetNetwork().ConnectToSeed(nodes[1].GetHostPeerInfo()))
+	assert.True(t, waitForPeers(nodes[0], 1))
+	assert.NotNil(t, nodes[0].GetNetwork().ConnectToSeed(nodes[2].GetHostPeerInfo()))
+
+	n := NewNode(nil, nil)
+	assert.Equal(t, errval.InvalidPrivateNetworkKey, n.SetPrivateNetworkKey("0102"))
+	assert.Equal(t, errval.InvalidPrivateNetworkKey, n.SetPrivateNetworkKey("not hex"))
+}
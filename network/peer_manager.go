@@ -26,6 +26,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/dappley/go-dappley/common/clock"
 	"github.com/dappley/go-dappley/common/log"
 	"github.com/dappley/go-dappley/common/pubsub"
 
@@ -69,6 +70,11 @@ type PeerManager struct {
 	netService           NetService
 	peerInfoConf         *storage.FileLoader
 	onPeerListReceivedCb onPeerListReceived
+	onPeerBannedCb       onPeerBanned
+	scores               map[peer.ID]*scoreRecord
+	bannedPeers          map[peer.ID]*BannedPeer
+	bannedPeersConf      *storage.FileLoader
+	clock                clock.Clock
 	mutex                sync.RWMutex
 }
 
@@ -77,6 +83,9 @@ func NewPeerManager(netService NetService, peerinfoConf *storage.FileLoader, onP
 	pm := &PeerManager{
 		seeds:                make(map[peer.ID]networkmodel.PeerInfo),
 		syncPeers:            make(map[peer.ID]networkmodel.PeerInfo),
+		scores:               make(map[peer.ID]*scoreRecord),
+		bannedPeers:          make(map[peer.ID]*BannedPeer),
+		clock:                clock.NewRealClock(),
 		mutex:                sync.RWMutex{},
 		netService:           netService,
 		peerInfoConf:         peerinfoConf,
@@ -203,7 +212,7 @@ func (pm *PeerManager) AddSyncPeer(peer networkmodel.PeerInfo) {
 //addSyncPeer adds a sync peer
 func (pm *PeerManager) addSyncPeer(peer networkmodel.PeerInfo) {
 
-	if pm.isPeerNew(peer.PeerId) {
+	if pm.isPeerNew(peer.PeerId) && !pm.isBanned(peer.PeerId) {
 		pm.syncPeers[peer.PeerId] = peer
 	}
 
@@ -295,10 +304,10 @@ func (pm *PeerManager) startSyncPeersSchedule() {
 		defer log.CrashHandler()
 
 		pm.BroadcastGetPeerListRequest()
-		ticker := time.NewTicker(syncPeersScheduleTime)
+		ticker := pm.clock.NewTicker(syncPeersScheduleTime)
 		for {
 			select {
-			case <-ticker.C:
+			case <-ticker.Chan():
 				pm.BroadcastGetPeerListRequest()
 			}
 		}
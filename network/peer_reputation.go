@@ -0,0 +1,317 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package network
+
+import (
+	"bytes"
+	"sort"
+	"time"
+
+	"github.com/dappley/go-dappley/common/clock"
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/network/networkmodel"
+	networkpb "github.com/dappley/go-dappley/network/pb"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/golang/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	// BanThreshold is the score at or below which a peer is banned
+	BanThreshold = -100
+	// DefaultBanDuration is how long a peer stays banned once its score falls to the threshold
+	DefaultBanDuration = 24 * time.Hour
+	// ScoreRecoveryPerHour is the number of points a peer regains every hour until its score is back at 0
+	ScoreRecoveryPerHour = 10
+)
+
+var (
+	violationPenalties = map[networkmodel.Violation]int{
+		networkmodel.ViolationInvalidBlock:    25,
+		networkmodel.ViolationBadPacket:       50,
+		networkmodel.ViolationInvalidBlocks:   50,
+		networkmodel.ViolationRejectedMessage: 25,
+	}
+)
+
+type onPeerBanned func(peerId peer.ID)
+
+// BannedPeer is a peer that is not allowed to connect until the ban expires
+type BannedPeer struct {
+	PeerId peer.ID
+	Until  time.Time
+	Reason string
+}
+
+// PeerScore is the score of a peer that violated the protocol. Peers start at 0
+type PeerScore struct {
+	PeerId peer.ID
+	Score  int
+}
+
+//scoreRecord is the score of a peer when it was last lowered
+type scoreRecord struct {
+	score   int
+	updated time.Time
+}
+
+//ToProto converts a BannedPeer to its protobuf message
+func (b *BannedPeer) ToProto() proto.Message {
+	return &networkpb.BannedPeer{
+		Id:     peer.IDB58Encode(b.PeerId),
+		Until:  b.Until.Unix(),
+		Reason: b.Reason,
+	}
+}
+
+//FromProto converts a protobuf message to a BannedPeer
+func (b *BannedPeer) FromProto(pb proto.Message) error {
+	peerId, err := peer.IDB58Decode(pb.(*networkpb.BannedPeer).GetId())
+	if err != nil {
+		return err
+	}
+	b.PeerId = peerId
+	b.Until = time.Unix(pb.(*networkpb.BannedPeer).GetUntil(), 0)
+	b.Reason = pb.(*networkpb.BannedPeer).GetReason()
+	return nil
+}
+
+//GetViolationPenalty returns the score that a peer loses for the violation
+func GetViolationPenalty(violation networkmodel.Violation) int {
+	return violationPenalties[violation]
+}
+
+//SetClock sets the clock that decides when bans expire and schedules the peer list requests
+func (pm *PeerManager) SetClock(c clock.Clock) {
+	pm.clock = c
+}
+
+//SetOnPeerBannedCb sets the function that runs when a peer is banned
+func (pm *PeerManager) SetOnPeerBannedCb(cb onPeerBanned) {
+	pm.onPeerBannedCb = cb
+}
+
+//SetBannedPeersConf sets the file that keeps the banned peers and loads the bans from it
+func (pm *PeerManager) SetBannedPeersConf(bannedPeersConf *storage.FileLoader) {
+	pm.mutex.Lock()
+	defer pm.mutex.Unlock()
+
+	pm.bannedPeersConf = bannedPeersConf
+	pm.loadBannedPeers()
+}
+
+// ReportViolation lowers the score of the peer by the penalty of the violation and bans the peer once its score falls
+//to the ban threshold. Scores recover by ScoreRecoveryPerHour, so that only violations close in time lead to a ban
+func (pm *PeerManager) ReportViolation(peerId peer.ID, violation networkmodel.Violation) {
+	if peerId == "" || peerId == pm.hostPeerId {
+		return
+	}
+
+	pm.mutex.Lock()
+	score := pm.getScore(peerId) - GetViolationPenalty(violation)
+	pm.scores[peerId] = &scoreRecord{score, pm.clock.Now()}
+	logger.WithFields(logger.Fields{
+		"peer_id":   peerId,
+		"violation": violation.String(),
+		"score":     score,
+	}).Warn("PeerManager: a peer violated the protocol.")
+
+	banned := score <= BanThreshold && !pm.isBanned(peerId)
+	if banned {
+		pm.ban(peerId, DefaultBanDuration, violation.String())
+	}
+	pm.mutex.Unlock()
+
+	if banned && pm.onPeerBannedCb != nil {
+		pm.onPeerBannedCb(peerId)
+	}
+}
+
+//GetPeerScore returns the score of the peer
+func (pm *PeerManager) GetPeerScore(peerId peer.ID) int {
+	pm.mutex.RLock()
+	defer pm.mutex.RUnlock()
+
+	return pm.getScore(peerId)
+}
+
+//GetPeerScores returns the scores of the peers that violated the protocol and have not recovered yet, lowest first
+func (pm *PeerManager) GetPeerScores() []PeerScore {
+	pm.mutex.RLock()
+	defer pm.mutex.RUnlock()
+
+	scores := []PeerScore{}
+	for peerId := range pm.scores {
+		if score := pm.getScore(peerId); score < 0 {
+			scores = append(scores, PeerScore{peerId, score})
+		}
+	}
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].Score != scores[j].Score {
+			return scores[i].Score < scores[j].Score
+		}
+		return scores[i].PeerId < scores[j].PeerId
+	})
+	return scores
+}
+
+//Ban bans the peer for the duration and disconnects it
+func (pm *PeerManager) Ban(peerId peer.ID, duration time.Duration, reason string) {
+	pm.mutex.Lock()
+	pm.ban(peerId, duration, reason)
+	pm.mutex.Unlock()
+
+	if pm.onPeerBannedCb != nil {
+		pm.onPeerBannedCb(peerId)
+	}
+}
+
+//Unban lifts the ban of the peer and resets its score
+func (pm *PeerManager) Unban(peerId peer.ID) error {
+	pm.mutex.Lock()
+	defer pm.mutex.Unlock()
+
+	if !pm.isBanned(peerId) {
+		return errval.PeerNotFound
+	}
+	delete(pm.bannedPeers, peerId)
+	delete(pm.scores, peerId)
+	pm.saveBannedPeers()
+
+	logger.WithFields(logger.Fields{
+		"peer_id": peerId,
+	}).Info("PeerManager: unbanned a peer.")
+	return nil
+}
+
+//IsBanned returns if the peer is banned
+func (pm *PeerManager) IsBanned(peerId peer.ID) bool {
+	pm.mutex.RLock()
+	defer pm.mutex.RUnlock()
+
+	return pm.isBanned(peerId)
+}
+
+//GetBannedPeers returns the peers whose ban has not expired, in the order of their expiry
+func (pm *PeerManager) GetBannedPeers() []BannedPeer {
+	pm.mutex.RLock()
+	defer pm.mutex.RUnlock()
+
+	bannedPeers := []BannedPeer{}
+	for peerId, bannedPeer := range pm.bannedPeers {
+		if pm.isBanned(peerId) {
+			bannedPeers = append(bannedPeers, *bannedPeer)
+		}
+	}
+	sort.Slice(bannedPeers, func(i, j int) bool {
+		if !bannedPeers[i].Until.Equal(bannedPeers[j].Until) {
+			return bannedPeers[i].Until.Before(bannedPeers[j].Until)
+		}
+		return bannedPeers[i].PeerId < bannedPeers[j].PeerId
+	})
+	return bannedPeers
+}
+
+//ban records the ban and drops the peer from the sync peers
+func (pm *PeerManager) ban(peerId peer.ID, duration time.Duration, reason string) {
+	pm.bannedPeers[peerId] = &BannedPeer{
+		PeerId: peerId,
+		Until:  pm.clock.Now().Add(duration),
+		Reason: reason,
+	}
+	delete(pm.scores, peerId)
+	if _, ok := pm.syncPeers[peerId]; ok {
+		delete(pm.syncPeers, peerId)
+		pm.saveSyncPeers()
+	}
+	pm.saveBannedPeers()
+
+	logger.WithFields(logger.Fields{
+		"peer_id":  peerId,
+		"duration": duration,
+		"reason":   reason,
+	}).Warn("PeerManager: banned a peer.")
+}
+
+//getScore returns the score of the peer after the recovery since it was last lowered
+func (pm *PeerManager) getScore(peerId peer.ID) int {
+	record, ok := pm.scores[peerId]
+	if !ok {
+		return 0
+	}
+	score := record.score + int(pm.clock.Now().Sub(record.updated)/(time.Hour/ScoreRecoveryPerHour))
+	if score > 0 {
+		return 0
+	}
+	return score
+}
+
+//isBanned returns if the peer has a ban that has not expired
+func (pm *PeerManager) isBanned(peerId peer.ID) bool {
+	bannedPeer, ok := pm.bannedPeers[peerId]
+	return ok && pm.clock.Now().Before(bannedPeer.Until)
+}
+
+//saveBannedPeers saves the bans that have not expired to the banned peers file
+func (pm *PeerManager) saveBannedPeers() {
+	if pm.bannedPeersConf == nil {
+		return
+	}
+
+	bannedPeerList := &networkpb.BannedPeerList{}
+	for peerId, bannedPeer := range pm.bannedPeers {
+		if !pm.isBanned(peerId) {
+			continue
+		}
+		bannedPeerList.BannedPeers = append(bannedPeerList.BannedPeers, bannedPeer.ToProto().(*networkpb.BannedPeer))
+	}
+	sort.Slice(bannedPeerList.BannedPeers, func(i, j int) bool {
+		return bannedPeerList.BannedPeers[i].GetId() < bannedPeerList.BannedPeers[j].GetId()
+	})
+
+	var buf bytes.Buffer
+	buf.WriteString(proto.MarshalTextString(bannedPeerList))
+	pm.bannedPeersConf.SaveToFile(buf)
+}
+
+//loadBannedPeers loads the bans that have not expired from the banned peers file
+func (pm *PeerManager) loadBannedPeers() {
+	content, err := pm.bannedPeersConf.ReadFromFile()
+	if err != nil {
+		return
+	}
+
+	bannedPeerList := &networkpb.BannedPeerList{}
+	if err := proto.UnmarshalText(string(content), bannedPeerList); err != nil {
+		logger.WithError(err).Warn("PeerManager: parse banned peers failed.")
+		return
+	}
+
+	for _, bannedPeerPb := range bannedPeerList.GetBannedPeers() {
+		bannedPeer := &BannedPeer{}
+		if err := bannedPeer.FromProto(bannedPeerPb); err != nil {
+			logger.WithError(err).Warn("PeerManager: parse banned peer failed.")
+			continue
+		}
+		pm.bannedPeers[bannedPeer.PeerId] = bannedPeer
+	}
+
+	logger.Infof("PeerManager: load banned peers count %v.", len(pm.bannedPeers))
+}
@@ -0,0 +1,149 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dappley/go-dappley/common/clock"
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/network/networkmodel"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+)
+
+const testPeerId = "QmT5oB6xHSunc64Aojoxa6zg9uH31ajiAVyNfCdBZiwFTV"
+
+func newTestPeerManager(t *testing.T, peerinfoConf *storage.FileLoader) (*PeerManager, *clock.VirtualClock, peer.ID) {
+	peerId, err := peer.IDB58Decode(testPeerId)
+	assert.Nil(t, err)
+	virtualClock := clock.NewVirtualClock(time.Unix(1600000000, 0))
+	pm := NewPeerManager(nil, peerinfoConf, nil, nil)
+	pm.SetClock(virtualClock)
+	return pm, virtualClock, peerId
+}
+
+func TestPeerManager_ReportViolation(t *testing.T) {
+	rfl := storage.NewRamFileLoader(confDir, "peer_reputation.conf")
+	defer rfl.DeleteFolder()
+	pm, _, peerId := newTestPeerManager(t, rfl.File)
+	var bannedPeerId peer.ID
+	pm.SetOnPeerBannedCb(func(peerId peer.ID) { bannedPeerId = peerId })
+	pm.addSyncPeer(networkmodel.PeerInfo{PeerId: peerId})
+
+	pm.ReportViolation(peerId, networkmodel.ViolationInvalidBlock)
+	assert.Equal(t, -GetViolationPenalty(networkmodel.ViolationInvalidBlock), pm.GetPeerScore(peerId))
+	assert.False(t, pm.IsBanned(peerId))
+
+	pm.ReportViolation(peerId, networkmodel.ViolationBadPacket)
+	assert.False(t, pm.IsBanned(peerId))
+	assert.Equal(t, peer.ID(""), bannedPeerId)
+
+	pm.ReportViolation(peerId, networkmodel.ViolationInvalidBlocks)
+	assert.True(t, pm.IsBanned(peerId))
+	assert.Equal(t, peerId, bannedPeerId)
+	assert.Equal(t, 0, pm.GetPeerScore(peerId))
+	assert.NotContains(t, pm.syncPeers, peerId)
+
+	bannedPeers := pm.GetBannedPeers()
+	assert.Equal(t, 1, len(bannedPeers))
+	assert.Equal(t, networkmodel.ViolationInvalidBlocks.String(), bannedPeers[0].Reason)
+
+	pm.addSyncPeer(networkmodel.PeerInfo{PeerId: peerId})
+	assert.NotContains(t, pm.syncPeers, peerId)
+}
+
+func TestPeerManager_ScoreRecovery(t *testing.T) {
+	rfl := storage.NewRamFileLoader(confDir, "peer_reputation.conf")
+	defer rfl.DeleteFolder()
+	pm, virtualClock, peerId := newTestPeerManager(t, rfl.File)
+
+	pm.ReportViolation(peerId, networkmodel.ViolationBadPacket)
+	assert.Equal(t, -50, pm.GetPeerScore(peerId))
+
+	virtualClock.Advance(2 * time.Hour)
+	assert.Equal(t, -50+2*ScoreRecoveryPerHour, pm.GetPeerScore(peerId))
+
+	//violations far apart in time do not add up to a ban
+	for i := 0; i < 4; i++ {
+		virtualClock.Advance(5 * time.Hour)
+		pm.ReportViolation(peerId, networkmodel.ViolationBadPacket)
+	}
+	assert.False(t, pm.IsBanned(peerId))
+
+	//the score does not recover above 0
+	virtualClock.Advance(24 * time.Hour)
+	assert.Equal(t, 0, pm.GetPeerScore(peerId))
+	assert.Empty(t, pm.GetPeerScores())
+}
+
+func TestPeerManager_BanExpiry(t *testing.T) {
+	rfl := storage.NewRamFileLoader(confDir, "peer_reputation.conf")
+	defer rfl.DeleteFolder()
+	pm, virtualClock, peerId := newTestPeerManager(t, rfl.File)
+
+	pm.Ban(peerId, time.Hour, "test")
+	assert.True(t, pm.IsBanned(peerId))
+
+	virtualClock.Advance(time.Hour - time.Second)
+	assert.True(t, pm.IsBanned(peerId))
+
+	virtualClock.Advance(time.Second)
+	assert.False(t, pm.IsBanned(peerId))
+	assert.Empty(t, pm.GetBannedPeers())
+}
+
+func TestPeerManager_Unban(t *testing.T) {
+	rfl := storage.NewRamFileLoader(confDir, "peer_reputation.conf")
+	defer rfl.DeleteFolder()
+	pm, _, peerId := newTestPeerManager(t, rfl.File)
+
+	assert.Equal(t, errval.PeerNotFound, pm.Unban(peerId))
+
+	pm.ReportViolation(peerId, networkmodel.ViolationInvalidBlock)
+	pm.Ban(peerId, time.Hour, "test")
+	assert.Nil(t, pm.Unban(peerId))
+	assert.False(t, pm.IsBanned(peerId))
+	assert.Equal(t, 0, pm.GetPeerScore(peerId))
+	assert.Empty(t, pm.GetPeerScores())
+}
+
+func TestPeerManager_BannedPeersPersistence(t *testing.T) {
+	rfl := storage.NewRamFileLoader(confDir, "peer_reputation.conf")
+	defer rfl.DeleteFolder()
+	bannedRfl := storage.NewRamFileLoader(confDir, "banned_peers.conf")
+	defer bannedRfl.DeleteFolder()
+
+	pm, virtualClock, peerId := newTestPeerManager(t, rfl.File)
+	pm.SetBannedPeersConf(bannedRfl.File)
+	pm.Ban(peerId, time.Hour, "test")
+
+	pm2, _, _ := newTestPeerManager(t, rfl.File)
+	pm2.SetClock(virtualClock)
+	pm2.SetBannedPeersConf(bannedRfl.File)
+	assert.True(t, pm2.IsBanned(peerId))
+	assert.Equal(t, pm.GetBannedPeers(), pm2.GetBannedPeers())
+
+	assert.Nil(t, pm2.Unban(peerId))
+	pm3, _, _ := newTestPeerManager(t, rfl.File)
+	pm3.SetBannedPeersConf(bannedRfl.File)
+	assert.False(t, pm3.IsBanned(peerId))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/dappley/go-dappley/common/clock"
 	"github.com/dappley/go-dappley/common/log"
 	errval "github.com/dappley/go-dappley/errors"
 
@@ -25,6 +26,7 @@ type PingService struct {
 	stop     chan bool
 	interval time.Duration
 	started  bool
+	clock    clock.Clock
 }
 
 //NewPingService returns a new instance of PingService or an error if specified parameters are invalid
@@ -38,9 +40,15 @@ func NewPingService(host host.Host, interval time.Duration) (*PingService, error
 		stop:     make(chan bool),
 		interval: interval,
 		started:  false,
+		clock:    clock.NewRealClock(),
 	}, nil
 }
 
+//SetClock sets the clock that schedules the pings. It should be set before the service starts
+func (ps *PingService) SetClock(c clock.Clock) {
+	ps.clock = c
+}
+
 //Start pings peers specified by getPeers() at PingService.interval invoking a callback with a list of PingResult
 func (ps *PingService) Start(getPeers func() map[peer.ID]networkmodel.PeerInfo, callback func([]*PingResult)) error {
 	if !ps.started {
@@ -48,11 +56,11 @@ func (ps *PingService) Start(getPeers func() map[peer.ID]networkmodel.PeerInfo,
 			defer log.CrashHandler()
 
 			logger.Debug("PingService: Starting ping service...")
-			ticker := time.NewTicker(ps.interval)
+			ticker := ps.clock.NewTicker(ps.interval)
 			defer ticker.Stop()
 			for {
 				select {
-				case <-ticker.C:
+				case <-ticker.Chan():
 					ps.pingPeers(getPeers(), callback)
 				case <-ps.stop:
 					logger.Debug("PingService: Stopping ping service...")
@@ -20,9 +20,12 @@ package network
 
 import (
 	"bufio"
+	"sync"
 
 	errval "github.com/dappley/go-dappley/errors"
 	"github.com/dappley/go-dappley/network/networkmodel"
+	networkpb "github.com/dappley/go-dappley/network/pb"
+	"github.com/golang/protobuf/proto"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/multiformats/go-multiaddr"
@@ -35,6 +38,7 @@ const (
 	highPriorityChLength   = 1024 * 4
 	normalPriorityChLength = 1024 * 4
 	WriteChTotalLength     = highPriorityChLength + normalPriorityChLength
+	compressionThreshold   = 1024
 )
 
 type Stream struct {
@@ -46,6 +50,13 @@ type Stream struct {
 	msgNotifyCh           chan bool
 	quitRdCh              chan bool
 	quitWrCh              chan bool
+	onViolationCb         onViolation
+	onHandshakeCb         OnStreamCbFunc
+	localHandshake        *networkmodel.Handshake
+	remoteHandshake       *networkmodel.Handshake
+	capabilities          networkmodel.Capability
+	stopOnce              sync.Once
+	mutex                 sync.RWMutex
 }
 
 //NewStream creates a new Stream instance
@@ -59,6 +70,13 @@ func NewStream(s network.Stream) *Stream {
 		make(chan bool, WriteChTotalLength),
 		make(chan bool, 1), //two channels to stop
 		make(chan bool, 1),
+		nil,
+		nil,
+		nil,
+		nil,
+		0,
+		sync.Once{},
+		sync.RWMutex{},
 	}
 }
 
@@ -68,6 +86,52 @@ func (s *Stream) GetPeerId() peer.ID { return s.peerInfo.PeerId }
 //GetRemoteAddr returns the remote multi address
 func (s *Stream) GetRemoteAddr() multiaddr.Multiaddr { return s.peerInfo.Addrs[0] }
 
+//SetOnViolationCb sets the function that runs when the peer violates the protocol
+func (s *Stream) SetOnViolationCb(cb onViolation) {
+	s.onViolationCb = cb
+}
+
+//SetOnHandshakeCb sets the function that runs when the peer passes the handshake
+func (s *Stream) SetOnHandshakeCb(cb OnStreamCbFunc) {
+	s.onHandshakeCb = cb
+}
+
+//IsHandshaked returns if the peer has passed the handshake
+func (s *Stream) IsHandshaked() bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.remoteHandshake != nil
+}
+
+//GetRemoteHandshake returns the handshake of the peer; nil if the peer has not passed the handshake
+func (s *Stream) GetRemoteHandshake() *networkmodel.Handshake {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.remoteHandshake
+}
+
+//GetCapabilities returns the optional protocols that both ends of the stream support
+func (s *Stream) GetCapabilities() networkmodel.Capability {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	return s.capabilities
+}
+
+//SendHandshake sends the handshake of the local node. It must be sent before any other command
+func (s *Stream) SendHandshake(handshake *networkmodel.Handshake) {
+	s.mutex.Lock()
+	s.localHandshake = handshake
+	s.mutex.Unlock()
+
+	data, err := proto.Marshal(handshake.ToProto())
+	if err != nil {
+		logger.WithError(err).Error("Stream: Unable to marshal the handshake")
+		return
+	}
+	cmd := networkmodel.NewDappCmd(networkmodel.HandshakeCmd, data, false)
+	s.Send(networkmodel.ConstructDappPacketFromData(cmd.Serialize(), false), networkmodel.HighPriorityCommand)
+}
+
 //Start starts a stream with a peer
 func (s *Stream) Start(quitCh chan<- *Stream, msgRcvCh chan *networkmodel.DappPacketContext) {
 	logger.Info("Stream: Start new stream")
@@ -75,15 +139,18 @@ func (s *Stream) Start(quitCh chan<- *Stream, msgRcvCh chan *networkmodel.DappPa
 	s.startLoop(rw, quitCh, msgRcvCh)
 }
 
-//StopStream stops a stream
+//StopStream stops a stream. The stream is reset rather than closed for writing, so that a read blocked on a silent peer
+//returns. Only the first call stops it; the read that fails on the reset stream stops it again
 func (s *Stream) StopStream() {
-	logger.WithFields(logger.Fields{
-		"peer_address": s.GetRemoteAddr(),
-		"pid":          s.GetPeerId(),
-	}).Info("Stream: A stream is terminated")
-	s.quitRdCh <- true
-	s.quitWrCh <- true
-	s.stream.Close()
+	s.stopOnce.Do(func() {
+		logger.WithFields(logger.Fields{
+			"peer_address": s.GetRemoteAddr(),
+			"pid":          s.GetPeerId(),
+		}).Info("Stream: A stream is terminated")
+		s.quitRdCh <- true
+		s.quitWrCh <- true
+		s.stream.Reset()
+	})
 }
 
 //Send sends a DappPacket to its peer
@@ -165,9 +232,37 @@ func (s *Stream) read(rw *bufio.ReadWriter, msgRcvCh chan *networkmodel.DappPack
 				logger.WithError(err).WithFields(logger.Fields{
 					"num_of_byte_read": n,
 				}).Warn("Stream: Parse packet failed")
+				if s.onViolationCb != nil {
+					s.onViolationCb(s.GetPeerId(), networkmodel.ViolationBadPacket)
+				}
+				s.StopStream()
+				return
+			}
+		}
+
+		length := packet.GetLength()
+		packet, err = packet.Decompress()
+		if err != nil {
+			logger.WithError(err).Warn("Stream: Decompress packet failed")
+			if s.onViolationCb != nil {
+				s.onViolationCb(s.GetPeerId(), networkmodel.ViolationBadPacket)
+			}
+			s.StopStream()
+			return
+		}
+
+		if !s.IsHandshaked() {
+			if err := s.handleHandshake(packet); err != nil {
+				logger.WithError(err).WithFields(logger.Fields{
+					"pid": s.GetPeerId(),
+				}).Warn("Stream: Handshake failed")
 				s.StopStream()
+				return
 			}
+			s.rawByteRead = s.rawByteRead[length:]
+			continue
 		}
+
 		select {
 		case msgRcvCh <- &networkmodel.DappPacketContext{packet, networkmodel.PeerInfo{s.GetPeerId(), []multiaddr.Multiaddr{s.GetRemoteAddr()}, nil}}:
 		default:
@@ -176,9 +271,58 @@ func (s *Stream) read(rw *bufio.ReadWriter, msgRcvCh chan *networkmodel.DappPack
 			}).Warn("Stream: message receive channel full!")
 			return
 		}
-		s.rawByteRead = s.rawByteRead[packet.GetLength():]
+		s.rawByteRead = s.rawByteRead[length:]
+	}
+
+}
+
+//handleHandshake verifies the handshake of the peer and negotiates the capabilities of the stream
+func (s *Stream) handleHandshake(packet *networkmodel.DappPacket) error {
+	cmd := networkmodel.ParseDappMsgFromDappPacket(packet)
+	if cmd.GetName() != networkmodel.HandshakeCmd {
+		return errval.HandshakeExpected
 	}
 
+	handshakePb := &networkpb.Handshake{}
+	if err := proto.Unmarshal(cmd.GetData(), handshakePb); err != nil {
+		return err
+	}
+	remoteHandshake := &networkmodel.Handshake{}
+	remoteHandshake.FromProto(handshakePb)
+
+	s.mutex.Lock()
+	localHandshake := s.localHandshake
+	if localHandshake == nil {
+		localHandshake = networkmodel.NewHandshake(nil, 0, 0)
+	}
+	if err := localHandshake.Verify(remoteHandshake); err != nil {
+		s.mutex.Unlock()
+		return err
+	}
+	s.remoteHandshake = remoteHandshake
+	s.capabilities = localHandshake.Negotiate(remoteHandshake)
+	s.mutex.Unlock()
+
+	logger.WithFields(logger.Fields{
+		"pid":              s.GetPeerId(),
+		"protocol_version": remoteHandshake.ProtocolVersion,
+		"best_height":      remoteHandshake.BestHeight,
+		"lib_height":       remoteHandshake.LIBHeight,
+		"capabilities":     s.GetCapabilities(),
+	}).Info("Stream: Handshake is done")
+
+	if s.onHandshakeCb != nil {
+		s.onHandshakeCb(s)
+	}
+	return nil
+}
+
+//encode compresses a large packet if both ends of the stream support compression
+func (s *Stream) encode(packet *networkmodel.DappPacket) *networkmodel.DappPacket {
+	if packet.GetPacketDataLength() < compressionThreshold || !s.GetCapabilities().Has(networkmodel.CapabilityCompression) {
+		return packet
+	}
+	return packet.Compress()
 }
 
 //readLoop keeps reading from its peer
@@ -215,7 +359,7 @@ func (s *Stream) writeLoop(rw *bufio.ReadWriter) error {
 			select {
 			case packet := <-s.highPriorityWriteCh:
 				t1 := time.Now().UnixNano()
-				n, err := s.stream.Write(packet.GetRawBytes())
+				n, err := s.stream.Write(s.encode(packet).GetRawBytes())
 				cost := (time.Now().UnixNano() - t1) / 1e6
 				logger.Debugf("High priority write cost : %v, peerId: %v", cost, s.GetPeerId())
 				if err != nil {
@@ -230,7 +374,7 @@ func (s *Stream) writeLoop(rw *bufio.ReadWriter) error {
 			select {
 			case packet := <-s.normalPriorityWriteCh:
 				t1 := time.Now().UnixNano()
-				n, err := s.stream.Write(packet.GetRawBytes())
+				n, err := s.stream.Write(s.encode(packet).GetRawBytes())
 				cost := (time.Now().UnixNano() - t1) / 1e6
 				logger.Debugf("Normal priority write cost : %v, peerId: %v", cost, s.GetPeerId())
 				if err != nil {
@@ -6,6 +6,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/dappley/go-dappley/common/clock"
 	"github.com/dappley/go-dappley/common/log"
 	errval "github.com/dappley/go-dappley/errors"
 
@@ -19,6 +20,12 @@ import (
 )
 
 type OnStreamCbFunc func(stream *Stream)
+type isPeerBanned func(peerId peer.ID) bool
+type onViolation func(peerId peer.ID, violation networkmodel.Violation)
+type getHandshake func() *networkmodel.Handshake
+
+//HandshakeTimeout is how long a peer has to pass the handshake before its stream is stopped
+const HandshakeTimeout = 10 * time.Second
 
 type StreamManager struct {
 	host                     *networkmodel.Host
@@ -28,7 +35,12 @@ type StreamManager struct {
 	streamMsgReceiveCh       chan *networkmodel.DappPacketContext
 	onStreamStopCb           OnStreamCbFunc
 	onStreamConnectedCb      OnStreamCbFunc
+	isPeerBannedCb           isPeerBanned
+	onViolationCb            onViolation
+	getHandshakeCb           getHandshake
+	peerFilter               *PeerFilter
 	ping                     *PingService
+	clock                    clock.Clock
 
 	mutex sync.RWMutex
 }
@@ -43,6 +55,8 @@ func NewStreamManager(config networkmodel.PeerConnectionConfig, streamMessageRec
 		streamStopNotificationCh: make(chan *Stream, 10),
 		onStreamStopCb:           onStreamStopCb,
 		onStreamConnectedCb:      onStreamConnectedCb,
+		peerFilter:               NewPeerFilter(),
+		clock:                    clock.NewRealClock(),
 		mutex:                    sync.RWMutex{},
 	}
 }
@@ -53,6 +67,41 @@ func (sm *StreamManager) Start(host *networkmodel.Host) {
 	sm.StartStreamStopListener()
 }
 
+//SetClock sets the clock that times the handshakes and the pings out
+func (sm *StreamManager) SetClock(c clock.Clock) {
+	sm.clock = c
+}
+
+//SetIsPeerBannedCb sets the function that decides whether a peer is refused
+func (sm *StreamManager) SetIsPeerBannedCb(cb isPeerBanned) {
+	sm.isPeerBannedCb = cb
+}
+
+//SetOnViolationCb sets the function that runs when a peer violates the protocol
+func (sm *StreamManager) SetOnViolationCb(cb onViolation) {
+	sm.onViolationCb = cb
+}
+
+//SetGetHandshakeCb sets the function that builds the handshake of the local node
+func (sm *StreamManager) SetGetHandshakeCb(cb getHandshake) {
+	sm.getHandshakeCb = cb
+}
+
+//GetPeerFilter returns the filter of the peers that the node connects to
+func (sm *StreamManager) GetPeerFilter() *PeerFilter { return sm.peerFilter }
+
+//SetPeerAllowlist replaces the allowlist of the peer filter and disconnects the peers that are no longer allowed
+func (sm *StreamManager) SetPeerAllowlist(peerIds []peer.ID) {
+	sm.peerFilter.SetAllowlist(peerIds)
+	sm.disconnectDisallowedPeers()
+}
+
+//SetPeerDenylist replaces the denylist of the peer filter and disconnects the peers that are denied
+func (sm *StreamManager) SetPeerDenylist(peerIds []peer.ID) {
+	sm.peerFilter.SetDenylist(peerIds)
+	sm.disconnectDisallowedPeers()
+}
+
 //GetStreams returns all currently connected streams
 func (sm *StreamManager) GetStreams() map[peer.ID]*StreamInfo { return sm.streams }
 
@@ -84,6 +133,17 @@ func (sm *StreamManager) Broadcast(packet *networkmodel.DappPacket, priority net
 	}
 }
 
+//Multicast sends a DappPacket to the peers indicated by "pids"
+func (sm *StreamManager) Multicast(packet *networkmodel.DappPacket, pids []peer.ID, priority networkmodel.DappCmdPriority) {
+	sm.mutex.RLock()
+	defer sm.mutex.RUnlock()
+	for _, pid := range pids {
+		if streamInfo, ok := sm.streams[pid]; ok {
+			streamInfo.stream.Send(packet, priority)
+		}
+	}
+}
+
 //Unicast sends a DappPacket to a peer indicated by "pid"
 func (sm *StreamManager) Unicast(packet *networkmodel.DappPacket, pid peer.ID, priority networkmodel.DappCmdPriority) {
 	sm.mutex.RLock()
@@ -111,6 +171,22 @@ func (sm *StreamManager) StreamHandler(s network.Stream) {
 		"addr":    stream.GetRemoteAddr(),
 	}).Info("StreamManager: Has received a new stream connection")
 
+	if sm.isBanned(peerId) {
+		logger.WithFields(logger.Fields{
+			"peer_id": peerId,
+		}).Warn("StreamManager: Refused a stream from a banned peer")
+		stream.StopStream()
+		return
+	}
+
+	if !sm.peerFilter.IsAllowed(peerId) {
+		logger.WithFields(logger.Fields{
+			"peer_id": peerId,
+		}).Warn("StreamManager: Refused a stream from a peer that is not allowed")
+		stream.StopStream()
+		return
+	}
+
 	if sm.isStreamConnected(peerId) {
 		logger.Warn("StreamManager: Stream is already connected")
 		stream.StopStream()
@@ -123,11 +199,52 @@ func (sm *StreamManager) StreamHandler(s network.Stream) {
 		return
 	}
 
-	stream.Start(sm.streamStopNotificationCh, sm.streamMsgReceiveCh)
+	stream.SetOnViolationCb(sm.onViolationCb)
+	stream.SetOnHandshakeCb(sm.onStreamConnectedCb)
+	sm.startStream(stream)
 
 	sm.addStream(stream, ConnectionTypeIn)
+}
+
+//startStream sends the handshake of the local node as the first command and starts the stream. The stream is stopped
+//if the peer does not pass the handshake in time
+func (sm *StreamManager) startStream(stream *Stream) {
+	handshake := networkmodel.NewHandshake(nil, 0, 0)
+	if sm.getHandshakeCb != nil {
+		handshake = sm.getHandshakeCb()
+	}
+	stream.SendHandshake(handshake)
+	stream.Start(sm.streamStopNotificationCh, sm.streamMsgReceiveCh)
+
+	timeout := sm.clock.After(HandshakeTimeout)
+	go func() {
+		defer log.CrashHandler()
+
+		<-timeout
+		sm.mutex.RLock()
+		defer sm.mutex.RUnlock()
 
-	sm.onStreamConnectedCb(stream)
+		streamInfo, ok := sm.streams[stream.GetPeerId()]
+		if !ok || streamInfo.stream != stream || stream.IsHandshaked() {
+			return
+		}
+		logger.WithFields(logger.Fields{
+			"peer_id": stream.GetPeerId(),
+		}).Warn("StreamManager: Handshake timed out")
+		stream.StopStream()
+	}()
+}
+
+//GetCapabilities returns the optional protocols that both the local node and the peer support
+func (sm *StreamManager) GetCapabilities(peerId peer.ID) networkmodel.Capability {
+	sm.mutex.RLock()
+	defer sm.mutex.RUnlock()
+
+	streamInfo, ok := sm.streams[peerId]
+	if !ok {
+		return 0
+	}
+	return streamInfo.stream.GetCapabilities()
 }
 
 //StartNewPingService starts pinging connected peers at the specified interval and recording the latency
@@ -144,6 +261,7 @@ func (sm *StreamManager) StartNewPingService(interval time.Duration) error {
 	if err != nil {
 		return err
 	}
+	pingService.SetClock(sm.clock)
 
 	if err := pingService.Start(sm.GetConnectedPeers, func(results []*PingResult) {
 		sm.mutex.Lock()
@@ -184,6 +302,48 @@ func (sm *StreamManager) isStreamConnected(peerId peer.ID) bool {
 	return isConnected
 }
 
+//isBanned returns if the peer is banned from connecting
+func (sm *StreamManager) isBanned(peerId peer.ID) bool {
+	return sm.isPeerBannedCb != nil && sm.isPeerBannedCb(peerId)
+}
+
+//Disconnect stops the stream with a peer
+func (sm *StreamManager) Disconnect(peerId peer.ID) {
+	sm.mutex.RLock()
+	defer sm.mutex.RUnlock()
+
+	streamInfo, ok := sm.streams[peerId]
+	if !ok {
+		return
+	}
+	streamInfo.stream.StopStream()
+}
+
+//disconnectDisallowedPeers stops the streams with the peers that the peer filter refuses and closes their connections,
+//which the connection gater only checks when they are opened
+func (sm *StreamManager) disconnectDisallowedPeers() {
+	sm.mutex.RLock()
+	defer sm.mutex.RUnlock()
+
+	for peerId, streamInfo := range sm.streams {
+		if !sm.peerFilter.IsAllowed(peerId) {
+			logger.WithFields(logger.Fields{
+				"peer_id": peerId,
+			}).Info("StreamManager: Disconnected a peer that is no longer allowed")
+			streamInfo.stream.StopStream()
+		}
+	}
+
+	if sm.host == nil {
+		return
+	}
+	for _, peerId := range sm.host.Network().Peers() {
+		if !sm.peerFilter.IsAllowed(peerId) {
+			sm.host.Network().ClosePeer(peerId)
+		}
+	}
+}
+
 //ConnectPeers connect to multiple peers
 func (sm *StreamManager) ConnectPeers(peers []networkmodel.PeerInfo) {
 
@@ -224,6 +384,14 @@ func (sm *StreamManager) connectPeer(peerInfo networkmodel.PeerInfo, connectionT
 		return nil
 	}
 
+	if sm.isBanned(peerInfo.PeerId) {
+		return errval.PeerBanned
+	}
+
+	if !sm.peerFilter.IsAllowed(peerInfo.PeerId) {
+		return errval.PeerNotAllowed
+	}
+
 	if sm.isStreamConnected(peerInfo.PeerId) {
 		return errval.StreamAlreadyConnected
 	}
@@ -250,7 +418,8 @@ func (sm *StreamManager) connectPeer(peerInfo networkmodel.PeerInfo, connectionT
 		"host_id": sm.host.ID(),
 	}).Info("StreamManager: Connect to a peer")
 
-	stream.Start(sm.streamStopNotificationCh, sm.streamMsgReceiveCh)
+	stream.SetOnViolationCb(sm.onViolationCb)
+	sm.startStream(stream)
 	sm.addStream(stream, connectionType)
 
 	return nil
@@ -19,6 +19,7 @@
 package network
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/dappley/go-dappley/network/networkmodel"
@@ -35,6 +36,13 @@ func TestStream_Send(t *testing.T) {
 		make(chan bool, WriteChTotalLength),
 		make(chan bool, 1), //two channels to stop
 		make(chan bool, 1),
+		nil,
+		nil,
+		nil,
+		nil,
+		0,
+		sync.Once{},
+		sync.RWMutex{},
 	}
 
 	data1 := networkmodel.ConstructDappPacketFromData([]byte("data1"), networkmodel.Unicast)
@@ -43,7 +43,7 @@ func FakeNodeWithPidAndAddr(peerinfoConf *storage.FileLoader, pid, addr string)
 	peerid, _ := peer.IDB58Decode(pid)
 	maddr, _ := ma.NewMultiaddr(addr)
 	peerInfo := networkmodel.PeerInfo{PeerId: peerid, Addrs: []ma.Multiaddr{maddr}}
-	node.network.streamManager.host = &networkmodel.Host{nil, peerInfo}
+	node.network.streamManager.host = &networkmodel.Host{Info: peerInfo}
 
 	return node
 }
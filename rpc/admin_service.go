@@ -39,6 +39,7 @@ import (
 	networkpb "github.com/dappley/go-dappley/network/pb"
 	rpcpb "github.com/dappley/go-dappley/rpc/pb"
 	"github.com/dappley/go-dappley/wallet"
+	"github.com/libp2p/go-libp2p-core/peer"
 )
 
 type AdminRpcService struct {
@@ -74,8 +75,6 @@ func (adminRpcService *AdminRpcService) RpcChangeProducer(ctx context.Context, i
 			return nil, status.Error(codes.Unknown, err.Error())
 		}
 	}
-
-	logic.ChangeProducers(addresses, height, adminRpcService.bm, int(kind))
 	return &rpcpb.ChangeProducerResponse{}, nil
 }
 
@@ -86,6 +85,84 @@ func (adminRpcService *AdminRpcService) RpcGetPeerInfo(ctx context.Context, in *
 	}, nil
 }
 
+func (adminRpcService *AdminRpcService) RpcGetBannedPeers(ctx context.Context, in *rpcpb.GetBannedPeersRequest) (*rpcpb.GetBannedPeersResponse, error) {
+	peerManager := adminRpcService.node.GetNetwork().GetPeerManager()
+
+	resp := &rpcpb.GetBannedPeersResponse{}
+	for _, bannedPeer := range peerManager.GetBannedPeers() {
+		resp.BannedPeers = append(resp.BannedPeers, bannedPeer.ToProto().(*networkpb.BannedPeer))
+	}
+	for _, peerScore := range peerManager.GetPeerScores() {
+		resp.PeerScores = append(resp.PeerScores, &rpcpb.PeerScore{
+			PeerId: peer.IDB58Encode(peerScore.PeerId),
+			Score:  int64(peerScore.Score),
+		})
+	}
+	return resp, nil
+}
+
+func (adminRpcService *AdminRpcService) RpcBanPeer(ctx context.Context, in *rpcpb.BanPeerRequest) (*rpcpb.BanPeerResponse, error) {
+	peerId, err := peer.IDB58Decode(in.GetPeerId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if in.GetDuration() < 0 {
+		return nil, status.Error(codes.InvalidArgument, errval.InvalidBanDuration.Error())
+	}
+
+	duration := network.DefaultBanDuration
+	if in.GetDuration() > 0 {
+		duration = time.Duration(in.GetDuration()) * time.Second
+	}
+	adminRpcService.node.GetNetwork().GetPeerManager().Ban(peerId, duration, "banned by admin")
+	return &rpcpb.BanPeerResponse{}, nil
+}
+
+func (adminRpcService *AdminRpcService) RpcUnbanPeer(ctx context.Context, in *rpcpb.UnbanPeerRequest) (*rpcpb.UnbanPeerResponse, error) {
+	peerId, err := peer.IDB58Decode(in.GetPeerId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := adminRpcService.node.GetNetwork().GetPeerManager().Unban(peerId); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &rpcpb.UnbanPeerResponse{}, nil
+}
+
+func (adminRpcService *AdminRpcService) RpcGetPeerFilter(ctx context.Context, in *rpcpb.GetPeerFilterRequest) (*rpcpb.GetPeerFilterResponse, error) {
+	peerFilter := adminRpcService.node.GetNetwork().GetPeerFilter()
+
+	resp := &rpcpb.GetPeerFilterResponse{}
+	for _, peerId := range peerFilter.GetAllowlist() {
+		resp.Allowlist = append(resp.Allowlist, peer.IDB58Encode(peerId))
+	}
+	for _, peerId := range peerFilter.GetDenylist() {
+		resp.Denylist = append(resp.Denylist, peer.IDB58Encode(peerId))
+	}
+	return resp, nil
+}
+
+func (adminRpcService *AdminRpcService) RpcSetPeerAllowlist(ctx context.Context, in *rpcpb.SetPeerAllowlistRequest) (*rpcpb.SetPeerAllowlistResponse, error) {
+	peerIds, err := network.DecodePeerIds(in.GetPeerIds())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	adminRpcService.node.GetNetwork().SetPeerAllowlist(peerIds)
+	return &rpcpb.SetPeerAllowlistResponse{}, nil
+}
+
+func (adminRpcService *AdminRpcService) RpcSetPeerDenylist(ctx context.Context, in *rpcpb.SetPeerDenylistRequest) (*rpcpb.SetPeerDenylistResponse, error) {
+	peerIds, err := network.DecodePeerIds(in.GetPeerIds())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	adminRpcService.node.GetNetwork().SetPeerDenylist(peerIds)
+	return &rpcpb.SetPeerDenylistResponse{}, nil
+}
+
 func (adminRpcService *AdminRpcService) RpcSend(ctx context.Context, in *rpcpb.SendRequest) (*rpcpb.SendResponse, error) {
 	start := time.Now().UnixNano() / 1e6
 	txRequestStats.concurrentCounter.Inc(1)
@@ -58,15 +58,15 @@ type Server struct {
 	node          *network.Node
 	password      string
 	bm            *lblockchain.BlockchainManager
-	dpos          *consensus.DPOS
+	dpos          consensus.Engine
 	metricsConfig *MetricsServiceConfig
 }
 
-func NewGrpcServer(node *network.Node, bm *lblockchain.BlockchainManager, dpos *consensus.DPOS, adminPassword string) *Server {
+func NewGrpcServer(node *network.Node, bm *lblockchain.BlockchainManager, dpos consensus.Engine, adminPassword string) *Server {
 	return NewGrpcServerWithMetrics(node, bm, adminPassword, dpos, nil)
 }
 
-func NewGrpcServerWithMetrics(node *network.Node, bm *lblockchain.BlockchainManager, adminPassword string, dpos *consensus.DPOS, config *MetricsServiceConfig) *Server {
+func NewGrpcServerWithMetrics(node *network.Node, bm *lblockchain.BlockchainManager, adminPassword string, dpos consensus.Engine, config *MetricsServiceConfig) *Server {
 	return &Server{
 		grpc.NewServer(),
 		node,
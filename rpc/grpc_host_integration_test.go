@@ -85,7 +85,7 @@ func CreateProducer(producerAddr, addr account.Address, db storage.Storage, txPo
 	consensus.On("Validate", mock.Anything).Return(true)
 	consensus.On("ChangeDynasty", mock.Anything).Return(true)
 	consensus.On("SetDynasty", mock.Anything).Return(true)
-	consensus.On("AddReplacement", mock.Anything).Return(true)
+	consensus.On("GetDynasty").Return(nil)
 
 	bc := lblockchain.CreateBlockchain(addr, db, libPolicy, txPool, 100000)
 	bm := lblockchain.NewBlockchainManager(bc, blockchain.NewBlockPool(nil), node, consensus)
@@ -789,7 +789,7 @@ func TestRpcSendTransaction(t *testing.T) {
 	c := rpcpb.NewRpcServiceClient(conn)
 
 	pubKeyHash := rpcContext.account.GetPubKeyHash()
-	utxos, err := lutxo.NewUTXOIndex(rpcContext.bm.Getblockchain().GetUtxoCache()).GetUTXOsAccordingToAmount(pubKeyHash, common.NewAmount(6))
+	utxos, err := lutxo.NewUTXOIndex(rpcContext.bm.Getblockchain().GetUtxoCache()).GetUTXOsAccordingToAmount(pubKeyHash, common.NewAmount(6), 0)
 	assert.Nil(t, err)
 
 	sendTxParam := transaction.NewSendTxParam(rpcContext.account.GetAddress(),
@@ -809,7 +809,7 @@ func TestRpcSendTransaction(t *testing.T) {
 	for (rpcContext.bm.Getblockchain().GetMaxHeight() - maxHeight) < 2 {
 	}
 
-	utxos2, err := lutxo.NewUTXOIndex(rpcContext.bm.Getblockchain().GetUtxoCache()).GetUTXOsAccordingToAmount(pubKeyHash, common.NewAmount(6))
+	utxos2, err := lutxo.NewUTXOIndex(rpcContext.bm.Getblockchain().GetUtxoCache()).GetUTXOsAccordingToAmount(pubKeyHash, common.NewAmount(6), 0)
 	sendTxParam2 := transaction.NewSendTxParam(rpcContext.account.GetAddress(),
 		rpcContext.account.GetKeyPair(),
 		receiverAccount.GetAddress(),
@@ -882,7 +882,7 @@ func TestRpcService_RpcSendBatchTransaction(t *testing.T) {
 
 	pubKeyHash := rpcContext.account.GetPubKeyHash()
 	utxoIndex := lutxo.NewUTXOIndex(rpcContext.bm.Getblockchain().GetUtxoCache())
-	utxos, err := utxoIndex.GetUTXOsAccordingToAmount(pubKeyHash, common.NewAmount(3))
+	utxos, err := utxoIndex.GetUTXOsAccordingToAmount(pubKeyHash, common.NewAmount(3), 0)
 	assert.Nil(t, err)
 
 	sendTxParam1 := transaction.NewSendTxParam(rpcContext.account.GetAddress(),
@@ -895,7 +895,7 @@ func TestRpcService_RpcSendBatchTransaction(t *testing.T) {
 		"")
 	transaction1, err := ltransaction.NewNormalUTXOTransaction(utxos, sendTxParam1)
 	utxoIndex.UpdateUtxos([]*transaction.Transaction{&transaction1})
-	utxos, err = utxoIndex.GetUTXOsAccordingToAmount(pubKeyHash, common.NewAmount(2))
+	utxos, err = utxoIndex.GetUTXOsAccordingToAmount(pubKeyHash, common.NewAmount(2), 0)
 	sendTxParam2 := transaction.NewSendTxParam(rpcContext.account.GetAddress(),
 		rpcContext.account.GetKeyPair(),
 		receiverAccount2.GetAddress(),
@@ -907,7 +907,7 @@ func TestRpcService_RpcSendBatchTransaction(t *testing.T) {
 	transaction2, err := ltransaction.NewNormalUTXOTransaction(utxos, sendTxParam2)
 	utxoIndex.UpdateUtxos([]*transaction.Transaction{&transaction2})
 	pubKeyHash1 := receiverAccount1.GetPubKeyHash()
-	utxos, err = utxoIndex.GetUTXOsAccordingToAmount(pubKeyHash1, common.NewAmount(1))
+	utxos, err = utxoIndex.GetUTXOsAccordingToAmount(pubKeyHash1, common.NewAmount(1), 0)
 	sendTxParam3 := transaction.NewSendTxParam(receiverAccount1.GetAddress(),
 		receiverAccount1.GetKeyPair(),
 		receiverAccount2.GetAddress(),
@@ -933,7 +933,7 @@ func TestRpcService_RpcSendBatchTransaction(t *testing.T) {
 	rpcContext.bp.Stop()
 	time.Sleep(time.Second)
 
-	utxos2, err := utxoIndex.GetUTXOsAccordingToAmount(pubKeyHash, common.NewAmount(3))
+	utxos2, err := utxoIndex.GetUTXOsAccordingToAmount(pubKeyHash, common.NewAmount(3), 0)
 	sendTxParamErr := transaction.NewSendTxParam(rpcContext.account.GetAddress(),
 		rpcContext.account.GetKeyPair(),
 		receiverAccount4.GetAddress(),
@@ -1108,7 +1108,7 @@ func TestRpcGetAllTransactionsFromTxPool(t *testing.T) {
 
 	// generate new transaction
 	pubKeyHash := rpcContext.account.GetPubKeyHash()
-	utxos, err := lutxo.NewUTXOIndex(rpcContext.bm.Getblockchain().GetUtxoCache()).GetUTXOsAccordingToAmount(pubKeyHash, common.NewAmount(6))
+	utxos, err := lutxo.NewUTXOIndex(rpcContext.bm.Getblockchain().GetUtxoCache()).GetUTXOsAccordingToAmount(pubKeyHash, common.NewAmount(6), 0)
 	assert.Nil(t, err)
 
 	sendTxParam := transaction.NewSendTxParam(rpcContext.account.GetAddress(),
@@ -1345,7 +1345,7 @@ func TestRpcService_RpcEstimateGas(t *testing.T) {
 	// estimate contract
 	contract = "{\"function\":\"record\",\"args\":[\"damnkW1X8KtnDLoKErLzAgaBtXDZKRywfF\",\"2000\"]}"
 	pubKeyHash := senderAccount.GetPubKeyHash()
-	utxos, err := lutxo.NewUTXOIndex(bm.Getblockchain().GetUtxoCache()).GetUTXOsAccordingToAmount(pubKeyHash, common.NewAmount(1))
+	utxos, err := lutxo.NewUTXOIndex(bm.Getblockchain().GetUtxoCache()).GetUTXOsAccordingToAmount(pubKeyHash, common.NewAmount(1), 0)
 	sendTxParam := transaction.NewSendTxParam(senderAccount.GetAddress(),
 		senderAccount.GetKeyPair(),
 		account.NewAddress(contractAddr),
@@ -1424,6 +1424,12 @@ func TestRpcService_RpcGasPrice(t *testing.T) {
 
 	assert.True(t, price.Cmp(common.NewAmount(0)) > 0)
 
+	suggestion := gasPriceResponse.GetSuggestion()
+	assert.NotNil(t, suggestion)
+	assert.Equal(t, gasPrice, suggestion.GetMedianGasPrice())
+	assert.True(t, common.NewAmountFromBytes(suggestion.GetLowGasPrice()).Cmp(price) <= 0)
+	assert.True(t, common.NewAmountFromBytes(suggestion.GetHighGasPrice()).Cmp(price) >= 0)
+
 	logic.RemoveAccountTestFile()
 }
 
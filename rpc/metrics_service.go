@@ -25,13 +25,13 @@ import (
 type MetricsService struct {
 	node *network.Node
 	bm   *lblockchain.BlockchainManager
-	dpos *consensus.DPOS
+	dpos consensus.Engine
 	ds   *metrics.DataStore
 	*MetricsServiceConfig
 	RPCPort uint32
 }
 
-func NewMetricsService(node *network.Node, bm *lblockchain.BlockchainManager, dpos *consensus.DPOS, config *MetricsServiceConfig, RPCPort uint32) *MetricsService {
+func NewMetricsService(node *network.Node, bm *lblockchain.BlockchainManager, dpos consensus.Engine, config *MetricsServiceConfig, RPCPort uint32) *MetricsService {
 	return (&MetricsService{node: node, bm: bm, dpos: dpos, MetricsServiceConfig: config, RPCPort: RPCPort}).init()
 }
 
@@ -64,32 +64,37 @@ func (ms *MetricsService) RpcGetStats(ctx context.Context, request *rpcpb.Metric
 	}, nil
 }
 
+// RpcGetProducerStats returns the blocks produced, missed and orphaned by each producer
+func (ms *MetricsService) RpcGetProducerStats(ctx context.Context, request *rpcpb.MetricsServiceRequest) (*rpcpb.GetProducerStatsResponse, error) {
+	response := &rpcpb.GetProducerStatsResponse{}
+	for _, stats := range ms.bm.GetProducerStats() {
+		response.Stats = append(response.Stats, &rpcpb.ProducerStats{
+			Address:           stats.Address,
+			Produced:          stats.Produced,
+			Missed:            stats.Missed,
+			Orphaned:          stats.Orphaned,
+			MeanLatency:       stats.MeanLatency(),
+			ConsecutiveMissed: stats.ConsecutiveMissed,
+		})
+	}
+	return response, nil
+}
+
 func (ms *MetricsService) RpcGetNodeConfig(ctx context.Context, request *rpcpb.MetricsServiceRequest) (*rpcpb.GetNodeConfigResponse, error) {
 	return ms.getNodeConfig(), nil
 }
 
+// RpcSetNodeConfig updates the local configuration of the node. The block size limit and the producers are chain
+// parameters that every node must agree on; they are only changed by governance proposals
 func (ms *MetricsService) RpcSetNodeConfig(ctx context.Context, request *rpcpb.SetNodeConfigRequest) (*rpcpb.GetNodeConfigResponse, error) {
 	for _, v := range request.GetUpdatedConfigs() {
 		if !util.InProtoEnum("rpcpb.SetNodeConfigRequest_ConfigType", v.String()) {
 			return nil, status.Error(codes.InvalidArgument, "unrecognized node configuration type")
 		}
 
-		if v == rpcpb.SetNodeConfigRequest_MAX_PRODUCERS || v == rpcpb.SetNodeConfigRequest_PRODUCERS {
-			if ms.dpos == nil {
-				return nil, status.Error(codes.InvalidArgument, "producer properties are only supported for DPOS Consensus")
-			}
-
-			if v == rpcpb.SetNodeConfigRequest_PRODUCERS {
-				maxProducers := ms.dpos.GetDynasty().GetMaxProducers()
-				for _, v := range request.GetUpdatedConfigs() {
-					if v == rpcpb.SetNodeConfigRequest_MAX_PRODUCERS {
-						maxProducers = int(request.GetMaxProducers())
-					}
-				}
-				if err := ms.dpos.GetDynasty().IsSettingProducersAllowed(request.GetProducers(), maxProducers); err != nil {
-					return nil, status.Error(codes.InvalidArgument, err.Error())
-				}
-			}
+		switch v {
+		case rpcpb.SetNodeConfigRequest_BLK_SIZE_LIMIT, rpcpb.SetNodeConfigRequest_MAX_PRODUCERS, rpcpb.SetNodeConfigRequest_PRODUCERS:
+			return nil, status.Error(codes.InvalidArgument, v.String()+" is a chain parameter and can only be changed by a governance proposal")
 		}
 	}
 
@@ -97,16 +102,10 @@ func (ms *MetricsService) RpcSetNodeConfig(ctx context.Context, request *rpcpb.S
 		switch v {
 		case rpcpb.SetNodeConfigRequest_TX_POOL_LIMIT:
 			ms.bm.Getblockchain().GetTxPool().SetSizeLimit(request.GetTxPoolLimit())
-		case rpcpb.SetNodeConfigRequest_BLK_SIZE_LIMIT:
-			ms.bm.Getblockchain().SetBlockSizeLimit(int(request.GetBlkSizeLimit()))
 		case rpcpb.SetNodeConfigRequest_MAX_CONN_OUT:
 			ms.node.GetNetwork().GetStreamManager().GetConnectionManager().SetMaxConnectionOutCount(int(request.GetMaxConnectionOut()))
 		case rpcpb.SetNodeConfigRequest_MAX_CONN_IN:
 			ms.node.GetNetwork().GetStreamManager().GetConnectionManager().SetMaxConnectionInCount(int(request.GetMaxConnectionIn()))
-		case rpcpb.SetNodeConfigRequest_MAX_PRODUCERS:
-			ms.dpos.GetDynasty().SetMaxProducers(int(request.GetMaxProducers()))
-		case rpcpb.SetNodeConfigRequest_PRODUCERS:
-			ms.dpos.GetDynasty().SetProducers(request.GetProducers())
 		}
 	}
 	return ms.getNodeConfig(), nil
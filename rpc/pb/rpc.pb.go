@@ -9,9 +9,11 @@ package rpcpb
 import (
 	context "context"
 	pb3 "github.com/dappley/go-dappley/core/block/pb"
+	pb4 "github.com/dappley/go-dappley/core/finality/pb"
 	pb "github.com/dappley/go-dappley/core/transaction/pb"
 	pb2 "github.com/dappley/go-dappley/core/utxo/pb"
-	pb4 "github.com/dappley/go-dappley/metrics/pb"
+	pb6 "github.com/dappley/go-dappley/logic/lightclient/pb"
+	pb5 "github.com/dappley/go-dappley/metrics/pb"
 	pb1 "github.com/dappley/go-dappley/network/pb"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -84,7 +86,7 @@ func (x SetNodeConfigRequest_ConfigType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SetNodeConfigRequest_ConfigType.Descriptor instead.
 func (SetNodeConfigRequest_ConfigType) EnumDescriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{46, 0}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{70, 0}
 }
 
 type CreateAccountRequest struct {
@@ -525,16 +527,14 @@ func (x *AddPeerRequest) GetFullAddress() string {
 	return ""
 }
 
-type GetVersionRequest struct {
+type GetBannedPeersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ProtoVersion string `protobuf:"bytes,1,opt,name=proto_version,json=protoVersion,proto3" json:"proto_version,omitempty"`
 }
 
-func (x *GetVersionRequest) Reset() {
-	*x = GetVersionRequest{}
+func (x *GetBannedPeersRequest) Reset() {
+	*x = GetBannedPeersRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -542,13 +542,13 @@ func (x *GetVersionRequest) Reset() {
 	}
 }
 
-func (x *GetVersionRequest) String() string {
+func (x *GetBannedPeersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVersionRequest) ProtoMessage() {}
+func (*GetBannedPeersRequest) ProtoMessage() {}
 
-func (x *GetVersionRequest) ProtoReflect() protoreflect.Message {
+func (x *GetBannedPeersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -560,28 +560,22 @@ func (x *GetVersionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVersionRequest.ProtoReflect.Descriptor instead.
-func (*GetVersionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBannedPeersRequest.ProtoReflect.Descriptor instead.
+func (*GetBannedPeersRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetVersionRequest) GetProtoVersion() string {
-	if x != nil {
-		return x.ProtoVersion
-	}
-	return ""
-}
-
-type GetUTXORequest struct {
+type BanPeerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` //account address
+	PeerId   string `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Duration int64  `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"` // ban duration in seconds, the default duration if 0
 }
 
-func (x *GetUTXORequest) Reset() {
-	*x = GetUTXORequest{}
+func (x *BanPeerRequest) Reset() {
+	*x = BanPeerRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -589,13 +583,13 @@ func (x *GetUTXORequest) Reset() {
 	}
 }
 
-func (x *GetUTXORequest) String() string {
+func (x *BanPeerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUTXORequest) ProtoMessage() {}
+func (*BanPeerRequest) ProtoMessage() {}
 
-func (x *GetUTXORequest) ProtoReflect() protoreflect.Message {
+func (x *BanPeerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -607,29 +601,35 @@ func (x *GetUTXORequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUTXORequest.ProtoReflect.Descriptor instead.
-func (*GetUTXORequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BanPeerRequest.ProtoReflect.Descriptor instead.
+func (*BanPeerRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetUTXORequest) GetAddress() string {
+func (x *BanPeerRequest) GetPeerId() string {
 	if x != nil {
-		return x.Address
+		return x.PeerId
 	}
 	return ""
 }
 
-type GetUTXOWithAmountRequest struct {
+func (x *BanPeerRequest) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+type UnbanPeerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` //account address
-	Amount  uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`  // Amount requested, UTXOs returned will be the minimum number needed to reach this amount
+	PeerId string `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
 }
 
-func (x *GetUTXOWithAmountRequest) Reset() {
-	*x = GetUTXOWithAmountRequest{}
+func (x *UnbanPeerRequest) Reset() {
+	*x = UnbanPeerRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -637,13 +637,13 @@ func (x *GetUTXOWithAmountRequest) Reset() {
 	}
 }
 
-func (x *GetUTXOWithAmountRequest) String() string {
+func (x *UnbanPeerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUTXOWithAmountRequest) ProtoMessage() {}
+func (*UnbanPeerRequest) ProtoMessage() {}
 
-func (x *GetUTXOWithAmountRequest) ProtoReflect() protoreflect.Message {
+func (x *UnbanPeerRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -655,36 +655,26 @@ func (x *GetUTXOWithAmountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUTXOWithAmountRequest.ProtoReflect.Descriptor instead.
-func (*GetUTXOWithAmountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnbanPeerRequest.ProtoReflect.Descriptor instead.
+func (*UnbanPeerRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetUTXOWithAmountRequest) GetAddress() string {
+func (x *UnbanPeerRequest) GetPeerId() string {
 	if x != nil {
-		return x.Address
+		return x.PeerId
 	}
 	return ""
 }
 
-func (x *GetUTXOWithAmountRequest) GetAmount() uint64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-type GetBlocksRequest struct {
+type GetPeerFilterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	StartBlockHashes [][]byte `protobuf:"bytes,1,rep,name=start_block_hashes,json=startBlockHashes,proto3" json:"start_block_hashes,omitempty"` // Query start block hashes, sorted descending by height.
-	MaxCount         int32    `protobuf:"varint,2,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`                          // Max query block count
 }
 
-func (x *GetBlocksRequest) Reset() {
-	*x = GetBlocksRequest{}
+func (x *GetPeerFilterRequest) Reset() {
+	*x = GetPeerFilterRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -692,13 +682,13 @@ func (x *GetBlocksRequest) Reset() {
 	}
 }
 
-func (x *GetBlocksRequest) String() string {
+func (x *GetPeerFilterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlocksRequest) ProtoMessage() {}
+func (*GetPeerFilterRequest) ProtoMessage() {}
 
-func (x *GetBlocksRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPeerFilterRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -710,35 +700,21 @@ func (x *GetBlocksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlocksRequest.ProtoReflect.Descriptor instead.
-func (*GetBlocksRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPeerFilterRequest.ProtoReflect.Descriptor instead.
+func (*GetPeerFilterRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetBlocksRequest) GetStartBlockHashes() [][]byte {
-	if x != nil {
-		return x.StartBlockHashes
-	}
-	return nil
-}
-
-func (x *GetBlocksRequest) GetMaxCount() int32 {
-	if x != nil {
-		return x.MaxCount
-	}
-	return 0
-}
-
-type GetBlockByHashRequest struct {
+type SetPeerAllowlistRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	PeerIds []string `protobuf:"bytes,1,rep,name=peer_ids,json=peerIds,proto3" json:"peer_ids,omitempty"` // replaces the allowlist. All peers that are not denied are allowed if it is empty
 }
 
-func (x *GetBlockByHashRequest) Reset() {
-	*x = GetBlockByHashRequest{}
+func (x *SetPeerAllowlistRequest) Reset() {
+	*x = SetPeerAllowlistRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -746,13 +722,13 @@ func (x *GetBlockByHashRequest) Reset() {
 	}
 }
 
-func (x *GetBlockByHashRequest) String() string {
+func (x *SetPeerAllowlistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlockByHashRequest) ProtoMessage() {}
+func (*SetPeerAllowlistRequest) ProtoMessage() {}
 
-func (x *GetBlockByHashRequest) ProtoReflect() protoreflect.Message {
+func (x *SetPeerAllowlistRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -764,28 +740,28 @@ func (x *GetBlockByHashRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlockByHashRequest.ProtoReflect.Descriptor instead.
-func (*GetBlockByHashRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetPeerAllowlistRequest.ProtoReflect.Descriptor instead.
+func (*SetPeerAllowlistRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *GetBlockByHashRequest) GetHash() []byte {
+func (x *SetPeerAllowlistRequest) GetPeerIds() []string {
 	if x != nil {
-		return x.Hash
+		return x.PeerIds
 	}
 	return nil
 }
 
-type GetBlockByHeightRequest struct {
+type SetPeerDenylistRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	PeerIds []string `protobuf:"bytes,1,rep,name=peer_ids,json=peerIds,proto3" json:"peer_ids,omitempty"` // replaces the denylist
 }
 
-func (x *GetBlockByHeightRequest) Reset() {
-	*x = GetBlockByHeightRequest{}
+func (x *SetPeerDenylistRequest) Reset() {
+	*x = SetPeerDenylistRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -793,13 +769,13 @@ func (x *GetBlockByHeightRequest) Reset() {
 	}
 }
 
-func (x *GetBlockByHeightRequest) String() string {
+func (x *SetPeerDenylistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlockByHeightRequest) ProtoMessage() {}
+func (*SetPeerDenylistRequest) ProtoMessage() {}
 
-func (x *GetBlockByHeightRequest) ProtoReflect() protoreflect.Message {
+func (x *SetPeerDenylistRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -811,28 +787,28 @@ func (x *GetBlockByHeightRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlockByHeightRequest.ProtoReflect.Descriptor instead.
-func (*GetBlockByHeightRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetPeerDenylistRequest.ProtoReflect.Descriptor instead.
+func (*SetPeerDenylistRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetBlockByHeightRequest) GetHeight() uint64 {
+func (x *SetPeerDenylistRequest) GetPeerIds() []string {
 	if x != nil {
-		return x.Height
+		return x.PeerIds
 	}
-	return 0
+	return nil
 }
 
-type SendTransactionRequest struct {
+type GetVersionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Transaction *pb.Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	ProtoVersion string `protobuf:"bytes,1,opt,name=proto_version,json=protoVersion,proto3" json:"proto_version,omitempty"`
 }
 
-func (x *SendTransactionRequest) Reset() {
-	*x = SendTransactionRequest{}
+func (x *GetVersionRequest) Reset() {
+	*x = GetVersionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -840,13 +816,13 @@ func (x *SendTransactionRequest) Reset() {
 	}
 }
 
-func (x *SendTransactionRequest) String() string {
+func (x *GetVersionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendTransactionRequest) ProtoMessage() {}
+func (*GetVersionRequest) ProtoMessage() {}
 
-func (x *SendTransactionRequest) ProtoReflect() protoreflect.Message {
+func (x *GetVersionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -858,28 +834,28 @@ func (x *SendTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendTransactionRequest.ProtoReflect.Descriptor instead.
-func (*SendTransactionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetVersionRequest.ProtoReflect.Descriptor instead.
+func (*GetVersionRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *SendTransactionRequest) GetTransaction() *pb.Transaction {
+func (x *GetVersionRequest) GetProtoVersion() string {
 	if x != nil {
-		return x.Transaction
+		return x.ProtoVersion
 	}
-	return nil
+	return ""
 }
 
-type SendBatchTransactionRequest struct {
+type GetUTXORequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Transactions []*pb.Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` //account address
 }
 
-func (x *SendBatchTransactionRequest) Reset() {
-	*x = SendBatchTransactionRequest{}
+func (x *GetUTXORequest) Reset() {
+	*x = GetUTXORequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -887,13 +863,13 @@ func (x *SendBatchTransactionRequest) Reset() {
 	}
 }
 
-func (x *SendBatchTransactionRequest) String() string {
+func (x *GetUTXORequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendBatchTransactionRequest) ProtoMessage() {}
+func (*GetUTXORequest) ProtoMessage() {}
 
-func (x *SendBatchTransactionRequest) ProtoReflect() protoreflect.Message {
+func (x *GetUTXORequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -905,26 +881,29 @@ func (x *SendBatchTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendBatchTransactionRequest.ProtoReflect.Descriptor instead.
-func (*SendBatchTransactionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUTXORequest.ProtoReflect.Descriptor instead.
+func (*GetUTXORequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *SendBatchTransactionRequest) GetTransactions() []*pb.Transaction {
+func (x *GetUTXORequest) GetAddress() string {
 	if x != nil {
-		return x.Transactions
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-type GetNewTransactionRequest struct {
+type GetUTXOWithAmountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` //account address
+	Amount  uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`  // Amount requested, UTXOs returned will be the minimum number needed to reach this amount
 }
 
-func (x *GetNewTransactionRequest) Reset() {
-	*x = GetNewTransactionRequest{}
+func (x *GetUTXOWithAmountRequest) Reset() {
+	*x = GetUTXOWithAmountRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -932,13 +911,13 @@ func (x *GetNewTransactionRequest) Reset() {
 	}
 }
 
-func (x *GetNewTransactionRequest) String() string {
+func (x *GetUTXOWithAmountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNewTransactionRequest) ProtoMessage() {}
+func (*GetUTXOWithAmountRequest) ProtoMessage() {}
 
-func (x *GetNewTransactionRequest) ProtoReflect() protoreflect.Message {
+func (x *GetUTXOWithAmountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -950,21 +929,36 @@ func (x *GetNewTransactionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNewTransactionRequest.ProtoReflect.Descriptor instead.
-func (*GetNewTransactionRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUTXOWithAmountRequest.ProtoReflect.Descriptor instead.
+func (*GetUTXOWithAmountRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{16}
 }
 
-type SubscribeRequest struct {
+func (x *GetUTXOWithAmountRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetUTXOWithAmountRequest) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type GetBlocksRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Topics []string `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
+	StartBlockHashes [][]byte `protobuf:"bytes,1,rep,name=start_block_hashes,json=startBlockHashes,proto3" json:"start_block_hashes,omitempty"` // Query start block hashes, sorted descending by height.
+	MaxCount         int32    `protobuf:"varint,2,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`                          // Max query block count
 }
 
-func (x *SubscribeRequest) Reset() {
-	*x = SubscribeRequest{}
+func (x *GetBlocksRequest) Reset() {
+	*x = GetBlocksRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -972,13 +966,13 @@ func (x *SubscribeRequest) Reset() {
 	}
 }
 
-func (x *SubscribeRequest) String() string {
+func (x *GetBlocksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeRequest) ProtoMessage() {}
+func (*GetBlocksRequest) ProtoMessage() {}
 
-func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+func (x *GetBlocksRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -990,26 +984,35 @@ func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBlocksRequest.ProtoReflect.Descriptor instead.
+func (*GetBlocksRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *SubscribeRequest) GetTopics() []string {
+func (x *GetBlocksRequest) GetStartBlockHashes() [][]byte {
 	if x != nil {
-		return x.Topics
+		return x.StartBlockHashes
 	}
 	return nil
 }
 
-type MetricsServiceRequest struct {
+func (x *GetBlocksRequest) GetMaxCount() int32 {
+	if x != nil {
+		return x.MaxCount
+	}
+	return 0
+}
+
+type GetBlockByHashRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (x *MetricsServiceRequest) Reset() {
-	*x = MetricsServiceRequest{}
+func (x *GetBlockByHashRequest) Reset() {
+	*x = GetBlockByHashRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1017,13 +1020,13 @@ func (x *MetricsServiceRequest) Reset() {
 	}
 }
 
-func (x *MetricsServiceRequest) String() string {
+func (x *GetBlockByHashRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetricsServiceRequest) ProtoMessage() {}
+func (*GetBlockByHashRequest) ProtoMessage() {}
 
-func (x *MetricsServiceRequest) ProtoReflect() protoreflect.Message {
+func (x *GetBlockByHashRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1035,19 +1038,28 @@ func (x *MetricsServiceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetricsServiceRequest.ProtoReflect.Descriptor instead.
-func (*MetricsServiceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBlockByHashRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockByHashRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{18}
 }
 
-type GetLastIrreversibleBlockRequest struct {
+func (x *GetBlockByHashRequest) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+type GetBlockByHeightRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (x *GetLastIrreversibleBlockRequest) Reset() {
-	*x = GetLastIrreversibleBlockRequest{}
+func (x *GetBlockByHeightRequest) Reset() {
+	*x = GetBlockByHeightRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1055,13 +1067,13 @@ func (x *GetLastIrreversibleBlockRequest) Reset() {
 	}
 }
 
-func (x *GetLastIrreversibleBlockRequest) String() string {
+func (x *GetBlockByHeightRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLastIrreversibleBlockRequest) ProtoMessage() {}
+func (*GetBlockByHeightRequest) ProtoMessage() {}
 
-func (x *GetLastIrreversibleBlockRequest) ProtoReflect() protoreflect.Message {
+func (x *GetBlockByHeightRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1073,12 +1085,19 @@ func (x *GetLastIrreversibleBlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLastIrreversibleBlockRequest.ProtoReflect.Descriptor instead.
-func (*GetLastIrreversibleBlockRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBlockByHeightRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockByHeightRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{19}
 }
 
-type EstimateGasRequest struct {
+func (x *GetBlockByHeightRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type SendTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1086,8 +1105,8 @@ type EstimateGasRequest struct {
 	Transaction *pb.Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
 }
 
-func (x *EstimateGasRequest) Reset() {
-	*x = EstimateGasRequest{}
+func (x *SendTransactionRequest) Reset() {
+	*x = SendTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1095,13 +1114,13 @@ func (x *EstimateGasRequest) Reset() {
 	}
 }
 
-func (x *EstimateGasRequest) String() string {
+func (x *SendTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EstimateGasRequest) ProtoMessage() {}
+func (*SendTransactionRequest) ProtoMessage() {}
 
-func (x *EstimateGasRequest) ProtoReflect() protoreflect.Message {
+func (x *SendTransactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1113,26 +1132,28 @@ func (x *EstimateGasRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EstimateGasRequest.ProtoReflect.Descriptor instead.
-func (*EstimateGasRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendTransactionRequest.ProtoReflect.Descriptor instead.
+func (*SendTransactionRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *EstimateGasRequest) GetTransaction() *pb.Transaction {
+func (x *SendTransactionRequest) GetTransaction() *pb.Transaction {
 	if x != nil {
 		return x.Transaction
 	}
 	return nil
 }
 
-type GasPriceRequest struct {
+type SendBatchTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Transactions []*pb.Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
 }
 
-func (x *GasPriceRequest) Reset() {
-	*x = GasPriceRequest{}
+func (x *SendBatchTransactionRequest) Reset() {
+	*x = SendBatchTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1140,13 +1161,13 @@ func (x *GasPriceRequest) Reset() {
 	}
 }
 
-func (x *GasPriceRequest) String() string {
+func (x *SendBatchTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GasPriceRequest) ProtoMessage() {}
+func (*SendBatchTransactionRequest) ProtoMessage() {}
 
-func (x *GasPriceRequest) ProtoReflect() protoreflect.Message {
+func (x *SendBatchTransactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1158,23 +1179,26 @@ func (x *GasPriceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GasPriceRequest.ProtoReflect.Descriptor instead.
-func (*GasPriceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendBatchTransactionRequest.ProtoReflect.Descriptor instead.
+func (*SendBatchTransactionRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{21}
 }
 
-type ContractQueryRequest struct {
+func (x *SendBatchTransactionRequest) GetTransactions() []*pb.Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type GetNewTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ContractAddr string `protobuf:"bytes,1,opt,name=contract_addr,json=contractAddr,proto3" json:"contract_addr,omitempty"`
-	Key          string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	Value        string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *ContractQueryRequest) Reset() {
-	*x = ContractQueryRequest{}
+func (x *GetNewTransactionRequest) Reset() {
+	*x = GetNewTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1182,13 +1206,13 @@ func (x *ContractQueryRequest) Reset() {
 	}
 }
 
-func (x *ContractQueryRequest) String() string {
+func (x *GetNewTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContractQueryRequest) ProtoMessage() {}
+func (*GetNewTransactionRequest) ProtoMessage() {}
 
-func (x *ContractQueryRequest) ProtoReflect() protoreflect.Message {
+func (x *GetNewTransactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1200,40 +1224,21 @@ func (x *ContractQueryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContractQueryRequest.ProtoReflect.Descriptor instead.
-func (*ContractQueryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetNewTransactionRequest.ProtoReflect.Descriptor instead.
+func (*GetNewTransactionRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ContractQueryRequest) GetContractAddr() string {
-	if x != nil {
-		return x.ContractAddr
-	}
-	return ""
-}
-
-func (x *ContractQueryRequest) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
-}
-
-func (x *ContractQueryRequest) GetValue() string {
-	if x != nil {
-		return x.Value
-	}
-	return ""
-}
-
-type ChangeProducerResponse struct {
+type SubscribeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Topics []string `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
 }
 
-func (x *ChangeProducerResponse) Reset() {
-	*x = ChangeProducerResponse{}
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1241,13 +1246,13 @@ func (x *ChangeProducerResponse) Reset() {
 	}
 }
 
-func (x *ChangeProducerResponse) String() string {
+func (x *SubscribeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeProducerResponse) ProtoMessage() {}
+func (*SubscribeRequest) ProtoMessage() {}
 
-func (x *ChangeProducerResponse) ProtoReflect() protoreflect.Message {
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1259,21 +1264,26 @@ func (x *ChangeProducerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeProducerResponse.ProtoReflect.Descriptor instead.
-func (*ChangeProducerResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{23}
 }
 
-type GetBalanceResponse struct {
+func (x *SubscribeRequest) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+type MetricsServiceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Amount int64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *GetBalanceResponse) Reset() {
-	*x = GetBalanceResponse{}
+func (x *MetricsServiceRequest) Reset() {
+	*x = MetricsServiceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1281,13 +1291,13 @@ func (x *GetBalanceResponse) Reset() {
 	}
 }
 
-func (x *GetBalanceResponse) String() string {
+func (x *MetricsServiceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBalanceResponse) ProtoMessage() {}
+func (*MetricsServiceRequest) ProtoMessage() {}
 
-func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
+func (x *MetricsServiceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1299,26 +1309,19 @@ func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBalanceResponse.ProtoReflect.Descriptor instead.
-func (*GetBalanceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MetricsServiceRequest.ProtoReflect.Descriptor instead.
+func (*MetricsServiceRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetBalanceResponse) GetAmount() int64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-type SendFromMinerResponse struct {
+type GetLastIrreversibleBlockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *SendFromMinerResponse) Reset() {
-	*x = SendFromMinerResponse{}
+func (x *GetLastIrreversibleBlockRequest) Reset() {
+	*x = GetLastIrreversibleBlockRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1326,13 +1329,13 @@ func (x *SendFromMinerResponse) Reset() {
 	}
 }
 
-func (x *SendFromMinerResponse) String() string {
+func (x *GetLastIrreversibleBlockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendFromMinerResponse) ProtoMessage() {}
+func (*GetLastIrreversibleBlockRequest) ProtoMessage() {}
 
-func (x *SendFromMinerResponse) ProtoReflect() protoreflect.Message {
+func (x *GetLastIrreversibleBlockRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1344,22 +1347,21 @@ func (x *SendFromMinerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendFromMinerResponse.ProtoReflect.Descriptor instead.
-func (*SendFromMinerResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLastIrreversibleBlockRequest.ProtoReflect.Descriptor instead.
+func (*GetLastIrreversibleBlockRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{25}
 }
 
-type SendResponse struct {
+type EstimateGasRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
-	Txid            string `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	Transaction *pb.Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
 }
 
-func (x *SendResponse) Reset() {
-	*x = SendResponse{}
+func (x *EstimateGasRequest) Reset() {
+	*x = EstimateGasRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1367,13 +1369,13 @@ func (x *SendResponse) Reset() {
 	}
 }
 
-func (x *SendResponse) String() string {
+func (x *EstimateGasRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendResponse) ProtoMessage() {}
+func (*EstimateGasRequest) ProtoMessage() {}
 
-func (x *SendResponse) ProtoReflect() protoreflect.Message {
+func (x *EstimateGasRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1385,35 +1387,26 @@ func (x *SendResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendResponse.ProtoReflect.Descriptor instead.
-func (*SendResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EstimateGasRequest.ProtoReflect.Descriptor instead.
+func (*EstimateGasRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *SendResponse) GetContractAddress() string {
-	if x != nil {
-		return x.ContractAddress
-	}
-	return ""
-}
-
-func (x *SendResponse) GetTxid() string {
+func (x *EstimateGasRequest) GetTransaction() *pb.Transaction {
 	if x != nil {
-		return x.Txid
+		return x.Transaction
 	}
-	return ""
+	return nil
 }
 
-type GetPeerInfoResponse struct {
+type GasPriceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	PeerList []*pb1.PeerInfo `protobuf:"bytes,1,rep,name=peer_list,json=peerList,proto3" json:"peer_list,omitempty"`
 }
 
-func (x *GetPeerInfoResponse) Reset() {
-	*x = GetPeerInfoResponse{}
+func (x *GasPriceRequest) Reset() {
+	*x = GasPriceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1421,13 +1414,13 @@ func (x *GetPeerInfoResponse) Reset() {
 	}
 }
 
-func (x *GetPeerInfoResponse) String() string {
+func (x *GasPriceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPeerInfoResponse) ProtoMessage() {}
+func (*GasPriceRequest) ProtoMessage() {}
 
-func (x *GetPeerInfoResponse) ProtoReflect() protoreflect.Message {
+func (x *GasPriceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1439,31 +1432,23 @@ func (x *GetPeerInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPeerInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetPeerInfoResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GasPriceRequest.ProtoReflect.Descriptor instead.
+func (*GasPriceRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetPeerInfoResponse) GetPeerList() []*pb1.PeerInfo {
-	if x != nil {
-		return x.PeerList
-	}
-	return nil
-}
-
-type GetBlockchainInfoResponse struct {
+type ContractQueryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TailBlockHash []byte   `protobuf:"bytes,1,opt,name=tail_block_hash,json=tailBlockHash,proto3" json:"tail_block_hash,omitempty"`
-	BlockHeight   uint64   `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	Producers     []string `protobuf:"bytes,3,rep,name=producers,proto3" json:"producers,omitempty"` // all producers' addresses
-	Timestamp     int64    `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	ContractAddr string `protobuf:"bytes,1,opt,name=contract_addr,json=contractAddr,proto3" json:"contract_addr,omitempty"`
+	Key          string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value        string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *GetBlockchainInfoResponse) Reset() {
-	*x = GetBlockchainInfoResponse{}
+func (x *ContractQueryRequest) Reset() {
+	*x = ContractQueryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1471,13 +1456,13 @@ func (x *GetBlockchainInfoResponse) Reset() {
 	}
 }
 
-func (x *GetBlockchainInfoResponse) String() string {
+func (x *ContractQueryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlockchainInfoResponse) ProtoMessage() {}
+func (*ContractQueryRequest) ProtoMessage() {}
 
-func (x *GetBlockchainInfoResponse) ProtoReflect() protoreflect.Message {
+func (x *ContractQueryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1489,47 +1474,42 @@ func (x *GetBlockchainInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlockchainInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetBlockchainInfoResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ContractQueryRequest.ProtoReflect.Descriptor instead.
+func (*ContractQueryRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetBlockchainInfoResponse) GetTailBlockHash() []byte {
+func (x *ContractQueryRequest) GetContractAddr() string {
 	if x != nil {
-		return x.TailBlockHash
+		return x.ContractAddr
 	}
-	return nil
+	return ""
 }
 
-func (x *GetBlockchainInfoResponse) GetBlockHeight() uint64 {
+func (x *ContractQueryRequest) GetKey() string {
 	if x != nil {
-		return x.BlockHeight
+		return x.Key
 	}
-	return 0
+	return ""
 }
 
-func (x *GetBlockchainInfoResponse) GetProducers() []string {
+func (x *ContractQueryRequest) GetValue() string {
 	if x != nil {
-		return x.Producers
+		return x.Value
 	}
-	return nil
+	return ""
 }
 
-func (x *GetBlockchainInfoResponse) GetTimestamp() int64 {
-	if x != nil {
-		return x.Timestamp
-	}
-	return 0
-}
-
-type AddPeerResponse struct {
+type GetTransactionReceiptRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Txid []byte `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
 }
 
-func (x *AddPeerResponse) Reset() {
-	*x = AddPeerResponse{}
+func (x *GetTransactionReceiptRequest) Reset() {
+	*x = GetTransactionReceiptRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1537,13 +1517,13 @@ func (x *AddPeerResponse) Reset() {
 	}
 }
 
-func (x *AddPeerResponse) String() string {
+func (x *GetTransactionReceiptRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddPeerResponse) ProtoMessage() {}
+func (*GetTransactionReceiptRequest) ProtoMessage() {}
 
-func (x *AddPeerResponse) ProtoReflect() protoreflect.Message {
+func (x *GetTransactionReceiptRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1555,22 +1535,26 @@ func (x *AddPeerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddPeerResponse.ProtoReflect.Descriptor instead.
-func (*AddPeerResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTransactionReceiptRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionReceiptRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{29}
 }
 
-type GetVersionResponse struct {
+func (x *GetTransactionReceiptRequest) GetTxid() []byte {
+	if x != nil {
+		return x.Txid
+	}
+	return nil
+}
+
+type GetCandidatesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ProtoVersion  string `protobuf:"bytes,1,opt,name=proto_version,json=protoVersion,proto3" json:"proto_version,omitempty"`
-	ServerVersion string `protobuf:"bytes,2,opt,name=server_version,json=serverVersion,proto3" json:"server_version,omitempty"`
 }
 
-func (x *GetVersionResponse) Reset() {
-	*x = GetVersionResponse{}
+func (x *GetCandidatesRequest) Reset() {
+	*x = GetCandidatesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1578,13 +1562,13 @@ func (x *GetVersionResponse) Reset() {
 	}
 }
 
-func (x *GetVersionResponse) String() string {
+func (x *GetCandidatesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVersionResponse) ProtoMessage() {}
+func (*GetCandidatesRequest) ProtoMessage() {}
 
-func (x *GetVersionResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCandidatesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1596,36 +1580,21 @@ func (x *GetVersionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVersionResponse.ProtoReflect.Descriptor instead.
-func (*GetVersionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCandidatesRequest.ProtoReflect.Descriptor instead.
+func (*GetCandidatesRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetVersionResponse) GetProtoVersion() string {
-	if x != nil {
-		return x.ProtoVersion
-	}
-	return ""
-}
-
-func (x *GetVersionResponse) GetServerVersion() string {
-	if x != nil {
-		return x.ServerVersion
-	}
-	return ""
-}
-
-type GetUTXOResponse struct {
+type GetVotesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Utxos        []*pb2.Utxo        `protobuf:"bytes,1,rep,name=utxos,proto3" json:"utxos,omitempty"`
-	BlockHeaders []*pb3.BlockHeader `protobuf:"bytes,2,rep,name=block_headers,json=blockHeaders,proto3" json:"block_headers,omitempty"` //The latest block headers
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"` //voter address
 }
 
-func (x *GetUTXOResponse) Reset() {
-	*x = GetUTXOResponse{}
+func (x *GetVotesRequest) Reset() {
+	*x = GetVotesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1633,13 +1602,13 @@ func (x *GetUTXOResponse) Reset() {
 	}
 }
 
-func (x *GetUTXOResponse) String() string {
+func (x *GetVotesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUTXOResponse) ProtoMessage() {}
+func (*GetVotesRequest) ProtoMessage() {}
 
-func (x *GetUTXOResponse) ProtoReflect() protoreflect.Message {
+func (x *GetVotesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1651,35 +1620,26 @@ func (x *GetUTXOResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUTXOResponse.ProtoReflect.Descriptor instead.
-func (*GetUTXOResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetVotesRequest.ProtoReflect.Descriptor instead.
+func (*GetVotesRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetUTXOResponse) GetUtxos() []*pb2.Utxo {
-	if x != nil {
-		return x.Utxos
-	}
-	return nil
-}
-
-func (x *GetUTXOResponse) GetBlockHeaders() []*pb3.BlockHeader {
+func (x *GetVotesRequest) GetAddress() string {
 	if x != nil {
-		return x.BlockHeaders
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-type GetBlocksResponse struct {
+type GetSlashedProducersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Blocks []*pb3.Block `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
 }
 
-func (x *GetBlocksResponse) Reset() {
-	*x = GetBlocksResponse{}
+func (x *GetSlashedProducersRequest) Reset() {
+	*x = GetSlashedProducersRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1687,13 +1647,13 @@ func (x *GetBlocksResponse) Reset() {
 	}
 }
 
-func (x *GetBlocksResponse) String() string {
+func (x *GetSlashedProducersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlocksResponse) ProtoMessage() {}
+func (*GetSlashedProducersRequest) ProtoMessage() {}
 
-func (x *GetBlocksResponse) ProtoReflect() protoreflect.Message {
+func (x *GetSlashedProducersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1705,28 +1665,19 @@ func (x *GetBlocksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlocksResponse.ProtoReflect.Descriptor instead.
-func (*GetBlocksResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSlashedProducersRequest.ProtoReflect.Descriptor instead.
+func (*GetSlashedProducersRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GetBlocksResponse) GetBlocks() []*pb3.Block {
-	if x != nil {
-		return x.Blocks
-	}
-	return nil
-}
-
-type GetBlockByHashResponse struct {
+type GetDynastyScheduleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Block *pb3.Block `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
 }
 
-func (x *GetBlockByHashResponse) Reset() {
-	*x = GetBlockByHashResponse{}
+func (x *GetDynastyScheduleRequest) Reset() {
+	*x = GetDynastyScheduleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1734,13 +1685,13 @@ func (x *GetBlockByHashResponse) Reset() {
 	}
 }
 
-func (x *GetBlockByHashResponse) String() string {
+func (x *GetDynastyScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlockByHashResponse) ProtoMessage() {}
+func (*GetDynastyScheduleRequest) ProtoMessage() {}
 
-func (x *GetBlockByHashResponse) ProtoReflect() protoreflect.Message {
+func (x *GetDynastyScheduleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1752,28 +1703,19 @@ func (x *GetBlockByHashResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlockByHashResponse.ProtoReflect.Descriptor instead.
-func (*GetBlockByHashResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDynastyScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetDynastyScheduleRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *GetBlockByHashResponse) GetBlock() *pb3.Block {
-	if x != nil {
-		return x.Block
-	}
-	return nil
-}
-
-type GetBlockByHeightResponse struct {
+type GetSupplyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Block *pb3.Block `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
 }
 
-func (x *GetBlockByHeightResponse) Reset() {
-	*x = GetBlockByHeightResponse{}
+func (x *GetSupplyRequest) Reset() {
+	*x = GetSupplyRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1781,13 +1723,13 @@ func (x *GetBlockByHeightResponse) Reset() {
 	}
 }
 
-func (x *GetBlockByHeightResponse) String() string {
+func (x *GetSupplyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBlockByHeightResponse) ProtoMessage() {}
+func (*GetSupplyRequest) ProtoMessage() {}
 
-func (x *GetBlockByHeightResponse) ProtoReflect() protoreflect.Message {
+func (x *GetSupplyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1799,28 +1741,21 @@ func (x *GetBlockByHeightResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBlockByHeightResponse.ProtoReflect.Descriptor instead.
-func (*GetBlockByHeightResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSupplyRequest.ProtoReflect.Descriptor instead.
+func (*GetSupplyRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *GetBlockByHeightResponse) GetBlock() *pb3.Block {
-	if x != nil {
-		return x.Block
-	}
-	return nil
-}
-
-type SendTransactionResponse struct {
+type GetChainParametersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GeneratedContractAddress string `protobuf:"bytes,1,opt,name=generated_contract_address,json=generatedContractAddress,proto3" json:"generated_contract_address,omitempty"`
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"` // the height of the block after which the parameters are in force. 0 for the tail block
 }
 
-func (x *SendTransactionResponse) Reset() {
-	*x = SendTransactionResponse{}
+func (x *GetChainParametersRequest) Reset() {
+	*x = GetChainParametersRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1828,13 +1763,13 @@ func (x *SendTransactionResponse) Reset() {
 	}
 }
 
-func (x *SendTransactionResponse) String() string {
+func (x *GetChainParametersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendTransactionResponse) ProtoMessage() {}
+func (*GetChainParametersRequest) ProtoMessage() {}
 
-func (x *SendTransactionResponse) ProtoReflect() protoreflect.Message {
+func (x *GetChainParametersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1846,26 +1781,29 @@ func (x *SendTransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendTransactionResponse.ProtoReflect.Descriptor instead.
-func (*SendTransactionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetChainParametersRequest.ProtoReflect.Descriptor instead.
+func (*GetChainParametersRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *SendTransactionResponse) GetGeneratedContractAddress() string {
+func (x *GetChainParametersRequest) GetHeight() uint64 {
 	if x != nil {
-		return x.GeneratedContractAddress
+		return x.Height
 	}
-	return ""
+	return 0
 }
 
-type SendBatchTransactionResponse struct {
+type GetLightBlockHeadersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	StartHeight uint64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	Count       uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` // at most 500 headers are returned
 }
 
-func (x *SendBatchTransactionResponse) Reset() {
-	*x = SendBatchTransactionResponse{}
+func (x *GetLightBlockHeadersRequest) Reset() {
+	*x = GetLightBlockHeadersRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1873,13 +1811,13 @@ func (x *SendBatchTransactionResponse) Reset() {
 	}
 }
 
-func (x *SendBatchTransactionResponse) String() string {
+func (x *GetLightBlockHeadersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendBatchTransactionResponse) ProtoMessage() {}
+func (*GetLightBlockHeadersRequest) ProtoMessage() {}
 
-func (x *SendBatchTransactionResponse) ProtoReflect() protoreflect.Message {
+func (x *GetLightBlockHeadersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1891,23 +1829,35 @@ func (x *SendBatchTransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendBatchTransactionResponse.ProtoReflect.Descriptor instead.
-func (*SendBatchTransactionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLightBlockHeadersRequest.ProtoReflect.Descriptor instead.
+func (*GetLightBlockHeadersRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{36}
 }
 
-type SendTransactionStatus struct {
+func (x *GetLightBlockHeadersRequest) GetStartHeight() uint64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+func (x *GetLightBlockHeadersRequest) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetUtxoProofsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Txid    []byte `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`  // Transactions that are sent
-	Code    uint32 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"` // grpc status code
-	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *SendTransactionStatus) Reset() {
-	*x = SendTransactionStatus{}
+func (x *GetUtxoProofsRequest) Reset() {
+	*x = GetUtxoProofsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1915,13 +1865,13 @@ func (x *SendTransactionStatus) Reset() {
 	}
 }
 
-func (x *SendTransactionStatus) String() string {
+func (x *GetUtxoProofsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendTransactionStatus) ProtoMessage() {}
+func (*GetUtxoProofsRequest) ProtoMessage() {}
 
-func (x *SendTransactionStatus) ProtoReflect() protoreflect.Message {
+func (x *GetUtxoProofsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1933,42 +1883,26 @@ func (x *SendTransactionStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendTransactionStatus.ProtoReflect.Descriptor instead.
-func (*SendTransactionStatus) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUtxoProofsRequest.ProtoReflect.Descriptor instead.
+func (*GetUtxoProofsRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *SendTransactionStatus) GetTxid() []byte {
-	if x != nil {
-		return x.Txid
-	}
-	return nil
-}
-
-func (x *SendTransactionStatus) GetCode() uint32 {
-	if x != nil {
-		return x.Code
-	}
-	return 0
-}
-
-func (x *SendTransactionStatus) GetMessage() string {
+func (x *GetUtxoProofsRequest) GetAddress() string {
 	if x != nil {
-		return x.Message
+		return x.Address
 	}
 	return ""
 }
 
-type GetNewTransactionResponse struct {
+type ChangeProducerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Transaction *pb.Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
 }
 
-func (x *GetNewTransactionResponse) Reset() {
-	*x = GetNewTransactionResponse{}
+func (x *ChangeProducerResponse) Reset() {
+	*x = ChangeProducerResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1976,13 +1910,13 @@ func (x *GetNewTransactionResponse) Reset() {
 	}
 }
 
-func (x *GetNewTransactionResponse) String() string {
+func (x *ChangeProducerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNewTransactionResponse) ProtoMessage() {}
+func (*ChangeProducerResponse) ProtoMessage() {}
 
-func (x *GetNewTransactionResponse) ProtoReflect() protoreflect.Message {
+func (x *ChangeProducerResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1994,28 +1928,21 @@ func (x *GetNewTransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNewTransactionResponse.ProtoReflect.Descriptor instead.
-func (*GetNewTransactionResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangeProducerResponse.ProtoReflect.Descriptor instead.
+func (*ChangeProducerResponse) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetNewTransactionResponse) GetTransaction() *pb.Transaction {
-	if x != nil {
-		return x.Transaction
-	}
-	return nil
-}
-
-type SubscribeResponse struct {
+type GetBalanceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Amount int64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *SubscribeResponse) Reset() {
-	*x = SubscribeResponse{}
+func (x *GetBalanceResponse) Reset() {
+	*x = GetBalanceResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2023,13 +1950,13 @@ func (x *SubscribeResponse) Reset() {
 	}
 }
 
-func (x *SubscribeResponse) String() string {
+func (x *GetBalanceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeResponse) ProtoMessage() {}
+func (*GetBalanceResponse) ProtoMessage() {}
 
-func (x *SubscribeResponse) ProtoReflect() protoreflect.Message {
+func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2041,26 +1968,26 @@ func (x *SubscribeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeResponse.ProtoReflect.Descriptor instead.
-func (*SubscribeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetBalanceResponse) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *SubscribeResponse) GetData() string {
+func (x *GetBalanceResponse) GetAmount() int64 {
 	if x != nil {
-		return x.Data
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-type GetAllTransactionsRequest struct {
+type SendFromMinerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetAllTransactionsRequest) Reset() {
-	*x = GetAllTransactionsRequest{}
+func (x *SendFromMinerResponse) Reset() {
+	*x = SendFromMinerResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2068,13 +1995,13 @@ func (x *GetAllTransactionsRequest) Reset() {
 	}
 }
 
-func (x *GetAllTransactionsRequest) String() string {
+func (x *SendFromMinerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllTransactionsRequest) ProtoMessage() {}
+func (*SendFromMinerResponse) ProtoMessage() {}
 
-func (x *GetAllTransactionsRequest) ProtoReflect() protoreflect.Message {
+func (x *SendFromMinerResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2086,21 +2013,22 @@ func (x *GetAllTransactionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllTransactionsRequest.ProtoReflect.Descriptor instead.
-func (*GetAllTransactionsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendFromMinerResponse.ProtoReflect.Descriptor instead.
+func (*SendFromMinerResponse) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{40}
 }
 
-type GetAllTransactionsResponse struct {
+type SendResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Transactions []*pb.Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Txid            string `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`
 }
 
-func (x *GetAllTransactionsResponse) Reset() {
-	*x = GetAllTransactionsResponse{}
+func (x *SendResponse) Reset() {
+	*x = SendResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2108,13 +2036,13 @@ func (x *GetAllTransactionsResponse) Reset() {
 	}
 }
 
-func (x *GetAllTransactionsResponse) String() string {
+func (x *SendResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllTransactionsResponse) ProtoMessage() {}
+func (*SendResponse) ProtoMessage() {}
 
-func (x *GetAllTransactionsResponse) ProtoReflect() protoreflect.Message {
+func (x *SendResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2126,28 +2054,35 @@ func (x *GetAllTransactionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllTransactionsResponse.ProtoReflect.Descriptor instead.
-func (*GetAllTransactionsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendResponse.ProtoReflect.Descriptor instead.
+func (*SendResponse) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetAllTransactionsResponse) GetTransactions() []*pb.Transaction {
+func (x *SendResponse) GetContractAddress() string {
 	if x != nil {
-		return x.Transactions
+		return x.ContractAddress
 	}
-	return nil
+	return ""
 }
 
-type GetLastIrreversibleBlockResponse struct {
+func (x *SendResponse) GetTxid() string {
+	if x != nil {
+		return x.Txid
+	}
+	return ""
+}
+
+type GetPeerInfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Block *pb3.Block `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	PeerList []*pb1.PeerInfo `protobuf:"bytes,1,rep,name=peer_list,json=peerList,proto3" json:"peer_list,omitempty"`
 }
 
-func (x *GetLastIrreversibleBlockResponse) Reset() {
-	*x = GetLastIrreversibleBlockResponse{}
+func (x *GetPeerInfoResponse) Reset() {
+	*x = GetPeerInfoResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2155,13 +2090,13 @@ func (x *GetLastIrreversibleBlockResponse) Reset() {
 	}
 }
 
-func (x *GetLastIrreversibleBlockResponse) String() string {
+func (x *GetPeerInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLastIrreversibleBlockResponse) ProtoMessage() {}
+func (*GetPeerInfoResponse) ProtoMessage() {}
 
-func (x *GetLastIrreversibleBlockResponse) ProtoReflect() protoreflect.Message {
+func (x *GetPeerInfoResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2173,28 +2108,32 @@ func (x *GetLastIrreversibleBlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLastIrreversibleBlockResponse.ProtoReflect.Descriptor instead.
-func (*GetLastIrreversibleBlockResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPeerInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetPeerInfoResponse) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetLastIrreversibleBlockResponse) GetBlock() *pb3.Block {
+func (x *GetPeerInfoResponse) GetPeerList() []*pb1.PeerInfo {
 	if x != nil {
-		return x.Block
+		return x.PeerList
 	}
 	return nil
 }
 
-type GetMetricsInfoResponse struct {
+type GetBlockchainInfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	TailBlockHash []byte   `protobuf:"bytes,1,opt,name=tail_block_hash,json=tailBlockHash,proto3" json:"tail_block_hash,omitempty"`
+	BlockHeight   uint64   `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	Producers     []string `protobuf:"bytes,3,rep,name=producers,proto3" json:"producers,omitempty"` // all producers' addresses
+	Timestamp     int64    `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	BlockGasLimit uint64   `protobuf:"varint,5,opt,name=block_gas_limit,json=blockGasLimit,proto3" json:"block_gas_limit,omitempty"`
 }
 
-func (x *GetMetricsInfoResponse) Reset() {
-	*x = GetMetricsInfoResponse{}
+func (x *GetBlockchainInfoResponse) Reset() {
+	*x = GetBlockchainInfoResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2202,13 +2141,13 @@ func (x *GetMetricsInfoResponse) Reset() {
 	}
 }
 
-func (x *GetMetricsInfoResponse) String() string {
+func (x *GetBlockchainInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMetricsInfoResponse) ProtoMessage() {}
+func (*GetBlockchainInfoResponse) ProtoMessage() {}
 
-func (x *GetMetricsInfoResponse) ProtoReflect() protoreflect.Message {
+func (x *GetBlockchainInfoResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2220,28 +2159,54 @@ func (x *GetMetricsInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMetricsInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetMetricsInfoResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBlockchainInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockchainInfoResponse) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetMetricsInfoResponse) GetData() string {
+func (x *GetBlockchainInfoResponse) GetTailBlockHash() []byte {
 	if x != nil {
-		return x.Data
+		return x.TailBlockHash
 	}
-	return ""
+	return nil
 }
 
-type GetStatsResponse struct {
+func (x *GetBlockchainInfoResponse) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *GetBlockchainInfoResponse) GetProducers() []string {
+	if x != nil {
+		return x.Producers
+	}
+	return nil
+}
+
+func (x *GetBlockchainInfoResponse) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *GetBlockchainInfoResponse) GetBlockGasLimit() uint64 {
+	if x != nil {
+		return x.BlockGasLimit
+	}
+	return 0
+}
+
+type AddPeerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Stats *pb4.Metrics `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
 }
 
-func (x *GetStatsResponse) Reset() {
-	*x = GetStatsResponse{}
+func (x *AddPeerResponse) Reset() {
+	*x = AddPeerResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2249,13 +2214,13 @@ func (x *GetStatsResponse) Reset() {
 	}
 }
 
-func (x *GetStatsResponse) String() string {
+func (x *AddPeerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatsResponse) ProtoMessage() {}
+func (*AddPeerResponse) ProtoMessage() {}
 
-func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
+func (x *AddPeerResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2267,36 +2232,22 @@ func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatsResponse.ProtoReflect.Descriptor instead.
-func (*GetStatsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddPeerResponse.ProtoReflect.Descriptor instead.
+func (*AddPeerResponse) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetStatsResponse) GetStats() *pb4.Metrics {
-	if x != nil {
-		return x.Stats
-	}
-	return nil
-}
-
-type GetNodeConfigResponse struct {
+type PeerScore struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TxPoolLimit      uint32   `protobuf:"varint,1,opt,name=tx_pool_limit,json=txPoolLimit,proto3" json:"tx_pool_limit,omitempty"`
-	BlkSizeLimit     uint32   `protobuf:"varint,2,opt,name=blk_size_limit,json=blkSizeLimit,proto3" json:"blk_size_limit,omitempty"`
-	MaxConnectionOut uint32   `protobuf:"varint,3,opt,name=max_connection_out,json=maxConnectionOut,proto3" json:"max_connection_out,omitempty"`
-	MaxConnectionIn  uint32   `protobuf:"varint,4,opt,name=max_connection_in,json=maxConnectionIn,proto3" json:"max_connection_in,omitempty"`
-	ProducerAddress  string   `protobuf:"bytes,5,opt,name=producer_address,json=producerAddress,proto3" json:"producer_address,omitempty"`
-	Producers        []string `protobuf:"bytes,6,rep,name=producers,proto3" json:"producers,omitempty"`
-	MaxProducers     uint32   `protobuf:"varint,7,opt,name=max_producers,json=maxProducers,proto3" json:"max_producers,omitempty"`
-	IpfsAddresses    []string `protobuf:"bytes,9,rep,name=ipfs_addresses,json=ipfsAddresses,proto3" json:"ipfs_addresses,omitempty"`
-	RpcPort          uint32   `protobuf:"varint,10,opt,name=rpc_port,json=rpcPort,proto3" json:"rpc_port,omitempty"`
+	PeerId string `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Score  int64  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
 }
 
-func (x *GetNodeConfigResponse) Reset() {
-	*x = GetNodeConfigResponse{}
+func (x *PeerScore) Reset() {
+	*x = PeerScore{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2304,13 +2255,13 @@ func (x *GetNodeConfigResponse) Reset() {
 	}
 }
 
-func (x *GetNodeConfigResponse) String() string {
+func (x *PeerScore) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNodeConfigResponse) ProtoMessage() {}
+func (*PeerScore) ProtoMessage() {}
 
-func (x *GetNodeConfigResponse) ProtoReflect() protoreflect.Message {
+func (x *PeerScore) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2322,90 +2273,36 @@ func (x *GetNodeConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNodeConfigResponse.ProtoReflect.Descriptor instead.
-func (*GetNodeConfigResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PeerScore.ProtoReflect.Descriptor instead.
+func (*PeerScore) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *GetNodeConfigResponse) GetTxPoolLimit() uint32 {
-	if x != nil {
-		return x.TxPoolLimit
-	}
-	return 0
-}
-
-func (x *GetNodeConfigResponse) GetBlkSizeLimit() uint32 {
-	if x != nil {
-		return x.BlkSizeLimit
-	}
-	return 0
-}
-
-func (x *GetNodeConfigResponse) GetMaxConnectionOut() uint32 {
-	if x != nil {
-		return x.MaxConnectionOut
-	}
-	return 0
-}
-
-func (x *GetNodeConfigResponse) GetMaxConnectionIn() uint32 {
-	if x != nil {
-		return x.MaxConnectionIn
-	}
-	return 0
-}
-
-func (x *GetNodeConfigResponse) GetProducerAddress() string {
+func (x *PeerScore) GetPeerId() string {
 	if x != nil {
-		return x.ProducerAddress
+		return x.PeerId
 	}
 	return ""
 }
 
-func (x *GetNodeConfigResponse) GetProducers() []string {
-	if x != nil {
-		return x.Producers
-	}
-	return nil
-}
-
-func (x *GetNodeConfigResponse) GetMaxProducers() uint32 {
-	if x != nil {
-		return x.MaxProducers
-	}
-	return 0
-}
-
-func (x *GetNodeConfigResponse) GetIpfsAddresses() []string {
-	if x != nil {
-		return x.IpfsAddresses
-	}
-	return nil
-}
-
-func (x *GetNodeConfigResponse) GetRpcPort() uint32 {
+func (x *PeerScore) GetScore() int64 {
 	if x != nil {
-		return x.RpcPort
+		return x.Score
 	}
 	return 0
 }
 
-type SetNodeConfigRequest struct {
+type GetBannedPeersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UpdatedConfigs   []SetNodeConfigRequest_ConfigType `protobuf:"varint,1,rep,packed,name=updated_configs,json=updatedConfigs,proto3,enum=rpcpb.SetNodeConfigRequest_ConfigType" json:"updated_configs,omitempty"`
-	TxPoolLimit      uint32                            `protobuf:"varint,2,opt,name=tx_pool_limit,json=txPoolLimit,proto3" json:"tx_pool_limit,omitempty"`
-	BlkSizeLimit     uint32                            `protobuf:"varint,3,opt,name=blk_size_limit,json=blkSizeLimit,proto3" json:"blk_size_limit,omitempty"`
-	MaxConnectionOut uint32                            `protobuf:"varint,4,opt,name=max_connection_out,json=maxConnectionOut,proto3" json:"max_connection_out,omitempty"`
-	MaxConnectionIn  uint32                            `protobuf:"varint,5,opt,name=max_connection_in,json=maxConnectionIn,proto3" json:"max_connection_in,omitempty"`
-	MaxProducers     uint32                            `protobuf:"varint,6,opt,name=max_producers,json=maxProducers,proto3" json:"max_producers,omitempty"`
-	Producers        []string                          `protobuf:"bytes,7,rep,name=producers,proto3" json:"producers,omitempty"`
+	BannedPeers []*pb1.BannedPeer `protobuf:"bytes,1,rep,name=banned_peers,json=bannedPeers,proto3" json:"banned_peers,omitempty"`
+	PeerScores  []*PeerScore      `protobuf:"bytes,2,rep,name=peer_scores,json=peerScores,proto3" json:"peer_scores,omitempty"`
 }
 
-func (x *SetNodeConfigRequest) Reset() {
-	*x = SetNodeConfigRequest{}
+func (x *GetBannedPeersResponse) Reset() {
+	*x = GetBannedPeersResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2413,13 +2310,13 @@ func (x *SetNodeConfigRequest) Reset() {
 	}
 }
 
-func (x *SetNodeConfigRequest) String() string {
+func (x *GetBannedPeersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetNodeConfigRequest) ProtoMessage() {}
+func (*GetBannedPeersResponse) ProtoMessage() {}
 
-func (x *SetNodeConfigRequest) ProtoReflect() protoreflect.Message {
+func (x *GetBannedPeersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2431,70 +2328,33 @@ func (x *SetNodeConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetNodeConfigRequest.ProtoReflect.Descriptor instead.
-func (*SetNodeConfigRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBannedPeersResponse.ProtoReflect.Descriptor instead.
+func (*GetBannedPeersResponse) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *SetNodeConfigRequest) GetUpdatedConfigs() []SetNodeConfigRequest_ConfigType {
+func (x *GetBannedPeersResponse) GetBannedPeers() []*pb1.BannedPeer {
 	if x != nil {
-		return x.UpdatedConfigs
+		return x.BannedPeers
 	}
 	return nil
 }
 
-func (x *SetNodeConfigRequest) GetTxPoolLimit() uint32 {
-	if x != nil {
-		return x.TxPoolLimit
-	}
-	return 0
-}
-
-func (x *SetNodeConfigRequest) GetBlkSizeLimit() uint32 {
-	if x != nil {
-		return x.BlkSizeLimit
-	}
-	return 0
-}
-
-func (x *SetNodeConfigRequest) GetMaxConnectionOut() uint32 {
-	if x != nil {
-		return x.MaxConnectionOut
-	}
-	return 0
-}
-
-func (x *SetNodeConfigRequest) GetMaxConnectionIn() uint32 {
-	if x != nil {
-		return x.MaxConnectionIn
-	}
-	return 0
-}
-
-func (x *SetNodeConfigRequest) GetMaxProducers() uint32 {
-	if x != nil {
-		return x.MaxProducers
-	}
-	return 0
-}
-
-func (x *SetNodeConfigRequest) GetProducers() []string {
+func (x *GetBannedPeersResponse) GetPeerScores() []*PeerScore {
 	if x != nil {
-		return x.Producers
+		return x.PeerScores
 	}
 	return nil
 }
 
-type EstimateGasResponse struct {
+type BanPeerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	GasCount []byte `protobuf:"bytes,1,opt,name=gas_count,json=gasCount,proto3" json:"gas_count,omitempty"`
 }
 
-func (x *EstimateGasResponse) Reset() {
-	*x = EstimateGasResponse{}
+func (x *BanPeerResponse) Reset() {
+	*x = BanPeerResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2502,13 +2362,13 @@ func (x *EstimateGasResponse) Reset() {
 	}
 }
 
-func (x *EstimateGasResponse) String() string {
+func (x *BanPeerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EstimateGasResponse) ProtoMessage() {}
+func (*BanPeerResponse) ProtoMessage() {}
 
-func (x *EstimateGasResponse) ProtoReflect() protoreflect.Message {
+func (x *BanPeerResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2520,28 +2380,19 @@ func (x *EstimateGasResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EstimateGasResponse.ProtoReflect.Descriptor instead.
-func (*EstimateGasResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BanPeerResponse.ProtoReflect.Descriptor instead.
+func (*BanPeerResponse) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *EstimateGasResponse) GetGasCount() []byte {
-	if x != nil {
-		return x.GasCount
-	}
-	return nil
-}
-
-type GasPriceResponse struct {
+type UnbanPeerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	GasPrice []byte `protobuf:"bytes,1,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
 }
 
-func (x *GasPriceResponse) Reset() {
-	*x = GasPriceResponse{}
+func (x *UnbanPeerResponse) Reset() {
+	*x = UnbanPeerResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2549,13 +2400,13 @@ func (x *GasPriceResponse) Reset() {
 	}
 }
 
-func (x *GasPriceResponse) String() string {
+func (x *UnbanPeerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GasPriceResponse) ProtoMessage() {}
+func (*UnbanPeerResponse) ProtoMessage() {}
 
-func (x *GasPriceResponse) ProtoReflect() protoreflect.Message {
+func (x *UnbanPeerResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2567,44 +2418,127 @@ func (x *GasPriceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GasPriceResponse.ProtoReflect.Descriptor instead.
-func (*GasPriceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnbanPeerResponse.ProtoReflect.Descriptor instead.
+func (*UnbanPeerResponse) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *GasPriceResponse) GetGasPrice() []byte {
+type GetPeerFilterResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Allowlist []string `protobuf:"bytes,1,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
+	Denylist  []string `protobuf:"bytes,2,rep,name=denylist,proto3" json:"denylist,omitempty"`
+}
+
+func (x *GetPeerFilterResponse) Reset() {
+	*x = GetPeerFilterResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPeerFilterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeerFilterResponse) ProtoMessage() {}
+
+func (x *GetPeerFilterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeerFilterResponse.ProtoReflect.Descriptor instead.
+func (*GetPeerFilterResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetPeerFilterResponse) GetAllowlist() []string {
 	if x != nil {
-		return x.GasPrice
+		return x.Allowlist
 	}
 	return nil
 }
 
-type ContractQueryResponse struct {
+func (x *GetPeerFilterResponse) GetDenylist() []string {
+	if x != nil {
+		return x.Denylist
+	}
+	return nil
+}
+
+type SetPeerAllowlistResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+func (x *SetPeerAllowlistResponse) Reset() {
+	*x = SetPeerAllowlistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *ContractQueryResponse) Reset() {
-	*x = ContractQueryResponse{}
+func (x *SetPeerAllowlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPeerAllowlistResponse) ProtoMessage() {}
+
+func (x *SetPeerAllowlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPeerAllowlistResponse.ProtoReflect.Descriptor instead.
+func (*SetPeerAllowlistResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{50}
+}
+
+type SetPeerDenylistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetPeerDenylistResponse) Reset() {
+	*x = SetPeerDenylistResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[49]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ContractQueryResponse) String() string {
+func (x *SetPeerDenylistResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContractQueryResponse) ProtoMessage() {}
+func (*SetPeerDenylistResponse) ProtoMessage() {}
 
-func (x *ContractQueryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[49]
+func (x *SetPeerDenylistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2615,582 +2549,3607 @@ func (x *ContractQueryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContractQueryResponse.ProtoReflect.Descriptor instead.
-func (*ContractQueryResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use SetPeerDenylistResponse.ProtoReflect.Descriptor instead.
+func (*SetPeerDenylistResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ContractQueryResponse) GetKey() string {
+type GetVersionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtoVersion  string `protobuf:"bytes,1,opt,name=proto_version,json=protoVersion,proto3" json:"proto_version,omitempty"`
+	ServerVersion string `protobuf:"bytes,2,opt,name=server_version,json=serverVersion,proto3" json:"server_version,omitempty"`
+}
+
+func (x *GetVersionResponse) Reset() {
+	*x = GetVersionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVersionResponse) ProtoMessage() {}
+
+func (x *GetVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVersionResponse.ProtoReflect.Descriptor instead.
+func (*GetVersionResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetVersionResponse) GetProtoVersion() string {
 	if x != nil {
-		return x.Key
+		return x.ProtoVersion
 	}
 	return ""
 }
 
-func (x *ContractQueryResponse) GetValue() string {
+func (x *GetVersionResponse) GetServerVersion() string {
 	if x != nil {
-		return x.Value
+		return x.ServerVersion
 	}
 	return ""
 }
 
-var File_github_com_dappley_go_dappley_rpc_pb_rpc_proto protoreflect.FileDescriptor
+type GetUTXOResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDesc = []byte{
-	0x0a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70,
-	0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f,
-	0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x2f, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x05, 0x72, 0x70, 0x63, 0x70, 0x62, 0x1a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64,
-	0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x2f, 0x70, 0x62, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70,
-	0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f,
-	0x63, 0x6f, 0x72, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x2f, 0x70, 0x62, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70,
-	0x70, 0x6c, 0x65, 0x79, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x75, 0x74, 0x78, 0x6f, 0x2f, 0x70,
-	0x62, 0x2f, 0x75, 0x74, 0x78, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x33, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79,
-	0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x2f, 0x70, 0x62, 0x2f, 0x70, 0x65, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61,
-	0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79,
-	0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2f, 0x70, 0x62, 0x2f, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x36, 0x0a, 0x14, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72,
-	0x61, 0x73, 0x65, 0x22, 0x61, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x2d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x3e, 0x0a, 0x14, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x72, 0x6f,
-	0x6d, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
-	0x02, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xcc, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x74,
-	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x50, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x03, 0x74, 0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61,
-	0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67,
-	0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x70,
-	0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x61, 0x73, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65,
-	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x33, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x75, 0x6c, 0x6c,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x66, 0x75, 0x6c, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x38, 0x0a, 0x11, 0x47,
-	0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x2a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x54, 0x58, 0x4f,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x22, 0x4c, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x55, 0x54, 0x58, 0x4f, 0x57, 0x69, 0x74, 0x68,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0x5d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52,
-	0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x65,
-	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2b,
-	0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x31, 0x0a, 0x17, 0x47,
-	0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x56,
-	0x0a, 0x16, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5d, 0x0a, 0x1b, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x2a, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x22, 0x17, 0x0a,
-	0x15, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x21, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73,
-	0x74, 0x49, 0x72, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x52, 0x0a, 0x12, 0x45, 0x73, 0x74,
-	0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x3c, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x11, 0x0a,
-	0x0f, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x63, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x2c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x17, 0x0a,
-	0x15, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x0a, 0x0c, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x74, 0x78, 0x69, 0x64, 0x22, 0x47, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x09,
-	0x70, 0x65, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xa2,
-	0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f,
-	0x74, 0x61, 0x69, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x74, 0x61, 0x69, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x60, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x70, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55,
-	0x54, 0x58, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x75,
-	0x74, 0x78, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x75, 0x74, 0x78,
-	0x6f, 0x70, 0x62, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x52, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x12,
-	0x39, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x62,
-	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0c, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0x3b, 0x0a, 0x11, 0x47, 0x65,
-	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x26, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
-	0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x3e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x24, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x40, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x57, 0x0a, 0x17, 0x53, 0x65, 0x6e,
-	0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x22, 0x1e, 0x0a, 0x1c, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x59, 0x0a, 0x15, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x59, 0x0a,
-	0x19, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x27, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0x1b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5c,
-	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0c,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x48, 0x0a, 0x20,
-	0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x72, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x24, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
-	0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x2c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0x3c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x73, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x73, 0x22, 0xeb, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d,
-	0x74, 0x78, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x12, 0x24, 0x0a, 0x0e, 0x62, 0x6c, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x62, 0x6c, 0x6b, 0x53, 0x69, 0x7a,
-	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x4f, 0x75, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0f, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
-	0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x78,
-	0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0c, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x12, 0x25,
-	0x0a, 0x0e, 0x69, 0x70, 0x66, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
-	0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x70, 0x66, 0x73, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x6f, 0x72,
-	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x70, 0x63, 0x50, 0x6f, 0x72, 0x74,
-	0x22, 0xc8, 0x03, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x0f, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0e, 0x32, 0x26, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x6f,
-	0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x78,
-	0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x0b, 0x74, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x24,
-	0x0a, 0x0e, 0x62, 0x6c, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x62, 0x6c, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x10, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f,
-	0x75, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6d,
-	0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x12, 0x23,
-	0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72,
-	0x73, 0x22, 0x78, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x11, 0x0a, 0x0d, 0x54, 0x58, 0x5f, 0x50, 0x4f, 0x4f, 0x4c, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54,
-	0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x4c, 0x4b, 0x5f, 0x53, 0x49, 0x5a, 0x45, 0x5f, 0x4c,
-	0x49, 0x4d, 0x49, 0x54, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x41, 0x58, 0x5f, 0x43, 0x4f,
-	0x4e, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x41, 0x58, 0x5f,
-	0x43, 0x4f, 0x4e, 0x4e, 0x5f, 0x49, 0x4e, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x41, 0x58,
-	0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x45, 0x52, 0x53, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09,
-	0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x45, 0x52, 0x53, 0x10, 0x05, 0x22, 0x32, 0x0a, 0x13, 0x45,
-	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x61, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0x2f, 0x0a, 0x10, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x22, 0x3f, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x32, 0xa3, 0x0b, 0x0a, 0x0a, 0x52, 0x70, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x46, 0x0a, 0x0d, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x70,
-	0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d, 0x52, 0x70, 0x63, 0x47,
-	0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70,
-	0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42,
-	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x5b, 0x0a, 0x14, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72, 0x70, 0x63, 0x70,
-	0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a,
-	0x0a, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x55, 0x54, 0x58, 0x4f, 0x12, 0x15, 0x2e, 0x72, 0x70,
-	0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x54, 0x58, 0x4f, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x54,
-	0x58, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01,
-	0x12, 0x55, 0x0a, 0x14, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x55, 0x54, 0x58, 0x4f, 0x57, 0x69,
-	0x74, 0x68, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x55, 0x54, 0x58, 0x4f, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70,
-	0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x54, 0x58, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x0c, 0x52, 0x70, 0x63, 0x47, 0x65,
-	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x11,
-	0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x61, 0x73,
-	0x68, 0x12, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x58, 0x0a, 0x13, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42,
-	0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x12, 0x52, 0x70,
-	0x63, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x64, 0x0a, 0x17, 0x52, 0x70, 0x63, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x61, 0x74, 0x63,
-	0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x72,
-	0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x23, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x14, 0x52, 0x70, 0x63, 0x47, 0x65,
-	0x74, 0x4e, 0x65, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1f, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x20, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x0c, 0x52, 0x70, 0x63, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x68, 0x0a,
-	0x1f, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x78, 0x50, 0x6f, 0x6f, 0x6c,
-	0x12, 0x20, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c,
-	0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x1b, 0x52, 0x70, 0x63, 0x47, 0x65,
-	0x74, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x72, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x62, 0x6c,
-	0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x26, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x72, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x62,
-	0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
-	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x72,
-	0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x52, 0x70, 0x63,
-	0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x19, 0x2e, 0x72, 0x70,
-	0x63, 0x70, 0x62, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45,
-	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x52, 0x70, 0x63, 0x47, 0x61, 0x73, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x73, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x70,
-	0x63, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x10, 0x52, 0x70, 0x63, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1b, 0x2e, 0x72, 0x70, 0x63,
-	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xa2, 0x02, 0x0a, 0x0c, 0x41, 0x64, 0x6d, 0x69,
-	0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x52, 0x70, 0x63, 0x41,
-	0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41,
-	0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x07, 0x52, 0x70, 0x63, 0x53, 0x65,
-	0x6e, 0x64, 0x12, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53,
-	0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a,
-	0x0e, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x70, 0x63,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x11, 0x52, 0x70, 0x63, 0x43,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x12, 0x1c, 0x2e,
-	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x70,
-	0x63, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xce, 0x02, 0x0a,
-	0x0d, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x52,
-	0x0a, 0x11, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
-	0x73, 0x12, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x10, 0x52, 0x70,
-	0x63, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c,
-	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72,
-	0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x10,
-	0x52, 0x70, 0x63, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x06, 0xa2,
-	0x02, 0x03, 0x48, 0x4c, 0x57, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	Utxos        []*pb2.Utxo        `protobuf:"bytes,1,rep,name=utxos,proto3" json:"utxos,omitempty"`
+	BlockHeaders []*pb3.BlockHeader `protobuf:"bytes,2,rep,name=block_headers,json=blockHeaders,proto3" json:"block_headers,omitempty"` //The latest block headers
 }
 
-var (
-	file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescOnce sync.Once
-	file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescData = file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDesc
-)
+func (x *GetUTXOResponse) Reset() {
+	*x = GetUTXOResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP() []byte {
-	file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescOnce.Do(func() {
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescData)
-	})
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescData
+func (x *GetUTXOResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
-var file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_goTypes = []interface{}{
-	(SetNodeConfigRequest_ConfigType)(0),     // 0: rpcpb.SetNodeConfigRequest.ConfigType
-	(*CreateAccountRequest)(nil),             // 1: rpcpb.CreateAccountRequest
-	(*ChangeProducerRequest)(nil),            // 2: rpcpb.ChangeProducerRequest
-	(*GetBalanceRequest)(nil),                // 3: rpcpb.GetBalanceRequest
-	(*SendFromMinerRequest)(nil),             // 4: rpcpb.SendFromMinerRequest
-	(*SendRequest)(nil),                      // 5: rpcpb.SendRequest
-	(*GetPeerInfoRequest)(nil),               // 6: rpcpb.GetPeerInfoRequest
-	(*GetBlockchainInfoRequest)(nil),         // 7: rpcpb.GetBlockchainInfoRequest
-	(*AddPeerRequest)(nil),                   // 8: rpcpb.AddPeerRequest
-	(*GetVersionRequest)(nil),                // 9: rpcpb.GetVersionRequest
-	(*GetUTXORequest)(nil),                   // 10: rpcpb.GetUTXORequest
-	(*GetUTXOWithAmountRequest)(nil),         // 11: rpcpb.GetUTXOWithAmountRequest
-	(*GetBlocksRequest)(nil),                 // 12: rpcpb.GetBlocksRequest
-	(*GetBlockByHashRequest)(nil),            // 13: rpcpb.GetBlockByHashRequest
-	(*GetBlockByHeightRequest)(nil),          // 14: rpcpb.GetBlockByHeightRequest
-	(*SendTransactionRequest)(nil),           // 15: rpcpb.SendTransactionRequest
-	(*SendBatchTransactionRequest)(nil),      // 16: rpcpb.SendBatchTransactionRequest
-	(*GetNewTransactionRequest)(nil),         // 17: rpcpb.GetNewTransactionRequest
-	(*SubscribeRequest)(nil),                 // 18: rpcpb.SubscribeRequest
-	(*MetricsServiceRequest)(nil),            // 19: rpcpb.MetricsServiceRequest
-	(*GetLastIrreversibleBlockRequest)(nil),  // 20: rpcpb.GetLastIrreversibleBlockRequest
-	(*EstimateGasRequest)(nil),               // 21: rpcpb.EstimateGasRequest
-	(*GasPriceRequest)(nil),                  // 22: rpcpb.GasPriceRequest
-	(*ContractQueryRequest)(nil),             // 23: rpcpb.ContractQueryRequest
-	(*ChangeProducerResponse)(nil),           // 24: rpcpb.ChangeProducerResponse
-	(*GetBalanceResponse)(nil),               // 25: rpcpb.GetBalanceResponse
-	(*SendFromMinerResponse)(nil),            // 26: rpcpb.SendFromMinerResponse
-	(*SendResponse)(nil),                     // 27: rpcpb.SendResponse
-	(*GetPeerInfoResponse)(nil),              // 28: rpcpb.GetPeerInfoResponse
-	(*GetBlockchainInfoResponse)(nil),        // 29: rpcpb.GetBlockchainInfoResponse
-	(*AddPeerResponse)(nil),                  // 30: rpcpb.AddPeerResponse
-	(*GetVersionResponse)(nil),               // 31: rpcpb.GetVersionResponse
-	(*GetUTXOResponse)(nil),                  // 32: rpcpb.GetUTXOResponse
-	(*GetBlocksResponse)(nil),                // 33: rpcpb.GetBlocksResponse
-	(*GetBlockByHashResponse)(nil),           // 34: rpcpb.GetBlockByHashResponse
-	(*GetBlockByHeightResponse)(nil),         // 35: rpcpb.GetBlockByHeightResponse
-	(*SendTransactionResponse)(nil),          // 36: rpcpb.SendTransactionResponse
-	(*SendBatchTransactionResponse)(nil),     // 37: rpcpb.SendBatchTransactionResponse
-	(*SendTransactionStatus)(nil),            // 38: rpcpb.SendTransactionStatus
-	(*GetNewTransactionResponse)(nil),        // 39: rpcpb.GetNewTransactionResponse
-	(*SubscribeResponse)(nil),                // 40: rpcpb.SubscribeResponse
-	(*GetAllTransactionsRequest)(nil),        // 41: rpcpb.GetAllTransactionsRequest
-	(*GetAllTransactionsResponse)(nil),       // 42: rpcpb.GetAllTransactionsResponse
-	(*GetLastIrreversibleBlockResponse)(nil), // 43: rpcpb.GetLastIrreversibleBlockResponse
-	(*GetMetricsInfoResponse)(nil),           // 44: rpcpb.GetMetricsInfoResponse
-	(*GetStatsResponse)(nil),                 // 45: rpcpb.GetStatsResponse
-	(*GetNodeConfigResponse)(nil),            // 46: rpcpb.GetNodeConfigResponse
-	(*SetNodeConfigRequest)(nil),             // 47: rpcpb.SetNodeConfigRequest
-	(*EstimateGasResponse)(nil),              // 48: rpcpb.EstimateGasResponse
-	(*GasPriceResponse)(nil),                 // 49: rpcpb.GasPriceResponse
-	(*ContractQueryResponse)(nil),            // 50: rpcpb.ContractQueryResponse
-	(*pb.Transaction)(nil),                   // 51: transactionpb.Transaction
-	(*pb1.PeerInfo)(nil),                     // 52: networkpb.PeerInfo
-	(*pb2.Utxo)(nil),                         // 53: utxopb.Utxo
-	(*pb3.BlockHeader)(nil),                  // 54: blockpb.BlockHeader
-	(*pb3.Block)(nil),                        // 55: blockpb.Block
-	(*pb4.Metrics)(nil),                      // 56: metricspb.Metrics
+func (*GetUTXOResponse) ProtoMessage() {}
+
+func (x *GetUTXOResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_depIdxs = []int32{
-	51, // 0: rpcpb.SendTransactionRequest.transaction:type_name -> transactionpb.Transaction
-	51, // 1: rpcpb.SendBatchTransactionRequest.transactions:type_name -> transactionpb.Transaction
-	51, // 2: rpcpb.EstimateGasRequest.transaction:type_name -> transactionpb.Transaction
-	52, // 3: rpcpb.GetPeerInfoResponse.peer_list:type_name -> networkpb.PeerInfo
-	53, // 4: rpcpb.GetUTXOResponse.utxos:type_name -> utxopb.Utxo
-	54, // 5: rpcpb.GetUTXOResponse.block_headers:type_name -> blockpb.BlockHeader
-	55, // 6: rpcpb.GetBlocksResponse.blocks:type_name -> blockpb.Block
-	55, // 7: rpcpb.GetBlockByHashResponse.block:type_name -> blockpb.Block
-	55, // 8: rpcpb.GetBlockByHeightResponse.block:type_name -> blockpb.Block
-	51, // 9: rpcpb.GetNewTransactionResponse.transaction:type_name -> transactionpb.Transaction
-	51, // 10: rpcpb.GetAllTransactionsResponse.transactions:type_name -> transactionpb.Transaction
-	55, // 11: rpcpb.GetLastIrreversibleBlockResponse.block:type_name -> blockpb.Block
-	56, // 12: rpcpb.GetStatsResponse.stats:type_name -> metricspb.Metrics
-	0,  // 13: rpcpb.SetNodeConfigRequest.updated_configs:type_name -> rpcpb.SetNodeConfigRequest.ConfigType
-	9,  // 14: rpcpb.RpcService.RpcGetVersion:input_type -> rpcpb.GetVersionRequest
-	3,  // 15: rpcpb.RpcService.RpcGetBalance:input_type -> rpcpb.GetBalanceRequest
-	7,  // 16: rpcpb.RpcService.RpcGetBlockchainInfo:input_type -> rpcpb.GetBlockchainInfoRequest
-	10, // 17: rpcpb.RpcService.RpcGetUTXO:input_type -> rpcpb.GetUTXORequest
-	11, // 18: rpcpb.RpcService.RpcGetUTXOWithAmount:input_type -> rpcpb.GetUTXOWithAmountRequest
-	12, // 19: rpcpb.RpcService.RpcGetBlocks:input_type -> rpcpb.GetBlocksRequest
-	13, // 20: rpcpb.RpcService.RpcGetBlockByHash:input_type -> rpcpb.GetBlockByHashRequest
-	14, // 21: rpcpb.RpcService.RpcGetBlockByHeight:input_type -> rpcpb.GetBlockByHeightRequest
-	15, // 22: rpcpb.RpcService.RpcSendTransaction:input_type -> rpcpb.SendTransactionRequest
-	16, // 23: rpcpb.RpcService.RpcSendBatchTransaction:input_type -> rpcpb.SendBatchTransactionRequest
-	17, // 24: rpcpb.RpcService.RpcGetNewTransaction:input_type -> rpcpb.GetNewTransactionRequest
-	18, // 25: rpcpb.RpcService.RpcSubscribe:input_type -> rpcpb.SubscribeRequest
-	41, // 26: rpcpb.RpcService.RpcGetAllTransactionsFromTxPool:input_type -> rpcpb.GetAllTransactionsRequest
-	20, // 27: rpcpb.RpcService.RpcGetLastIrreversibleBlock:input_type -> rpcpb.GetLastIrreversibleBlockRequest
-	21, // 28: rpcpb.RpcService.RpcEstimateGas:input_type -> rpcpb.EstimateGasRequest
-	22, // 29: rpcpb.RpcService.RpcGasPrice:input_type -> rpcpb.GasPriceRequest
-	23, // 30: rpcpb.RpcService.RpcContractQuery:input_type -> rpcpb.ContractQueryRequest
-	8,  // 31: rpcpb.AdminService.RpcAddPeer:input_type -> rpcpb.AddPeerRequest
-	5,  // 32: rpcpb.AdminService.RpcSend:input_type -> rpcpb.SendRequest
-	6,  // 33: rpcpb.AdminService.RpcGetPeerInfo:input_type -> rpcpb.GetPeerInfoRequest
-	2,  // 34: rpcpb.AdminService.RpcChangeProducer:input_type -> rpcpb.ChangeProducerRequest
-	19, // 35: rpcpb.MetricService.RpcGetMetricsInfo:input_type -> rpcpb.MetricsServiceRequest
-	19, // 36: rpcpb.MetricService.RpcGetStats:input_type -> rpcpb.MetricsServiceRequest
-	19, // 37: rpcpb.MetricService.RpcGetNodeConfig:input_type -> rpcpb.MetricsServiceRequest
-	47, // 38: rpcpb.MetricService.RpcSetNodeConfig:input_type -> rpcpb.SetNodeConfigRequest
-	31, // 39: rpcpb.RpcService.RpcGetVersion:output_type -> rpcpb.GetVersionResponse
-	25, // 40: rpcpb.RpcService.RpcGetBalance:output_type -> rpcpb.GetBalanceResponse
-	29, // 41: rpcpb.RpcService.RpcGetBlockchainInfo:output_type -> rpcpb.GetBlockchainInfoResponse
-	32, // 42: rpcpb.RpcService.RpcGetUTXO:output_type -> rpcpb.GetUTXOResponse
-	32, // 43: rpcpb.RpcService.RpcGetUTXOWithAmount:output_type -> rpcpb.GetUTXOResponse
-	33, // 44: rpcpb.RpcService.RpcGetBlocks:output_type -> rpcpb.GetBlocksResponse
-	34, // 45: rpcpb.RpcService.RpcGetBlockByHash:output_type -> rpcpb.GetBlockByHashResponse
-	35, // 46: rpcpb.RpcService.RpcGetBlockByHeight:output_type -> rpcpb.GetBlockByHeightResponse
-	36, // 47: rpcpb.RpcService.RpcSendTransaction:output_type -> rpcpb.SendTransactionResponse
-	37, // 48: rpcpb.RpcService.RpcSendBatchTransaction:output_type -> rpcpb.SendBatchTransactionResponse
-	39, // 49: rpcpb.RpcService.RpcGetNewTransaction:output_type -> rpcpb.GetNewTransactionResponse
-	40, // 50: rpcpb.RpcService.RpcSubscribe:output_type -> rpcpb.SubscribeResponse
-	42, // 51: rpcpb.RpcService.RpcGetAllTransactionsFromTxPool:output_type -> rpcpb.GetAllTransactionsResponse
-	43, // 52: rpcpb.RpcService.RpcGetLastIrreversibleBlock:output_type -> rpcpb.GetLastIrreversibleBlockResponse
-	48, // 53: rpcpb.RpcService.RpcEstimateGas:output_type -> rpcpb.EstimateGasResponse
-	49, // 54: rpcpb.RpcService.RpcGasPrice:output_type -> rpcpb.GasPriceResponse
-	50, // 55: rpcpb.RpcService.RpcContractQuery:output_type -> rpcpb.ContractQueryResponse
-	30, // 56: rpcpb.AdminService.RpcAddPeer:output_type -> rpcpb.AddPeerResponse
-	27, // 57: rpcpb.AdminService.RpcSend:output_type -> rpcpb.SendResponse
-	28, // 58: rpcpb.AdminService.RpcGetPeerInfo:output_type -> rpcpb.GetPeerInfoResponse
-	24, // 59: rpcpb.AdminService.RpcChangeProducer:output_type -> rpcpb.ChangeProducerResponse
-	44, // 60: rpcpb.MetricService.RpcGetMetricsInfo:output_type -> rpcpb.GetMetricsInfoResponse
-	45, // 61: rpcpb.MetricService.RpcGetStats:output_type -> rpcpb.GetStatsResponse
-	46, // 62: rpcpb.MetricService.RpcGetNodeConfig:output_type -> rpcpb.GetNodeConfigResponse
-	46, // 63: rpcpb.MetricService.RpcSetNodeConfig:output_type -> rpcpb.GetNodeConfigResponse
-	39, // [39:64] is the sub-list for method output_type
-	14, // [14:39] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+
+// Deprecated: Use GetUTXOResponse.ProtoReflect.Descriptor instead.
+func (*GetUTXOResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{53}
 }
 
-func init() { file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() }
-func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
-	if File_github_com_dappley_go_dappley_rpc_pb_rpc_proto != nil {
-		return
+func (x *GetUTXOResponse) GetUtxos() []*pb2.Utxo {
+	if x != nil {
+		return x.Utxos
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateAccountRequest); i {
+	return nil
+}
+
+func (x *GetUTXOResponse) GetBlockHeaders() []*pb3.BlockHeader {
+	if x != nil {
+		return x.BlockHeaders
+	}
+	return nil
+}
+
+type GetBlocksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blocks []*pb3.Block `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+}
+
+func (x *GetBlocksResponse) Reset() {
+	*x = GetBlocksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlocksResponse) ProtoMessage() {}
+
+func (x *GetBlocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlocksResponse.ProtoReflect.Descriptor instead.
+func (*GetBlocksResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetBlocksResponse) GetBlocks() []*pb3.Block {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+type GetBlockByHashResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Block *pb3.Block `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+}
+
+func (x *GetBlockByHashResponse) Reset() {
+	*x = GetBlockByHashResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlockByHashResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockByHashResponse) ProtoMessage() {}
+
+func (x *GetBlockByHashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockByHashResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockByHashResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetBlockByHashResponse) GetBlock() *pb3.Block {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+type GetBlockByHeightResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Block *pb3.Block `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+}
+
+func (x *GetBlockByHeightResponse) Reset() {
+	*x = GetBlockByHeightResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlockByHeightResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockByHeightResponse) ProtoMessage() {}
+
+func (x *GetBlockByHeightResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockByHeightResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockByHeightResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetBlockByHeightResponse) GetBlock() *pb3.Block {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+type SendTransactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GeneratedContractAddress string `protobuf:"bytes,1,opt,name=generated_contract_address,json=generatedContractAddress,proto3" json:"generated_contract_address,omitempty"`
+}
+
+func (x *SendTransactionResponse) Reset() {
+	*x = SendTransactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTransactionResponse) ProtoMessage() {}
+
+func (x *SendTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTransactionResponse.ProtoReflect.Descriptor instead.
+func (*SendTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SendTransactionResponse) GetGeneratedContractAddress() string {
+	if x != nil {
+		return x.GeneratedContractAddress
+	}
+	return ""
+}
+
+type SendBatchTransactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SendBatchTransactionResponse) Reset() {
+	*x = SendBatchTransactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendBatchTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendBatchTransactionResponse) ProtoMessage() {}
+
+func (x *SendBatchTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendBatchTransactionResponse.ProtoReflect.Descriptor instead.
+func (*SendBatchTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{58}
+}
+
+type SendTransactionStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txid    []byte `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`  // Transactions that are sent
+	Code    uint32 `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"` // grpc status code
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *SendTransactionStatus) Reset() {
+	*x = SendTransactionStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendTransactionStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTransactionStatus) ProtoMessage() {}
+
+func (x *SendTransactionStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTransactionStatus.ProtoReflect.Descriptor instead.
+func (*SendTransactionStatus) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SendTransactionStatus) GetTxid() []byte {
+	if x != nil {
+		return x.Txid
+	}
+	return nil
+}
+
+func (x *SendTransactionStatus) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *SendTransactionStatus) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetNewTransactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transaction *pb.Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+}
+
+func (x *GetNewTransactionResponse) Reset() {
+	*x = GetNewTransactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNewTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNewTransactionResponse) ProtoMessage() {}
+
+func (x *GetNewTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNewTransactionResponse.ProtoReflect.Descriptor instead.
+func (*GetNewTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetNewTransactionResponse) GetTransaction() *pb.Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type SubscribeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *SubscribeResponse) Reset() {
+	*x = SubscribeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeResponse) ProtoMessage() {}
+
+func (x *SubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SubscribeResponse) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type GetAllTransactionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetAllTransactionsRequest) Reset() {
+	*x = GetAllTransactionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllTransactionsRequest) ProtoMessage() {}
+
+func (x *GetAllTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{62}
+}
+
+type GetAllTransactionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transactions []*pb.Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+}
+
+func (x *GetAllTransactionsResponse) Reset() {
+	*x = GetAllTransactionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllTransactionsResponse) ProtoMessage() {}
+
+func (x *GetAllTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetAllTransactionsResponse) GetTransactions() []*pb.Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type GetLastIrreversibleBlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Block         *pb3.Block         `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Justification *pb4.Justification `protobuf:"bytes,2,opt,name=justification,proto3" json:"justification,omitempty"` // empty if the block is irreversible by depth rather than by finality votes
+}
+
+func (x *GetLastIrreversibleBlockResponse) Reset() {
+	*x = GetLastIrreversibleBlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLastIrreversibleBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLastIrreversibleBlockResponse) ProtoMessage() {}
+
+func (x *GetLastIrreversibleBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLastIrreversibleBlockResponse.ProtoReflect.Descriptor instead.
+func (*GetLastIrreversibleBlockResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetLastIrreversibleBlockResponse) GetBlock() *pb3.Block {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *GetLastIrreversibleBlockResponse) GetJustification() *pb4.Justification {
+	if x != nil {
+		return x.Justification
+	}
+	return nil
+}
+
+type GetMetricsInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data string `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetMetricsInfoResponse) Reset() {
+	*x = GetMetricsInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMetricsInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMetricsInfoResponse) ProtoMessage() {}
+
+func (x *GetMetricsInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMetricsInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetMetricsInfoResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetMetricsInfoResponse) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+type GetStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stats *pb5.Metrics `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *GetStatsResponse) Reset() {
+	*x = GetStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsResponse) ProtoMessage() {}
+
+func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetStatsResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetStatsResponse) GetStats() *pb5.Metrics {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type ProducerStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address           string  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Produced          uint64  `protobuf:"varint,2,opt,name=produced,proto3" json:"produced,omitempty"`                                            // blocks on the main chain
+	Missed            uint64  `protobuf:"varint,3,opt,name=missed,proto3" json:"missed,omitempty"`                                                // slots without a block
+	Orphaned          uint64  `protobuf:"varint,4,opt,name=orphaned,proto3" json:"orphaned,omitempty"`                                            // blocks reverted by a fork
+	MeanLatency       float64 `protobuf:"fixed64,5,opt,name=mean_latency,json=meanLatency,proto3" json:"mean_latency,omitempty"`                  // mean seconds between the start of a slot and the block timestamp
+	ConsecutiveMissed uint64  `protobuf:"varint,6,opt,name=consecutive_missed,json=consecutiveMissed,proto3" json:"consecutive_missed,omitempty"` // rounds missed since the last block
+}
+
+func (x *ProducerStats) Reset() {
+	*x = ProducerStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProducerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProducerStats) ProtoMessage() {}
+
+func (x *ProducerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProducerStats.ProtoReflect.Descriptor instead.
+func (*ProducerStats) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ProducerStats) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ProducerStats) GetProduced() uint64 {
+	if x != nil {
+		return x.Produced
+	}
+	return 0
+}
+
+func (x *ProducerStats) GetMissed() uint64 {
+	if x != nil {
+		return x.Missed
+	}
+	return 0
+}
+
+func (x *ProducerStats) GetOrphaned() uint64 {
+	if x != nil {
+		return x.Orphaned
+	}
+	return 0
+}
+
+func (x *ProducerStats) GetMeanLatency() float64 {
+	if x != nil {
+		return x.MeanLatency
+	}
+	return 0
+}
+
+func (x *ProducerStats) GetConsecutiveMissed() uint64 {
+	if x != nil {
+		return x.ConsecutiveMissed
+	}
+	return 0
+}
+
+type GetProducerStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Stats []*ProducerStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *GetProducerStatsResponse) Reset() {
+	*x = GetProducerStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProducerStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProducerStatsResponse) ProtoMessage() {}
+
+func (x *GetProducerStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProducerStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetProducerStatsResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetProducerStatsResponse) GetStats() []*ProducerStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type GetNodeConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxPoolLimit      uint32   `protobuf:"varint,1,opt,name=tx_pool_limit,json=txPoolLimit,proto3" json:"tx_pool_limit,omitempty"`
+	BlkSizeLimit     uint32   `protobuf:"varint,2,opt,name=blk_size_limit,json=blkSizeLimit,proto3" json:"blk_size_limit,omitempty"`
+	MaxConnectionOut uint32   `protobuf:"varint,3,opt,name=max_connection_out,json=maxConnectionOut,proto3" json:"max_connection_out,omitempty"`
+	MaxConnectionIn  uint32   `protobuf:"varint,4,opt,name=max_connection_in,json=maxConnectionIn,proto3" json:"max_connection_in,omitempty"`
+	ProducerAddress  string   `protobuf:"bytes,5,opt,name=producer_address,json=producerAddress,proto3" json:"producer_address,omitempty"`
+	Producers        []string `protobuf:"bytes,6,rep,name=producers,proto3" json:"producers,omitempty"`
+	MaxProducers     uint32   `protobuf:"varint,7,opt,name=max_producers,json=maxProducers,proto3" json:"max_producers,omitempty"`
+	IpfsAddresses    []string `protobuf:"bytes,9,rep,name=ipfs_addresses,json=ipfsAddresses,proto3" json:"ipfs_addresses,omitempty"`
+	RpcPort          uint32   `protobuf:"varint,10,opt,name=rpc_port,json=rpcPort,proto3" json:"rpc_port,omitempty"`
+}
+
+func (x *GetNodeConfigResponse) Reset() {
+	*x = GetNodeConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNodeConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNodeConfigResponse) ProtoMessage() {}
+
+func (x *GetNodeConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNodeConfigResponse.ProtoReflect.Descriptor instead.
+func (*GetNodeConfigResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetNodeConfigResponse) GetTxPoolLimit() uint32 {
+	if x != nil {
+		return x.TxPoolLimit
+	}
+	return 0
+}
+
+func (x *GetNodeConfigResponse) GetBlkSizeLimit() uint32 {
+	if x != nil {
+		return x.BlkSizeLimit
+	}
+	return 0
+}
+
+func (x *GetNodeConfigResponse) GetMaxConnectionOut() uint32 {
+	if x != nil {
+		return x.MaxConnectionOut
+	}
+	return 0
+}
+
+func (x *GetNodeConfigResponse) GetMaxConnectionIn() uint32 {
+	if x != nil {
+		return x.MaxConnectionIn
+	}
+	return 0
+}
+
+func (x *GetNodeConfigResponse) GetProducerAddress() string {
+	if x != nil {
+		return x.ProducerAddress
+	}
+	return ""
+}
+
+func (x *GetNodeConfigResponse) GetProducers() []string {
+	if x != nil {
+		return x.Producers
+	}
+	return nil
+}
+
+func (x *GetNodeConfigResponse) GetMaxProducers() uint32 {
+	if x != nil {
+		return x.MaxProducers
+	}
+	return 0
+}
+
+func (x *GetNodeConfigResponse) GetIpfsAddresses() []string {
+	if x != nil {
+		return x.IpfsAddresses
+	}
+	return nil
+}
+
+func (x *GetNodeConfigResponse) GetRpcPort() uint32 {
+	if x != nil {
+		return x.RpcPort
+	}
+	return 0
+}
+
+type SetNodeConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UpdatedConfigs   []SetNodeConfigRequest_ConfigType `protobuf:"varint,1,rep,packed,name=updated_configs,json=updatedConfigs,proto3,enum=rpcpb.SetNodeConfigRequest_ConfigType" json:"updated_configs,omitempty"`
+	TxPoolLimit      uint32                            `protobuf:"varint,2,opt,name=tx_pool_limit,json=txPoolLimit,proto3" json:"tx_pool_limit,omitempty"`
+	BlkSizeLimit     uint32                            `protobuf:"varint,3,opt,name=blk_size_limit,json=blkSizeLimit,proto3" json:"blk_size_limit,omitempty"`
+	MaxConnectionOut uint32                            `protobuf:"varint,4,opt,name=max_connection_out,json=maxConnectionOut,proto3" json:"max_connection_out,omitempty"`
+	MaxConnectionIn  uint32                            `protobuf:"varint,5,opt,name=max_connection_in,json=maxConnectionIn,proto3" json:"max_connection_in,omitempty"`
+	MaxProducers     uint32                            `protobuf:"varint,6,opt,name=max_producers,json=maxProducers,proto3" json:"max_producers,omitempty"`
+	Producers        []string                          `protobuf:"bytes,7,rep,name=producers,proto3" json:"producers,omitempty"`
+}
+
+func (x *SetNodeConfigRequest) Reset() {
+	*x = SetNodeConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetNodeConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNodeConfigRequest) ProtoMessage() {}
+
+func (x *SetNodeConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNodeConfigRequest.ProtoReflect.Descriptor instead.
+func (*SetNodeConfigRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SetNodeConfigRequest) GetUpdatedConfigs() []SetNodeConfigRequest_ConfigType {
+	if x != nil {
+		return x.UpdatedConfigs
+	}
+	return nil
+}
+
+func (x *SetNodeConfigRequest) GetTxPoolLimit() uint32 {
+	if x != nil {
+		return x.TxPoolLimit
+	}
+	return 0
+}
+
+func (x *SetNodeConfigRequest) GetBlkSizeLimit() uint32 {
+	if x != nil {
+		return x.BlkSizeLimit
+	}
+	return 0
+}
+
+func (x *SetNodeConfigRequest) GetMaxConnectionOut() uint32 {
+	if x != nil {
+		return x.MaxConnectionOut
+	}
+	return 0
+}
+
+func (x *SetNodeConfigRequest) GetMaxConnectionIn() uint32 {
+	if x != nil {
+		return x.MaxConnectionIn
+	}
+	return 0
+}
+
+func (x *SetNodeConfigRequest) GetMaxProducers() uint32 {
+	if x != nil {
+		return x.MaxProducers
+	}
+	return 0
+}
+
+func (x *SetNodeConfigRequest) GetProducers() []string {
+	if x != nil {
+		return x.Producers
+	}
+	return nil
+}
+
+type EstimateGasResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GasCount   []byte              `protobuf:"bytes,1,opt,name=gas_count,json=gasCount,proto3" json:"gas_count,omitempty"`
+	Suggestion *GasPriceSuggestion `protobuf:"bytes,2,opt,name=suggestion,proto3" json:"suggestion,omitempty"`
+}
+
+func (x *EstimateGasResponse) Reset() {
+	*x = EstimateGasResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateGasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateGasResponse) ProtoMessage() {}
+
+func (x *EstimateGasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateGasResponse.ProtoReflect.Descriptor instead.
+func (*EstimateGasResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *EstimateGasResponse) GetGasCount() []byte {
+	if x != nil {
+		return x.GasCount
+	}
+	return nil
+}
+
+func (x *EstimateGasResponse) GetSuggestion() *GasPriceSuggestion {
+	if x != nil {
+		return x.Suggestion
+	}
+	return nil
+}
+
+type GasPriceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GasPrice   []byte              `protobuf:"bytes,1,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price,omitempty"`
+	Suggestion *GasPriceSuggestion `protobuf:"bytes,2,opt,name=suggestion,proto3" json:"suggestion,omitempty"`
+}
+
+func (x *GasPriceResponse) Reset() {
+	*x = GasPriceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GasPriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GasPriceResponse) ProtoMessage() {}
+
+func (x *GasPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GasPriceResponse.ProtoReflect.Descriptor instead.
+func (*GasPriceResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GasPriceResponse) GetGasPrice() []byte {
+	if x != nil {
+		return x.GasPrice
+	}
+	return nil
+}
+
+func (x *GasPriceResponse) GetSuggestion() *GasPriceSuggestion {
+	if x != nil {
+		return x.Suggestion
+	}
+	return nil
+}
+
+type GasPriceSuggestion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LowGasPrice    []byte `protobuf:"bytes,1,opt,name=low_gas_price,json=lowGasPrice,proto3" json:"low_gas_price,omitempty"`
+	MedianGasPrice []byte `protobuf:"bytes,2,opt,name=median_gas_price,json=medianGasPrice,proto3" json:"median_gas_price,omitempty"`
+	HighGasPrice   []byte `protobuf:"bytes,3,opt,name=high_gas_price,json=highGasPrice,proto3" json:"high_gas_price,omitempty"`
+	LowTip         []byte `protobuf:"bytes,4,opt,name=low_tip,json=lowTip,proto3" json:"low_tip,omitempty"`
+	MedianTip      []byte `protobuf:"bytes,5,opt,name=median_tip,json=medianTip,proto3" json:"median_tip,omitempty"`
+	HighTip        []byte `protobuf:"bytes,6,opt,name=high_tip,json=highTip,proto3" json:"high_tip,omitempty"`
+}
+
+func (x *GasPriceSuggestion) Reset() {
+	*x = GasPriceSuggestion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GasPriceSuggestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GasPriceSuggestion) ProtoMessage() {}
+
+func (x *GasPriceSuggestion) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GasPriceSuggestion.ProtoReflect.Descriptor instead.
+func (*GasPriceSuggestion) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GasPriceSuggestion) GetLowGasPrice() []byte {
+	if x != nil {
+		return x.LowGasPrice
+	}
+	return nil
+}
+
+func (x *GasPriceSuggestion) GetMedianGasPrice() []byte {
+	if x != nil {
+		return x.MedianGasPrice
+	}
+	return nil
+}
+
+func (x *GasPriceSuggestion) GetHighGasPrice() []byte {
+	if x != nil {
+		return x.HighGasPrice
+	}
+	return nil
+}
+
+func (x *GasPriceSuggestion) GetLowTip() []byte {
+	if x != nil {
+		return x.LowTip
+	}
+	return nil
+}
+
+func (x *GasPriceSuggestion) GetMedianTip() []byte {
+	if x != nil {
+		return x.MedianTip
+	}
+	return nil
+}
+
+func (x *GasPriceSuggestion) GetHighTip() []byte {
+	if x != nil {
+		return x.HighTip
+	}
+	return nil
+}
+
+type ContractQueryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *ContractQueryResponse) Reset() {
+	*x = ContractQueryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContractQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractQueryResponse) ProtoMessage() {}
+
+func (x *ContractQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractQueryResponse.ProtoReflect.Descriptor instead.
+func (*ContractQueryResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ContractQueryResponse) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ContractQueryResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type GetTransactionReceiptResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Receipt *pb.TransactionReceipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+}
+
+func (x *GetTransactionReceiptResponse) Reset() {
+	*x = GetTransactionReceiptResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionReceiptResponse) ProtoMessage() {}
+
+func (x *GetTransactionReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionReceiptResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetTransactionReceiptResponse) GetReceipt() *pb.TransactionReceipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+type Candidate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Votes   []byte `protobuf:"bytes,2,opt,name=votes,proto3" json:"votes,omitempty"`
+}
+
+func (x *Candidate) Reset() {
+	*x = Candidate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Candidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Candidate) ProtoMessage() {}
+
+func (x *Candidate) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Candidate.ProtoReflect.Descriptor instead.
+func (*Candidate) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *Candidate) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Candidate) GetVotes() []byte {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+type GetCandidatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Candidates         []*Candidate `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"` // sorted descending by votes
+	NextElectionHeight uint64       `protobuf:"varint,2,opt,name=next_election_height,json=nextElectionHeight,proto3" json:"next_election_height,omitempty"`
+}
+
+func (x *GetCandidatesResponse) Reset() {
+	*x = GetCandidatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCandidatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCandidatesResponse) ProtoMessage() {}
+
+func (x *GetCandidatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCandidatesResponse.ProtoReflect.Descriptor instead.
+func (*GetCandidatesResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetCandidatesResponse) GetCandidates() []*Candidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *GetCandidatesResponse) GetNextElectionHeight() uint64 {
+	if x != nil {
+		return x.NextElectionHeight
+	}
+	return 0
+}
+
+type Vote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txid       []byte   `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
+	Amount     []byte   `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Candidates []string `protobuf:"bytes,3,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	Height     uint64   `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *Vote) Reset() {
+	*x = Vote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Vote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vote) ProtoMessage() {}
+
+func (x *Vote) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vote.ProtoReflect.Descriptor instead.
+func (*Vote) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *Vote) GetTxid() []byte {
+	if x != nil {
+		return x.Txid
+	}
+	return nil
+}
+
+func (x *Vote) GetAmount() []byte {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Vote) GetCandidates() []string {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *Vote) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type GetVotesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Votes []*Vote `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes,omitempty"`
+}
+
+func (x *GetVotesResponse) Reset() {
+	*x = GetVotesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVotesResponse) ProtoMessage() {}
+
+func (x *GetVotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVotesResponse.ProtoReflect.Descriptor instead.
+func (*GetVotesResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetVotesResponse) GetVotes() []*Vote {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+type SlashedProducer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Txid    []byte `protobuf:"bytes,2,opt,name=txid,proto3" json:"txid,omitempty"`      // the slash transaction carrying the evidence
+	Height  uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"` // the height of the block that packed the slash transaction
+}
+
+func (x *SlashedProducer) Reset() {
+	*x = SlashedProducer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SlashedProducer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SlashedProducer) ProtoMessage() {}
+
+func (x *SlashedProducer) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SlashedProducer.ProtoReflect.Descriptor instead.
+func (*SlashedProducer) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SlashedProducer) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SlashedProducer) GetTxid() []byte {
+	if x != nil {
+		return x.Txid
+	}
+	return nil
+}
+
+func (x *SlashedProducer) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type GetSlashedProducersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Producers []*SlashedProducer `protobuf:"bytes,1,rep,name=producers,proto3" json:"producers,omitempty"`
+}
+
+func (x *GetSlashedProducersResponse) Reset() {
+	*x = GetSlashedProducersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSlashedProducersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSlashedProducersResponse) ProtoMessage() {}
+
+func (x *GetSlashedProducersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSlashedProducersResponse.ProtoReflect.Descriptor instead.
+func (*GetSlashedProducersResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetSlashedProducersResponse) GetProducers() []*SlashedProducer {
+	if x != nil {
+		return x.Producers
+	}
+	return nil
+}
+
+type DynastyChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height   uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`    // the height of the block after which the change takes effect
+	Original string `protobuf:"bytes,2,opt,name=original,proto3" json:"original,omitempty"` // the producer that scheduled the change
+	New      string `protobuf:"bytes,3,opt,name=new,proto3" json:"new,omitempty"`           // the producer that replaces or joins the original producer
+	Kind     uint32 `protobuf:"varint,4,opt,name=kind,proto3" json:"kind,omitempty"`        // 1: replace, 2: add, 3: remove
+	Txid     []byte `protobuf:"bytes,5,opt,name=txid,proto3" json:"txid,omitempty"`         // the producer change transaction
+}
+
+func (x *DynastyChange) Reset() {
+	*x = DynastyChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DynastyChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynastyChange) ProtoMessage() {}
+
+func (x *DynastyChange) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DynastyChange.ProtoReflect.Descriptor instead.
+func (*DynastyChange) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *DynastyChange) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *DynastyChange) GetOriginal() string {
+	if x != nil {
+		return x.Original
+	}
+	return ""
+}
+
+func (x *DynastyChange) GetNew() string {
+	if x != nil {
+		return x.New
+	}
+	return ""
+}
+
+func (x *DynastyChange) GetKind() uint32 {
+	if x != nil {
+		return x.Kind
+	}
+	return 0
+}
+
+func (x *DynastyChange) GetTxid() []byte {
+	if x != nil {
+		return x.Txid
+	}
+	return nil
+}
+
+type GetDynastyScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Producers []string         `protobuf:"bytes,1,rep,name=producers,proto3" json:"producers,omitempty"` // the current producers
+	Changes   []*DynastyChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`     // the changes that have not taken effect yet
+}
+
+func (x *GetDynastyScheduleResponse) Reset() {
+	*x = GetDynastyScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDynastyScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDynastyScheduleResponse) ProtoMessage() {}
+
+func (x *GetDynastyScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDynastyScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GetDynastyScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetDynastyScheduleResponse) GetProducers() []string {
+	if x != nil {
+		return x.Producers
+	}
+	return nil
+}
+
+func (x *GetDynastyScheduleResponse) GetChanges() []*DynastyChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type GetSupplyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Minted      []byte  `protobuf:"bytes,1,opt,name=minted,proto3" json:"minted,omitempty"`                              // the coins minted by all blocks
+	Burnt       []byte  `protobuf:"bytes,2,opt,name=burnt,proto3" json:"burnt,omitempty"`                                // the gas fees burnt
+	Supply      []byte  `protobuf:"bytes,3,opt,name=supply,proto3" json:"supply,omitempty"`                              // the minted coins that are not burnt
+	SupplyCap   []byte  `protobuf:"bytes,4,opt,name=supply_cap,json=supplyCap,proto3" json:"supply_cap,omitempty"`       // the maximum number of coins ever minted. Empty if the supply is not limited
+	BlockReward []byte  `protobuf:"bytes,5,opt,name=block_reward,json=blockReward,proto3" json:"block_reward,omitempty"` // the reward of the next block
+	Inflation   float64 `protobuf:"fixed64,6,opt,name=inflation,proto3" json:"inflation,omitempty"`                      // the coins minted in the next year relative to the supply
+}
+
+func (x *GetSupplyResponse) Reset() {
+	*x = GetSupplyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSupplyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSupplyResponse) ProtoMessage() {}
+
+func (x *GetSupplyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSupplyResponse.ProtoReflect.Descriptor instead.
+func (*GetSupplyResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *GetSupplyResponse) GetMinted() []byte {
+	if x != nil {
+		return x.Minted
+	}
+	return nil
+}
+
+func (x *GetSupplyResponse) GetBurnt() []byte {
+	if x != nil {
+		return x.Burnt
+	}
+	return nil
+}
+
+func (x *GetSupplyResponse) GetSupply() []byte {
+	if x != nil {
+		return x.Supply
+	}
+	return nil
+}
+
+func (x *GetSupplyResponse) GetSupplyCap() []byte {
+	if x != nil {
+		return x.SupplyCap
+	}
+	return nil
+}
+
+func (x *GetSupplyResponse) GetBlockReward() []byte {
+	if x != nil {
+		return x.BlockReward
+	}
+	return nil
+}
+
+func (x *GetSupplyResponse) GetInflation() float64 {
+	if x != nil {
+		return x.Inflation
+	}
+	return 0
+}
+
+type Proposal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txid             []byte   `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"` // the proposal transaction
+	Proposer         string   `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	Parameter        string   `protobuf:"bytes,3,opt,name=parameter,proto3" json:"parameter,omitempty"` // blockSizeLimit, gasPriceFloor, maxProducers or timeBetweenBlk
+	Value            uint64   `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+	Height           uint64   `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`                                             // the height of the block that contains the proposal
+	ActivationHeight uint64   `protobuf:"varint,6,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"` // the height of the block after which the proposal is tallied
+	Approvals        []string `protobuf:"bytes,7,rep,name=approvals,proto3" json:"approvals,omitempty"`                                        // the current producers that approve the proposal
+}
+
+func (x *Proposal) Reset() {
+	*x = Proposal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Proposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Proposal) ProtoMessage() {}
+
+func (x *Proposal) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Proposal.ProtoReflect.Descriptor instead.
+func (*Proposal) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *Proposal) GetTxid() []byte {
+	if x != nil {
+		return x.Txid
+	}
+	return nil
+}
+
+func (x *Proposal) GetProposer() string {
+	if x != nil {
+		return x.Proposer
+	}
+	return ""
+}
+
+func (x *Proposal) GetParameter() string {
+	if x != nil {
+		return x.Parameter
+	}
+	return ""
+}
+
+func (x *Proposal) GetValue() uint64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Proposal) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *Proposal) GetActivationHeight() uint64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
+func (x *Proposal) GetApprovals() []string {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+type GetChainParametersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height         uint64      `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"` // the height of the block after which the parameters are in force
+	BlockSizeLimit uint32      `protobuf:"varint,2,opt,name=block_size_limit,json=blockSizeLimit,proto3" json:"block_size_limit,omitempty"`
+	GasPriceFloor  uint64      `protobuf:"varint,3,opt,name=gas_price_floor,json=gasPriceFloor,proto3" json:"gas_price_floor,omitempty"`
+	MaxProducers   uint32      `protobuf:"varint,4,opt,name=max_producers,json=maxProducers,proto3" json:"max_producers,omitempty"`
+	TimeBetweenBlk uint32      `protobuf:"varint,5,opt,name=time_between_blk,json=timeBetweenBlk,proto3" json:"time_between_blk,omitempty"`
+	Quorum         uint32      `protobuf:"varint,6,opt,name=quorum,proto3" json:"quorum,omitempty"`      // the approvals a proposal needs to pass in the current dynasty
+	Proposals      []*Proposal `protobuf:"bytes,7,rep,name=proposals,proto3" json:"proposals,omitempty"` // the proposals that have not been tallied yet
+}
+
+func (x *GetChainParametersResponse) Reset() {
+	*x = GetChainParametersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChainParametersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChainParametersResponse) ProtoMessage() {}
+
+func (x *GetChainParametersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChainParametersResponse.ProtoReflect.Descriptor instead.
+func (*GetChainParametersResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetChainParametersResponse) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GetChainParametersResponse) GetBlockSizeLimit() uint32 {
+	if x != nil {
+		return x.BlockSizeLimit
+	}
+	return 0
+}
+
+func (x *GetChainParametersResponse) GetGasPriceFloor() uint64 {
+	if x != nil {
+		return x.GasPriceFloor
+	}
+	return 0
+}
+
+func (x *GetChainParametersResponse) GetMaxProducers() uint32 {
+	if x != nil {
+		return x.MaxProducers
+	}
+	return 0
+}
+
+func (x *GetChainParametersResponse) GetTimeBetweenBlk() uint32 {
+	if x != nil {
+		return x.TimeBetweenBlk
+	}
+	return 0
+}
+
+func (x *GetChainParametersResponse) GetQuorum() uint32 {
+	if x != nil {
+		return x.Quorum
+	}
+	return 0
+}
+
+func (x *GetChainParametersResponse) GetProposals() []*Proposal {
+	if x != nil {
+		return x.Proposals
+	}
+	return nil
+}
+
+type GetLightBlockHeadersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Headers []*pb6.LightBlockHeader `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty"`
+}
+
+func (x *GetLightBlockHeadersResponse) Reset() {
+	*x = GetLightBlockHeadersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLightBlockHeadersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLightBlockHeadersResponse) ProtoMessage() {}
+
+func (x *GetLightBlockHeadersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLightBlockHeadersResponse.ProtoReflect.Descriptor instead.
+func (*GetLightBlockHeadersResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetLightBlockHeadersResponse) GetHeaders() []*pb6.LightBlockHeader {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+type GetUtxoProofsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Proofs []*pb6.UtxoProof `protobuf:"bytes,1,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	Height uint64           `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"` // the height of the tail block when the utxos were read
+}
+
+func (x *GetUtxoProofsResponse) Reset() {
+	*x = GetUtxoProofsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUtxoProofsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUtxoProofsResponse) ProtoMessage() {}
+
+func (x *GetUtxoProofsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUtxoProofsResponse.ProtoReflect.Descriptor instead.
+func (*GetUtxoProofsResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetUtxoProofsResponse) GetProofs() []*pb6.UtxoProof {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+func (x *GetUtxoProofsResponse) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+var File_github_com_dappley_go_dappley_rpc_pb_rpc_proto protoreflect.FileDescriptor
+
+var file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDesc = []byte{
+	0x0a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70,
+	0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f,
+	0x72, 0x70, 0x63, 0x2f, 0x70, 0x62, 0x2f, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x05, 0x72, 0x70, 0x63, 0x70, 0x62, 0x1a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64,
+	0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x2f, 0x70, 0x62, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70,
+	0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f,
+	0x63, 0x6f, 0x72, 0x65, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x2f, 0x70, 0x62, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70,
+	0x70, 0x6c, 0x65, 0x79, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x75, 0x74, 0x78, 0x6f, 0x2f, 0x70,
+	0x62, 0x2f, 0x75, 0x74, 0x78, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x33, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79,
+	0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x2f, 0x70, 0x62, 0x2f, 0x70, 0x65, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61,
+	0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79,
+	0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2f, 0x70, 0x62, 0x2f, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x3d, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f,
+	0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f,
+	0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x2f, 0x70, 0x62, 0x2f, 0x66, 0x69, 0x6e, 0x61,
+	0x6c, 0x69, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x44, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67,
+	0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2f,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x70, 0x62, 0x2f, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x36, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73,
+	0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61,
+	0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x22, 0x61, 0x0a, 0x15, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x2d, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x3e, 0x0a, 0x14, 0x53, 0x65,
+	0x6e, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xcc, 0x01, 0x0a, 0x0b, 0x53,
+	0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e,
+	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x69, 0x70,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x74, 0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x33, 0x0a, 0x0e, 0x41,
+	0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x22, 0x17, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x50, 0x65, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x45, 0x0a, 0x0e, 0x42, 0x61, 0x6e,
+	0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70,
+	0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x2b, 0x0a, 0x10, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64, 0x22, 0x16, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x34, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0x33, 0x0a, 0x16, 0x53,
+	0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x44, 0x65, 0x6e, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64, 0x73,
+	0x22, 0x38, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x2a, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x55, 0x54, 0x58, 0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x4c, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x55, 0x54, 0x58,
+	0x4f, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x2b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42,
+	0x79, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x22, 0x31, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0x56, 0x0a, 0x16, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a,
+	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5d, 0x0a, 0x1b, 0x53,
+	0x65, 0x6e, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0c, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x62,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x4e, 0x65, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2a, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x21, 0x0a, 0x1f, 0x47,
+	0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x72, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x62,
+	0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x52,
+	0x0a, 0x12, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x63, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x32, 0x0a, 0x1c, 0x47, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22, 0x16,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65,
+	0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x1b, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x44, 0x79, 0x6e, 0x61, 0x73, 0x74, 0x79, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x12,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x33, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x56, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4c, 0x69,
+	0x67, 0x68, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x30, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x22, 0x18, 0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x53, 0x65, 0x6e,
+	0x64, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x4d, 0x0a, 0x0c, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69,
+	0x64, 0x22, 0x47, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xca, 0x01, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x61, 0x69, 0x6c,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0d, 0x74, 0x61, 0x69, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x26, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x47,
+	0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x50, 0x65,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x0a, 0x09, 0x50, 0x65,
+	0x65, 0x72, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x61,
+	0x6e, 0x6e, 0x65, 0x64, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x38, 0x0a, 0x0c, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x0b,
+	0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x31, 0x0a, 0x0b, 0x70,
+	0x65, 0x65, 0x72, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x52, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x11,
+	0x0a, 0x0f, 0x42, 0x61, 0x6e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x13, 0x0a, 0x11, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65,
+	0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x65, 0x6e, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x65, 0x6e, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x65, 0x74,
+	0x50, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72,
+	0x44, 0x65, 0x6e, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x60, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x70, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x54, 0x58, 0x4f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x75, 0x74, 0x78, 0x6f, 0x70, 0x62, 0x2e, 0x55, 0x74,
+	0x78, 0x6f, 0x52, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x22, 0x3b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x22, 0x3e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48,
+	0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x22, 0x40, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a,
+	0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x22, 0x57, 0x0a, 0x17, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c,
+	0x0a, 0x1a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x18, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x1e, 0x0a, 0x1c,
+	0x53, 0x65, 0x6e, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x59, 0x0a, 0x15,
+	0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x59, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4e, 0x65,
+	0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x27, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x1b, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41,
+	0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x4c, 0x61,
+	0x73, 0x74, 0x49, 0x72, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x3f, 0x0a, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x69, 0x6e, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x70, 0x62, 0x2e, 0x4a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6a, 0x75, 0x73, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x3c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x70, 0x62, 0x2e,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xcb,
+	0x01, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x6f, 0x72, 0x70, 0x68, 0x61, 0x6e, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65,
+	0x61, 0x6e, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0b, 0x6d, 0x65, 0x61, 0x6e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x2d, 0x0a,
+	0x12, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x73,
+	0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x73, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x22, 0x46, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x22, 0xeb, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22,
+	0x0a, 0x0d, 0x74, 0x78, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x6c, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x62, 0x6c, 0x6b, 0x53,
+	0x69, 0x7a, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d,
+	0x61, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x69, 0x70, 0x66, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x70, 0x66, 0x73, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x70, 0x63, 0x5f, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x70, 0x63, 0x50, 0x6f,
+	0x72, 0x74, 0x22, 0xc8, 0x03, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x0f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74,
+	0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x22, 0x0a, 0x0d,
+	0x74, 0x78, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x24, 0x0a, 0x0e, 0x62, 0x6c, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x62, 0x6c, 0x6b, 0x53, 0x69, 0x7a,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4f, 0x75, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0f, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65,
+	0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x65, 0x72, 0x73, 0x22, 0x78, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x58, 0x5f, 0x50, 0x4f, 0x4f, 0x4c, 0x5f, 0x4c, 0x49, 0x4d,
+	0x49, 0x54, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x4c, 0x4b, 0x5f, 0x53, 0x49, 0x5a, 0x45,
+	0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x41, 0x58, 0x5f,
+	0x43, 0x4f, 0x4e, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x41,
+	0x58, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x5f, 0x49, 0x4e, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x4d,
+	0x41, 0x58, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x45, 0x52, 0x53, 0x10, 0x04, 0x12, 0x0d,
+	0x0a, 0x09, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x45, 0x52, 0x53, 0x10, 0x05, 0x22, 0x6d, 0x0a,
+	0x13, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x61, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x61,
+	0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6a, 0x0a, 0x10,
+	0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a,
+	0x0a, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x75,
+	0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xdb, 0x01, 0x0a, 0x12, 0x47, 0x61, 0x73,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x22, 0x0a, 0x0d, 0x6c, 0x6f, 0x77, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6c, 0x6f, 0x77, 0x47, 0x61, 0x73, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x5f, 0x67, 0x61,
+	0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x24, 0x0a,
+	0x0e, 0x68, 0x69, 0x67, 0x68, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x68, 0x69, 0x67, 0x68, 0x47, 0x61, 0x73, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x6f, 0x77, 0x5f, 0x74, 0x69, 0x70, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6c, 0x6f, 0x77, 0x54, 0x69, 0x70, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x5f, 0x74, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x54, 0x69, 0x70, 0x12, 0x19, 0x0a, 0x08, 0x68,
+	0x69, 0x67, 0x68, 0x5f, 0x74, 0x69, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x68,
+	0x69, 0x67, 0x68, 0x54, 0x69, 0x70, 0x22, 0x3f, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x5c, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x22, 0x3b, 0x0a, 0x09, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x6f, 0x74,
+	0x65, 0x73, 0x22, 0x7b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x63,
+	0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x30, 0x0a,
+	0x14, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6e, 0x65, 0x78,
+	0x74, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
+	0x6a, 0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x35, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x21, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x6f, 0x74,
+	0x65, 0x73, 0x22, 0x57, 0x0a, 0x0f, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x74,
+	0x78, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x53, 0x0a, 0x1b, 0x47,
+	0x65, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x09, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x65, 0x72, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73,
+	0x22, 0x7d, 0x0a, 0x0d, 0x44, 0x79, 0x6e, 0x61, 0x73, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6e, 0x65, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x78, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22,
+	0x6a, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x44, 0x79, 0x6e, 0x61, 0x73, 0x74, 0x79, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x44, 0x79, 0x6e, 0x61, 0x73, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0xb9, 0x01, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x72,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x75, 0x72, 0x6e, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x75, 0x70, 0x70, 0x6c,
+	0x79, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x75, 0x70,
+	0x70, 0x6c, 0x79, 0x43, 0x61, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x66,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x69, 0x6e,
+	0x66, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xd1, 0x01, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x73, 0x22, 0x9c, 0x02, 0x0a, 0x1a,
+	0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x0f,
+	0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46,
+	0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x5f, 0x62, 0x6c, 0x6b, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e,
+	0x42, 0x6c, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x71, 0x75, 0x6f, 0x72, 0x75, 0x6d, 0x12, 0x2d, 0x0a, 0x09, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x22, 0x59, 0x0a, 0x1c, 0x47, 0x65,
+	0x74, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x4c, 0x69, 0x67, 0x68,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x07, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0x61, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30,
+	0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x70, 0x62, 0x2e, 0x55,
+	0x74, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x32, 0xbe, 0x11, 0x0a, 0x0a, 0x52, 0x70, 0x63,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x0d, 0x52, 0x70, 0x63, 0x47, 0x65,
+	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x46, 0x0a, 0x0d, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x14, 0x52, 0x70, 0x63, 0x47, 0x65,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x1f, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0a, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x55, 0x54,
+	0x58, 0x4f, 0x12, 0x15, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x54,
+	0x58, 0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x54, 0x58, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x55, 0x0a, 0x14, 0x52, 0x70, 0x63, 0x47, 0x65,
+	0x74, 0x55, 0x54, 0x58, 0x4f, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1f, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x54, 0x58, 0x4f, 0x57,
+	0x69, 0x74, 0x68, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x54, 0x58, 0x4f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x43,
+	0x0a, 0x0c, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x17,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x11, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x52, 0x70, 0x63, 0x47, 0x65,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42,
+	0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42,
+	0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x55, 0x0a, 0x12, 0x52, 0x70, 0x63, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53,
+	0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x17, 0x52, 0x70, 0x63, 0x53,
+	0x65, 0x6e, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d,
+	0x0a, 0x14, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x4e, 0x65, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x45, 0x0a,
+	0x0c, 0x52, 0x70, 0x63, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x17, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x68, 0x0a, 0x1f, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x72, 0x6f,
+	0x6d, 0x54, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x20, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x70,
+	0x0a, 0x1b, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x72, 0x72, 0x65,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x26, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x72, 0x72,
+	0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x72, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x62, 0x6c,
+	0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x49, 0x0a, 0x0e, 0x52, 0x70, 0x63, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47,
+	0x61, 0x73, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x52,
+	0x70, 0x63, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x73, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a,
+	0x10, 0x52, 0x70, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x12, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x67,
+	0x0a, 0x18, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x23, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x10, 0x52, 0x70, 0x63, 0x47, 0x65,
+	0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x52, 0x70, 0x63, 0x47,
+	0x65, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x16, 0x52, 0x70,
+	0x63, 0x47, 0x65, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x65, 0x72, 0x73, 0x12, 0x21, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a,
+	0x15, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x44, 0x79, 0x6e, 0x61, 0x73, 0x74, 0x79, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x44, 0x79, 0x6e, 0x61, 0x73, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x79, 0x6e, 0x61, 0x73, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a,
+	0x0c, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x17, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x5e, 0x0a, 0x15, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x20, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x64, 0x0a, 0x17, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x67, 0x68,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x22, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x67, 0x68, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x69, 0x67,
+	0x68, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x10, 0x52, 0x70, 0x63, 0x47,
+	0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x12, 0x1b, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x74, 0x78, 0x6f, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xfc, 0x05, 0x0a, 0x0c, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x52, 0x70,
+	0x63, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x07, 0x52, 0x70, 0x63,
+	0x53, 0x65, 0x6e, 0x64, 0x12, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x49, 0x0a, 0x0e, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x11, 0x52, 0x70,
+	0x63, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x12,
+	0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52,
+	0x0a, 0x11, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x50, 0x65,
+	0x65, 0x72, 0x73, 0x12, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42,
+	0x61, 0x6e, 0x6e, 0x65, 0x64, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6e,
+	0x6e, 0x65, 0x64, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x52, 0x70, 0x63, 0x42, 0x61, 0x6e, 0x50, 0x65, 0x65, 0x72,
+	0x12, 0x15, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x42, 0x61, 0x6e, 0x50, 0x65, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x42, 0x61, 0x6e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x43, 0x0a, 0x0c, 0x52, 0x70, 0x63, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x50, 0x65, 0x65,
+	0x72, 0x12, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x50,
+	0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x55, 0x6e, 0x62, 0x61, 0x6e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x10, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74,
+	0x50, 0x65, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x52, 0x70, 0x63, 0x53, 0x65,
+	0x74, 0x50, 0x65, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1e,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x55, 0x0a, 0x12, 0x52, 0x70, 0x63, 0x53, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x44,
+	0x65, 0x6e, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x44, 0x65, 0x6e, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53,
+	0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x44, 0x65, 0x6e, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xa6, 0x03, 0x0a, 0x0d, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x52, 0x0a, 0x11, 0x52, 0x70,
+	0x63, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46,
+	0x0a, 0x0b, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1c, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x10, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74,
+	0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x10, 0x52, 0x70, 0x63, 0x53,
+	0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x13, 0x52, 0x70, 0x63,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x42, 0x06, 0xa2, 0x02, 0x03, 0x48, 0x4c, 0x57, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescOnce sync.Once
+	file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescData = file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDesc
+)
+
+func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP() []byte {
+	file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescOnce.Do(func() {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescData)
+	})
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescData
+}
+
+var file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 89)
+var file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_goTypes = []interface{}{
+	(SetNodeConfigRequest_ConfigType)(0),     // 0: rpcpb.SetNodeConfigRequest.ConfigType
+	(*CreateAccountRequest)(nil),             // 1: rpcpb.CreateAccountRequest
+	(*ChangeProducerRequest)(nil),            // 2: rpcpb.ChangeProducerRequest
+	(*GetBalanceRequest)(nil),                // 3: rpcpb.GetBalanceRequest
+	(*SendFromMinerRequest)(nil),             // 4: rpcpb.SendFromMinerRequest
+	(*SendRequest)(nil),                      // 5: rpcpb.SendRequest
+	(*GetPeerInfoRequest)(nil),               // 6: rpcpb.GetPeerInfoRequest
+	(*GetBlockchainInfoRequest)(nil),         // 7: rpcpb.GetBlockchainInfoRequest
+	(*AddPeerRequest)(nil),                   // 8: rpcpb.AddPeerRequest
+	(*GetBannedPeersRequest)(nil),            // 9: rpcpb.GetBannedPeersRequest
+	(*BanPeerRequest)(nil),                   // 10: rpcpb.BanPeerRequest
+	(*UnbanPeerRequest)(nil),                 // 11: rpcpb.UnbanPeerRequest
+	(*GetPeerFilterRequest)(nil),             // 12: rpcpb.GetPeerFilterRequest
+	(*SetPeerAllowlistRequest)(nil),          // 13: rpcpb.SetPeerAllowlistRequest
+	(*SetPeerDenylistRequest)(nil),           // 14: rpcpb.SetPeerDenylistRequest
+	(*GetVersionRequest)(nil),                // 15: rpcpb.GetVersionRequest
+	(*GetUTXORequest)(nil),                   // 16: rpcpb.GetUTXORequest
+	(*GetUTXOWithAmountRequest)(nil),         // 17: rpcpb.GetUTXOWithAmountRequest
+	(*GetBlocksRequest)(nil),                 // 18: rpcpb.GetBlocksRequest
+	(*GetBlockByHashRequest)(nil),            // 19: rpcpb.GetBlockByHashRequest
+	(*GetBlockByHeightRequest)(nil),          // 20: rpcpb.GetBlockByHeightRequest
+	(*SendTransactionRequest)(nil),           // 21: rpcpb.SendTransactionRequest
+	(*SendBatchTransactionRequest)(nil),      // 22: rpcpb.SendBatchTransactionRequest
+	(*GetNewTransactionRequest)(nil),         // 23: rpcpb.GetNewTransactionRequest
+	(*SubscribeRequest)(nil),                 // 24: rpcpb.SubscribeRequest
+	(*MetricsServiceRequest)(nil),            // 25: rpcpb.MetricsServiceRequest
+	(*GetLastIrreversibleBlockRequest)(nil),  // 26: rpcpb.GetLastIrreversibleBlockRequest
+	(*EstimateGasRequest)(nil),               // 27: rpcpb.EstimateGasRequest
+	(*GasPriceRequest)(nil),                  // 28: rpcpb.GasPriceRequest
+	(*ContractQueryRequest)(nil),             // 29: rpcpb.ContractQueryRequest
+	(*GetTransactionReceiptRequest)(nil),     // 30: rpcpb.GetTransactionReceiptRequest
+	(*GetCandidatesRequest)(nil),             // 31: rpcpb.GetCandidatesRequest
+	(*GetVotesRequest)(nil),                  // 32: rpcpb.GetVotesRequest
+	(*GetSlashedProducersRequest)(nil),       // 33: rpcpb.GetSlashedProducersRequest
+	(*GetDynastyScheduleRequest)(nil),        // 34: rpcpb.GetDynastyScheduleRequest
+	(*GetSupplyRequest)(nil),                 // 35: rpcpb.GetSupplyRequest
+	(*GetChainParametersRequest)(nil),        // 36: rpcpb.GetChainParametersRequest
+	(*GetLightBlockHeadersRequest)(nil),      // 37: rpcpb.GetLightBlockHeadersRequest
+	(*GetUtxoProofsRequest)(nil),             // 38: rpcpb.GetUtxoProofsRequest
+	(*ChangeProducerResponse)(nil),           // 39: rpcpb.ChangeProducerResponse
+	(*GetBalanceResponse)(nil),               // 40: rpcpb.GetBalanceResponse
+	(*SendFromMinerResponse)(nil),            // 41: rpcpb.SendFromMinerResponse
+	(*SendResponse)(nil),                     // 42: rpcpb.SendResponse
+	(*GetPeerInfoResponse)(nil),              // 43: rpcpb.GetPeerInfoResponse
+	(*GetBlockchainInfoResponse)(nil),        // 44: rpcpb.GetBlockchainInfoResponse
+	(*AddPeerResponse)(nil),                  // 45: rpcpb.AddPeerResponse
+	(*PeerScore)(nil),                        // 46: rpcpb.PeerScore
+	(*GetBannedPeersResponse)(nil),           // 47: rpcpb.GetBannedPeersResponse
+	(*BanPeerResponse)(nil),                  // 48: rpcpb.BanPeerResponse
+	(*UnbanPeerResponse)(nil),                // 49: rpcpb.UnbanPeerResponse
+	(*GetPeerFilterResponse)(nil),            // 50: rpcpb.GetPeerFilterResponse
+	(*SetPeerAllowlistResponse)(nil),         // 51: rpcpb.SetPeerAllowlistResponse
+	(*SetPeerDenylistResponse)(nil),          // 52: rpcpb.SetPeerDenylistResponse
+	(*GetVersionResponse)(nil),               // 53: rpcpb.GetVersionResponse
+	(*GetUTXOResponse)(nil),                  // 54: rpcpb.GetUTXOResponse
+	(*GetBlocksResponse)(nil),                // 55: rpcpb.GetBlocksResponse
+	(*GetBlockByHashResponse)(nil),           // 56: rpcpb.GetBlockByHashResponse
+	(*GetBlockByHeightResponse)(nil),         // 57: rpcpb.GetBlockByHeightResponse
+	(*SendTransactionResponse)(nil),          // 58: rpcpb.SendTransactionResponse
+	(*SendBatchTransactionResponse)(nil),     // 59: rpcpb.SendBatchTransactionResponse
+	(*SendTransactionStatus)(nil),            // 60: rpcpb.SendTransactionStatus
+	(*GetNewTransactionResponse)(nil),        // 61: rpcpb.GetNewTransactionResponse
+	(*SubscribeResponse)(nil),                // 62: rpcpb.SubscribeResponse
+	(*GetAllTransactionsRequest)(nil),        // 63: rpcpb.GetAllTransactionsRequest
+	(*GetAllTransactionsResponse)(nil),       // 64: rpcpb.GetAllTransactionsResponse
+	(*GetLastIrreversibleBlockResponse)(nil), // 65: rpcpb.GetLastIrreversibleBlockResponse
+	(*GetMetricsInfoResponse)(nil),           // 66: rpcpb.GetMetricsInfoResponse
+	(*GetStatsResponse)(nil),                 // 67: rpcpb.GetStatsResponse
+	(*ProducerStats)(nil),                    // 68: rpcpb.ProducerStats
+	(*GetProducerStatsResponse)(nil),         // 69: rpcpb.GetProducerStatsResponse
+	(*GetNodeConfigResponse)(nil),            // 70: rpcpb.GetNodeConfigResponse
+	(*SetNodeConfigRequest)(nil),             // 71: rpcpb.SetNodeConfigRequest
+	(*EstimateGasResponse)(nil),              // 72: rpcpb.EstimateGasResponse
+	(*GasPriceResponse)(nil),                 // 73: rpcpb.GasPriceResponse
+	(*GasPriceSuggestion)(nil),               // 74: rpcpb.GasPriceSuggestion
+	(*ContractQueryResponse)(nil),            // 75: rpcpb.ContractQueryResponse
+	(*GetTransactionReceiptResponse)(nil),    // 76: rpcpb.GetTransactionReceiptResponse
+	(*Candidate)(nil),                        // 77: rpcpb.Candidate
+	(*GetCandidatesResponse)(nil),            // 78: rpcpb.GetCandidatesResponse
+	(*Vote)(nil),                             // 79: rpcpb.Vote
+	(*GetVotesResponse)(nil),                 // 80: rpcpb.GetVotesResponse
+	(*SlashedProducer)(nil),                  // 81: rpcpb.SlashedProducer
+	(*GetSlashedProducersResponse)(nil),      // 82: rpcpb.GetSlashedProducersResponse
+	(*DynastyChange)(nil),                    // 83: rpcpb.DynastyChange
+	(*GetDynastyScheduleResponse)(nil),       // 84: rpcpb.GetDynastyScheduleResponse
+	(*GetSupplyResponse)(nil),                // 85: rpcpb.GetSupplyResponse
+	(*Proposal)(nil),                         // 86: rpcpb.Proposal
+	(*GetChainParametersResponse)(nil),       // 87: rpcpb.GetChainParametersResponse
+	(*GetLightBlockHeadersResponse)(nil),     // 88: rpcpb.GetLightBlockHeadersResponse
+	(*GetUtxoProofsResponse)(nil),            // 89: rpcpb.GetUtxoProofsResponse
+	(*pb.Transaction)(nil),                   // 90: transactionpb.Transaction
+	(*pb1.PeerInfo)(nil),                     // 91: networkpb.PeerInfo
+	(*pb1.BannedPeer)(nil),                   // 92: networkpb.BannedPeer
+	(*pb2.Utxo)(nil),                         // 93: utxopb.Utxo
+	(*pb3.BlockHeader)(nil),                  // 94: blockpb.BlockHeader
+	(*pb3.Block)(nil),                        // 95: blockpb.Block
+	(*pb4.Justification)(nil),                // 96: finalitypb.Justification
+	(*pb5.Metrics)(nil),                      // 97: metricspb.Metrics
+	(*pb.TransactionReceipt)(nil),            // 98: transactionpb.TransactionReceipt
+	(*pb6.LightBlockHeader)(nil),             // 99: lightclientpb.LightBlockHeader
+	(*pb6.UtxoProof)(nil),                    // 100: lightclientpb.UtxoProof
+}
+var file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_depIdxs = []int32{
+	90,  // 0: rpcpb.SendTransactionRequest.transaction:type_name -> transactionpb.Transaction
+	90,  // 1: rpcpb.SendBatchTransactionRequest.transactions:type_name -> transactionpb.Transaction
+	90,  // 2: rpcpb.EstimateGasRequest.transaction:type_name -> transactionpb.Transaction
+	91,  // 3: rpcpb.GetPeerInfoResponse.peer_list:type_name -> networkpb.PeerInfo
+	92,  // 4: rpcpb.GetBannedPeersResponse.banned_peers:type_name -> networkpb.BannedPeer
+	46,  // 5: rpcpb.GetBannedPeersResponse.peer_scores:type_name -> rpcpb.PeerScore
+	93,  // 6: rpcpb.GetUTXOResponse.utxos:type_name -> utxopb.Utxo
+	94,  // 7: rpcpb.GetUTXOResponse.block_headers:type_name -> blockpb.BlockHeader
+	95,  // 8: rpcpb.GetBlocksResponse.blocks:type_name -> blockpb.Block
+	95,  // 9: rpcpb.GetBlockByHashResponse.block:type_name -> blockpb.Block
+	95,  // 10: rpcpb.GetBlockByHeightResponse.block:type_name -> blockpb.Block
+	90,  // 11: rpcpb.GetNewTransactionResponse.transaction:type_name -> transactionpb.Transaction
+	90,  // 12: rpcpb.GetAllTransactionsResponse.transactions:type_name -> transactionpb.Transaction
+	95,  // 13: rpcpb.GetLastIrreversibleBlockResponse.block:type_name -> blockpb.Block
+	96,  // 14: rpcpb.GetLastIrreversibleBlockResponse.justification:type_name -> finalitypb.Justification
+	97,  // 15: rpcpb.GetStatsResponse.stats:type_name -> metricspb.Metrics
+	68,  // 16: rpcpb.GetProducerStatsResponse.stats:type_name -> rpcpb.ProducerStats
+	0,   // 17: rpcpb.SetNodeConfigRequest.updated_configs:type_name -> rpcpb.SetNodeConfigRequest.ConfigType
+	74,  // 18: rpcpb.EstimateGasResponse.suggestion:type_name -> rpcpb.GasPriceSuggestion
+	74,  // 19: rpcpb.GasPriceResponse.suggestion:type_name -> rpcpb.GasPriceSuggestion
+	98,  // 20: rpcpb.GetTransactionReceiptResponse.receipt:type_name -> transactionpb.TransactionReceipt
+	77,  // 21: rpcpb.GetCandidatesResponse.candidates:type_name -> rpcpb.Candidate
+	79,  // 22: rpcpb.GetVotesResponse.votes:type_name -> rpcpb.Vote
+	81,  // 23: rpcpb.GetSlashedProducersResponse.producers:type_name -> rpcpb.SlashedProducer
+	83,  // 24: rpcpb.GetDynastyScheduleResponse.changes:type_name -> rpcpb.DynastyChange
+	86,  // 25: rpcpb.GetChainParametersResponse.proposals:type_name -> rpcpb.Proposal
+	99,  // 26: rpcpb.GetLightBlockHeadersResponse.headers:type_name -> lightclientpb.LightBlockHeader
+	100, // 27: rpcpb.GetUtxoProofsResponse.proofs:type_name -> lightclientpb.UtxoProof
+	15,  // 28: rpcpb.RpcService.RpcGetVersion:input_type -> rpcpb.GetVersionRequest
+	3,   // 29: rpcpb.RpcService.RpcGetBalance:input_type -> rpcpb.GetBalanceRequest
+	7,   // 30: rpcpb.RpcService.RpcGetBlockchainInfo:input_type -> rpcpb.GetBlockchainInfoRequest
+	16,  // 31: rpcpb.RpcService.RpcGetUTXO:input_type -> rpcpb.GetUTXORequest
+	17,  // 32: rpcpb.RpcService.RpcGetUTXOWithAmount:input_type -> rpcpb.GetUTXOWithAmountRequest
+	18,  // 33: rpcpb.RpcService.RpcGetBlocks:input_type -> rpcpb.GetBlocksRequest
+	19,  // 34: rpcpb.RpcService.RpcGetBlockByHash:input_type -> rpcpb.GetBlockByHashRequest
+	20,  // 35: rpcpb.RpcService.RpcGetBlockByHeight:input_type -> rpcpb.GetBlockByHeightRequest
+	21,  // 36: rpcpb.RpcService.RpcSendTransaction:input_type -> rpcpb.SendTransactionRequest
+	22,  // 37: rpcpb.RpcService.RpcSendBatchTransaction:input_type -> rpcpb.SendBatchTransactionRequest
+	23,  // 38: rpcpb.RpcService.RpcGetNewTransaction:input_type -> rpcpb.GetNewTransactionRequest
+	24,  // 39: rpcpb.RpcService.RpcSubscribe:input_type -> rpcpb.SubscribeRequest
+	63,  // 40: rpcpb.RpcService.RpcGetAllTransactionsFromTxPool:input_type -> rpcpb.GetAllTransactionsRequest
+	26,  // 41: rpcpb.RpcService.RpcGetLastIrreversibleBlock:input_type -> rpcpb.GetLastIrreversibleBlockRequest
+	27,  // 42: rpcpb.RpcService.RpcEstimateGas:input_type -> rpcpb.EstimateGasRequest
+	28,  // 43: rpcpb.RpcService.RpcGasPrice:input_type -> rpcpb.GasPriceRequest
+	29,  // 44: rpcpb.RpcService.RpcContractQuery:input_type -> rpcpb.ContractQueryRequest
+	30,  // 45: rpcpb.RpcService.RpcGetTransactionReceipt:input_type -> rpcpb.GetTransactionReceiptRequest
+	31,  // 46: rpcpb.RpcService.RpcGetCandidates:input_type -> rpcpb.GetCandidatesRequest
+	32,  // 47: rpcpb.RpcService.RpcGetVotes:input_type -> rpcpb.GetVotesRequest
+	33,  // 48: rpcpb.RpcService.RpcGetSlashedProducers:input_type -> rpcpb.GetSlashedProducersRequest
+	34,  // 49: rpcpb.RpcService.RpcGetDynastySchedule:input_type -> rpcpb.GetDynastyScheduleRequest
+	35,  // 50: rpcpb.RpcService.RpcGetSupply:input_type -> rpcpb.GetSupplyRequest
+	36,  // 51: rpcpb.RpcService.RpcGetChainParameters:input_type -> rpcpb.GetChainParametersRequest
+	37,  // 52: rpcpb.RpcService.RpcGetLightBlockHeaders:input_type -> rpcpb.GetLightBlockHeadersRequest
+	38,  // 53: rpcpb.RpcService.RpcGetUtxoProofs:input_type -> rpcpb.GetUtxoProofsRequest
+	8,   // 54: rpcpb.AdminService.RpcAddPeer:input_type -> rpcpb.AddPeerRequest
+	5,   // 55: rpcpb.AdminService.RpcSend:input_type -> rpcpb.SendRequest
+	6,   // 56: rpcpb.AdminService.RpcGetPeerInfo:input_type -> rpcpb.GetPeerInfoRequest
+	2,   // 57: rpcpb.AdminService.RpcChangeProducer:input_type -> rpcpb.ChangeProducerRequest
+	9,   // 58: rpcpb.AdminService.RpcGetBannedPeers:input_type -> rpcpb.GetBannedPeersRequest
+	10,  // 59: rpcpb.AdminService.RpcBanPeer:input_type -> rpcpb.BanPeerRequest
+	11,  // 60: rpcpb.AdminService.RpcUnbanPeer:input_type -> rpcpb.UnbanPeerRequest
+	12,  // 61: rpcpb.AdminService.RpcGetPeerFilter:input_type -> rpcpb.GetPeerFilterRequest
+	13,  // 62: rpcpb.AdminService.RpcSetPeerAllowlist:input_type -> rpcpb.SetPeerAllowlistRequest
+	14,  // 63: rpcpb.AdminService.RpcSetPeerDenylist:input_type -> rpcpb.SetPeerDenylistRequest
+	25,  // 64: rpcpb.MetricService.RpcGetMetricsInfo:input_type -> rpcpb.MetricsServiceRequest
+	25,  // 65: rpcpb.MetricService.RpcGetStats:input_type -> rpcpb.MetricsServiceRequest
+	25,  // 66: rpcpb.MetricService.RpcGetNodeConfig:input_type -> rpcpb.MetricsServiceRequest
+	71,  // 67: rpcpb.MetricService.RpcSetNodeConfig:input_type -> rpcpb.SetNodeConfigRequest
+	25,  // 68: rpcpb.MetricService.RpcGetProducerStats:input_type -> rpcpb.MetricsServiceRequest
+	53,  // 69: rpcpb.RpcService.RpcGetVersion:output_type -> rpcpb.GetVersionResponse
+	40,  // 70: rpcpb.RpcService.RpcGetBalance:output_type -> rpcpb.GetBalanceResponse
+	44,  // 71: rpcpb.RpcService.RpcGetBlockchainInfo:output_type -> rpcpb.GetBlockchainInfoResponse
+	54,  // 72: rpcpb.RpcService.RpcGetUTXO:output_type -> rpcpb.GetUTXOResponse
+	54,  // 73: rpcpb.RpcService.RpcGetUTXOWithAmount:output_type -> rpcpb.GetUTXOResponse
+	55,  // 74: rpcpb.RpcService.RpcGetBlocks:output_type -> rpcpb.GetBlocksResponse
+	56,  // 75: rpcpb.RpcService.RpcGetBlockByHash:output_type -> rpcpb.GetBlockByHashResponse
+	57,  // 76: rpcpb.RpcService.RpcGetBlockByHeight:output_type -> rpcpb.GetBlockByHeightResponse
+	58,  // 77: rpcpb.RpcService.RpcSendTransaction:output_type -> rpcpb.SendTransactionResponse
+	59,  // 78: rpcpb.RpcService.RpcSendBatchTransaction:output_type -> rpcpb.SendBatchTransactionResponse
+	61,  // 79: rpcpb.RpcService.RpcGetNewTransaction:output_type -> rpcpb.GetNewTransactionResponse
+	62,  // 80: rpcpb.RpcService.RpcSubscribe:output_type -> rpcpb.SubscribeResponse
+	64,  // 81: rpcpb.RpcService.RpcGetAllTransactionsFromTxPool:output_type -> rpcpb.GetAllTransactionsResponse
+	65,  // 82: rpcpb.RpcService.RpcGetLastIrreversibleBlock:output_type -> rpcpb.GetLastIrreversibleBlockResponse
+	72,  // 83: rpcpb.RpcService.RpcEstimateGas:output_type -> rpcpb.EstimateGasResponse
+	73,  // 84: rpcpb.RpcService.RpcGasPrice:output_type -> rpcpb.GasPriceResponse
+	75,  // 85: rpcpb.RpcService.RpcContractQuery:output_type -> rpcpb.ContractQueryResponse
+	76,  // 86: rpcpb.RpcService.RpcGetTransactionReceipt:output_type -> rpcpb.GetTransactionReceiptResponse
+	78,  // 87: rpcpb.RpcService.RpcGetCandidates:output_type -> rpcpb.GetCandidatesResponse
+	80,  // 88: rpcpb.RpcService.RpcGetVotes:output_type -> rpcpb.GetVotesResponse
+	82,  // 89: rpcpb.RpcService.RpcGetSlashedProducers:output_type -> rpcpb.GetSlashedProducersResponse
+	84,  // 90: rpcpb.RpcService.RpcGetDynastySchedule:output_type -> rpcpb.GetDynastyScheduleResponse
+	85,  // 91: rpcpb.RpcService.RpcGetSupply:output_type -> rpcpb.GetSupplyResponse
+	87,  // 92: rpcpb.RpcService.RpcGetChainParameters:output_type -> rpcpb.GetChainParametersResponse
+	88,  // 93: rpcpb.RpcService.RpcGetLightBlockHeaders:output_type -> rpcpb.GetLightBlockHeadersResponse
+	89,  // 94: rpcpb.RpcService.RpcGetUtxoProofs:output_type -> rpcpb.GetUtxoProofsResponse
+	45,  // 95: rpcpb.AdminService.RpcAddPeer:output_type -> rpcpb.AddPeerResponse
+	42,  // 96: rpcpb.AdminService.RpcSend:output_type -> rpcpb.SendResponse
+	43,  // 97: rpcpb.AdminService.RpcGetPeerInfo:output_type -> rpcpb.GetPeerInfoResponse
+	39,  // 98: rpcpb.AdminService.RpcChangeProducer:output_type -> rpcpb.ChangeProducerResponse
+	47,  // 99: rpcpb.AdminService.RpcGetBannedPeers:output_type -> rpcpb.GetBannedPeersResponse
+	48,  // 100: rpcpb.AdminService.RpcBanPeer:output_type -> rpcpb.BanPeerResponse
+	49,  // 101: rpcpb.AdminService.RpcUnbanPeer:output_type -> rpcpb.UnbanPeerResponse
+	50,  // 102: rpcpb.AdminService.RpcGetPeerFilter:output_type -> rpcpb.GetPeerFilterResponse
+	51,  // 103: rpcpb.AdminService.RpcSetPeerAllowlist:output_type -> rpcpb.SetPeerAllowlistResponse
+	52,  // 104: rpcpb.AdminService.RpcSetPeerDenylist:output_type -> rpcpb.SetPeerDenylistResponse
+	66,  // 105: rpcpb.MetricService.RpcGetMetricsInfo:output_type -> rpcpb.GetMetricsInfoResponse
+	67,  // 106: rpcpb.MetricService.RpcGetStats:output_type -> rpcpb.GetStatsResponse
+	70,  // 107: rpcpb.MetricService.RpcGetNodeConfig:output_type -> rpcpb.GetNodeConfigResponse
+	70,  // 108: rpcpb.MetricService.RpcSetNodeConfig:output_type -> rpcpb.GetNodeConfigResponse
+	69,  // 109: rpcpb.MetricService.RpcGetProducerStats:output_type -> rpcpb.GetProducerStatsResponse
+	69,  // [69:110] is the sub-list for method output_type
+	28,  // [28:69] is the sub-list for method input_type
+	28,  // [28:28] is the sub-list for extension type_name
+	28,  // [28:28] is the sub-list for extension extendee
+	0,   // [0:28] is the sub-list for field type_name
+}
+
+func init() { file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() }
+func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
+	if File_github_com_dappley_go_dappley_rpc_pb_rpc_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeProducerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBalanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendFromMinerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeerInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockchainInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPeerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBannedPeersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BanPeerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnbanPeerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeerFilterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPeerAllowlistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPeerDenylistRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVersionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUTXORequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUTXOWithAmountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlocksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockByHashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockByHeightRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendBatchTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNewTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetricsServiceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLastIrreversibleBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateGasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GasPriceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContractQueryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionReceiptRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCandidatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVotesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSlashedProducersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDynastyScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSupplyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChainParametersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3201,8 +6160,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeProducerRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLightBlockHeadersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3213,8 +6172,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBalanceRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUtxoProofsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3225,8 +6184,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendFromMinerRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeProducerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3237,8 +6196,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBalanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3249,8 +6208,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPeerInfoRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendFromMinerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3261,8 +6220,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockchainInfoRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3273,8 +6232,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddPeerRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeerInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3285,8 +6244,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVersionRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockchainInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3297,8 +6256,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUTXORequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPeerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3309,8 +6268,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUTXOWithAmountRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerScore); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3321,8 +6280,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlocksRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBannedPeersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3333,8 +6292,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockByHashRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BanPeerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3345,8 +6304,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockByHeightRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnbanPeerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3357,8 +6316,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendTransactionRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeerFilterResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3369,8 +6328,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendBatchTransactionRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPeerAllowlistResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3381,8 +6340,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNewTransactionRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPeerDenylistResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3393,8 +6352,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVersionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3405,8 +6364,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MetricsServiceRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUTXOResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3417,8 +6376,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLastIrreversibleBlockRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlocksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3429,8 +6388,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EstimateGasRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockByHashResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3441,8 +6400,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GasPriceRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockByHeightResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3453,8 +6412,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContractQueryRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3465,8 +6424,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChangeProducerResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendBatchTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3477,8 +6436,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBalanceResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendTransactionStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3489,8 +6448,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendFromMinerResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNewTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3501,8 +6460,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3513,8 +6472,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPeerInfoResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllTransactionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3525,8 +6484,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockchainInfoResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllTransactionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3537,8 +6496,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddPeerResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLastIrreversibleBlockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3549,8 +6508,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVersionResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMetricsInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3561,8 +6520,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUTXOResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3573,8 +6532,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlocksResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProducerStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3585,8 +6544,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockByHashResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProducerStatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3597,8 +6556,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockByHeightResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodeConfigResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3609,8 +6568,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendTransactionResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetNodeConfigRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3621,8 +6580,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendBatchTransactionResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateGasResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3633,8 +6592,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendTransactionStatus); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GasPriceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3645,8 +6604,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNewTransactionResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GasPriceSuggestion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3657,8 +6616,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContractQueryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3669,8 +6628,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllTransactionsRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionReceiptResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3681,8 +6640,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllTransactionsResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Candidate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3693,8 +6652,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLastIrreversibleBlockResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCandidatesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3705,8 +6664,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMetricsInfoResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Vote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3717,8 +6676,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStatsResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVotesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3729,8 +6688,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNodeConfigResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SlashedProducer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3741,8 +6700,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetNodeConfigRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSlashedProducersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3753,8 +6712,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EstimateGasResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DynastyChange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3765,8 +6724,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GasPriceResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDynastyScheduleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3777,8 +6736,56 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContractQueryResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSupplyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Proposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChainParametersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLightBlockHeadersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUtxoProofsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3796,7 +6803,7 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   50,
+			NumMessages:   89,
 			NumExtensions: 0,
 			NumServices:   3,
 		},
@@ -3840,6 +6847,15 @@ type RpcServiceClient interface {
 	RpcEstimateGas(ctx context.Context, in *EstimateGasRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
 	RpcGasPrice(ctx context.Context, in *GasPriceRequest, opts ...grpc.CallOption) (*GasPriceResponse, error)
 	RpcContractQuery(ctx context.Context, in *ContractQueryRequest, opts ...grpc.CallOption) (*ContractQueryResponse, error)
+	RpcGetTransactionReceipt(ctx context.Context, in *GetTransactionReceiptRequest, opts ...grpc.CallOption) (*GetTransactionReceiptResponse, error)
+	RpcGetCandidates(ctx context.Context, in *GetCandidatesRequest, opts ...grpc.CallOption) (*GetCandidatesResponse, error)
+	RpcGetVotes(ctx context.Context, in *GetVotesRequest, opts ...grpc.CallOption) (*GetVotesResponse, error)
+	RpcGetSlashedProducers(ctx context.Context, in *GetSlashedProducersRequest, opts ...grpc.CallOption) (*GetSlashedProducersResponse, error)
+	RpcGetDynastySchedule(ctx context.Context, in *GetDynastyScheduleRequest, opts ...grpc.CallOption) (*GetDynastyScheduleResponse, error)
+	RpcGetSupply(ctx context.Context, in *GetSupplyRequest, opts ...grpc.CallOption) (*GetSupplyResponse, error)
+	RpcGetChainParameters(ctx context.Context, in *GetChainParametersRequest, opts ...grpc.CallOption) (*GetChainParametersResponse, error)
+	RpcGetLightBlockHeaders(ctx context.Context, in *GetLightBlockHeadersRequest, opts ...grpc.CallOption) (*GetLightBlockHeadersResponse, error)
+	RpcGetUtxoProofs(ctx context.Context, in *GetUtxoProofsRequest, opts ...grpc.CallOption) (*GetUtxoProofsResponse, error)
 }
 
 type rpcServiceClient struct {
@@ -4013,80 +7029,161 @@ func (x *rpcServiceRpcGetNewTransactionClient) Recv() (*GetNewTransactionRespons
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return m, nil
+	return m, nil
+}
+
+func (c *rpcServiceClient) RpcSubscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (RpcService_RpcSubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RpcService_serviceDesc.Streams[3], "/rpcpb.RpcService/RpcSubscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rpcServiceRpcSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RpcService_RpcSubscribeClient interface {
+	Recv() (*SubscribeResponse, error)
+	grpc.ClientStream
+}
+
+type rpcServiceRpcSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *rpcServiceRpcSubscribeClient) Recv() (*SubscribeResponse, error) {
+	m := new(SubscribeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *rpcServiceClient) RpcGetAllTransactionsFromTxPool(ctx context.Context, in *GetAllTransactionsRequest, opts ...grpc.CallOption) (*GetAllTransactionsResponse, error) {
+	out := new(GetAllTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.RpcService/RpcGetAllTransactionsFromTxPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) RpcGetLastIrreversibleBlock(ctx context.Context, in *GetLastIrreversibleBlockRequest, opts ...grpc.CallOption) (*GetLastIrreversibleBlockResponse, error) {
+	out := new(GetLastIrreversibleBlockResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.RpcService/RpcGetLastIrreversibleBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) RpcEstimateGas(ctx context.Context, in *EstimateGasRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error) {
+	out := new(EstimateGasResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.RpcService/RpcEstimateGas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) RpcGasPrice(ctx context.Context, in *GasPriceRequest, opts ...grpc.CallOption) (*GasPriceResponse, error) {
+	out := new(GasPriceResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.RpcService/RpcGasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) RpcContractQuery(ctx context.Context, in *ContractQueryRequest, opts ...grpc.CallOption) (*ContractQueryResponse, error) {
+	out := new(ContractQueryResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.RpcService/RpcContractQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (c *rpcServiceClient) RpcSubscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (RpcService_RpcSubscribeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_RpcService_serviceDesc.Streams[3], "/rpcpb.RpcService/RpcSubscribe", opts...)
+func (c *rpcServiceClient) RpcGetTransactionReceipt(ctx context.Context, in *GetTransactionReceiptRequest, opts ...grpc.CallOption) (*GetTransactionReceiptResponse, error) {
+	out := new(GetTransactionReceiptResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.RpcService/RpcGetTransactionReceipt", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &rpcServiceRpcSubscribeClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+	return out, nil
 }
 
-type RpcService_RpcSubscribeClient interface {
-	Recv() (*SubscribeResponse, error)
-	grpc.ClientStream
+func (c *rpcServiceClient) RpcGetCandidates(ctx context.Context, in *GetCandidatesRequest, opts ...grpc.CallOption) (*GetCandidatesResponse, error) {
+	out := new(GetCandidatesResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.RpcService/RpcGetCandidates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type rpcServiceRpcSubscribeClient struct {
-	grpc.ClientStream
+func (c *rpcServiceClient) RpcGetVotes(ctx context.Context, in *GetVotesRequest, opts ...grpc.CallOption) (*GetVotesResponse, error) {
+	out := new(GetVotesResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.RpcService/RpcGetVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *rpcServiceRpcSubscribeClient) Recv() (*SubscribeResponse, error) {
-	m := new(SubscribeResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func (c *rpcServiceClient) RpcGetSlashedProducers(ctx context.Context, in *GetSlashedProducersRequest, opts ...grpc.CallOption) (*GetSlashedProducersResponse, error) {
+	out := new(GetSlashedProducersResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.RpcService/RpcGetSlashedProducers", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *rpcServiceClient) RpcGetAllTransactionsFromTxPool(ctx context.Context, in *GetAllTransactionsRequest, opts ...grpc.CallOption) (*GetAllTransactionsResponse, error) {
-	out := new(GetAllTransactionsResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.RpcService/RpcGetAllTransactionsFromTxPool", in, out, opts...)
+func (c *rpcServiceClient) RpcGetDynastySchedule(ctx context.Context, in *GetDynastyScheduleRequest, opts ...grpc.CallOption) (*GetDynastyScheduleResponse, error) {
+	out := new(GetDynastyScheduleResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.RpcService/RpcGetDynastySchedule", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *rpcServiceClient) RpcGetLastIrreversibleBlock(ctx context.Context, in *GetLastIrreversibleBlockRequest, opts ...grpc.CallOption) (*GetLastIrreversibleBlockResponse, error) {
-	out := new(GetLastIrreversibleBlockResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.RpcService/RpcGetLastIrreversibleBlock", in, out, opts...)
+func (c *rpcServiceClient) RpcGetSupply(ctx context.Context, in *GetSupplyRequest, opts ...grpc.CallOption) (*GetSupplyResponse, error) {
+	out := new(GetSupplyResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.RpcService/RpcGetSupply", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *rpcServiceClient) RpcEstimateGas(ctx context.Context, in *EstimateGasRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error) {
-	out := new(EstimateGasResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.RpcService/RpcEstimateGas", in, out, opts...)
+func (c *rpcServiceClient) RpcGetChainParameters(ctx context.Context, in *GetChainParametersRequest, opts ...grpc.CallOption) (*GetChainParametersResponse, error) {
+	out := new(GetChainParametersResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.RpcService/RpcGetChainParameters", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *rpcServiceClient) RpcGasPrice(ctx context.Context, in *GasPriceRequest, opts ...grpc.CallOption) (*GasPriceResponse, error) {
-	out := new(GasPriceResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.RpcService/RpcGasPrice", in, out, opts...)
+func (c *rpcServiceClient) RpcGetLightBlockHeaders(ctx context.Context, in *GetLightBlockHeadersRequest, opts ...grpc.CallOption) (*GetLightBlockHeadersResponse, error) {
+	out := new(GetLightBlockHeadersResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.RpcService/RpcGetLightBlockHeaders", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *rpcServiceClient) RpcContractQuery(ctx context.Context, in *ContractQueryRequest, opts ...grpc.CallOption) (*ContractQueryResponse, error) {
-	out := new(ContractQueryResponse)
-	err := c.cc.Invoke(ctx, "/rpcpb.RpcService/RpcContractQuery", in, out, opts...)
+func (c *rpcServiceClient) RpcGetUtxoProofs(ctx context.Context, in *GetUtxoProofsRequest, opts ...grpc.CallOption) (*GetUtxoProofsResponse, error) {
+	out := new(GetUtxoProofsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.RpcService/RpcGetUtxoProofs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4112,6 +7209,15 @@ type RpcServiceServer interface {
 	RpcEstimateGas(context.Context, *EstimateGasRequest) (*EstimateGasResponse, error)
 	RpcGasPrice(context.Context, *GasPriceRequest) (*GasPriceResponse, error)
 	RpcContractQuery(context.Context, *ContractQueryRequest) (*ContractQueryResponse, error)
+	RpcGetTransactionReceipt(context.Context, *GetTransactionReceiptRequest) (*GetTransactionReceiptResponse, error)
+	RpcGetCandidates(context.Context, *GetCandidatesRequest) (*GetCandidatesResponse, error)
+	RpcGetVotes(context.Context, *GetVotesRequest) (*GetVotesResponse, error)
+	RpcGetSlashedProducers(context.Context, *GetSlashedProducersRequest) (*GetSlashedProducersResponse, error)
+	RpcGetDynastySchedule(context.Context, *GetDynastyScheduleRequest) (*GetDynastyScheduleResponse, error)
+	RpcGetSupply(context.Context, *GetSupplyRequest) (*GetSupplyResponse, error)
+	RpcGetChainParameters(context.Context, *GetChainParametersRequest) (*GetChainParametersResponse, error)
+	RpcGetLightBlockHeaders(context.Context, *GetLightBlockHeadersRequest) (*GetLightBlockHeadersResponse, error)
+	RpcGetUtxoProofs(context.Context, *GetUtxoProofsRequest) (*GetUtxoProofsResponse, error)
 }
 
 // UnimplementedRpcServiceServer can be embedded to have forward compatible implementations.
@@ -4169,6 +7275,33 @@ func (*UnimplementedRpcServiceServer) RpcGasPrice(context.Context, *GasPriceRequ
 func (*UnimplementedRpcServiceServer) RpcContractQuery(context.Context, *ContractQueryRequest) (*ContractQueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RpcContractQuery not implemented")
 }
+func (*UnimplementedRpcServiceServer) RpcGetTransactionReceipt(context.Context, *GetTransactionReceiptRequest) (*GetTransactionReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RpcGetTransactionReceipt not implemented")
+}
+func (*UnimplementedRpcServiceServer) RpcGetCandidates(context.Context, *GetCandidatesRequest) (*GetCandidatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RpcGetCandidates not implemented")
+}
+func (*UnimplementedRpcServiceServer) RpcGetVotes(context.Context, *GetVotesRequest) (*GetVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RpcGetVotes not implemented")
+}
+func (*UnimplementedRpcServiceServer) RpcGetSlashedProducers(context.Context, *GetSlashedProducersRequest) (*GetSlashedProducersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RpcGetSlashedProducers not implemented")
+}
+func (*UnimplementedRpcServiceServer) RpcGetDynastySchedule(context.Context, *GetDynastyScheduleRequest) (*GetDynastyScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RpcGetDynastySchedule not implemented")
+}
+func (*UnimplementedRpcServiceServer) RpcGetSupply(context.Context, *GetSupplyRequest) (*GetSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RpcGetSupply not implemented")
+}
+func (*UnimplementedRpcServiceServer) RpcGetChainParameters(context.Context, *GetChainParametersRequest) (*GetChainParametersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RpcGetChainParameters not implemented")
+}
+func (*UnimplementedRpcServiceServer) RpcGetLightBlockHeaders(context.Context, *GetLightBlockHeadersRequest) (*GetLightBlockHeadersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RpcGetLightBlockHeaders not implemented")
+}
+func (*UnimplementedRpcServiceServer) RpcGetUtxoProofs(context.Context, *GetUtxoProofsRequest) (*GetUtxoProofsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RpcGetUtxoProofs not implemented")
+}
 
 func RegisterRpcServiceServer(s *grpc.Server, srv RpcServiceServer) {
 	s.RegisterService(&_RpcService_serviceDesc, srv)
@@ -4311,193 +7444,355 @@ func _RpcService_RpcGetBlockByHash_Handler(srv interface{}, ctx context.Context,
 		FullMethod: "/rpcpb.RpcService/RpcGetBlockByHash",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcServiceServer).RpcGetBlockByHash(ctx, req.(*GetBlockByHashRequest))
+		return srv.(RpcServiceServer).RpcGetBlockByHash(ctx, req.(*GetBlockByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_RpcGetBlockByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockByHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).RpcGetBlockByHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.RpcService/RpcGetBlockByHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).RpcGetBlockByHeight(ctx, req.(*GetBlockByHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_RpcSendTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).RpcSendTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.RpcService/RpcSendTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).RpcSendTransaction(ctx, req.(*SendTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_RpcSendBatchTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendBatchTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).RpcSendBatchTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.RpcService/RpcSendBatchTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).RpcSendBatchTransaction(ctx, req.(*SendBatchTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_RpcGetNewTransaction_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetNewTransactionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RpcServiceServer).RpcGetNewTransaction(m, &rpcServiceRpcGetNewTransactionServer{stream})
+}
+
+type RpcService_RpcGetNewTransactionServer interface {
+	Send(*GetNewTransactionResponse) error
+	grpc.ServerStream
+}
+
+type rpcServiceRpcGetNewTransactionServer struct {
+	grpc.ServerStream
+}
+
+func (x *rpcServiceRpcGetNewTransactionServer) Send(m *GetNewTransactionResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RpcService_RpcSubscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RpcServiceServer).RpcSubscribe(m, &rpcServiceRpcSubscribeServer{stream})
+}
+
+type RpcService_RpcSubscribeServer interface {
+	Send(*SubscribeResponse) error
+	grpc.ServerStream
+}
+
+type rpcServiceRpcSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *rpcServiceRpcSubscribeServer) Send(m *SubscribeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RpcService_RpcGetAllTransactionsFromTxPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).RpcGetAllTransactionsFromTxPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.RpcService/RpcGetAllTransactionsFromTxPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).RpcGetAllTransactionsFromTxPool(ctx, req.(*GetAllTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_RpcGetLastIrreversibleBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLastIrreversibleBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).RpcGetLastIrreversibleBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.RpcService/RpcGetLastIrreversibleBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).RpcGetLastIrreversibleBlock(ctx, req.(*GetLastIrreversibleBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_RpcEstimateGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateGasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).RpcEstimateGas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.RpcService/RpcEstimateGas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).RpcEstimateGas(ctx, req.(*EstimateGasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_RpcGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GasPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).RpcGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.RpcService/RpcGasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).RpcGasPrice(ctx, req.(*GasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_RpcContractQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContractQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).RpcContractQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.RpcService/RpcContractQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).RpcContractQuery(ctx, req.(*ContractQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_RpcGetTransactionReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).RpcGetTransactionReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.RpcService/RpcGetTransactionReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).RpcGetTransactionReceipt(ctx, req.(*GetTransactionReceiptRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcService_RpcGetBlockByHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBlockByHeightRequest)
+func _RpcService_RpcGetCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCandidatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcServiceServer).RpcGetBlockByHeight(ctx, in)
+		return srv.(RpcServiceServer).RpcGetCandidates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.RpcService/RpcGetBlockByHeight",
+		FullMethod: "/rpcpb.RpcService/RpcGetCandidates",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcServiceServer).RpcGetBlockByHeight(ctx, req.(*GetBlockByHeightRequest))
+		return srv.(RpcServiceServer).RpcGetCandidates(ctx, req.(*GetCandidatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcService_RpcSendTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendTransactionRequest)
+func _RpcService_RpcGetVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVotesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcServiceServer).RpcSendTransaction(ctx, in)
+		return srv.(RpcServiceServer).RpcGetVotes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.RpcService/RpcSendTransaction",
+		FullMethod: "/rpcpb.RpcService/RpcGetVotes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcServiceServer).RpcSendTransaction(ctx, req.(*SendTransactionRequest))
+		return srv.(RpcServiceServer).RpcGetVotes(ctx, req.(*GetVotesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcService_RpcSendBatchTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendBatchTransactionRequest)
+func _RpcService_RpcGetSlashedProducers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSlashedProducersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcServiceServer).RpcSendBatchTransaction(ctx, in)
+		return srv.(RpcServiceServer).RpcGetSlashedProducers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.RpcService/RpcSendBatchTransaction",
+		FullMethod: "/rpcpb.RpcService/RpcGetSlashedProducers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcServiceServer).RpcSendBatchTransaction(ctx, req.(*SendBatchTransactionRequest))
+		return srv.(RpcServiceServer).RpcGetSlashedProducers(ctx, req.(*GetSlashedProducersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcService_RpcGetNewTransaction_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetNewTransactionRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(RpcServiceServer).RpcGetNewTransaction(m, &rpcServiceRpcGetNewTransactionServer{stream})
-}
-
-type RpcService_RpcGetNewTransactionServer interface {
-	Send(*GetNewTransactionResponse) error
-	grpc.ServerStream
-}
-
-type rpcServiceRpcGetNewTransactionServer struct {
-	grpc.ServerStream
-}
-
-func (x *rpcServiceRpcGetNewTransactionServer) Send(m *GetNewTransactionResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _RpcService_RpcSubscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SubscribeRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(RpcServiceServer).RpcSubscribe(m, &rpcServiceRpcSubscribeServer{stream})
-}
-
-type RpcService_RpcSubscribeServer interface {
-	Send(*SubscribeResponse) error
-	grpc.ServerStream
-}
-
-type rpcServiceRpcSubscribeServer struct {
-	grpc.ServerStream
-}
-
-func (x *rpcServiceRpcSubscribeServer) Send(m *SubscribeResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _RpcService_RpcGetAllTransactionsFromTxPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllTransactionsRequest)
+func _RpcService_RpcGetDynastySchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDynastyScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcServiceServer).RpcGetAllTransactionsFromTxPool(ctx, in)
+		return srv.(RpcServiceServer).RpcGetDynastySchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.RpcService/RpcGetAllTransactionsFromTxPool",
+		FullMethod: "/rpcpb.RpcService/RpcGetDynastySchedule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcServiceServer).RpcGetAllTransactionsFromTxPool(ctx, req.(*GetAllTransactionsRequest))
+		return srv.(RpcServiceServer).RpcGetDynastySchedule(ctx, req.(*GetDynastyScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcService_RpcGetLastIrreversibleBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLastIrreversibleBlockRequest)
+func _RpcService_RpcGetSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSupplyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcServiceServer).RpcGetLastIrreversibleBlock(ctx, in)
+		return srv.(RpcServiceServer).RpcGetSupply(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.RpcService/RpcGetLastIrreversibleBlock",
+		FullMethod: "/rpcpb.RpcService/RpcGetSupply",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcServiceServer).RpcGetLastIrreversibleBlock(ctx, req.(*GetLastIrreversibleBlockRequest))
+		return srv.(RpcServiceServer).RpcGetSupply(ctx, req.(*GetSupplyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcService_RpcEstimateGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateGasRequest)
+func _RpcService_RpcGetChainParameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChainParametersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcServiceServer).RpcEstimateGas(ctx, in)
+		return srv.(RpcServiceServer).RpcGetChainParameters(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.RpcService/RpcEstimateGas",
+		FullMethod: "/rpcpb.RpcService/RpcGetChainParameters",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcServiceServer).RpcEstimateGas(ctx, req.(*EstimateGasRequest))
+		return srv.(RpcServiceServer).RpcGetChainParameters(ctx, req.(*GetChainParametersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcService_RpcGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GasPriceRequest)
+func _RpcService_RpcGetLightBlockHeaders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLightBlockHeadersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcServiceServer).RpcGasPrice(ctx, in)
+		return srv.(RpcServiceServer).RpcGetLightBlockHeaders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.RpcService/RpcGasPrice",
+		FullMethod: "/rpcpb.RpcService/RpcGetLightBlockHeaders",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcServiceServer).RpcGasPrice(ctx, req.(*GasPriceRequest))
+		return srv.(RpcServiceServer).RpcGetLightBlockHeaders(ctx, req.(*GetLightBlockHeadersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RpcService_RpcContractQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContractQueryRequest)
+func _RpcService_RpcGetUtxoProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUtxoProofsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RpcServiceServer).RpcContractQuery(ctx, in)
+		return srv.(RpcServiceServer).RpcGetUtxoProofs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/rpcpb.RpcService/RpcContractQuery",
+		FullMethod: "/rpcpb.RpcService/RpcGetUtxoProofs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RpcServiceServer).RpcContractQuery(ctx, req.(*ContractQueryRequest))
+		return srv.(RpcServiceServer).RpcGetUtxoProofs(ctx, req.(*GetUtxoProofsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -4558,6 +7853,42 @@ var _RpcService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RpcContractQuery",
 			Handler:    _RpcService_RpcContractQuery_Handler,
 		},
+		{
+			MethodName: "RpcGetTransactionReceipt",
+			Handler:    _RpcService_RpcGetTransactionReceipt_Handler,
+		},
+		{
+			MethodName: "RpcGetCandidates",
+			Handler:    _RpcService_RpcGetCandidates_Handler,
+		},
+		{
+			MethodName: "RpcGetVotes",
+			Handler:    _RpcService_RpcGetVotes_Handler,
+		},
+		{
+			MethodName: "RpcGetSlashedProducers",
+			Handler:    _RpcService_RpcGetSlashedProducers_Handler,
+		},
+		{
+			MethodName: "RpcGetDynastySchedule",
+			Handler:    _RpcService_RpcGetDynastySchedule_Handler,
+		},
+		{
+			MethodName: "RpcGetSupply",
+			Handler:    _RpcService_RpcGetSupply_Handler,
+		},
+		{
+			MethodName: "RpcGetChainParameters",
+			Handler:    _RpcService_RpcGetChainParameters_Handler,
+		},
+		{
+			MethodName: "RpcGetLightBlockHeaders",
+			Handler:    _RpcService_RpcGetLightBlockHeaders_Handler,
+		},
+		{
+			MethodName: "RpcGetUtxoProofs",
+			Handler:    _RpcService_RpcGetUtxoProofs_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -4594,6 +7925,12 @@ type AdminServiceClient interface {
 	RpcSend(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*SendResponse, error)
 	RpcGetPeerInfo(ctx context.Context, in *GetPeerInfoRequest, opts ...grpc.CallOption) (*GetPeerInfoResponse, error)
 	RpcChangeProducer(ctx context.Context, in *ChangeProducerRequest, opts ...grpc.CallOption) (*ChangeProducerResponse, error)
+	RpcGetBannedPeers(ctx context.Context, in *GetBannedPeersRequest, opts ...grpc.CallOption) (*GetBannedPeersResponse, error)
+	RpcBanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*BanPeerResponse, error)
+	RpcUnbanPeer(ctx context.Context, in *UnbanPeerRequest, opts ...grpc.CallOption) (*UnbanPeerResponse, error)
+	RpcGetPeerFilter(ctx context.Context, in *GetPeerFilterRequest, opts ...grpc.CallOption) (*GetPeerFilterResponse, error)
+	RpcSetPeerAllowlist(ctx context.Context, in *SetPeerAllowlistRequest, opts ...grpc.CallOption) (*SetPeerAllowlistResponse, error)
+	RpcSetPeerDenylist(ctx context.Context, in *SetPeerDenylistRequest, opts ...grpc.CallOption) (*SetPeerDenylistResponse, error)
 }
 
 type adminServiceClient struct {
@@ -4640,12 +7977,72 @@ func (c *adminServiceClient) RpcChangeProducer(ctx context.Context, in *ChangePr
 	return out, nil
 }
 
+func (c *adminServiceClient) RpcGetBannedPeers(ctx context.Context, in *GetBannedPeersRequest, opts ...grpc.CallOption) (*GetBannedPeersResponse, error) {
+	out := new(GetBannedPeersResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.AdminService/RpcGetBannedPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RpcBanPeer(ctx context.Context, in *BanPeerRequest, opts ...grpc.CallOption) (*BanPeerResponse, error) {
+	out := new(BanPeerResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.AdminService/RpcBanPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RpcUnbanPeer(ctx context.Context, in *UnbanPeerRequest, opts ...grpc.CallOption) (*UnbanPeerResponse, error) {
+	out := new(UnbanPeerResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.AdminService/RpcUnbanPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RpcGetPeerFilter(ctx context.Context, in *GetPeerFilterRequest, opts ...grpc.CallOption) (*GetPeerFilterResponse, error) {
+	out := new(GetPeerFilterResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.AdminService/RpcGetPeerFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RpcSetPeerAllowlist(ctx context.Context, in *SetPeerAllowlistRequest, opts ...grpc.CallOption) (*SetPeerAllowlistResponse, error) {
+	out := new(SetPeerAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.AdminService/RpcSetPeerAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RpcSetPeerDenylist(ctx context.Context, in *SetPeerDenylistRequest, opts ...grpc.CallOption) (*SetPeerDenylistResponse, error) {
+	out := new(SetPeerDenylistResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.AdminService/RpcSetPeerDenylist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 type AdminServiceServer interface {
 	RpcAddPeer(context.Context, *AddPeerRequest) (*AddPeerResponse, error)
 	RpcSend(context.Context, *SendRequest) (*SendResponse, error)
 	RpcGetPeerInfo(context.Context, *GetPeerInfoRequest) (*GetPeerInfoResponse, error)
 	RpcChangeProducer(context.Context, *ChangeProducerRequest) (*ChangeProducerResponse, error)
+	RpcGetBannedPeers(context.Context, *GetBannedPeersRequest) (*GetBannedPeersResponse, error)
+	RpcBanPeer(context.Context, *BanPeerRequest) (*BanPeerResponse, error)
+	RpcUnbanPeer(context.Context, *UnbanPeerRequest) (*UnbanPeerResponse, error)
+	RpcGetPeerFilter(context.Context, *GetPeerFilterRequest) (*GetPeerFilterResponse, error)
+	RpcSetPeerAllowlist(context.Context, *SetPeerAllowlistRequest) (*SetPeerAllowlistResponse, error)
+	RpcSetPeerDenylist(context.Context, *SetPeerDenylistRequest) (*SetPeerDenylistResponse, error)
 }
 
 // UnimplementedAdminServiceServer can be embedded to have forward compatible implementations.
@@ -4664,6 +8061,24 @@ func (*UnimplementedAdminServiceServer) RpcGetPeerInfo(context.Context, *GetPeer
 func (*UnimplementedAdminServiceServer) RpcChangeProducer(context.Context, *ChangeProducerRequest) (*ChangeProducerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RpcChangeProducer not implemented")
 }
+func (*UnimplementedAdminServiceServer) RpcGetBannedPeers(context.Context, *GetBannedPeersRequest) (*GetBannedPeersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RpcGetBannedPeers not implemented")
+}
+func (*UnimplementedAdminServiceServer) RpcBanPeer(context.Context, *BanPeerRequest) (*BanPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RpcBanPeer not implemented")
+}
+func (*UnimplementedAdminServiceServer) RpcUnbanPeer(context.Context, *UnbanPeerRequest) (*UnbanPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RpcUnbanPeer not implemented")
+}
+func (*UnimplementedAdminServiceServer) RpcGetPeerFilter(context.Context, *GetPeerFilterRequest) (*GetPeerFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RpcGetPeerFilter not implemented")
+}
+func (*UnimplementedAdminServiceServer) RpcSetPeerAllowlist(context.Context, *SetPeerAllowlistRequest) (*SetPeerAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RpcSetPeerAllowlist not implemented")
+}
+func (*UnimplementedAdminServiceServer) RpcSetPeerDenylist(context.Context, *SetPeerDenylistRequest) (*SetPeerDenylistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RpcSetPeerDenylist not implemented")
+}
 
 func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
 	s.RegisterService(&_AdminService_serviceDesc, srv)
@@ -4741,6 +8156,114 @@ func _AdminService_RpcChangeProducer_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_RpcGetBannedPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBannedPeersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RpcGetBannedPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/RpcGetBannedPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RpcGetBannedPeers(ctx, req.(*GetBannedPeersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RpcBanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BanPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RpcBanPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/RpcBanPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RpcBanPeer(ctx, req.(*BanPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RpcUnbanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RpcUnbanPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/RpcUnbanPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RpcUnbanPeer(ctx, req.(*UnbanPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RpcGetPeerFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPeerFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RpcGetPeerFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/RpcGetPeerFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RpcGetPeerFilter(ctx, req.(*GetPeerFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RpcSetPeerAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPeerAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RpcSetPeerAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/RpcSetPeerAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RpcSetPeerAllowlist(ctx, req.(*SetPeerAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RpcSetPeerDenylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPeerDenylistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RpcSetPeerDenylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/RpcSetPeerDenylist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RpcSetPeerDenylist(ctx, req.(*SetPeerDenylistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AdminService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpcpb.AdminService",
 	HandlerType: (*AdminServiceServer)(nil),
@@ -4761,6 +8284,30 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RpcChangeProducer",
 			Handler:    _AdminService_RpcChangeProducer_Handler,
 		},
+		{
+			MethodName: "RpcGetBannedPeers",
+			Handler:    _AdminService_RpcGetBannedPeers_Handler,
+		},
+		{
+			MethodName: "RpcBanPeer",
+			Handler:    _AdminService_RpcBanPeer_Handler,
+		},
+		{
+			MethodName: "RpcUnbanPeer",
+			Handler:    _AdminService_RpcUnbanPeer_Handler,
+		},
+		{
+			MethodName: "RpcGetPeerFilter",
+			Handler:    _AdminService_RpcGetPeerFilter_Handler,
+		},
+		{
+			MethodName: "RpcSetPeerAllowlist",
+			Handler:    _AdminService_RpcSetPeerAllowlist_Handler,
+		},
+		{
+			MethodName: "RpcSetPeerDenylist",
+			Handler:    _AdminService_RpcSetPeerDenylist_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "github.com/dappley/go-dappley/rpc/pb/rpc.proto",
@@ -4774,6 +8321,7 @@ type MetricServiceClient interface {
 	RpcGetStats(ctx context.Context, in *MetricsServiceRequest, opts ...grpc.CallOption) (*GetStatsResponse, error)
 	RpcGetNodeConfig(ctx context.Context, in *MetricsServiceRequest, opts ...grpc.CallOption) (*GetNodeConfigResponse, error)
 	RpcSetNodeConfig(ctx context.Context, in *SetNodeConfigRequest, opts ...grpc.CallOption) (*GetNodeConfigResponse, error)
+	RpcGetProducerStats(ctx context.Context, in *MetricsServiceRequest, opts ...grpc.CallOption) (*GetProducerStatsResponse, error)
 }
 
 type metricServiceClient struct {
@@ -4820,12 +8368,22 @@ func (c *metricServiceClient) RpcSetNodeConfig(ctx context.Context, in *SetNodeC
 	return out, nil
 }
 
+func (c *metricServiceClient) RpcGetProducerStats(ctx context.Context, in *MetricsServiceRequest, opts ...grpc.CallOption) (*GetProducerStatsResponse, error) {
+	out := new(GetProducerStatsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.MetricService/RpcGetProducerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MetricServiceServer is the server API for MetricService service.
 type MetricServiceServer interface {
 	RpcGetMetricsInfo(context.Context, *MetricsServiceRequest) (*GetMetricsInfoResponse, error)
 	RpcGetStats(context.Context, *MetricsServiceRequest) (*GetStatsResponse, error)
 	RpcGetNodeConfig(context.Context, *MetricsServiceRequest) (*GetNodeConfigResponse, error)
 	RpcSetNodeConfig(context.Context, *SetNodeConfigRequest) (*GetNodeConfigResponse, error)
+	RpcGetProducerStats(context.Context, *MetricsServiceRequest) (*GetProducerStatsResponse, error)
 }
 
 // UnimplementedMetricServiceServer can be embedded to have forward compatible implementations.
@@ -4844,6 +8402,9 @@ func (*UnimplementedMetricServiceServer) RpcGetNodeConfig(context.Context, *Metr
 func (*UnimplementedMetricServiceServer) RpcSetNodeConfig(context.Context, *SetNodeConfigRequest) (*GetNodeConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RpcSetNodeConfig not implemented")
 }
+func (*UnimplementedMetricServiceServer) RpcGetProducerStats(context.Context, *MetricsServiceRequest) (*GetProducerStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RpcGetProducerStats not implemented")
+}
 
 func RegisterMetricServiceServer(s *grpc.Server, srv MetricServiceServer) {
 	s.RegisterService(&_MetricService_serviceDesc, srv)
@@ -4921,6 +8482,24 @@ func _MetricService_RpcSetNodeConfig_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MetricService_RpcGetProducerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MetricsServiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetricServiceServer).RpcGetProducerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.MetricService/RpcGetProducerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetricServiceServer).RpcGetProducerStats(ctx, req.(*MetricsServiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MetricService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpcpb.MetricService",
 	HandlerType: (*MetricServiceServer)(nil),
@@ -4941,6 +8520,10 @@ var _MetricService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RpcSetNodeConfig",
 			Handler:    _MetricService_RpcSetNodeConfig_Handler,
 		},
+		{
+			MethodName: "RpcGetProducerStats",
+			Handler:    _MetricService_RpcGetProducerStats_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "github.com/dappley/go-dappley/rpc/pb/rpc.proto",
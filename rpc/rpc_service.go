@@ -18,8 +18,10 @@ package rpc
 
 import (
 	"context"
+	"encoding/hex"
 
 	"io"
+	"math/big"
 	"strings"
 	"sync"
 	"time"
@@ -37,7 +39,11 @@ import (
 
 	"github.com/dappley/go-dappley/core/block"
 	blockpb "github.com/dappley/go-dappley/core/block/pb"
+	finalitypb "github.com/dappley/go-dappley/core/finality/pb"
 	"github.com/dappley/go-dappley/logic/lblockchain"
+	"github.com/dappley/go-dappley/logic/lelection"
+	"github.com/dappley/go-dappley/logic/lgovernance"
+	"github.com/dappley/go-dappley/logic/lightclient"
 
 	"github.com/dappley/go-dappley/core/account"
 	"github.com/dappley/go-dappley/crypto/byteutils"
@@ -58,6 +64,7 @@ const (
 	ProtoVersion                   = "1.0.0"
 	MaxGetBlocksCount       int32  = 500
 	MinUtxoBlockHeaderCount uint64 = 6
+	secondsPerYear          uint64 = 365 * 24 * 3600
 )
 
 type RpcService struct {
@@ -130,6 +137,7 @@ func (rpcService *RpcService) RpcGetBlockchainInfo(ctx context.Context, in *rpcp
 		BlockHeight:   rpcService.GetBlockchain().GetMaxHeight(),
 		Producers:     rpcService.dynasty.GetProducers(),
 		Timestamp:     tailBlock.GetTimestamp(),
+		BlockGasLimit: rpcService.GetBlockchain().GetBlockGasLimit(),
 	}, nil
 }
 
@@ -363,8 +371,8 @@ func (rpcService *RpcService) RpcSendTransaction(ctx context.Context, in *rpcpb.
 	tx.FromProto(in.GetTransaction())
 
 	adaptedTx := transaction.NewTxAdapter(tx)
-	if !adaptedTx.IsNormal() && !adaptedTx.IsContract() {
-		return nil, status.Error(codes.InvalidArgument, "transaction type error, must be normal or contract")
+	if !adaptedTx.IsNormal() && !adaptedTx.IsContract() && !adaptedTx.IsVote() && !adaptedTx.IsUnvote() && !adaptedTx.IsGovernance() {
+		return nil, status.Error(codes.InvalidArgument, "transaction type error, must be normal, contract, vote, unvote or governance")
 	}
 
 	bc := rpcService.GetBlockchain()
@@ -373,6 +381,9 @@ func (rpcService *RpcService) RpcSendTransaction(ctx context.Context, in *rpcpb.
 		if adaptedTx.GasPrice.Cmp(common.NewAmount(0)) < 0 || tx.GasPrice.Cmp(common.NewAmount(0)) == ltransaction.GasConsumption {
 			return nil, status.Error(codes.InvalidArgument, "gas price error, must be a positive number")
 		}
+		if tx.GasPrice.Uint64() < bc.GetGasPriceFloor() {
+			return nil, status.Error(codes.InvalidArgument, errval.GasPriceTooLow.Error())
+		}
 
 		engine := vm.NewV8Engine()
 		defer engine.DestroyEngine()
@@ -395,7 +406,7 @@ func (rpcService *RpcService) RpcSendTransaction(ctx context.Context, in *rpcpb.
 	}
 	rpcService.mutex.Unlock()
 
-	if err := ltransaction.VerifyTransaction(rpcService.utxoIndex, tx, 0); err != nil {
+	if err := ltransaction.VerifyTransaction(rpcService.utxoIndex, tx, bc.GetMaxHeight()+1); err != nil {
 		logger.Warn(err.Error())
 		return nil, status.Error(codes.FailedPrecondition, errval.TransactionVerifyFailed.Error())
 	}
@@ -434,12 +445,12 @@ func (rpcService *RpcService) RpcSendBatchTransaction(ctx context.Context, in *r
 	st := status.New(codes.OK, "")
 	for _, tx := range txs {
 		adaptedTx := transaction.NewTxAdapter(&tx)
-		if !adaptedTx.IsNormal() && !adaptedTx.IsContract() {
+		if !adaptedTx.IsNormal() && !adaptedTx.IsContract() && !adaptedTx.IsVote() && !adaptedTx.IsUnvote() {
 			st = status.New(codes.Unknown, "one or more transactions are invalid")
 			respon = append(respon, &rpcpb.SendTransactionStatus{
 				Txid:    tx.ID,
 				Code:    uint32(codes.InvalidArgument),
-				Message: "transaction type error, must be normal or contract",
+				Message: "transaction type error, must be normal, contract, vote or unvote",
 			})
 			continue
 		}
@@ -454,7 +465,7 @@ func (rpcService *RpcService) RpcSendBatchTransaction(ctx context.Context, in *r
 			continue
 		}
 
-		if err := ltransaction.VerifyTransaction(utxoIndex, &tx, 0); err != nil {
+		if err := ltransaction.VerifyTransaction(utxoIndex, &tx, rpcService.GetBlockchain().GetMaxHeight()+1); err != nil {
 			st = status.New(codes.Unknown, "one or more transactions are invalid")
 			// add invalid transactions to response details if exists
 			respon = append(respon, &rpcpb.SendTransactionStatus{
@@ -541,7 +552,11 @@ func (rpcService *RpcService) RpcGetLastIrreversibleBlock(ctx context.Context, i
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
 
-	return &rpcpb.GetLastIrreversibleBlockResponse{Block: blk.ToProto().(*blockpb.Block)}, nil
+	response := &rpcpb.GetLastIrreversibleBlockResponse{Block: blk.ToProto().(*blockpb.Block)}
+	if justification, err := rpcService.GetBlockchain().GetJustification(blk.GetHash()); err == nil {
+		response.Justification = justification.ToProto().(*finalitypb.Justification)
+	}
+	return response, nil
 }
 
 // RpcEstimateGas estimate gas value of contract deploy and execution.
@@ -570,13 +585,30 @@ func (rpcService *RpcService) RpcEstimateGas(ctx context.Context, in *rpcpb.Esti
 		rpcService.GetBlockchain().GetUtxoCache(),
 		rpcService.GetBlockchain().GetDb(),
 	)
-	return &rpcpb.EstimateGasResponse{GasCount: byteutils.FromUint64(gasCount)}, err
+	return &rpcpb.EstimateGasResponse{
+		GasCount:   byteutils.FromUint64(gasCount),
+		Suggestion: gasPriceSuggestionToProto(rpcService.GetBlockchain().SuggestGasPrice()),
+	}, err
 }
 
-// RpcGasPrice returns current gas price.
+// RpcGasPrice returns the median gas price together with the low/median/high suggestions of the gas price oracle.
 func (rpcService *RpcService) RpcGasPrice(ctx context.Context, in *rpcpb.GasPriceRequest) (*rpcpb.GasPriceResponse, error) {
-	gasPrice := rpcService.GetBlockchain().GasPrice()
-	return &rpcpb.GasPriceResponse{GasPrice: byteutils.FromUint64(gasPrice)}, nil
+	suggestion := rpcService.GetBlockchain().SuggestGasPrice()
+	return &rpcpb.GasPriceResponse{
+		GasPrice:   byteutils.FromUint64(suggestion.MedianGasPrice),
+		Suggestion: gasPriceSuggestionToProto(suggestion),
+	}, nil
+}
+
+func gasPriceSuggestionToProto(suggestion *lblockchain.GasPriceSuggestion) *rpcpb.GasPriceSuggestion {
+	return &rpcpb.GasPriceSuggestion{
+		LowGasPrice:    byteutils.FromUint64(suggestion.LowGasPrice),
+		MedianGasPrice: byteutils.FromUint64(suggestion.MedianGasPrice),
+		HighGasPrice:   byteutils.FromUint64(suggestion.HighGasPrice),
+		LowTip:         byteutils.FromUint64(suggestion.LowTip),
+		MedianTip:      byteutils.FromUint64(suggestion.MedianTip),
+		HighTip:        byteutils.FromUint64(suggestion.HighTip),
+	}
 }
 
 // RpcContractQuery returns the query result of contract storage
@@ -594,3 +626,195 @@ func (rpcService *RpcService) RpcContractQuery(ctx context.Context, in *rpcpb.Co
 	}
 	return &rpcpb.ContractQueryResponse{Key: queryKey, Value: resultValue}, nil
 }
+
+// RpcGetTransactionReceipt returns the execution receipt of a contract transaction packed in the blockchain
+func (rpcService *RpcService) RpcGetTransactionReceipt(ctx context.Context, in *rpcpb.GetTransactionReceiptRequest) (*rpcpb.GetTransactionReceiptResponse, error) {
+	if len(in.GetTxid()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "txid is empty")
+	}
+	receipt, err := rpcService.GetBlockchain().GetTxReceipt(in.GetTxid())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &rpcpb.GetTransactionReceiptResponse{Receipt: receipt.ToProto().(*transactionpb.TransactionReceipt)}, nil
+}
+
+// RpcGetCandidates returns the producer candidates and their votes
+func (rpcService *RpcService) RpcGetCandidates(ctx context.Context, in *rpcpb.GetCandidatesRequest) (*rpcpb.GetCandidatesResponse, error) {
+	bc := rpcService.GetBlockchain()
+	state := scState.NewScState(bc.GetUtxoCache())
+	response := &rpcpb.GetCandidatesResponse{
+		NextElectionHeight: (bc.GetMaxHeight()/lelection.ElectionInterval + 1) * lelection.ElectionInterval,
+	}
+	for _, candidate := range lelection.GetCandidates(state) {
+		response.Candidates = append(response.Candidates, &rpcpb.Candidate{
+			Address: candidate.Address,
+			Votes:   candidate.Votes.Bytes(),
+		})
+	}
+	return response, nil
+}
+
+// RpcGetVotes returns the votes currently cast by an address
+func (rpcService *RpcService) RpcGetVotes(ctx context.Context, in *rpcpb.GetVotesRequest) (*rpcpb.GetVotesResponse, error) {
+	acc := account.NewTransactionAccountByAddress(account.NewAddress(in.GetAddress()))
+	if !acc.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, errval.InvalidAddress.Error())
+	}
+
+	state := scState.NewScState(rpcService.GetBlockchain().GetUtxoCache())
+	response := &rpcpb.GetVotesResponse{}
+	for _, record := range lelection.GetVotes(state, in.GetAddress()) {
+		txid, err := hex.DecodeString(record.TxID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		amount, err := common.NewAmountFromString(record.Amount)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		response.Votes = append(response.Votes, &rpcpb.Vote{
+			Txid:       txid,
+			Amount:     amount.Bytes(),
+			Candidates: record.Candidates,
+			Height:     record.Height,
+		})
+	}
+	return response, nil
+}
+
+// RpcGetSlashedProducers returns the producers that were removed from the dynasty for double-minting
+func (rpcService *RpcService) RpcGetSlashedProducers(ctx context.Context, in *rpcpb.GetSlashedProducersRequest) (*rpcpb.GetSlashedProducersResponse, error) {
+	state := scState.NewScState(rpcService.GetBlockchain().GetUtxoCache())
+	response := &rpcpb.GetSlashedProducersResponse{}
+	for _, record := range lelection.GetSlashedProducers(state) {
+		txid, err := hex.DecodeString(record.TxID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		response.Producers = append(response.Producers, &rpcpb.SlashedProducer{
+			Address: record.Address,
+			Txid:    txid,
+			Height:  record.Height,
+		})
+	}
+	return response, nil
+}
+
+// RpcGetDynastySchedule returns the current producers and the dynasty changes that have not taken effect yet
+func (rpcService *RpcService) RpcGetDynastySchedule(ctx context.Context, in *rpcpb.GetDynastyScheduleRequest) (*rpcpb.GetDynastyScheduleResponse, error) {
+	bc := rpcService.GetBlockchain()
+	state := scState.NewScState(bc.GetUtxoCache())
+	response := &rpcpb.GetDynastyScheduleResponse{
+		Producers: rpcService.dynasty.GetProducers(),
+	}
+	for _, change := range lelection.GetScheduledChanges(state) {
+		if change.Height <= bc.GetMaxHeight() {
+			continue
+		}
+		txid, err := hex.DecodeString(change.TxID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		response.Changes = append(response.Changes, &rpcpb.DynastyChange{
+			Height:   change.Height,
+			Original: change.Original,
+			New:      change.New,
+			Kind:     uint32(change.Kind),
+			Txid:     txid,
+		})
+	}
+	return response, nil
+}
+
+// RpcGetChainParameters returns the chain parameters in force after the block at the requested height and the
+// governance proposals that have not been tallied yet
+func (rpcService *RpcService) RpcGetChainParameters(ctx context.Context, in *rpcpb.GetChainParametersRequest) (*rpcpb.GetChainParametersResponse, error) {
+	bc := rpcService.GetBlockchain()
+	height := in.GetHeight()
+	if height == 0 || height > bc.GetMaxHeight() {
+		height = bc.GetMaxHeight()
+	}
+	params, from, err := bc.GetChainParameters(height)
+	if err != nil {
+		params, from = rpcService.bm.GetChainParameters(), 0
+	}
+
+	producers := rpcService.dynasty.GetProducers()
+	response := &rpcpb.GetChainParametersResponse{
+		Height:         from,
+		BlockSizeLimit: uint32(params.BlockSizeLimit),
+		GasPriceFloor:  params.GasPriceFloor,
+		MaxProducers:   uint32(params.MaxProducers),
+		TimeBetweenBlk: uint32(params.TimeBetweenBlk),
+		Quorum:         uint32(lgovernance.Quorum(len(producers))),
+	}
+	state := scState.NewScState(bc.GetUtxoCache())
+	for _, proposal := range lgovernance.GetProposals(state) {
+		if proposal.ActivationHeight <= bc.GetMaxHeight() {
+			continue
+		}
+		txid, err := hex.DecodeString(proposal.ID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		response.Proposals = append(response.Proposals, &rpcpb.Proposal{
+			Txid:             txid,
+			Proposer:         proposal.Proposer,
+			Parameter:        proposal.Parameter,
+			Value:            proposal.Value,
+			Height:           proposal.Height,
+			ActivationHeight: proposal.ActivationHeight,
+			Approvals:        proposal.GetApprovals(state, producers),
+		})
+	}
+	return response, nil
+}
+
+// RpcGetLightBlockHeaders returns the headers of the main chain from the start height for the light clients
+func (rpcService *RpcService) RpcGetLightBlockHeaders(ctx context.Context, in *rpcpb.GetLightBlockHeadersRequest) (*rpcpb.GetLightBlockHeadersResponse, error) {
+	return &rpcpb.GetLightBlockHeadersResponse{
+		Headers: lightclient.GetLightBlockHeaders(rpcService.GetBlockchain(), in.GetStartHeight(), in.GetCount()),
+	}, nil
+}
+
+// RpcGetUtxoProofs returns the proofs of the transactions that created the utxos of the address, which a light client
+// verifies against its block headers
+func (rpcService *RpcService) RpcGetUtxoProofs(ctx context.Context, in *rpcpb.GetUtxoProofsRequest) (*rpcpb.GetUtxoProofsResponse, error) {
+	addressAccount := account.NewTransactionAccountByAddress(account.NewAddress(in.GetAddress()))
+	if !addressAccount.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, errval.InvalidAddress.Error())
+	}
+
+	result := lightclient.GetUtxoProofs(rpcService.GetBlockchain(), addressAccount.GetPubKeyHash())
+	return &rpcpb.GetUtxoProofsResponse{Proofs: result.GetProofs(), Height: result.GetHeight()}, nil
+}
+
+// RpcGetSupply returns the coins minted and burnt so far and the inflation of the monetary policy
+func (rpcService *RpcService) RpcGetSupply(ctx context.Context, in *rpcpb.GetSupplyRequest) (*rpcpb.GetSupplyResponse, error) {
+	bc := rpcService.GetBlockchain()
+	height := bc.GetMaxHeight()
+	policy := transaction.GetMonetaryPolicy()
+	minted := policy.GetEmission(height)
+	burnt := ltransaction.GetBurntFees(scState.NewScState(bc.GetUtxoCache()))
+	supply, err := minted.Sub(burnt)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &rpcpb.GetSupplyResponse{
+		Minted:      minted.Bytes(),
+		Burnt:       burnt.Bytes(),
+		Supply:      supply.Bytes(),
+		BlockReward: policy.GetReward(height + 1).Bytes(),
+	}
+	if supplyCap := policy.GetSupplyCap(); supplyCap != nil {
+		response.SupplyCap = supplyCap.Bytes()
+	}
+	if timeBetweenBlk := rpcService.dynasty.GetTimeBetweenBlk(); timeBetweenBlk > 0 && !supply.IsZero() {
+		nextYear, _ := policy.GetEmission(height + secondsPerYear/uint64(timeBetweenBlk)).Sub(minted)
+		inflation, _ := new(big.Float).Quo(new(big.Float).SetInt(nextYear.BigInt()), new(big.Float).SetInt(supply.BigInt())).Float64()
+		response.Inflation = inflation
+	}
+	return response, nil
+}
@@ -0,0 +1,82 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/consensus"
+	"github.com/dappley/go-dappley/core/account"
+	errval "github.com/dappley/go-dappley/errors"
+	"github.com/dappley/go-dappley/logic/lightclient"
+	lightclientpb "github.com/dappley/go-dappley/logic/lightclient/pb"
+	rpcpb "github.com/dappley/go-dappley/rpc/pb"
+)
+
+//rpcLightSource serves the headers and the proofs of a light client from the rpc server
+type rpcLightSource struct {
+	conn *DappSdkGrpcClient
+}
+
+func (source *rpcLightSource) GetLightBlockHeaders(startHeight uint64, count uint32) ([]*lightclientpb.LightBlockHeader, error) {
+	response, err := source.conn.rpcClient.RpcGetLightBlockHeaders(
+		context.Background(),
+		&rpcpb.GetLightBlockHeadersRequest{StartHeight: startHeight, Count: count},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return response.Headers, nil
+}
+
+func (source *rpcLightSource) GetUtxoProofs(pubKeyHash account.PubKeyHash) (*lightclientpb.ReturnUtxoProofs, error) {
+	response, err := source.conn.rpcClient.RpcGetUtxoProofs(
+		context.Background(),
+		&rpcpb.GetUtxoProofsRequest{Address: pubKeyHash.GenerateAddress().String()},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &lightclientpb.ReturnUtxoProofs{PubKeyHash: pubKeyHash, Proofs: response.Proofs, Height: response.Height}, nil
+}
+
+//DappSdkLightClient checks the outputs of an address against the block headers signed by the producers
+type DappSdkLightClient struct {
+	client *lightclient.Client
+	source *rpcLightSource
+}
+
+//NewDappSdkLightClient creates a light client that trusts the genesis block of the hash and the producers of the dynasty
+func NewDappSdkLightClient(sdk *DappSdk, genesisHash hash.Hash, dynasty *consensus.Dynasty) (*DappSdkLightClient, error) {
+	source := &rpcLightSource{sdk.conn}
+	headers, err := source.GetLightBlockHeaders(0, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(headers) == 0 || !bytes.Equal(headers[0].GetHeader().GetHash(), genesisHash) {
+		return nil, errval.InvalidLightBlockHeader
+	}
+
+	client, err := lightclient.NewClient(headers[0], dynasty)
+	if err != nil {
+		return nil, err
+	}
+	return &DappSdkLightClient{client: client, source: source}, nil
+}
+
+//Sync downloads and verifies the block headers after the tail of the light client
+func (lightClient *DappSdkLightClient) Sync() error {
+	return lightClient.client.Sync(lightClient.source)
+}
+
+//GetBlockHeight returns the height of the verified headers
+func (lightClient *DappSdkLightClient) GetBlockHeight() uint64 {
+	return lightClient.client.GetMaxHeight()
+}
+
+//GetUnverifiedBalance syncs the block headers and returns the total value of the outputs that the server proves to be
+//paid to the address. Whether the outputs are unspent and whether any is missing is taken from the server
+func (lightClient *DappSdkLightClient) GetUnverifiedBalance(address string) (*common.Amount, error) {
+	return lightClient.client.GetUnverifiedBalance(lightClient.source, account.NewAddress(address))
+}
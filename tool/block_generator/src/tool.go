@@ -1,6 +1,7 @@
 package tool
 
 import (
+	"crypto/rand"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -153,6 +154,9 @@ func generateBlock(utxoIndex *lutxo.UTXOIndex, parentBlk *block.Block, bc *lbloc
 	txs = append(txs, &cbtx)
 	utxoIndex.UpdateUtxo(&cbtx)
 	b := block.NewBlockWithTimestamp(txs, parentBlk, time, producer.String())
+	if err := consensus.GenerateVrfProof(b, key, rand.Reader); err != nil {
+		logger.WithError(err).Warn("Tool: cannot generate the VRF proof.")
+	}
 	b.SetHash(lblock.CalculateHashWithNonce(b))
 	b.SetNonce(0)
 	lblock.SignBlock(b, key)
@@ -254,7 +258,7 @@ func generateTransaction(addrs []account.Address, wm *wallet.AccountManager, utx
 }
 
 func newTransaction(sender, receiver account.Address, senderKeyPair *account.KeyPair, utxoIndex *lutxo.UTXOIndex, senderPkh account.PubKeyHash, amount *common.Amount, gasLimit *common.Amount, gasPrice *common.Amount, contract string) *transaction.Transaction {
-	utxos, _ := utxoIndex.GetUTXOsAccordingToAmount([]byte(senderPkh), amount)
+	utxos, _ := utxoIndex.GetUTXOsAccordingToAmount([]byte(senderPkh), amount, 0)
 
 	sendTxParam := transaction.NewSendTxParam(sender, senderKeyPair, receiver, amount, common.NewAmount(0), gasLimit, gasPrice, contract)
 	tx, err := ltransaction.NewNormalUTXOTransaction(utxos, sendTxParam)
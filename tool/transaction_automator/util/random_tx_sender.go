@@ -180,7 +180,7 @@ func (sender *BatchTxSender) createTransaction(from, to account.Address, amount,
 	}
 	ta := account.NewAccountByKey(senderKeyPair)
 
-	prevUtxos, err := sender.account.GetUtxoIndex().GetUTXOsAccordingToAmount(ta.GetPubKeyHash(), amount)
+	prevUtxos, err := sender.account.GetUtxoIndex().GetUTXOsAccordingToAmount(ta.GetPubKeyHash(), amount, 0)
 
 	if err != nil {
 		return nil
@@ -27,7 +27,7 @@ func (txSender *NormalTxSender) Generate(params transaction.SendTxParam) {
 	}
 	ta := account.NewAccountByKey(params.SenderKeyPair)
 
-	prevUtxos, err := txSender.account.GetUtxoIndex().GetUTXOsAccordingToAmount(ta.GetPubKeyHash(), params.Amount)
+	prevUtxos, err := txSender.account.GetUtxoIndex().GetUTXOsAccordingToAmount(ta.GetPubKeyHash(), params.Amount, 0)
 
 	if err != nil {
 		logger.WithError(err).Panic("NormalTx: Unable to get UTXOs to match the amount")
@@ -6,7 +6,6 @@ import (
 	"github.com/dappley/go-dappley/core/transaction"
 	"github.com/dappley/go-dappley/core/transactionbase"
 	"github.com/dappley/go-dappley/core/utxo"
-	"github.com/dappley/go-dappley/logic/ltransaction"
 	logger "github.com/sirupsen/logrus"
 	"time"
 )
@@ -14,7 +13,7 @@ import (
 func NewTransaction(prevUtxos []*utxo.UTXO, vouts []transactionbase.TXOutput, tip *common.Amount, senderKeyPair *account.KeyPair) *transaction.Transaction {
 	tx := &transaction.Transaction{
 		nil,
-		prepareInputLists(prevUtxos, senderKeyPair.GetPublicKey(), nil),
+		prepareInputLists(prevUtxos, senderKeyPair, nil),
 		vouts,
 		tip,
 		common.NewAmount(0),
@@ -22,19 +21,26 @@ func NewTransaction(prevUtxos []*utxo.UTXO, vouts []transactionbase.TXOutput, ti
 		time.Now().UnixNano() / 1e6, transaction.TxTypeDefault}
 	tx.ID = tx.Hash()
 
-	err := ltransaction.NewTxDecorator(tx).Sign(senderKeyPair.GetPrivateKey(), prevUtxos)
+	adaptedTx := transaction.NewTxAdapter(tx)
+	err := adaptedTx.SignWithKeyPair(senderKeyPair, prevUtxos)
 	if err != nil {
 		logger.Panic("Sign transaction failed. Terminating...")
 	}
 	return tx
 }
 
-func prepareInputLists(utxos []*utxo.UTXO, publicKey []byte, signature []byte) []transactionbase.TXInput {
+func prepareInputLists(utxos []*utxo.UTXO, senderKeyPair *account.KeyPair, signature []byte) []transactionbase.TXInput {
 	var inputs []transactionbase.TXInput
 
 	// Build a list of inputs
 	for _, utxo := range utxos {
-		input := transactionbase.TXInput{utxo.Txid, utxo.TxIndex, signature, publicKey}
+		input := transactionbase.TXInput{
+			Txid:      utxo.Txid,
+			Vout:      utxo.TxIndex,
+			Signature: signature,
+			PubKey:    senderKeyPair.GetPublicKey(),
+			Algorithm: senderKeyPair.GetAlgorithm(),
+		}
 		inputs = append(inputs, input)
 	}
 
@@ -8,15 +8,44 @@ import (
 	logger "github.com/sirupsen/logrus"
 
 	"github.com/dappley/go-dappley/core/account"
-	"github.com/dappley/go-dappley/crypto/keystore/secp256k1"
+	"github.com/dappley/go-dappley/crypto"
+	"github.com/dappley/go-dappley/crypto/keystore"
+	errval "github.com/dappley/go-dappley/errors"
 )
 
+const (
+	secp256k1PubKeyLength = 64 // a secp256k1 key without the uncompressed point prefix
+	prefixedPubKeyLength  = 33 // an ed25519 or schnorr key prefixed with its algorithm byte
+)
+
+//decodeContractPublicKey splits a public key passed by a contract into its signature algorithm and key. A 64-byte key
+//is a secp256k1 key, the key of any other algorithm is prefixed with the algorithm byte
+func decodeContractPublicKey(pubKeyBytes []byte) (keystore.Algorithm, []byte, error) {
+	if len(pubKeyBytes) == secp256k1PubKeyLength {
+		return keystore.SECP256K1, pubKeyBytes, nil
+	}
+	if len(pubKeyBytes) != prefixedPubKeyLength {
+		return 0, nil, errval.IncorrectPublicKey
+	}
+	alg := keystore.Algorithm(pubKeyBytes[0])
+	if alg == keystore.SECP256K1 || crypto.CheckAlgorithm(alg) != nil {
+		return 0, nil, errval.AlgorithmInvalid
+	}
+	return alg, pubKeyBytes[1:], nil
+}
+
 //export VerifySignatureFunc
 func VerifySignatureFunc(msg, pubkey, sig *C.char) bool {
-	goMsg := C.GoString(msg)
-	goPubkey := C.GoString(pubkey)
-	goSig := C.GoString(sig)
+	return verifySignature(C.GoString(msg), C.GoString(pubkey), C.GoString(sig))
+}
 
+//export VerifyPublicKeyFunc
+func VerifyPublicKeyFunc(addr, pubkey *C.char) bool {
+	return verifyPublicKey(C.GoString(addr), C.GoString(pubkey))
+}
+
+//verifySignature verifies the hex signature of the message with the hex public key of any signature algorithm
+func verifySignature(goMsg, goPubkey, goSig string) bool {
 	data := sha256.Sum256([]byte(goMsg))
 
 	sigBytes, err := hex.DecodeString(goSig)
@@ -37,11 +66,19 @@ func VerifySignatureFunc(msg, pubkey, sig *C.char) bool {
 		return false
 	}
 
-	originPub := make([]byte, 1+len(pubKeyBytes))
-	originPub[0] = 4 // uncompressed point
-	copy(originPub[1:], pubKeyBytes)
+	alg, originPub, err := decodeContractPublicKey(pubKeyBytes)
+	if err != nil {
+		logger.WithError(err).WithFields(logger.Fields{
+			"content":    goMsg,
+			"public_key": pubKeyBytes,
+		}).Debug("SmartContract: failed to decode public key.")
+		return false
+	}
+	if alg == keystore.SECP256K1 {
+		originPub = append([]byte{4}, originPub...) // uncompressed point
+	}
 
-	res, err := secp256k1.Verify(data[:], sigBytes, originPub)
+	res, err := crypto.Verify(alg, data[:], sigBytes, originPub)
 	if err != nil {
 		logger.WithError(err).WithFields(logger.Fields{
 			"content":    goMsg,
@@ -54,11 +91,8 @@ func VerifySignatureFunc(msg, pubkey, sig *C.char) bool {
 	return res
 }
 
-//export VerifyPublicKeyFunc
-func VerifyPublicKeyFunc(addr, pubkey *C.char) bool {
-	goAddr := C.GoString(addr)
-	goPubkey := C.GoString(pubkey)
-
+//verifyPublicKey returns if the hex public key is the key of the address
+func verifyPublicKey(goAddr, goPubkey string) bool {
 	pubKeyBytes, err := hex.DecodeString(goPubkey)
 	if err != nil {
 		logger.WithError(err).WithFields(logger.Fields{
@@ -77,7 +111,20 @@ func VerifyPublicKeyFunc(addr, pubkey *C.char) bool {
 
 	}
 
-	ta := account.NewTransactionAccountByPubKey(pubKeyBytes)
+	//a key without the algorithm prefix is of the algorithm of the address
+	alg, err := account.NewTransactionAccountByAddress(account.NewAddress(goAddr)).GetPubKeyHash().GetAlgorithm()
+	if err != nil {
+		return false
+	}
+	if len(pubKeyBytes) == prefixedPubKeyLength {
+		keyAlg, key, err := decodeContractPublicKey(pubKeyBytes)
+		if err != nil || keyAlg != alg {
+			return false
+		}
+		pubKeyBytes = key
+	}
+
+	ta := account.NewTransactionAccountByPubKeyWithAlgorithm(pubKeyBytes, alg)
 
 	return ta.GetAddress().String() == goAddr
 }
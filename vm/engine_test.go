@@ -21,6 +21,7 @@ import (
 
 	"github.com/dappley/go-dappley/common"
 	"github.com/dappley/go-dappley/core"
+	"github.com/dappley/go-dappley/crypto/keystore"
 	"github.com/dappley/go-dappley/crypto/keystore/secp256k1"
 	"github.com/stretchr/testify/assert"
 )
@@ -411,6 +412,30 @@ func TestCrypto_VerifyPublicKey(t *testing.T) {
 	)
 }
 
+func TestCrypto_VerifyEd25519(t *testing.T) {
+	acc := account.NewAccountWithAlgorithm(keystore.ED25519)
+	msg := "hello world dappley"
+	data := sha256.Sum256([]byte(msg))
+	signature, err := acc.GetKeyPair().Sign(data[:])
+	assert.Nil(t, err)
+	sig := hex.EncodeToString(signature)
+	pubKey := hex.EncodeToString(acc.GetKeyPair().GetPublicKey())
+	prefixedPubKey := hex.EncodeToString(append([]byte{byte(keystore.ED25519)}, acc.GetKeyPair().GetPublicKey()...))
+	schnorrPubKey := hex.EncodeToString(append([]byte{byte(keystore.SCHNORR)}, acc.GetKeyPair().GetPublicKey()...))
+
+	assert.True(t, verifySignature(msg, prefixedPubKey, sig))
+	assert.False(t, verifySignature("another message", prefixedPubKey, sig))
+	//the key must name its algorithm
+	assert.False(t, verifySignature(msg, pubKey, sig))
+	assert.False(t, verifySignature(msg, schnorrPubKey, sig))
+
+	//the public key is checked with the algorithm of the address, with or without the prefix
+	assert.True(t, verifyPublicKey(acc.GetAddress().String(), pubKey))
+	assert.True(t, verifyPublicKey(acc.GetAddress().String(), prefixedPubKey))
+	assert.False(t, verifyPublicKey(acc.GetAddress().String(), schnorrPubKey))
+	assert.False(t, verifyPublicKey(account.NewAccount().GetAddress().String(), pubKey))
+}
+
 func TestMath(t *testing.T) {
 	script, _ := ioutil.ReadFile("test/test_math.js")
 